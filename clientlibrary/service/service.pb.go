@@ -25,346 +25,358 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 5424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9d, 0x5f, 0x6f, 0x24, 0x49,
-	0x52, 0xc0, 0xcf, 0x2f, 0x2c, 0xd4, 0x71, 0x0b, 0xf4, 0xc2, 0xb2, 0xb7, 0xdc, 0xcd, 0xcc, 0xce,
-	0xce, 0xd8, 0x33, 0x63, 0xbb, 0x3d, 0x3b, 0xb3, 0x7f, 0x4e, 0x77, 0x48, 0xa8, 0xc7, 0x1e, 0x7b,
-	0x7d, 0x67, 0x7b, 0x8c, 0xbb, 0x3d, 0x23, 0xad, 0x84, 0x44, 0xb9, 0x2b, 0xdd, 0x2e, 0x5c, 0x5d,
-	0x59, 0x57, 0x95, 0xdd, 0x9e, 0x3e, 0x04, 0x02, 0x81, 0x40, 0x20, 0x10, 0x27, 0xfe, 0xbd, 0x22,
-	0xf1, 0x31, 0xf8, 0x04, 0x3c, 0xde, 0x23, 0x8f, 0x68, 0xf7, 0x0b, 0xf0, 0x11, 0x50, 0x65, 0x66,
-	0xe5, 0x9f, 0xa8, 0x88, 0xac, 0xf2, 0x3d, 0xcd, 0xa8, 0xe3, 0x17, 0x11, 0xf9, 0x37, 0x32, 0x32,
-	0x2b, 0xab, 0x1c, 0xdd, 0x2d, 0x2e, 0x76, 0x8a, 0x92, 0x0b, 0x5e, 0xed, 0x54, 0xac, 0x5c, 0xa6,
-	0x53, 0xd6, 0xfc, 0x3b, 0x94, 0x3f, 0x0f, 0xde, 0x89, 0xf3, 0x95, 0x58, 0x15, 0xec, 0xc3, 0x0f,
-	0x2c, 0x39, 0xe5, 0xf3, 0x79, 0x9c, 0x27, 0x95, 0x42, 0x3e, 0x7c, 0xdf, 0x4a, 0xd8, 0x92, 0xe5,
-	0x42, 0xff, 0xfe, 0xec, 0xbf, 0xfe, 0x6f, 0x2d, 0x7a, 0x77, 0x37, 0x4b, 0x59, 0x2e, 0x76, 0xb5,
-	0xc6, 0xe0, 0xab, 0xe8, 0x3b, 0xa3, 0xa2, 0x38, 0x60, 0xe2, 0x35, 0x2b, 0xab, 0x94, 0xe7, 0x83,
-	0x8f, 0x87, 0xda, 0xc1, 0xf0, 0xac, 0x98, 0x0e, 0x47, 0x45, 0x31, 0xb4, 0xc2, 0xe1, 0x19, 0xfb,
-	0xe9, 0x82, 0x55, 0xe2, 0xc3, 0x07, 0x61, 0xa8, 0x2a, 0x78, 0x5e, 0xb1, 0xc1, 0x65, 0xf4, 0x5b,
-	0xa3, 0xa2, 0x18, 0x33, 0xb1, 0xc7, 0xea, 0x0a, 0x8c, 0x45, 0x2c, 0xd8, 0x60, 0xa3, 0xa5, 0xea,
-	0x03, 0xc6, 0xc7, 0xa3, 0x6e, 0x50, 0xfb, 0x99, 0x44, 0xdf, 0xae, 0xfd, 0x5c, 0x2d, 0x44, 0xc2,
-	0x6f, 0xf2, 0xc1, 0x47, 0x6d, 0x45, 0x2d, 0x32, 0xb6, 0xef, 0x87, 0x10, 0x6d, 0xf5, 0x4d, 0xf4,
-	0xeb, 0x6f, 0xe2, 0x2c, 0x63, 0x62, 0xb7, 0x64, 0x75, 0xc1, 0x7d, 0x1d, 0x25, 0x1a, 0x2a, 0x99,
-	0xb1, 0xfb, 0x71, 0x90, 0xd1, 0x86, 0xbf, 0x8a, 0xbe, 0xa3, 0x24, 0x67, 0x6c, 0xca, 0x97, 0xac,
-	0x1c, 0xa0, 0x5a, 0x5a, 0x48, 0x34, 0x79, 0x0b, 0x82, 0xb6, 0x77, 0x79, 0xbe, 0x64, 0xa5, 0xc0,
-	0x6d, 0x6b, 0x61, 0xd8, 0xb6, 0x85, 0xb4, 0xed, 0xbf, 0x5b, 0x8b, 0xbe, 0x37, 0x9a, 0x4e, 0xf9,
-	0x22, 0x17, 0x47, 0x7c, 0x1a, 0x67, 0x47, 0x69, 0x7e, 0x7d, 0xc2, 0x6e, 0x76, 0xaf, 0x6a, 0x3e,
-	0x9f, 0xb1, 0xc1, 0x73, 0xbf, 0x55, 0x15, 0x3a, 0x34, 0xec, 0xd0, 0x85, 0x8d, 0xef, 0x4f, 0x6f,
-	0xa7, 0xa4, 0xcb, 0xf2, 0x4f, 0x6b, 0xd1, 0x1d, 0x58, 0x96, 0x31, 0xcf, 0x96, 0xcc, 0x96, 0xe6,
-	0xb3, 0x0e, 0xc3, 0x3e, 0x6e, 0xca, 0xf3, 0xf9, 0x6d, 0xd5, 0x74, 0x89, 0xb2, 0xe8, 0x3d, 0x77,
-	0xb8, 0x8c, 0x59, 0x25, 0xa7, 0xd3, 0x63, 0x7a, 0x44, 0x68, 0xc4, 0x78, 0x7e, 0xd2, 0x07, 0xd5,
-	0xde, 0xd2, 0x68, 0xa0, 0xbd, 0x65, 0xbc, 0x32, 0xce, 0x1e, 0xa1, 0x16, 0x1c, 0xc2, 0xf8, 0x7a,
-	0xdc, 0x83, 0xd4, 0xae, 0xfe, 0x38, 0xfa, 0x8d, 0x37, 0xbc, 0xbc, 0xae, 0x8a, 0x78, 0xca, 0xf4,
-	0x54, 0x78, 0xe8, 0x6b, 0x37, 0x52, 0x38, 0x1b, 0xd6, 0xbb, 0x30, 0x67, 0xd0, 0x36, 0xc2, 0x57,
-	0x05, 0x83, 0x31, 0xc8, 0x2a, 0xd6, 0x42, 0x6a, 0xd0, 0x42, 0x48, 0xdb, 0xbe, 0x8e, 0x06, 0xd6,
-	0xf6, 0xc5, 0x9f, 0xb0, 0xa9, 0x18, 0x25, 0x09, 0xec, 0x15, 0xab, 0x2b, 0x89, 0xe1, 0x28, 0x49,
-	0xa8, 0x5e, 0xc1, 0x51, 0xed, 0xec, 0x26, 0x7a, 0x1f, 0x38, 0x3b, 0x4a, 0x2b, 0xe9, 0x70, 0x3b,
-	0x6c, 0x45, 0x63, 0xc6, 0xe9, 0xb0, 0x2f, 0xae, 0x1d, 0xff, 0xc5, 0x5a, 0xf4, 0x5d, 0xc4, 0xf3,
-	0x19, 0x9b, 0xf3, 0x25, 0x1b, 0x3c, 0xed, 0xb6, 0xa6, 0x48, 0xe3, 0xff, 0x93, 0x5b, 0x68, 0x20,
-	0xc3, 0x64, 0xcc, 0x32, 0x36, 0x15, 0xe4, 0x30, 0x51, 0xe2, 0xce, 0x61, 0x62, 0x30, 0x67, 0x86,
-	0x35, 0xc2, 0x03, 0x26, 0x76, 0x17, 0x65, 0xc9, 0x72, 0x41, 0xf6, 0xa5, 0x45, 0x3a, 0xfb, 0xd2,
-	0x43, 0x91, 0xfa, 0x1c, 0x30, 0x31, 0xca, 0x32, 0xb2, 0x3e, 0x4a, 0xdc, 0x59, 0x1f, 0x83, 0x69,
-	0x0f, 0xd3, 0xe8, 0x37, 0x9d, 0x16, 0x13, 0x87, 0xf9, 0x25, 0x1f, 0xd0, 0x6d, 0x21, 0xe5, 0xc6,
-	0xc7, 0x46, 0x27, 0x87, 0x54, 0xe3, 0xe5, 0xdb, 0x82, 0x97, 0x74, 0xb7, 0x28, 0x71, 0x67, 0x35,
-	0x0c, 0xa6, 0x3d, 0xfc, 0x51, 0xf4, 0xae, 0x8e, 0x92, 0xcd, 0x7a, 0xf6, 0x00, 0x0d, 0xa1, 0x70,
-	0x41, 0x7b, 0xd8, 0x41, 0xb5, 0xcc, 0x1f, 0xa7, 0xb3, 0xb2, 0x8e, 0x3e, 0xb8, 0x79, 0x2d, 0xed,
-	0x30, 0x6f, 0x29, 0x6d, 0x9e, 0x47, 0xbf, 0xed, 0x9b, 0xdf, 0x8d, 0xf3, 0x29, 0xcb, 0x06, 0x4f,
-	0x42, 0xea, 0x8a, 0x31, 0xae, 0x36, 0x7b, 0xb1, 0x36, 0xd8, 0x69, 0x42, 0x07, 0xd3, 0x8f, 0x51,
-	0x6d, 0x10, 0x4a, 0x1f, 0x84, 0xa1, 0x96, 0xed, 0x3d, 0x96, 0x31, 0xd2, 0xb6, 0x12, 0x76, 0xd8,
-	0x36, 0x90, 0xb6, 0x5d, 0x46, 0xbf, 0x63, 0xba, 0xb9, 0xce, 0x0b, 0xa4, 0xbc, 0x5e, 0x74, 0x36,
-	0x89, 0x7e, 0x74, 0x21, 0xe3, 0x6b, 0xab, 0x1f, 0xdc, 0xaa, 0x8f, 0x8e, 0x28, 0x78, 0x7d, 0x40,
-	0x3c, 0x79, 0x10, 0x86, 0xb4, 0xed, 0xbf, 0x5f, 0x8b, 0xbe, 0xaf, 0x65, 0x2f, 0xf3, 0xf8, 0x22,
-	0x63, 0x72, 0x89, 0x3f, 0x61, 0xe2, 0x86, 0x97, 0xd7, 0xe3, 0x55, 0x3e, 0x25, 0xd2, 0x19, 0x1c,
-	0xee, 0x48, 0x67, 0x48, 0x25, 0x5d, 0x98, 0x3f, 0x8d, 0x3e, 0x68, 0x06, 0xc5, 0x55, 0x9c, 0xcf,
-	0xd8, 0x8f, 0x2b, 0x9e, 0x8f, 0x8a, 0x74, 0x94, 0x24, 0xe5, 0x60, 0x88, 0x77, 0x3d, 0xe4, 0x4c,
-	0x09, 0x76, 0x7a, 0xf3, 0x4e, 0xfa, 0xac, 0x5b, 0x59, 0xf0, 0x02, 0xa6, 0xcf, 0x4d, 0xf3, 0x09,
-	0x5e, 0x50, 0xe9, 0xb3, 0x8f, 0xb4, 0xac, 0x1e, 0xd7, 0x6b, 0x10, 0x6e, 0xf5, 0xd8, 0x5d, 0x74,
-	0xee, 0x87, 0x10, 0xbb, 0x06, 0x34, 0x0d, 0xc5, 0xf3, 0xcb, 0x74, 0x76, 0x5e, 0x24, 0xf5, 0x1c,
-	0x7a, 0x8c, 0xd7, 0xd9, 0x41, 0x88, 0x35, 0x80, 0x40, 0xb5, 0xb7, 0x7f, 0xb4, 0x59, 0xa6, 0x8e,
-	0x4b, 0xfb, 0x25, 0x9f, 0x1f, 0xb1, 0x59, 0x3c, 0x5d, 0xe9, 0x60, 0xfa, 0x69, 0x28, 0x8a, 0x41,
-	0xda, 0x14, 0xe2, 0xb3, 0x5b, 0x6a, 0xe9, 0xf2, 0xfc, 0xc7, 0x5a, 0xf4, 0xc0, 0x1b, 0x27, 0x7a,
-	0x30, 0xa9, 0xd2, 0x8f, 0xf2, 0xe4, 0x8c, 0x55, 0x22, 0x2e, 0xc5, 0xe0, 0x87, 0x81, 0x31, 0x40,
-	0xe8, 0x98, 0xb2, 0xfd, 0xe8, 0x97, 0xd2, 0xb5, 0xbd, 0x3e, 0xae, 0x57, 0x09, 0x1d, 0x7f, 0xfc,
-	0x5e, 0x97, 0x12, 0x18, 0x7d, 0xee, 0x87, 0x10, 0xdb, 0xeb, 0x52, 0x70, 0x98, 0x2f, 0x53, 0xc1,
-	0x0e, 0x58, 0xce, 0xca, 0x76, 0xaf, 0x2b, 0x55, 0x1f, 0x21, 0x7a, 0x9d, 0x40, 0x6d, 0xa4, 0xf3,
-	0xbc, 0x99, 0x4c, 0x63, 0x33, 0x60, 0xa4, 0x95, 0x6b, 0x6c, 0xf5, 0x83, 0xed, 0x56, 0xd9, 0xf1,
-	0x79, 0xc6, 0x96, 0xfc, 0x1a, 0x6e, 0x95, 0x5d, 0x13, 0x0a, 0x20, 0xb6, 0xca, 0x28, 0x68, 0xd3,
-	0x01, 0xc7, 0xcf, 0xeb, 0x94, 0xdd, 0x80, 0x74, 0xc0, 0x55, 0xae, 0xc5, 0x44, 0x3a, 0x80, 0x60,
-	0xda, 0xc3, 0x49, 0xf4, 0x6b, 0x52, 0xf8, 0x63, 0x9e, 0xe6, 0x83, 0xbb, 0x88, 0x52, 0x2d, 0x30,
-	0x56, 0xef, 0xd1, 0x00, 0x28, 0x71, 0xfd, 0xab, 0x5e, 0x9b, 0x1f, 0x12, 0x4a, 0x60, 0x59, 0x5e,
-	0xef, 0xc2, 0x6c, 0x1e, 0x26, 0x85, 0x75, 0xfc, 0x1a, 0x5f, 0xc5, 0x65, 0x9a, 0xcf, 0x06, 0x98,
-	0xae, 0x23, 0x27, 0xf2, 0x30, 0x8c, 0x03, 0x43, 0x58, 0x2b, 0x8e, 0x8a, 0xa2, 0xac, 0xc3, 0x22,
-	0x36, 0x84, 0x7d, 0x24, 0x38, 0x84, 0x5b, 0x28, 0xee, 0x6d, 0x8f, 0x4d, 0xb3, 0x34, 0x0f, 0x7a,
-	0xd3, 0x48, 0x1f, 0x6f, 0x16, 0x05, 0x83, 0xf7, 0x88, 0xc5, 0x4b, 0xd6, 0xd4, 0x0c, 0x6b, 0x19,
-	0x17, 0x08, 0x0e, 0x5e, 0x00, 0xda, 0x4d, 0xaf, 0x14, 0x1f, 0xc7, 0xd7, 0xac, 0x6e, 0x60, 0x56,
-	0x2f, 0xaa, 0x03, 0x4c, 0xdf, 0x23, 0x88, 0x4d, 0x2f, 0x4e, 0x6a, 0x57, 0x8b, 0xe8, 0x7d, 0x29,
-	0x3f, 0x8d, 0x4b, 0x91, 0x4e, 0xd3, 0x22, 0xce, 0x9b, 0xcd, 0x14, 0x36, 0xaf, 0x5b, 0x94, 0x71,
-	0xb9, 0xdd, 0x93, 0xd6, 0x6e, 0xff, 0x7d, 0x2d, 0xfa, 0x08, 0xfa, 0x3d, 0x65, 0xe5, 0x3c, 0x95,
-	0x7b, 0xf2, 0x4a, 0x05, 0xe1, 0xc1, 0x17, 0x61, 0xa3, 0x2d, 0x05, 0x53, 0x9a, 0x1f, 0xdc, 0x5e,
-	0xd1, 0x66, 0x62, 0x63, 0xbd, 0x4f, 0x79, 0x55, 0x26, 0xad, 0x33, 0xab, 0x71, 0xb3, 0xf9, 0x90,
-	0x42, 0x22, 0x13, 0x6b, 0x41, 0x60, 0x86, 0x9f, 0xe7, 0x55, 0x63, 0x1d, 0x9b, 0xe1, 0x56, 0x1c,
-	0x9c, 0xe1, 0x1e, 0x66, 0x67, 0xf8, 0xe9, 0xe2, 0x22, 0x4b, 0xab, 0xab, 0x34, 0x9f, 0xe9, 0xb4,
-	0xdb, 0xd7, 0xb5, 0x62, 0x98, 0x79, 0x6f, 0x74, 0x72, 0x98, 0x13, 0x3d, 0x58, 0x48, 0x27, 0x60,
-	0x98, 0x6c, 0x74, 0x72, 0x76, 0x37, 0x64, 0xa5, 0xf5, 0x36, 0x1c, 0xec, 0x86, 0x1c, 0xd5, 0x5a,
-	0x4a, 0xec, 0x86, 0xda, 0x94, 0xdd, 0x0d, 0xb9, 0x75, 0xa8, 0x78, 0xb6, 0x64, 0xe7, 0x65, 0x0a,
-	0x76, 0x43, 0x5e, 0xf9, 0x1a, 0x86, 0xd8, 0x0d, 0x51, 0xac, 0x0d, 0x54, 0x96, 0x38, 0x60, 0x62,
-	0x2c, 0x62, 0xb1, 0xa8, 0x40, 0xa0, 0x72, 0x6c, 0x18, 0x84, 0x08, 0x54, 0x04, 0xaa, 0xbd, 0xfd,
-	0x61, 0x14, 0xa9, 0x13, 0x0c, 0x79, 0xca, 0xe4, 0xaf, 0x3d, 0xfa, 0x68, 0xc3, 0x3b, 0x62, 0xfa,
-	0x28, 0x40, 0xd8, 0x84, 0x47, 0xfd, 0x2e, 0x0f, 0xcf, 0x06, 0xa8, 0x86, 0x14, 0x11, 0x09, 0x0f,
-	0x40, 0x60, 0x41, 0xc7, 0x57, 0xfc, 0x06, 0x2f, 0x68, 0x2d, 0x09, 0x17, 0x54, 0x13, 0xf6, 0x38,
-	0x5b, 0x17, 0x14, 0x3b, 0xce, 0x6e, 0x8a, 0x11, 0x3a, 0xce, 0x86, 0x8c, 0x1d, 0x33, 0xae, 0xe1,
-	0x17, 0x9c, 0x5f, 0xcf, 0xe3, 0xf2, 0x1a, 0x8c, 0x19, 0x4f, 0xb9, 0x61, 0x88, 0x31, 0x43, 0xb1,
-	0x76, 0xcc, 0xb8, 0x0e, 0xeb, 0x74, 0xf9, 0xbc, 0xcc, 0xc0, 0x98, 0xf1, 0x6c, 0x68, 0x84, 0x18,
-	0x33, 0x04, 0x6a, 0xa3, 0x93, 0xeb, 0x6d, 0xcc, 0xe0, 0x01, 0x8a, 0xa7, 0x3e, 0x66, 0xd4, 0x01,
-	0x0a, 0x82, 0xc1, 0x21, 0x74, 0x50, 0xc6, 0xc5, 0x15, 0x3e, 0x84, 0xa4, 0x28, 0x3c, 0x84, 0x1a,
-	0x04, 0xf6, 0xf7, 0x98, 0xc5, 0xe5, 0xf4, 0x0a, 0xef, 0x6f, 0x25, 0x0b, 0xf7, 0xb7, 0x61, 0x60,
-	0x7f, 0x2b, 0xc1, 0x9b, 0x54, 0x5c, 0x1d, 0x33, 0x11, 0xe3, 0xfd, 0xed, 0x33, 0xe1, 0xfe, 0x6e,
-	0xb1, 0x36, 0x1f, 0x77, 0x1d, 0x8e, 0x17, 0x17, 0xd5, 0xb4, 0x4c, 0x2f, 0xd8, 0x20, 0x60, 0xc5,
-	0x40, 0x44, 0x3e, 0x4e, 0xc2, 0xda, 0xe7, 0xcf, 0xd7, 0xa2, 0xbb, 0x4d, 0xb7, 0xf3, 0xaa, 0xd2,
-	0x6b, 0x9f, 0xef, 0xfe, 0x33, 0xbc, 0x7f, 0x09, 0x9c, 0x78, 0xc0, 0xd0, 0x43, 0xcd, 0xc9, 0x0d,
-	0xf0, 0x22, 0x9d, 0xe7, 0x95, 0x29, 0xd4, 0x17, 0x7d, 0xac, 0x3b, 0x0a, 0x44, 0x6e, 0xd0, 0x4b,
-	0xd1, 0xa6, 0x65, 0xba, 0x7f, 0x1a, 0xd9, 0x61, 0x52, 0x81, 0xb4, 0xac, 0x69, 0x6f, 0x87, 0x20,
-	0xd2, 0x32, 0x9c, 0x84, 0x43, 0xe1, 0xa0, 0xe4, 0x8b, 0xa2, 0xea, 0x18, 0x0a, 0x00, 0x0a, 0x0f,
-	0x85, 0x36, 0xac, 0x7d, 0xbe, 0x8d, 0x7e, 0xd7, 0x1d, 0x7e, 0x6e, 0x63, 0x6f, 0xd3, 0x63, 0x0a,
-	0x6b, 0xe2, 0x61, 0x5f, 0xdc, 0x66, 0x14, 0x8d, 0x67, 0xb1, 0xc7, 0x44, 0x9c, 0x66, 0xd5, 0x60,
-	0x1d, 0xb7, 0xd1, 0xc8, 0x89, 0x8c, 0x02, 0xe3, 0x60, 0x7c, 0xdb, 0x5b, 0x14, 0x59, 0x3a, 0x6d,
-	0x3f, 0xde, 0xd1, 0xba, 0x46, 0x1c, 0x8e, 0x6f, 0x2e, 0x06, 0xe3, 0x75, 0x9d, 0xfa, 0xc9, 0xff,
-	0x4c, 0x56, 0x05, 0xc3, 0xe3, 0xb5, 0x87, 0x84, 0xe3, 0x35, 0x44, 0x61, 0x7d, 0xc6, 0x4c, 0x1c,
-	0xc5, 0x2b, 0xbe, 0x20, 0xe2, 0xb5, 0x11, 0x87, 0xeb, 0xe3, 0x62, 0x76, 0x6f, 0x60, 0x3c, 0x1c,
-	0xe6, 0x82, 0x95, 0x79, 0x9c, 0xed, 0x67, 0xf1, 0xac, 0x1a, 0x10, 0x31, 0xc6, 0xa7, 0x88, 0xbd,
-	0x01, 0x4d, 0x23, 0xcd, 0x78, 0x58, 0xed, 0xc7, 0x4b, 0x5e, 0xa6, 0x82, 0x6e, 0x46, 0x8b, 0x74,
-	0x36, 0xa3, 0x87, 0xa2, 0xde, 0x46, 0xe5, 0xf4, 0x2a, 0x5d, 0xb2, 0x24, 0xe0, 0xad, 0x41, 0x7a,
-	0x78, 0x73, 0x50, 0xa4, 0xd3, 0xc6, 0x7c, 0x51, 0x4e, 0x19, 0xd9, 0x69, 0x4a, 0xdc, 0xd9, 0x69,
-	0x06, 0xd3, 0x1e, 0xfe, 0x7a, 0x2d, 0xfa, 0x3d, 0x25, 0x75, 0x9f, 0xb9, 0xec, 0xc5, 0xd5, 0xd5,
-	0x05, 0x8f, 0xcb, 0x64, 0xf0, 0x09, 0x66, 0x07, 0x45, 0x8d, 0xeb, 0x67, 0xb7, 0x51, 0x81, 0xcd,
-	0x5a, 0xe7, 0xdd, 0x76, 0xc6, 0xa1, 0xcd, 0xea, 0x21, 0xe1, 0x66, 0x85, 0x28, 0x0c, 0x20, 0x52,
-	0xae, 0x8e, 0xe4, 0xd6, 0x49, 0x7d, 0xff, 0x5c, 0x6e, 0xa3, 0x93, 0x83, 0xf1, 0xb1, 0x16, 0xfa,
-	0xa3, 0x65, 0x9b, 0xb2, 0x81, 0x8f, 0x98, 0x61, 0x5f, 0x9c, 0xf4, 0x6c, 0x66, 0x45, 0xd8, 0x73,
-	0x6b, 0x66, 0x0c, 0xfb, 0xe2, 0x84, 0x67, 0x27, 0xac, 0x85, 0x3c, 0x23, 0xa1, 0x6d, 0xd8, 0x17,
-	0x87, 0xd9, 0x97, 0x66, 0x9a, 0x75, 0xe1, 0x49, 0xc0, 0x0e, 0x5c, 0x1b, 0x36, 0x7b, 0xb1, 0xda,
-	0xe1, 0xdf, 0xae, 0x45, 0xdf, 0xb3, 0x1e, 0x8f, 0x79, 0x92, 0x5e, 0xae, 0x14, 0xf4, 0x3a, 0xce,
-	0x16, 0xac, 0x1a, 0x3c, 0xa3, 0xac, 0xb5, 0x59, 0x53, 0x82, 0xe7, 0xb7, 0xd2, 0x81, 0x73, 0x67,
-	0x54, 0x14, 0xd9, 0x6a, 0xc2, 0xe6, 0x45, 0x46, 0xce, 0x1d, 0x0f, 0x09, 0xcf, 0x1d, 0x88, 0xc2,
-	0xac, 0x7c, 0xc2, 0xeb, 0x9c, 0x1f, 0xcd, 0xca, 0xa5, 0x28, 0x9c, 0x95, 0x37, 0x08, 0xcc, 0x95,
-	0x26, 0x7c, 0x97, 0x67, 0x19, 0x9b, 0x8a, 0xf6, 0xbd, 0x0d, 0xa3, 0x69, 0x89, 0x70, 0xae, 0x04,
-	0x48, 0x7b, 0x2a, 0xd7, 0xec, 0x21, 0xe3, 0x92, 0xbd, 0x58, 0x1d, 0xa5, 0xf9, 0xf5, 0x00, 0x4f,
-	0x0b, 0x2c, 0x40, 0x9c, 0xca, 0xa1, 0x20, 0xdc, 0xab, 0x9e, 0xe7, 0x09, 0xc7, 0xf7, 0xaa, 0xb5,
-	0x24, 0xbc, 0x57, 0xd5, 0x04, 0x34, 0x79, 0xc6, 0x28, 0x93, 0xb5, 0x24, 0x6c, 0x52, 0x13, 0x58,
-	0x28, 0xd4, 0xcf, 0x6e, 0xc8, 0x50, 0x08, 0x9e, 0xd6, 0x6c, 0x74, 0x72, 0x70, 0x84, 0x36, 0x9b,
-	0xd6, 0x7d, 0x26, 0xa6, 0x57, 0xf8, 0x08, 0xf5, 0x90, 0xf0, 0x08, 0x85, 0x28, 0xac, 0xd2, 0x84,
-	0x9b, 0x4d, 0xf7, 0x3a, 0x3e, 0x3e, 0x5a, 0x1b, 0xee, 0x8d, 0x4e, 0x0e, 0x6e, 0x23, 0x0f, 0xe7,
-	0xb2, 0xcd, 0xd0, 0x41, 0xae, 0x64, 0xe1, 0x6d, 0xa4, 0x61, 0x60, 0xe9, 0x95, 0x40, 0x9e, 0x65,
-	0xad, 0xd3, 0x8a, 0xde, 0x69, 0xd6, 0x46, 0x27, 0xa7, 0x9d, 0xfc, 0xab, 0xd9, 0xc6, 0x29, 0xe9,
-	0x09, 0xaf, 0xe7, 0xc8, 0xeb, 0x38, 0x4b, 0x93, 0x58, 0xb0, 0x09, 0xbf, 0x66, 0x39, 0xbe, 0x63,
-	0xd2, 0xa5, 0x55, 0xfc, 0xd0, 0x53, 0x08, 0xef, 0x98, 0xc2, 0x8a, 0x70, 0x9c, 0x28, 0xfa, 0xbc,
-	0x62, 0xbb, 0x71, 0x45, 0x44, 0x32, 0x0f, 0x09, 0x8f, 0x13, 0x88, 0xc2, 0x7c, 0x55, 0xc9, 0x5f,
-	0xbe, 0x2d, 0x58, 0x99, 0xb2, 0x7c, 0xca, 0xf0, 0x7c, 0x15, 0x52, 0xe1, 0x7c, 0x15, 0xa1, 0xe1,
-	0x5e, 0x6d, 0x2f, 0x16, 0xec, 0xc5, 0x6a, 0x92, 0xce, 0x59, 0x25, 0xe2, 0x79, 0x81, 0xef, 0xd5,
-	0x00, 0x14, 0xde, 0xab, 0xb5, 0xe1, 0xd6, 0xd1, 0x90, 0x09, 0x88, 0xed, 0xeb, 0x5e, 0x90, 0x08,
-	0x5c, 0xf7, 0x22, 0x50, 0xd8, 0xb0, 0x16, 0x40, 0x1f, 0x12, 0xb4, 0xac, 0x04, 0x1f, 0x12, 0xd0,
-	0x74, 0xeb, 0xc0, 0xcd, 0x30, 0xe3, 0x7a, 0x6a, 0x76, 0x14, 0x7d, 0xec, 0x4e, 0xd1, 0xcd, 0x5e,
-	0x2c, 0x7e, 0xc2, 0x77, 0xc6, 0xb2, 0x58, 0x2e, 0x5b, 0x81, 0x63, 0xb4, 0x86, 0xe9, 0x73, 0xc2,
-	0xe7, 0xb0, 0xda, 0xe1, 0x5f, 0xae, 0x45, 0x1f, 0x62, 0x1e, 0x5f, 0x15, 0xd2, 0xef, 0xd3, 0x6e,
-	0x5b, 0x8a, 0x24, 0xee, 0xb3, 0x85, 0x35, 0xec, 0x95, 0x8c, 0x46, 0x64, 0xaf, 0xbb, 0xe9, 0x02,
-	0xf8, 0x49, 0x9b, 0x29, 0x3f, 0xe4, 0x88, 0x2b, 0x19, 0x21, 0xde, 0xee, 0x87, 0xfc, 0x72, 0x55,
-	0x60, 0x3f, 0x64, 0x6c, 0x68, 0x31, 0xb1, 0x1f, 0x42, 0x30, 0x3b, 0x3b, 0xdd, 0xea, 0xbd, 0x49,
-	0xc5, 0x95, 0xcc, 0xb7, 0xc0, 0xec, 0xf4, 0xca, 0x6a, 0x20, 0x62, 0x76, 0x92, 0x30, 0xcc, 0x48,
-	0x1a, 0xb0, 0x9e, 0x9b, 0x58, 0x2c, 0x37, 0x86, 0xdc, 0x99, 0xf9, 0xa8, 0x1b, 0x84, 0xe3, 0xb5,
-	0x11, 0xeb, 0xad, 0xcf, 0x93, 0x90, 0x05, 0xb0, 0xfd, 0xd9, 0xec, 0xc5, 0x6a, 0x87, 0x7f, 0x1e,
-	0x7d, 0xb7, 0x55, 0xb1, 0x7d, 0x16, 0x8b, 0x45, 0xc9, 0x92, 0xc1, 0x4e, 0x47, 0xb9, 0x1b, 0xd0,
-	0xb8, 0x7e, 0xda, 0x5f, 0xa1, 0x95, 0xa3, 0x37, 0x9c, 0x1a, 0x56, 0xa6, 0x0c, 0xcf, 0x42, 0x26,
-	0x7d, 0x36, 0x98, 0xa3, 0xd3, 0x3a, 0xad, 0x6d, 0xb6, 0x3b, 0xba, 0x46, 0xcb, 0x38, 0xcd, 0xe4,
-	0xc3, 0xda, 0x4f, 0x42, 0x46, 0x3d, 0x34, 0xb8, 0xcd, 0x26, 0x55, 0x5a, 0x91, 0x59, 0xce, 0x71,
-	0x67, 0x7b, 0xb6, 0x45, 0x47, 0x02, 0x64, 0x77, 0xb6, 0xdd, 0x93, 0xd6, 0x6e, 0x45, 0xb3, 0xe4,
-	0xd5, 0x3f, 0xbb, 0x83, 0x1c, 0xf3, 0xaa, 0x55, 0x91, 0x91, 0xbe, 0xdd, 0x93, 0xd6, 0x5e, 0xff,
-	0x2c, 0xfa, 0xa0, 0xed, 0x55, 0x2f, 0x44, 0x3b, 0x9d, 0xa6, 0xc0, 0x5a, 0xf4, 0xb4, 0xbf, 0x82,
-	0xdd, 0xd2, 0x7c, 0x99, 0x56, 0x82, 0x97, 0xab, 0xf1, 0x15, 0xbf, 0x69, 0x5e, 0x23, 0xf1, 0x67,
-	0xab, 0x06, 0x86, 0x0e, 0x41, 0x6c, 0x69, 0x70, 0xb2, 0xe5, 0xca, 0xbe, 0x6e, 0x52, 0x11, 0xae,
-	0x1c, 0xa2, 0xc3, 0x95, 0x4f, 0xda, 0x58, 0xd5, 0xd4, 0xca, 0xbe, 0x1b, 0xb3, 0x81, 0x17, 0xb5,
-	0xfd, 0x7e, 0xcc, 0xa3, 0x6e, 0xd0, 0x66, 0x2c, 0x5a, 0xbc, 0x97, 0x5e, 0x5e, 0x9a, 0x3a, 0xe1,
-	0x25, 0x75, 0x11, 0x22, 0x63, 0x21, 0x50, 0x9b, 0x74, 0xef, 0xa7, 0x19, 0x93, 0x27, 0xfa, 0xaf,
-	0x2e, 0x2f, 0x33, 0x1e, 0x27, 0x20, 0xe9, 0xae, 0xc5, 0x43, 0x57, 0x4e, 0x24, 0xdd, 0x18, 0x67,
-	0xef, 0x0a, 0xd4, 0xd2, 0x33, 0x36, 0xe5, 0xf9, 0x34, 0xcd, 0xe0, 0x2d, 0x54, 0xa9, 0x69, 0x84,
-	0xc4, 0x5d, 0x81, 0x16, 0x64, 0x17, 0xc6, 0x5a, 0x54, 0x4f, 0xfb, 0xa6, 0xfc, 0x0f, 0xdb, 0x8a,
-	0x8e, 0x98, 0x58, 0x18, 0x11, 0xcc, 0xee, 0x3d, 0x6b, 0xe1, 0x79, 0x21, 0x8d, 0xdf, 0x6b, 0x6b,
-	0x29, 0x09, 0xb1, 0xf7, 0xf4, 0x09, 0xbb, 0x87, 0xaa, 0x7f, 0xdf, 0xe3, 0x37, 0xb9, 0x34, 0x7a,
-	0xbf, 0xad, 0xd2, 0xc8, 0x88, 0x3d, 0x14, 0x64, 0xb4, 0xe1, 0x9f, 0x44, 0xbf, 0x2a, 0x0d, 0x97,
-	0xbc, 0x18, 0xdc, 0x41, 0x14, 0x4a, 0xe7, 0xce, 0xe6, 0x5d, 0x52, 0x6e, 0xaf, 0x16, 0x98, 0xb1,
-	0x71, 0x5e, 0xc5, 0x33, 0x78, 0xd1, 0xda, 0xf6, 0xb8, 0x94, 0x12, 0x57, 0x0b, 0xda, 0x94, 0x3f,
-	0x2a, 0x4e, 0x78, 0xa2, 0xad, 0x23, 0x35, 0x34, 0xc2, 0xd0, 0xa8, 0x70, 0x21, 0x9b, 0xcc, 0x9c,
-	0xc4, 0xcb, 0x74, 0x66, 0x16, 0x1c, 0x15, 0xb7, 0x2a, 0x90, 0xcc, 0x58, 0x66, 0xe8, 0x40, 0x44,
-	0x32, 0x43, 0xc2, 0xda, 0xe7, 0xbf, 0xac, 0x45, 0xf7, 0x2c, 0x73, 0xd0, 0x9c, 0xd6, 0x1d, 0xe6,
-	0x97, 0xbc, 0x4e, 0x7d, 0x8e, 0xd2, 0xfc, 0xba, 0x1a, 0x7c, 0x4e, 0x99, 0xc4, 0x79, 0x53, 0x94,
-	0x2f, 0x6e, 0xad, 0x67, 0xb3, 0xd6, 0xe6, 0x28, 0xcb, 0x3e, 0xcf, 0x56, 0x1a, 0x20, 0x6b, 0x35,
-	0x27, 0x5e, 0x90, 0x23, 0xb2, 0xd6, 0x10, 0x6f, 0xbb, 0xd8, 0x38, 0xcf, 0x78, 0x0e, 0xbb, 0xd8,
-	0x5a, 0xa8, 0x85, 0x44, 0x17, 0xb7, 0x20, 0x1b, 0x8f, 0x1b, 0x91, 0x3a, 0x75, 0x19, 0x65, 0x19,
-	0x88, 0xc7, 0x46, 0xd5, 0x00, 0x44, 0x3c, 0x46, 0x41, 0xed, 0xe7, 0x2c, 0xfa, 0x76, 0xdd, 0xa4,
-	0xa7, 0x25, 0x5b, 0xa6, 0x0c, 0x5e, 0xbd, 0x70, 0x24, 0xc4, 0xfc, 0xf7, 0x09, 0x3b, 0xb3, 0xce,
-	0xf3, 0xaa, 0xc8, 0xe2, 0xea, 0x4a, 0x3f, 0x8c, 0xf7, 0xeb, 0xdc, 0x08, 0xe1, 0xe3, 0xf8, 0x87,
-	0x1d, 0x94, 0x0d, 0xea, 0x8d, 0xcc, 0x84, 0x98, 0x75, 0x5c, 0xb5, 0x15, 0x66, 0x36, 0x3a, 0x39,
-	0x7b, 0xe2, 0x7d, 0x10, 0x67, 0x19, 0x2b, 0x57, 0x8d, 0xec, 0x38, 0xce, 0xd3, 0x4b, 0x56, 0x09,
-	0x70, 0xe2, 0xad, 0xa9, 0x21, 0xc4, 0x88, 0x13, 0xef, 0x00, 0x6e, 0xb3, 0x79, 0xe0, 0xf9, 0x30,
-	0x4f, 0xd8, 0x5b, 0x90, 0xcd, 0x43, 0x3b, 0x92, 0x21, 0xb2, 0x79, 0x8a, 0xb5, 0x27, 0xbf, 0x2f,
-	0x32, 0x3e, 0xbd, 0xd6, 0x4b, 0x80, 0xdf, 0xc1, 0x52, 0x02, 0xd7, 0x80, 0xfb, 0x21, 0xc4, 0x2e,
-	0x02, 0x52, 0x70, 0xc6, 0x8a, 0x2c, 0x9e, 0xc2, 0xfb, 0x37, 0x4a, 0x47, 0xcb, 0x88, 0x45, 0x00,
-	0x32, 0xa0, 0xb8, 0xfa, 0x5e, 0x0f, 0x56, 0x5c, 0x70, 0xad, 0xe7, 0x7e, 0x08, 0xb1, 0xcb, 0xa0,
-	0x14, 0x8c, 0x8b, 0x2c, 0x15, 0x60, 0x1a, 0x28, 0x0d, 0x29, 0x21, 0xa6, 0x81, 0x4f, 0x00, 0x93,
-	0xc7, 0xac, 0x9c, 0x31, 0xd4, 0xa4, 0x94, 0x04, 0x4d, 0x36, 0x84, 0xbd, 0x6c, 0xac, 0xea, 0xce,
-	0x8b, 0x15, 0xb8, 0x6c, 0xac, 0xab, 0xc5, 0x8b, 0x15, 0x71, 0xd9, 0xd8, 0x03, 0x40, 0x11, 0x4f,
-	0xe3, 0x4a, 0xe0, 0x45, 0x94, 0x92, 0x60, 0x11, 0x1b, 0xc2, 0xae, 0xd1, 0xaa, 0x88, 0x0b, 0x01,
-	0xd6, 0x68, 0x5d, 0x00, 0xe7, 0x09, 0xf4, 0x5d, 0x52, 0x6e, 0x23, 0x89, 0xea, 0x15, 0x26, 0xf6,
-	0x53, 0x96, 0x25, 0x15, 0x88, 0x24, 0xba, 0xdd, 0x1b, 0x29, 0x11, 0x49, 0xda, 0x14, 0x18, 0x4a,
-	0xfa, 0x7c, 0x1c, 0xab, 0x1d, 0x38, 0x1a, 0xbf, 0x1f, 0x42, 0x6c, 0x7c, 0x6a, 0x0a, 0xbd, 0x1b,
-	0x97, 0x65, 0x5a, 0x2f, 0xfe, 0xeb, 0x78, 0x81, 0x1a, 0x39, 0x11, 0x9f, 0x30, 0x0e, 0x4c, 0xaf,
-	0x26, 0x70, 0x63, 0x05, 0x83, 0xa1, 0xfb, 0xe3, 0x20, 0x63, 0x33, 0x4e, 0x29, 0x71, 0x1e, 0xa1,
-	0x62, 0xad, 0x89, 0x3c, 0x41, 0x5d, 0xef, 0xc2, 0x9c, 0x37, 0x91, 0x8c, 0x8b, 0x63, 0xbe, 0x64,
-	0x13, 0xfe, 0xf2, 0x6d, 0x5a, 0x89, 0x34, 0x9f, 0xe9, 0x95, 0xfb, 0x39, 0x61, 0x09, 0x83, 0x89,
-	0x37, 0x91, 0x3a, 0x95, 0x6c, 0x02, 0x01, 0xca, 0x72, 0xc2, 0x6e, 0xd0, 0x04, 0x02, 0x5a, 0x34,
-	0x1c, 0x91, 0x40, 0x84, 0x78, 0x7b, 0x8e, 0x62, 0x9c, 0xeb, 0xd7, 0xcf, 0x27, 0xbc, 0xc9, 0xe5,
-	0x28, 0x6b, 0x10, 0x24, 0xb6, 0xb2, 0x41, 0x05, 0xbb, 0xbf, 0x34, 0xfe, 0xed, 0x14, 0x7b, 0x44,
-	0xd8, 0x69, 0x4f, 0xb3, 0xc7, 0x3d, 0x48, 0xc4, 0x95, 0xbd, 0x07, 0x40, 0xb9, 0x6a, 0x5f, 0x03,
-	0x78, 0xdc, 0x83, 0x74, 0xce, 0x64, 0xdc, 0x6a, 0xbd, 0x88, 0xa7, 0xd7, 0xb3, 0x92, 0x2f, 0xf2,
-	0x64, 0x97, 0x67, 0xbc, 0x04, 0x67, 0x32, 0x5e, 0xa9, 0x01, 0x4a, 0x9c, 0xc9, 0x74, 0xa8, 0xd8,
-	0x0c, 0xce, 0x2d, 0xc5, 0x28, 0x4b, 0x67, 0x70, 0x47, 0xed, 0x19, 0x92, 0x00, 0x91, 0xc1, 0xa1,
-	0x20, 0x32, 0x88, 0xd4, 0x8e, 0x5b, 0xa4, 0xd3, 0x38, 0x53, 0xfe, 0x76, 0x68, 0x33, 0x1e, 0xd8,
-	0x39, 0x88, 0x10, 0x05, 0xa4, 0x9e, 0x93, 0x45, 0x99, 0x1f, 0xe6, 0x82, 0x93, 0xf5, 0x6c, 0x80,
-	0xce, 0x7a, 0x3a, 0x20, 0x08, 0xab, 0x13, 0xf6, 0xb6, 0x2e, 0x4d, 0xfd, 0x0f, 0x16, 0x56, 0xeb,
-	0xdf, 0x87, 0x5a, 0x1e, 0x0a, 0xab, 0x80, 0x03, 0x95, 0xd1, 0x4e, 0xd4, 0x80, 0x09, 0x68, 0xfb,
-	0xc3, 0xe4, 0x51, 0x37, 0x88, 0xfb, 0x19, 0x8b, 0x55, 0xc6, 0x42, 0x7e, 0x24, 0xd0, 0xc7, 0x4f,
-	0x03, 0xda, 0xe3, 0x16, 0xaf, 0x3e, 0x57, 0x6c, 0x7a, 0xdd, 0xba, 0xd6, 0xe4, 0x17, 0x54, 0x21,
-	0xc4, 0x71, 0x0b, 0x81, 0xe2, 0x5d, 0x74, 0x38, 0xe5, 0x79, 0xa8, 0x8b, 0x6a, 0x79, 0x9f, 0x2e,
-	0xd2, 0x9c, 0xdd, 0xfc, 0x1a, 0xa9, 0x1e, 0x99, 0xaa, 0x9b, 0x36, 0x09, 0x0b, 0x2e, 0x44, 0x6c,
-	0x7e, 0x49, 0xd8, 0xe6, 0xe4, 0xd0, 0xe7, 0x71, 0xfb, 0xce, 0x77, 0xcb, 0xca, 0x31, 0x7d, 0xe7,
-	0x9b, 0x62, 0xe9, 0x4a, 0xaa, 0x31, 0xd2, 0x61, 0xc5, 0x1f, 0x27, 0x5b, 0xfd, 0x60, 0xbb, 0xe5,
-	0xf1, 0x7c, 0xee, 0x66, 0x2c, 0x2e, 0x95, 0xd7, 0xed, 0x80, 0x21, 0x8b, 0x11, 0x5b, 0x9e, 0x00,
-	0x0e, 0x42, 0x98, 0xe7, 0x79, 0x97, 0xe7, 0x82, 0xe5, 0x02, 0x0b, 0x61, 0xbe, 0x31, 0x0d, 0x86,
-	0x42, 0x18, 0xa5, 0x00, 0xc6, 0xad, 0x3c, 0x0f, 0x62, 0xe2, 0x24, 0x9e, 0xa3, 0x19, 0x9b, 0x3a,
-	0xeb, 0x51, 0xf2, 0xd0, 0xb8, 0x05, 0x9c, 0xf3, 0x90, 0xcf, 0xf5, 0x32, 0x89, 0xcb, 0x99, 0x39,
-	0xdd, 0x48, 0x06, 0x4f, 0x69, 0x3b, 0x3e, 0x49, 0x3c, 0xe4, 0x0b, 0x6b, 0x80, 0xb0, 0x73, 0x38,
-	0x8f, 0x67, 0xa6, 0xa6, 0x48, 0x0d, 0xa4, 0xbc, 0x55, 0xd5, 0x47, 0xdd, 0x20, 0xf0, 0xf3, 0x3a,
-	0x4d, 0x18, 0x0f, 0xf8, 0x91, 0xf2, 0x3e, 0x7e, 0x20, 0x08, 0xb2, 0xb7, 0xba, 0xde, 0x6a, 0x47,
-	0x37, 0xca, 0x13, 0xbd, 0x8f, 0x1d, 0x12, 0xcd, 0x03, 0xb8, 0x50, 0xf6, 0x46, 0xf0, 0x60, 0x8e,
-	0x36, 0x07, 0xb4, 0xa1, 0x39, 0x6a, 0xce, 0x5f, 0xfb, 0xcc, 0x51, 0x0c, 0xd6, 0x3e, 0x7f, 0xa6,
-	0xe7, 0xe8, 0x5e, 0x2c, 0xe2, 0x3a, 0x6f, 0x7f, 0x9d, 0xb2, 0x1b, 0xbd, 0x11, 0x46, 0xea, 0xdb,
-	0x50, 0x43, 0xf9, 0xca, 0x2a, 0xd8, 0x15, 0xef, 0xf4, 0xe6, 0x03, 0xbe, 0xf5, 0x0e, 0xa1, 0xd3,
-	0x37, 0xd8, 0x2a, 0xec, 0xf4, 0xe6, 0x03, 0xbe, 0xf5, 0xbb, 0xf0, 0x9d, 0xbe, 0xc1, 0x0b, 0xf1,
-	0x3b, 0xbd, 0x79, 0xed, 0xfb, 0xaf, 0x9a, 0x89, 0xeb, 0x3a, 0xaf, 0xf3, 0xb0, 0xa9, 0x48, 0x97,
-	0x0c, 0x4b, 0x27, 0x7d, 0x7b, 0x06, 0x0d, 0xa5, 0x93, 0xb4, 0x8a, 0xf3, 0x35, 0x2a, 0xac, 0x14,
-	0xa7, 0xbc, 0x4a, 0xe5, 0x43, 0xfa, 0xe7, 0x3d, 0x8c, 0x36, 0x70, 0x68, 0xd3, 0x14, 0x52, 0xb2,
-	0x8f, 0x1b, 0x3d, 0xd4, 0xde, 0x62, 0xde, 0x0a, 0xd8, 0x6b, 0x5f, 0x66, 0xde, 0xee, 0x49, 0xdb,
-	0x07, 0x7f, 0x1e, 0xe3, 0x3e, 0x71, 0x0c, 0xf5, 0x2a, 0xfa, 0xd0, 0xf1, 0x69, 0x7f, 0x05, 0xed,
-	0xfe, 0x6f, 0x9a, 0x7d, 0x05, 0xf4, 0xaf, 0x27, 0xc1, 0xb3, 0x3e, 0x16, 0xc1, 0x44, 0x78, 0x7e,
-	0x2b, 0x1d, 0x5d, 0x90, 0x7f, 0x68, 0x36, 0xd0, 0x0d, 0x2a, 0xdf, 0xe5, 0x90, 0xef, 0x80, 0xea,
-	0x39, 0x11, 0xea, 0x56, 0x0b, 0xc3, 0x99, 0xf1, 0xd9, 0x2d, 0xb5, 0x9c, 0x6f, 0x93, 0x79, 0xb0,
-	0x7e, 0xe7, 0xd0, 0x29, 0x4f, 0xc8, 0xb2, 0x43, 0xc3, 0x02, 0x7d, 0x7e, 0x5b, 0x35, 0x6a, 0xae,
-	0x38, 0xb0, 0xfc, 0x3a, 0xc7, 0xf3, 0x9e, 0x86, 0xbd, 0xef, 0x75, 0x7c, 0x7a, 0x3b, 0x25, 0x5d,
-	0x96, 0xff, 0x5c, 0x8b, 0x1e, 0x7a, 0xac, 0x7d, 0x9e, 0x00, 0x4e, 0x3d, 0x7e, 0x14, 0xb0, 0x4f,
-	0x29, 0x99, 0xc2, 0xfd, 0xfe, 0x2f, 0xa7, 0x6c, 0x3f, 0xe4, 0xe5, 0xa9, 0xec, 0xa7, 0x99, 0x60,
-	0x65, 0xfb, 0x43, 0x5e, 0xbe, 0x5d, 0x45, 0x0d, 0xe9, 0x0f, 0x79, 0x05, 0x70, 0xe7, 0x43, 0x5e,
-	0x88, 0x67, 0xf4, 0x43, 0x5e, 0xa8, 0xb5, 0xe0, 0x87, 0xbc, 0xc2, 0x1a, 0x54, 0x78, 0x6f, 0x8a,
-	0xa0, 0xce, 0xad, 0x7b, 0x59, 0xf4, 0x8f, 0xb1, 0x9f, 0xdd, 0x46, 0x85, 0x58, 0xe0, 0x14, 0x27,
-	0xef, 0xb9, 0xf5, 0x68, 0x53, 0xef, 0xae, 0xdb, 0x4e, 0x6f, 0x5e, 0xfb, 0xfe, 0xa9, 0xde, 0xdd,
-	0x98, 0x70, 0xce, 0x4b, 0xf9, 0x11, 0xb7, 0xcd, 0x50, 0x78, 0xae, 0x2d, 0xb8, 0x3d, 0xbf, 0xd5,
-	0x0f, 0x26, 0xaa, 0x5b, 0x13, 0xba, 0xd3, 0x87, 0x5d, 0x86, 0x40, 0x97, 0xef, 0xf4, 0xe6, 0x89,
-	0x65, 0x44, 0xf9, 0x56, 0xbd, 0xdd, 0xc3, 0x98, 0xdf, 0xd7, 0x4f, 0xfb, 0x2b, 0x68, 0xf7, 0x4b,
-	0x9d, 0x36, 0xba, 0xee, 0x65, 0x3f, 0x6f, 0x77, 0x99, 0x1a, 0x7b, 0xdd, 0x3c, 0xec, 0x8b, 0x87,
-	0x12, 0x08, 0x77, 0x09, 0xed, 0x4a, 0x20, 0xd0, 0x65, 0xf4, 0xd3, 0xdb, 0x29, 0xe9, 0xb2, 0xfc,
-	0xf3, 0x5a, 0x74, 0x97, 0x2c, 0x8b, 0x1e, 0x07, 0x9f, 0xf7, 0xb5, 0x0c, 0xc6, 0xc3, 0x17, 0xb7,
-	0xd6, 0xd3, 0x85, 0xfa, 0xb7, 0xb5, 0xe8, 0x5e, 0xa0, 0x50, 0x6a, 0x80, 0xdc, 0xc2, 0xba, 0x3f,
-	0x50, 0x7e, 0x70, 0x7b, 0x45, 0x6a, 0xb9, 0x77, 0xf1, 0x71, 0xfb, 0xa3, 0x4c, 0x01, 0xdb, 0x63,
-	0xfa, 0xa3, 0x4c, 0xdd, 0x5a, 0xf0, 0x90, 0x27, 0xbe, 0x68, 0x36, 0x5d, 0xe8, 0x21, 0x8f, 0xbc,
-	0xa1, 0x16, 0xfc, 0xb8, 0x04, 0xc6, 0x61, 0x4e, 0x5e, 0xbe, 0x2d, 0xe2, 0x3c, 0xa1, 0x9d, 0x28,
-	0x79, 0xb7, 0x13, 0xc3, 0xc1, 0xc3, 0xb1, 0x5a, 0x7a, 0xc6, 0x9b, 0x8d, 0xd4, 0x63, 0x4a, 0xdf,
-	0x20, 0xc1, 0xc3, 0xb1, 0x16, 0x4a, 0x78, 0xd3, 0x59, 0x63, 0xc8, 0x1b, 0x48, 0x16, 0x9f, 0xf4,
-	0x41, 0x41, 0x8a, 0x6e, 0xbc, 0x99, 0x33, 0xf7, 0xad, 0x90, 0x95, 0xd6, 0xb9, 0xfb, 0x76, 0x4f,
-	0x9a, 0x70, 0x3b, 0x66, 0xe2, 0x4b, 0x16, 0x27, 0xac, 0x0c, 0xba, 0x35, 0x54, 0x2f, 0xb7, 0x2e,
-	0x8d, 0xb9, 0xdd, 0xe5, 0xd9, 0x62, 0x9e, 0xeb, 0xce, 0x24, 0xdd, 0xba, 0x54, 0xb7, 0x5b, 0x40,
-	0xc3, 0x63, 0x41, 0xeb, 0x56, 0xa6, 0x97, 0x4f, 0xc2, 0x66, 0xbc, 0xac, 0x72, 0xb3, 0x17, 0x4b,
-	0xd7, 0x53, 0x0f, 0xa3, 0x8e, 0x7a, 0x82, 0x91, 0xb4, 0xdd, 0x93, 0x86, 0xe7, 0x73, 0x8e, 0x5b,
-	0x33, 0x9e, 0x76, 0x3a, 0x6c, 0xb5, 0x86, 0xd4, 0xd3, 0xfe, 0x0a, 0xf0, 0x34, 0x54, 0x8f, 0xaa,
-	0xa3, 0xb4, 0x12, 0xfb, 0x69, 0x96, 0x0d, 0x36, 0x03, 0xc3, 0xa4, 0x81, 0x82, 0xa7, 0xa1, 0x08,
-	0x4c, 0x8c, 0xe4, 0xe6, 0xf4, 0x30, 0x1f, 0x74, 0xd9, 0x91, 0x54, 0xaf, 0x91, 0xec, 0xd2, 0xe0,
-	0x44, 0xcb, 0x69, 0x6a, 0x53, 0xdb, 0x61, 0xb8, 0xe1, 0x5a, 0x15, 0xde, 0xe9, 0xcd, 0x83, 0xc7,
-	0xed, 0x92, 0x92, 0x2b, 0xcb, 0x03, 0xca, 0x84, 0xb7, 0x92, 0x3c, 0xec, 0xa0, 0xc0, 0xa9, 0xa0,
-	0x9a, 0x46, 0x6f, 0xd2, 0x64, 0xc6, 0x04, 0xfa, 0xa4, 0xc8, 0x05, 0x82, 0x4f, 0x8a, 0x00, 0x08,
-	0xba, 0x4e, 0xfd, 0x6e, 0x8e, 0x43, 0x0f, 0x13, 0xac, 0xeb, 0xb4, 0xb2, 0x43, 0x85, 0xba, 0x0e,
-	0xa5, 0x41, 0x34, 0x30, 0x6e, 0xf5, 0xeb, 0xf8, 0x4f, 0x42, 0x66, 0xc0, 0x3b, 0xf9, 0x9b, 0xbd,
-	0x58, 0xb0, 0xa2, 0x58, 0x87, 0xe9, 0x3c, 0x15, 0xd8, 0x8a, 0xe2, 0xd8, 0xa8, 0x91, 0xd0, 0x8a,
-	0xd2, 0x46, 0xa9, 0xea, 0xd5, 0x39, 0xc2, 0x61, 0x12, 0xae, 0x9e, 0x62, 0xfa, 0x55, 0xcf, 0xb0,
-	0xad, 0x07, 0x9b, 0xb9, 0x19, 0x32, 0xe2, 0x4a, 0x6f, 0x96, 0x91, 0xb1, 0x2d, 0x5f, 0xd3, 0x84,
-	0x60, 0x28, 0xea, 0x50, 0x0a, 0xf0, 0xc0, 0xbe, 0xe6, 0x9a, 0x67, 0xaf, 0x45, 0xc1, 0xe2, 0x32,
-	0xce, 0xa7, 0xe8, 0xe6, 0x54, 0x1a, 0x6c, 0x91, 0xa1, 0xcd, 0x29, 0xa9, 0x01, 0x1e, 0x9b, 0xfb,
-	0x2f, 0x58, 0x22, 0x53, 0xc1, 0xbc, 0xc9, 0xe8, 0xbf, 0x5f, 0xf9, 0xb8, 0x07, 0x09, 0x1f, 0x9b,
-	0x37, 0x80, 0x39, 0xf8, 0x56, 0x4e, 0x3f, 0x09, 0x98, 0xf2, 0xd1, 0xd0, 0x46, 0x98, 0x56, 0x01,
-	0x83, 0xda, 0x24, 0xb8, 0x4c, 0xfc, 0x84, 0xad, 0xb0, 0x41, 0x6d, 0xf3, 0x53, 0x89, 0x84, 0x06,
-	0x75, 0x1b, 0x05, 0x79, 0xa6, 0xbb, 0x0f, 0x5a, 0x0f, 0xe8, 0xbb, 0x5b, 0x9f, 0x8d, 0x4e, 0x0e,
-	0xcc, 0x9c, 0xbd, 0x74, 0xe9, 0x3d, 0x27, 0x40, 0x0a, 0xba, 0x97, 0x2e, 0xf1, 0xc7, 0x04, 0x9b,
-	0xbd, 0x58, 0xf8, 0x48, 0x3e, 0x16, 0xec, 0x6d, 0xf3, 0xac, 0x1c, 0x29, 0xae, 0x94, 0xb7, 0x1e,
-	0x96, 0x3f, 0xea, 0x06, 0xed, 0x05, 0xd8, 0xd3, 0x92, 0x4f, 0x59, 0x55, 0xe9, 0x2f, 0x55, 0xfa,
-	0x37, 0x8c, 0xb4, 0x6c, 0x08, 0xbe, 0x53, 0xf9, 0x20, 0x0c, 0x39, 0x9f, 0x97, 0x53, 0x22, 0xfb,
-	0xd5, 0x9b, 0x75, 0x54, 0xb3, 0xfd, 0xc1, 0x9b, 0x8d, 0x4e, 0xce, 0x4e, 0x2f, 0x2d, 0x75, 0x3f,
-	0x73, 0xf3, 0x08, 0x55, 0xc7, 0xbe, 0x70, 0xf3, 0xb8, 0x07, 0xa9, 0x5d, 0x7d, 0x19, 0xbd, 0x73,
-	0xc4, 0x67, 0x63, 0x96, 0x27, 0x83, 0xef, 0xfb, 0x57, 0x68, 0xf9, 0x6c, 0x58, 0xff, 0x6c, 0x8c,
-	0xde, 0xa1, 0xc4, 0xf6, 0x12, 0xe0, 0x1e, 0xbb, 0x58, 0xcc, 0xc6, 0x22, 0x16, 0xe0, 0x12, 0xa0,
-	0xfc, 0x7d, 0x58, 0x0b, 0x88, 0x4b, 0x80, 0x1e, 0x00, 0xec, 0x4d, 0x4a, 0xc6, 0x50, 0x7b, 0xb5,
-	0x20, 0x68, 0x4f, 0x03, 0x36, 0x8b, 0x30, 0xf6, 0xea, 0x44, 0x1d, 0x5e, 0xda, 0xb3, 0x3a, 0x52,
-	0x4a, 0x64, 0x11, 0x6d, 0xca, 0x0e, 0x6e, 0x55, 0x7d, 0xf9, 0xd5, 0x91, 0xc5, 0x7c, 0x1e, 0x97,
-	0x2b, 0x30, 0xb8, 0x75, 0x2d, 0x1d, 0x80, 0x18, 0xdc, 0x28, 0x68, 0x67, 0x6d, 0xd3, 0xcc, 0xd3,
-	0xeb, 0x03, 0x5e, 0xf2, 0x85, 0x48, 0x73, 0x06, 0xbf, 0x3c, 0x61, 0x1a, 0xd4, 0x65, 0x88, 0x59,
-	0x4b, 0xb1, 0x36, 0xcb, 0x95, 0x84, 0xba, 0x4f, 0x28, 0x3f, 0x9e, 0x5d, 0x09, 0x5e, 0xc2, 0xe7,
-	0x89, 0xca, 0x0a, 0x84, 0x88, 0x2c, 0x97, 0x84, 0x41, 0xdf, 0x9f, 0xa6, 0xf9, 0x0c, 0xed, 0xfb,
-	0x53, 0xf7, 0xeb, 0xaf, 0xf7, 0x68, 0xc0, 0x4e, 0x28, 0xd5, 0x68, 0x6a, 0x02, 0xe8, 0x77, 0x39,
-	0xd1, 0x46, 0x77, 0x09, 0x62, 0x42, 0xe1, 0x24, 0x70, 0xf5, 0xaa, 0x60, 0x39, 0x4b, 0x9a, 0x5b,
-	0x73, 0x98, 0x2b, 0x8f, 0x08, 0xba, 0x82, 0xa4, 0x8d, 0x45, 0x52, 0x7e, 0xb6, 0xc8, 0x4f, 0x4b,
-	0x7e, 0x99, 0x66, 0xac, 0x04, 0xb1, 0x48, 0xa9, 0x3b, 0x72, 0x22, 0x16, 0x61, 0x9c, 0xbd, 0x7e,
-	0x21, 0xa5, 0xde, 0x17, 0xe0, 0x27, 0x65, 0x3c, 0x85, 0xd7, 0x2f, 0x94, 0x8d, 0x36, 0x46, 0x9c,
-	0x0c, 0x06, 0x70, 0x27, 0xd1, 0x51, 0xae, 0xf3, 0x95, 0x1c, 0x1f, 0xfa, 0x5d, 0x42, 0xf9, 0x4d,
-	0xd4, 0x0a, 0x24, 0x3a, 0xda, 0x1c, 0x46, 0x12, 0x89, 0x4e, 0x58, 0xc3, 0x2e, 0x25, 0x92, 0x3b,
-	0xd1, 0xd7, 0x8a, 0xc0, 0x52, 0xa2, 0x6c, 0x34, 0x42, 0x62, 0x29, 0x69, 0x41, 0x20, 0x20, 0x35,
-	0xd3, 0x60, 0x86, 0x06, 0x24, 0x23, 0x0d, 0x06, 0x24, 0x97, 0xb2, 0x81, 0xe2, 0x30, 0x4f, 0x45,
-	0x1a, 0x67, 0x63, 0x26, 0x4e, 0xe3, 0x32, 0x9e, 0x33, 0xc1, 0x4a, 0x18, 0x28, 0x34, 0x32, 0xf4,
-	0x18, 0x22, 0x50, 0x50, 0xac, 0x76, 0xf8, 0x07, 0xd1, 0x7b, 0xf5, 0xba, 0xcf, 0x72, 0xfd, 0xb7,
-	0x6b, 0x5e, 0xca, 0x3f, 0x7a, 0x35, 0x78, 0xdf, 0xd8, 0x18, 0x8b, 0x92, 0xc5, 0xf3, 0xc6, 0xf6,
-	0xbb, 0xe6, 0x77, 0x09, 0x3e, 0x5d, 0xab, 0xc7, 0xf3, 0x09, 0x17, 0xe9, 0x65, 0xbd, 0xcd, 0xd6,
-	0x6f, 0x10, 0x81, 0xf1, 0xec, 0x8a, 0x87, 0x81, 0x6f, 0x51, 0x60, 0x9c, 0x8d, 0xd3, 0xae, 0xf4,
-	0x8c, 0x15, 0x19, 0x8c, 0xd3, 0x9e, 0xb6, 0x04, 0x88, 0x38, 0x8d, 0x82, 0x76, 0x72, 0xba, 0xe2,
-	0x09, 0x0b, 0x57, 0x66, 0xc2, 0xfa, 0x55, 0x66, 0xe2, 0xbd, 0x94, 0x91, 0x45, 0xef, 0x1d, 0xb3,
-	0xf9, 0x05, 0x2b, 0xab, 0xab, 0xb4, 0xa0, 0xbe, 0xdb, 0x6a, 0x89, 0xce, 0xef, 0xb6, 0x12, 0xa8,
-	0x5d, 0x09, 0x2c, 0x70, 0x58, 0x9d, 0xc4, 0x73, 0x26, 0xbf, 0xac, 0x01, 0x56, 0x02, 0xc7, 0x88,
-	0x03, 0x11, 0x2b, 0x01, 0x09, 0x3b, 0xef, 0x77, 0x59, 0xe6, 0x8c, 0xcd, 0xea, 0x11, 0x56, 0x9e,
-	0xc6, 0xab, 0x39, 0xcb, 0x85, 0x36, 0x09, 0xce, 0xe4, 0x1d, 0x93, 0x38, 0x4f, 0x9c, 0xc9, 0xf7,
-	0xd1, 0x73, 0x42, 0x93, 0xd7, 0xf0, 0xa7, 0xbc, 0x14, 0xea, 0x2f, 0x53, 0x9d, 0x97, 0x19, 0x08,
-	0x4d, 0x7e, 0xa3, 0x7a, 0x24, 0x11, 0x9a, 0xc2, 0x1a, 0xce, 0x5f, 0x21, 0xf0, 0xca, 0xf0, 0x9a,
-	0x95, 0x66, 0x9c, 0xbc, 0x9c, 0xc7, 0x69, 0xa6, 0x47, 0xc3, 0x0f, 0x03, 0xb6, 0x09, 0x1d, 0xe2,
-	0xaf, 0x10, 0xf4, 0xd5, 0x75, 0xfe, 0x6e, 0x43, 0xb8, 0x84, 0xe0, 0x11, 0x41, 0x87, 0x7d, 0xe2,
-	0x11, 0x41, 0xb7, 0x96, 0xdd, 0xb9, 0x5b, 0x56, 0x72, 0x2b, 0x49, 0xec, 0xf2, 0x04, 0x9e, 0x17,
-	0x3a, 0x36, 0x01, 0x48, 0xec, 0xdc, 0x83, 0x0a, 0x36, 0x35, 0xb0, 0xd8, 0x7e, 0x9a, 0xc7, 0x59,
-	0xfa, 0x33, 0x98, 0xd6, 0x3b, 0x76, 0x1a, 0x82, 0x48, 0x0d, 0x70, 0x12, 0x73, 0x75, 0xc0, 0xc4,
-	0x24, 0xad, 0x43, 0xff, 0xa3, 0x40, 0xbb, 0x49, 0xa2, 0xdb, 0x95, 0x43, 0x3a, 0xdf, 0x68, 0x85,
-	0xcd, 0x3a, 0x2a, 0x8a, 0x71, 0xbd, 0xaa, 0x9e, 0xb1, 0x29, 0x4b, 0x0b, 0x31, 0xf8, 0x2c, 0xdc,
-	0x56, 0x00, 0x27, 0x2e, 0x5a, 0xf4, 0x50, 0x73, 0x1e, 0xdf, 0xd7, 0xb1, 0x64, 0xac, 0xfe, 0x64,
-	0xe3, 0x79, 0xc5, 0x4a, 0x9d, 0x68, 0x1c, 0x30, 0x01, 0x66, 0xa7, 0xc3, 0x0d, 0x1d, 0xb0, 0xae,
-	0x28, 0x31, 0x3b, 0xc3, 0x1a, 0xf6, 0xb0, 0xcf, 0xe1, 0xf4, 0x37, 0xb7, 0xe5, 0x7d, 0xc3, 0x2d,
-	0xd2, 0x98, 0x43, 0x11, 0x87, 0x7d, 0x34, 0x6d, 0xb3, 0xb5, 0xb6, 0xdb, 0x51, 0xbe, 0x3a, 0x84,
-	0x57, 0x26, 0x10, 0x4b, 0x12, 0x23, 0xb2, 0xb5, 0x00, 0xee, 0x1c, 0x86, 0x97, 0x3c, 0x4e, 0xa6,
-	0x71, 0x25, 0x4e, 0xe3, 0x55, 0xc6, 0xe3, 0x44, 0xae, 0xeb, 0xf0, 0x30, 0xbc, 0x61, 0x86, 0x2e,
-	0x44, 0x1d, 0x86, 0x53, 0xb0, 0x9b, 0x9d, 0xc9, 0xbf, 0x44, 0xa9, 0xef, 0x72, 0xc2, 0xec, 0x4c,
-	0x96, 0x17, 0xde, 0xe3, 0x7c, 0x10, 0x86, 0xec, 0x3b, 0x68, 0x4a, 0x24, 0xd3, 0x90, 0x7b, 0x98,
-	0x8e, 0x97, 0x80, 0x7c, 0x14, 0x20, 0xec, 0x77, 0x29, 0xd4, 0xef, 0xcd, 0x1f, 0x1f, 0x12, 0xfa,
-	0x4b, 0xd6, 0x5b, 0x98, 0xae, 0x0b, 0x0d, 0xdd, 0x0f, 0xdc, 0x6d, 0xf7, 0xa4, 0x6d, 0x9a, 0xb9,
-	0x7b, 0x15, 0x8b, 0x51, 0x92, 0x1c, 0xb3, 0x0a, 0x79, 0xa1, 0xbc, 0x16, 0x0e, 0xad, 0x94, 0x48,
-	0x33, 0xdb, 0x94, 0x1d, 0xe8, 0xb5, 0xec, 0x65, 0x92, 0x0a, 0x2d, 0x6b, 0x6e, 0x48, 0x6f, 0xb5,
-	0x0d, 0xb4, 0x29, 0xa2, 0x56, 0x34, 0x6d, 0x63, 0x79, 0xcd, 0x4c, 0xf8, 0x6c, 0x96, 0x31, 0x0d,
-	0x9d, 0xb1, 0x58, 0x7d, 0xc8, 0x6f, 0xa7, 0x6d, 0x0b, 0x05, 0x89, 0x58, 0x1e, 0x54, 0xb0, 0x69,
-	0x64, 0x8d, 0xa9, 0x47, 0x52, 0x4d, 0xc3, 0x6e, 0xb4, 0xcd, 0x78, 0x00, 0x91, 0x46, 0xa2, 0xa0,
-	0x7d, 0xef, 0xad, 0x16, 0x1f, 0xb0, 0xa6, 0x25, 0xe0, 0x27, 0x88, 0xa4, 0xb2, 0x23, 0x26, 0xde,
-	0x7b, 0x43, 0x30, 0xbb, 0x4f, 0x00, 0x1e, 0x5e, 0xac, 0x0e, 0x13, 0xb8, 0x4f, 0x80, 0xfa, 0x92,
-	0x21, 0xf6, 0x09, 0x14, 0xeb, 0x77, 0x9d, 0x39, 0xf7, 0x3a, 0x8a, 0x2b, 0x5b, 0x39, 0xa4, 0xeb,
-	0x50, 0x30, 0xd4, 0x75, 0x94, 0x82, 0xdf, 0xa4, 0xee, 0xd1, 0x1a, 0xd2, 0xa4, 0xd8, 0xb9, 0xda,
-	0x7a, 0x17, 0x66, 0xe3, 0x92, 0xd9, 0x4f, 0xca, 0x2b, 0x4b, 0xf8, 0x17, 0xfc, 0x95, 0x90, 0x88,
-	0x4b, 0x2d, 0x48, 0xd9, 0x7e, 0xf1, 0xd1, 0x7f, 0x7f, 0x7d, 0x67, 0xed, 0x17, 0x5f, 0xdf, 0x59,
-	0xfb, 0xdf, 0xaf, 0xef, 0xac, 0xfd, 0xfc, 0x9b, 0x3b, 0xdf, 0xfa, 0xc5, 0x37, 0x77, 0xbe, 0xf5,
-	0x3f, 0xdf, 0xdc, 0xf9, 0xd6, 0x57, 0xef, 0xe8, 0xbf, 0x50, 0x7c, 0xf1, 0x2b, 0xf2, 0xef, 0x0c,
-	0x3f, 0xff, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x6f, 0x8d, 0x25, 0xc5, 0x78, 0x00, 0x00,
+	// 5601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9d, 0xdd, 0x6f, 0x1d, 0x49,
+	0x56, 0xc0, 0xc7, 0x2f, 0x0c, 0xf4, 0xb2, 0x03, 0xdc, 0xd9, 0x19, 0x76, 0x87, 0xdd, 0x7c, 0x4d,
+	0x62, 0x27, 0x71, 0xdc, 0xce, 0x24, 0xf3, 0xb1, 0xda, 0x45, 0x42, 0x8e, 0x9d, 0x78, 0xbc, 0x1b,
+	0x27, 0xe6, 0xde, 0xeb, 0x44, 0x1a, 0x09, 0x89, 0x76, 0xdf, 0xf2, 0x75, 0xe3, 0xbe, 0xdd, 0xbd,
+	0xdd, 0x7d, 0x6f, 0x72, 0x17, 0x81, 0x40, 0x20, 0x10, 0x08, 0xc4, 0x8a, 0xaf, 0x57, 0x24, 0xfe,
+	0x1a, 0x1e, 0xf7, 0x91, 0x47, 0x34, 0xf3, 0xc6, 0x7f, 0xc0, 0x1b, 0xaa, 0xef, 0xaa, 0xd3, 0xe7,
+	0x54, 0xb7, 0xf7, 0x61, 0x94, 0x91, 0xcf, 0xef, 0x9c, 0x53, 0xd5, 0x55, 0x75, 0xea, 0x54, 0x75,
+	0x75, 0xdd, 0xe8, 0x7a, 0x75, 0xb6, 0x5b, 0xd5, 0x65, 0x5b, 0x36, 0xbb, 0x0d, 0xab, 0x57, 0x59,
+	0xca, 0xf4, 0xbf, 0xb1, 0xf8, 0xf3, 0xe8, 0xdd, 0xa4, 0x58, 0xb7, 0xeb, 0x8a, 0x7d, 0xf4, 0x5d,
+	0x4b, 0xa6, 0xe5, 0x62, 0x91, 0x14, 0xb3, 0x46, 0x22, 0x1f, 0x7d, 0x68, 0x25, 0x6c, 0xc5, 0x8a,
+	0x56, 0xfd, 0xfd, 0xd1, 0xff, 0xfe, 0xdf, 0x46, 0xf4, 0xde, 0x7e, 0x9e, 0xb1, 0xa2, 0xdd, 0x57,
+	0x1a, 0xa3, 0xaf, 0xa2, 0x6f, 0xef, 0x55, 0xd5, 0x21, 0x6b, 0x5f, 0xb1, 0xba, 0xc9, 0xca, 0x62,
+	0xf4, 0x71, 0xac, 0x1c, 0xc4, 0xe3, 0x2a, 0x8d, 0xf7, 0xaa, 0x2a, 0xb6, 0xc2, 0x78, 0xcc, 0x7e,
+	0xb6, 0x64, 0x4d, 0xfb, 0xd1, 0xed, 0x30, 0xd4, 0x54, 0x65, 0xd1, 0xb0, 0xd1, 0x79, 0xf4, 0x3b,
+	0x7b, 0x55, 0x35, 0x61, 0xed, 0x01, 0xe3, 0x15, 0x98, 0xb4, 0x49, 0xcb, 0x46, 0x5b, 0x1d, 0x55,
+	0x1f, 0x30, 0x3e, 0xee, 0xf6, 0x83, 0xca, 0xcf, 0x34, 0xfa, 0x16, 0xf7, 0x73, 0xb1, 0x6c, 0x67,
+	0xe5, 0x9b, 0x62, 0x74, 0xb3, 0xab, 0xa8, 0x44, 0xc6, 0xf6, 0xad, 0x10, 0xa2, 0xac, 0xbe, 0x8e,
+	0x7e, 0xf3, 0x75, 0x92, 0xe7, 0xac, 0xdd, 0xaf, 0x19, 0x2f, 0xb8, 0xaf, 0x23, 0x45, 0xb1, 0x94,
+	0x19, 0xbb, 0x1f, 0x07, 0x19, 0x65, 0xf8, 0xab, 0xe8, 0xdb, 0x52, 0x32, 0x66, 0x69, 0xb9, 0x62,
+	0xf5, 0x08, 0xd5, 0x52, 0x42, 0xe2, 0x91, 0x77, 0x20, 0x68, 0x7b, 0xbf, 0x2c, 0x56, 0xac, 0x6e,
+	0x71, 0xdb, 0x4a, 0x18, 0xb6, 0x6d, 0x21, 0x65, 0xfb, 0xef, 0x36, 0xa2, 0xef, 0xef, 0xa5, 0x69,
+	0xb9, 0x2c, 0xda, 0xe7, 0x65, 0x9a, 0xe4, 0xcf, 0xb3, 0xe2, 0xf2, 0x05, 0x7b, 0xb3, 0x7f, 0xc1,
+	0xf9, 0x62, 0xce, 0x46, 0x8f, 0xfd, 0xa7, 0x2a, 0xd1, 0xd8, 0xb0, 0xb1, 0x0b, 0x1b, 0xdf, 0x9f,
+	0x5e, 0x4d, 0x49, 0x95, 0xe5, 0x9f, 0x36, 0xa2, 0x6b, 0xb0, 0x2c, 0x93, 0x32, 0x5f, 0x31, 0x5b,
+	0x9a, 0xcf, 0x7a, 0x0c, 0xfb, 0xb8, 0x29, 0xcf, 0xe7, 0x57, 0x55, 0x53, 0x25, 0xfa, 0xb3, 0xe8,
+	0xbb, 0xb0, 0x40, 0xcf, 0xb3, 0xa6, 0xdd, 0xab, 0xaa, 0x66, 0xb4, 0xdb, 0x63, 0x53, 0x83, 0xa6,
+	0x10, 0x0f, 0x87, 0x2b, 0x28, 0xf7, 0x7f, 0xb1, 0x11, 0x7d, 0x0f, 0xfa, 0x1f, 0xb3, 0x55, 0x79,
+	0xc9, 0xf6, 0xaa, 0x6a, 0xd4, 0x67, 0xcf, 0x90, 0xa6, 0x04, 0x9f, 0x5c, 0x41, 0x43, 0x15, 0x21,
+	0x8f, 0xde, 0x77, 0x07, 0xcc, 0x84, 0x35, 0x22, 0xa0, 0xdc, 0xa3, 0xc7, 0x84, 0x42, 0x8c, 0xd3,
+	0xfb, 0x43, 0x50, 0xe5, 0x2d, 0x8b, 0x46, 0xca, 0x5b, 0x5e, 0x36, 0xc6, 0xd9, 0x5d, 0xd4, 0x82,
+	0x43, 0x18, 0x5f, 0xf7, 0x06, 0x90, 0xca, 0xd5, 0x1f, 0x47, 0xbf, 0xf5, 0xba, 0xac, 0x2f, 0x9b,
+	0x2a, 0x49, 0x99, 0x0a, 0x06, 0x77, 0x7c, 0x6d, 0x2d, 0x85, 0xf1, 0x60, 0xb3, 0x0f, 0x73, 0x86,
+	0xad, 0x16, 0xbe, 0xac, 0x18, 0x8c, 0xc2, 0x56, 0x91, 0x0b, 0xa9, 0x61, 0x0b, 0x21, 0x65, 0xfb,
+	0x32, 0x1a, 0x59, 0xdb, 0x67, 0x7f, 0xc2, 0xd2, 0x76, 0x6f, 0x36, 0x83, 0xad, 0x62, 0x75, 0x05,
+	0x11, 0xef, 0xcd, 0x66, 0x54, 0xab, 0xe0, 0xa8, 0x72, 0xf6, 0x26, 0xfa, 0x10, 0x38, 0x13, 0x5d,
+	0x75, 0x36, 0x1b, 0xed, 0x84, 0xad, 0x28, 0xcc, 0x38, 0x8d, 0x87, 0xe2, 0x4e, 0xff, 0x47, 0x3c,
+	0x8f, 0xd9, 0xa2, 0x5c, 0x31, 0xd0, 0xff, 0x51, 0x6b, 0x92, 0x24, 0xfa, 0x7f, 0x58, 0x03, 0xe9,
+	0x26, 0x13, 0x96, 0xb3, 0xb4, 0x25, 0xbb, 0x89, 0x14, 0xf7, 0x76, 0x13, 0x83, 0x39, 0x23, 0x4c,
+	0x0b, 0x0f, 0x59, 0xbb, 0xbf, 0xac, 0x6b, 0x56, 0xb4, 0x64, 0x5b, 0x5a, 0xa4, 0xb7, 0x2d, 0x3d,
+	0x14, 0xa9, 0xcf, 0x21, 0x6b, 0xf7, 0xf2, 0x9c, 0xac, 0x8f, 0x14, 0xf7, 0xd6, 0xc7, 0x60, 0xca,
+	0x43, 0x1a, 0xfd, 0xb6, 0xf3, 0xc4, 0xda, 0xa3, 0xe2, 0xbc, 0x1c, 0xd1, 0xcf, 0x42, 0xc8, 0x8d,
+	0x8f, 0xad, 0x5e, 0x0e, 0xa9, 0xc6, 0xd3, 0xb7, 0x55, 0x59, 0xd3, 0xcd, 0x22, 0xc5, 0xbd, 0xd5,
+	0x30, 0x98, 0xf2, 0xf0, 0x47, 0xd1, 0x7b, 0x2a, 0x40, 0xea, 0x19, 0xfd, 0x36, 0x1a, 0x3d, 0xe1,
+	0x94, 0x7e, 0xa7, 0x87, 0xea, 0x98, 0x3f, 0xce, 0xe6, 0x35, 0x8f, 0x3e, 0xb8, 0x79, 0x25, 0xed,
+	0x31, 0x6f, 0x29, 0x65, 0xbe, 0x8c, 0xbe, 0xe3, 0x9b, 0xdf, 0x4f, 0x8a, 0x94, 0xe5, 0xa3, 0xfb,
+	0x21, 0x75, 0xc9, 0x18, 0x57, 0xdb, 0x83, 0x58, 0x1b, 0xec, 0x14, 0xa1, 0x82, 0xe9, 0xc7, 0xa8,
+	0x36, 0x08, 0xa5, 0xb7, 0xc3, 0x50, 0xc7, 0xf6, 0x01, 0xcb, 0x19, 0x69, 0x5b, 0x0a, 0x7b, 0x6c,
+	0x1b, 0x48, 0xd9, 0xae, 0xa3, 0x0f, 0x4c, 0x33, 0xf3, 0xcc, 0x48, 0xc8, 0xf9, 0xa4, 0xb3, 0x4d,
+	0xb4, 0xa3, 0x0b, 0x19, 0x5f, 0x0f, 0x86, 0xc1, 0x9d, 0xfa, 0xa8, 0x88, 0x82, 0xd7, 0x07, 0xc4,
+	0x93, 0xdb, 0x61, 0x48, 0xd9, 0xfe, 0xfb, 0x8d, 0xe8, 0x07, 0x4a, 0xf6, 0xb4, 0x48, 0xce, 0x72,
+	0x26, 0x66, 0xf7, 0x17, 0xac, 0x7d, 0x53, 0xd6, 0x97, 0x93, 0x75, 0x91, 0x12, 0x09, 0x1d, 0x0e,
+	0xf7, 0x24, 0x74, 0xa4, 0x92, 0x2a, 0xcc, 0x9f, 0x9a, 0xf4, 0x69, 0xff, 0x22, 0x29, 0xe6, 0xec,
+	0x27, 0x4d, 0x59, 0xec, 0x55, 0xd9, 0xde, 0x6c, 0x56, 0x8f, 0x62, 0xbc, 0xe9, 0x21, 0x67, 0x4a,
+	0xb0, 0x3b, 0x98, 0x77, 0x16, 0x10, 0xea, 0x29, 0xb7, 0x65, 0x05, 0x17, 0x10, 0xfa, 0xf1, 0xb5,
+	0x65, 0x45, 0x2d, 0x20, 0x7c, 0xa4, 0x63, 0xf5, 0x98, 0xcf, 0x41, 0xb8, 0xd5, 0x63, 0x77, 0xd2,
+	0xb9, 0x15, 0x42, 0xec, 0x1c, 0xa0, 0x1f, 0x54, 0x59, 0x9c, 0x67, 0xf3, 0xd3, 0x6a, 0xc6, 0xc7,
+	0xd0, 0x3d, 0xbc, 0xce, 0x0e, 0x42, 0xcc, 0x01, 0x04, 0xaa, 0xbc, 0xfd, 0xa3, 0xcd, 0xb3, 0x55,
+	0x5c, 0x7a, 0x56, 0x97, 0x8b, 0xe7, 0x6c, 0x9e, 0xa4, 0x6b, 0x15, 0x4c, 0x3f, 0x0d, 0x45, 0x31,
+	0x48, 0x9b, 0x42, 0x7c, 0x76, 0x45, 0x2d, 0x55, 0x9e, 0xff, 0xd8, 0x88, 0x6e, 0x7b, 0xfd, 0x44,
+	0x75, 0x26, 0x59, 0xfa, 0xbd, 0x62, 0x36, 0x66, 0x4d, 0x9b, 0xd4, 0xed, 0xe8, 0x47, 0x81, 0x3e,
+	0x40, 0xe8, 0x98, 0xb2, 0xfd, 0xf8, 0x57, 0xd2, 0xb5, 0xad, 0x3e, 0xa9, 0x92, 0x94, 0xa9, 0xf8,
+	0xe3, 0xb7, 0xba, 0x90, 0xc0, 0xe8, 0x73, 0x2b, 0x84, 0xd8, 0x56, 0x17, 0x82, 0xa3, 0x62, 0x95,
+	0xb5, 0xec, 0x90, 0x15, 0xac, 0xee, 0xb6, 0xba, 0x54, 0xf5, 0x11, 0xa2, 0xd5, 0x09, 0xd4, 0x46,
+	0x3a, 0xcf, 0x9b, 0xc9, 0x34, 0xb6, 0x03, 0x46, 0x3a, 0xb9, 0xc6, 0x83, 0x61, 0xb0, 0xdd, 0x2c,
+	0x70, 0x7c, 0xca, 0xe5, 0x05, 0xd8, 0x2c, 0x70, 0x4d, 0x48, 0x80, 0xd8, 0x2c, 0x40, 0x41, 0x9b,
+	0x0e, 0x38, 0x7e, 0x5e, 0x65, 0xec, 0x0d, 0x48, 0x07, 0x5c, 0x65, 0x2e, 0x26, 0xd2, 0x01, 0x04,
+	0x53, 0x1e, 0x5e, 0x44, 0xbf, 0x21, 0x84, 0x3f, 0x29, 0xb3, 0x62, 0x74, 0x1d, 0x51, 0xe2, 0x02,
+	0x63, 0xf5, 0x06, 0x0d, 0x80, 0x12, 0xf3, 0xbf, 0xaa, 0xb9, 0xf9, 0x0e, 0xa1, 0x04, 0xa6, 0xe5,
+	0xcd, 0x3e, 0xcc, 0xe6, 0x61, 0x42, 0xc8, 0xe3, 0xd7, 0xe4, 0x22, 0xa9, 0xb3, 0x62, 0x3e, 0xc2,
+	0x74, 0x1d, 0x39, 0x91, 0x87, 0x61, 0x1c, 0xe8, 0xc2, 0x4a, 0x71, 0xaf, 0xaa, 0xea, 0x72, 0x85,
+	0x77, 0x61, 0x1f, 0x09, 0x76, 0xe1, 0x0e, 0x8a, 0x7b, 0x3b, 0x60, 0x69, 0x9e, 0x15, 0x41, 0x6f,
+	0x0a, 0x19, 0xe2, 0xcd, 0xa2, 0xa0, 0xf3, 0x3e, 0x67, 0xc9, 0x8a, 0xe9, 0x9a, 0x61, 0x4f, 0xc6,
+	0x05, 0x82, 0x9d, 0x17, 0x80, 0x76, 0xd1, 0x2b, 0xc4, 0xc7, 0xc9, 0x25, 0xe3, 0x0f, 0x98, 0xf1,
+	0x49, 0x75, 0x84, 0xe9, 0x7b, 0x04, 0xb1, 0xe8, 0xc5, 0x49, 0xe5, 0x6a, 0x19, 0x7d, 0x28, 0xe4,
+	0x27, 0x49, 0xdd, 0x66, 0x69, 0x56, 0x25, 0x85, 0x5e, 0x4c, 0x61, 0xe3, 0xba, 0x43, 0x19, 0x97,
+	0x3b, 0x03, 0x69, 0xe5, 0xf6, 0xdf, 0x37, 0xa2, 0x9b, 0xd0, 0xef, 0x09, 0xab, 0x17, 0x99, 0x58,
+	0x93, 0x37, 0x32, 0x08, 0x8f, 0xbe, 0x08, 0x1b, 0xed, 0x28, 0x98, 0xd2, 0xfc, 0xf0, 0xea, 0x8a,
+	0x36, 0x13, 0x9b, 0xa8, 0x75, 0xca, 0xcb, 0x7a, 0xd6, 0xd9, 0xb5, 0x9b, 0xe8, 0xc5, 0x87, 0x10,
+	0x12, 0x99, 0x58, 0x07, 0x02, 0x23, 0xfc, 0xb4, 0x68, 0xb4, 0x75, 0x6c, 0x84, 0x5b, 0x71, 0x70,
+	0x84, 0x7b, 0x98, 0x1d, 0xe1, 0x27, 0xcb, 0xb3, 0x3c, 0x6b, 0x2e, 0xb2, 0x62, 0xae, 0xd2, 0x6e,
+	0x5f, 0xd7, 0x8a, 0x61, 0xe6, 0xbd, 0xd5, 0xcb, 0x61, 0x4e, 0x54, 0x67, 0x21, 0x9d, 0x80, 0x6e,
+	0xb2, 0xd5, 0xcb, 0xd9, 0xd5, 0x90, 0x95, 0xf2, 0x65, 0x38, 0x58, 0x0d, 0x39, 0xaa, 0x5c, 0x4a,
+	0xac, 0x86, 0xba, 0x94, 0x5d, 0x0d, 0xb9, 0x75, 0x68, 0xf8, 0x6e, 0xdf, 0x69, 0x9d, 0x81, 0xd5,
+	0x90, 0x57, 0x3e, 0xcd, 0x10, 0xab, 0x21, 0x8a, 0xb5, 0x81, 0xca, 0x12, 0x87, 0xac, 0x9d, 0xb4,
+	0x49, 0xbb, 0x6c, 0x40, 0xa0, 0x72, 0x6c, 0x18, 0x84, 0x08, 0x54, 0x04, 0xaa, 0xbc, 0xfd, 0x61,
+	0x14, 0xc9, 0x1d, 0x0c, 0xb1, 0xcb, 0xe4, 0xcf, 0x3d, 0x52, 0xe0, 0x6f, 0x31, 0xdd, 0x0c, 0x10,
+	0x36, 0xe1, 0x91, 0x7f, 0x17, 0x9b, 0x67, 0x23, 0x54, 0x43, 0x88, 0x88, 0x84, 0x07, 0x20, 0xb0,
+	0xa0, 0x93, 0x8b, 0xf2, 0x0d, 0x5e, 0x50, 0x2e, 0x09, 0x17, 0x54, 0x11, 0x76, 0x43, 0x5f, 0x15,
+	0x14, 0xdb, 0xd0, 0xd7, 0xc5, 0x08, 0x6d, 0xe8, 0x43, 0xc6, 0xf6, 0x19, 0xd7, 0xf0, 0x93, 0xb2,
+	0xbc, 0x5c, 0x24, 0xf5, 0x25, 0xe8, 0x33, 0x9e, 0xb2, 0x66, 0x88, 0x3e, 0x43, 0xb1, 0xb6, 0xcf,
+	0xb8, 0x0e, 0x79, 0xba, 0x7c, 0x5a, 0xe7, 0xa0, 0xcf, 0x78, 0x36, 0x14, 0x42, 0xf4, 0x19, 0x02,
+	0xb5, 0xd1, 0xc9, 0xf5, 0x36, 0x61, 0x70, 0x03, 0xc5, 0x53, 0x9f, 0x30, 0x6a, 0x03, 0x05, 0xc1,
+	0x60, 0x17, 0x3a, 0xac, 0x93, 0xea, 0x02, 0xef, 0x42, 0x42, 0x14, 0xee, 0x42, 0x1a, 0x81, 0xed,
+	0x3d, 0x61, 0x49, 0x9d, 0x5e, 0xe0, 0xed, 0x2d, 0x65, 0xe1, 0xf6, 0x36, 0x0c, 0x6c, 0x6f, 0x29,
+	0x78, 0x9d, 0xb5, 0x17, 0xc7, 0xac, 0x4d, 0xf0, 0xf6, 0xf6, 0x99, 0x70, 0x7b, 0x77, 0x58, 0x9b,
+	0x8f, 0xbb, 0x0e, 0x27, 0xcb, 0xb3, 0x26, 0xad, 0xb3, 0x33, 0x36, 0x0a, 0x58, 0x31, 0x10, 0x91,
+	0x8f, 0x93, 0xb0, 0xf2, 0xf9, 0x8b, 0x8d, 0xe8, 0xba, 0x6e, 0xf6, 0xb2, 0x69, 0xd4, 0xdc, 0xe7,
+	0xbb, 0xff, 0x0c, 0x6f, 0x5f, 0x02, 0x27, 0x5e, 0xb1, 0x0c, 0x50, 0x73, 0x72, 0x03, 0xbc, 0x48,
+	0xa7, 0x45, 0x63, 0x0a, 0xf5, 0xc5, 0x10, 0xeb, 0x8e, 0x02, 0x91, 0x1b, 0x0c, 0x52, 0xb4, 0x69,
+	0x99, 0x6a, 0x1f, 0x2d, 0x3b, 0x9a, 0x35, 0x20, 0x2d, 0xd3, 0xcf, 0xdb, 0x21, 0x88, 0xb4, 0x0c,
+	0x27, 0x61, 0x57, 0x38, 0xac, 0xcb, 0x65, 0xd5, 0xf4, 0x74, 0x05, 0x00, 0x85, 0xbb, 0x42, 0x17,
+	0x56, 0x3e, 0xdf, 0x46, 0xbf, 0xeb, 0x76, 0x3f, 0xf7, 0x61, 0xef, 0xd0, 0x7d, 0x0a, 0x7b, 0xc4,
+	0xf1, 0x50, 0xdc, 0x66, 0x14, 0xda, 0x73, 0x7b, 0xc0, 0xda, 0x24, 0xcb, 0x9b, 0xd1, 0x26, 0x6e,
+	0x43, 0xcb, 0x89, 0x8c, 0x02, 0xe3, 0x60, 0x7c, 0x3b, 0x58, 0x56, 0x79, 0x96, 0x76, 0x5f, 0xef,
+	0x28, 0x5d, 0x23, 0x0e, 0xc7, 0x37, 0x17, 0x83, 0xf1, 0x9a, 0xa7, 0x7e, 0xe2, 0x7f, 0xa6, 0xeb,
+	0x8a, 0xe1, 0xf1, 0xda, 0x43, 0xc2, 0xf1, 0x1a, 0xa2, 0xb0, 0x3e, 0x13, 0xd6, 0x3e, 0x4f, 0xd6,
+	0xe5, 0x92, 0x88, 0xd7, 0x46, 0x1c, 0xae, 0x8f, 0x8b, 0xd9, 0xb5, 0x81, 0xf1, 0x70, 0x54, 0xb4,
+	0xac, 0x2e, 0x92, 0xfc, 0x59, 0x9e, 0xcc, 0x9b, 0x11, 0x11, 0x63, 0x7c, 0x8a, 0x58, 0x1b, 0xd0,
+	0x34, 0xf2, 0x18, 0x8f, 0x9a, 0x67, 0xc9, 0xaa, 0xac, 0xb3, 0x96, 0x7e, 0x8c, 0x16, 0xe9, 0x7d,
+	0x8c, 0x1e, 0x8a, 0x7a, 0xdb, 0xab, 0xd3, 0x8b, 0x6c, 0xc5, 0x66, 0x01, 0x6f, 0x1a, 0x19, 0xe0,
+	0xcd, 0x41, 0x91, 0x46, 0x9b, 0x94, 0xcb, 0x3a, 0x65, 0x64, 0xa3, 0x49, 0x71, 0x6f, 0xa3, 0x19,
+	0x4c, 0x79, 0xf8, 0xeb, 0x8d, 0xe8, 0xf7, 0xa4, 0xd4, 0x7d, 0xe7, 0x72, 0x90, 0x34, 0x17, 0x67,
+	0x65, 0x52, 0xcf, 0x46, 0x9f, 0x60, 0x76, 0x50, 0xd4, 0xb8, 0x7e, 0x74, 0x15, 0x15, 0xf8, 0x58,
+	0x79, 0xde, 0x6d, 0x47, 0x1c, 0xfa, 0x58, 0x3d, 0x24, 0xfc, 0x58, 0x21, 0x0a, 0x03, 0x88, 0x90,
+	0xcb, 0x2d, 0xb9, 0x4d, 0x52, 0xdf, 0xdf, 0x97, 0xdb, 0xea, 0xe5, 0x60, 0x7c, 0xe4, 0x42, 0xbf,
+	0xb7, 0xec, 0x50, 0x36, 0xf0, 0x1e, 0x13, 0x0f, 0xc5, 0x49, 0xcf, 0x66, 0x54, 0x84, 0x3d, 0x77,
+	0x46, 0x46, 0x3c, 0x14, 0x27, 0x3c, 0x3b, 0x61, 0x2d, 0xe4, 0x19, 0x09, 0x6d, 0xf1, 0x50, 0x1c,
+	0x66, 0x5f, 0x8a, 0xd1, 0xf3, 0xc2, 0xfd, 0x80, 0x1d, 0x38, 0x37, 0x6c, 0x0f, 0x62, 0x95, 0xc3,
+	0xbf, 0xdd, 0x88, 0xbe, 0x6f, 0x3d, 0x1e, 0x97, 0xb3, 0xec, 0x7c, 0x2d, 0xa1, 0x57, 0x49, 0xbe,
+	0x64, 0xcd, 0xe8, 0x11, 0x65, 0xad, 0xcb, 0x9a, 0x12, 0x3c, 0xbe, 0x92, 0x0e, 0x1c, 0x3b, 0x7b,
+	0x55, 0x95, 0xaf, 0xa7, 0x6c, 0x51, 0xe5, 0xe4, 0xd8, 0xf1, 0x90, 0xf0, 0xd8, 0x81, 0x28, 0xcc,
+	0xca, 0xa7, 0x25, 0xcf, 0xf9, 0xd1, 0xac, 0x5c, 0x88, 0xc2, 0x59, 0xb9, 0x46, 0x60, 0xae, 0x34,
+	0x2d, 0xf7, 0xcb, 0x3c, 0x67, 0x69, 0xdb, 0x3d, 0xb7, 0x61, 0x34, 0x2d, 0x11, 0xce, 0x95, 0x00,
+	0x69, 0x77, 0xe5, 0xf4, 0x1a, 0x32, 0xa9, 0xd9, 0x93, 0x35, 0x3f, 0xb8, 0x32, 0xc2, 0xd3, 0x02,
+	0x0b, 0x10, 0xbb, 0x72, 0x28, 0x08, 0xd7, 0xaa, 0xa7, 0xc5, 0xac, 0xc4, 0xd7, 0xaa, 0x5c, 0x12,
+	0x5e, 0xab, 0x2a, 0x02, 0x9a, 0x1c, 0x33, 0xca, 0xe4, 0x98, 0xf5, 0x99, 0x1c, 0x33, 0xd7, 0xa4,
+	0x17, 0x0a, 0xd5, 0xbb, 0x1b, 0x32, 0x14, 0x82, 0xb7, 0x35, 0x5b, 0xbd, 0x1c, 0xec, 0xa1, 0x7a,
+	0xd1, 0xfa, 0x8c, 0xb5, 0xe9, 0x05, 0xde, 0x43, 0x3d, 0x24, 0xdc, 0x43, 0x21, 0x0a, 0xab, 0x34,
+	0x2d, 0x35, 0x81, 0x57, 0xc9, 0xca, 0xc3, 0x55, 0xf2, 0x38, 0xb8, 0x8c, 0x3c, 0x5a, 0x88, 0x67,
+	0x86, 0x76, 0x72, 0x29, 0x0b, 0x2f, 0x23, 0x0d, 0x03, 0x4b, 0x2f, 0x05, 0x62, 0x2f, 0x6b, 0x93,
+	0x56, 0xf4, 0x76, 0xb3, 0xb6, 0x7a, 0x39, 0xe5, 0xe4, 0x5f, 0xcd, 0x32, 0x4e, 0x4a, 0x5f, 0x94,
+	0x7c, 0x8c, 0xbc, 0x4a, 0xf2, 0x6c, 0x96, 0xb4, 0x6c, 0x5a, 0x5e, 0xb2, 0x02, 0x5f, 0x31, 0xa9,
+	0xd2, 0x4a, 0x3e, 0xf6, 0x14, 0xc2, 0x2b, 0xa6, 0xb0, 0x22, 0xec, 0x27, 0x92, 0x3e, 0x6d, 0xd8,
+	0x7e, 0xd2, 0x10, 0x91, 0xcc, 0x43, 0xc2, 0xfd, 0x04, 0xa2, 0x30, 0x5f, 0x95, 0xf2, 0xa7, 0x6f,
+	0x2b, 0x56, 0x67, 0xac, 0x48, 0x19, 0x9e, 0xaf, 0x42, 0x2a, 0x9c, 0xaf, 0x22, 0x34, 0x5c, 0xab,
+	0x1d, 0x24, 0x2d, 0x7b, 0xb2, 0x9e, 0x66, 0x0b, 0xd6, 0xb4, 0xc9, 0xa2, 0xc2, 0xd7, 0x6a, 0x00,
+	0x0a, 0xaf, 0xd5, 0xba, 0x70, 0x67, 0x6b, 0xc8, 0x04, 0xc4, 0xee, 0x71, 0x2f, 0x48, 0x04, 0x8e,
+	0x7b, 0x11, 0x28, 0x7c, 0xb0, 0x16, 0x40, 0x5f, 0x12, 0x74, 0xac, 0x04, 0x5f, 0x12, 0xd0, 0x74,
+	0x67, 0xc3, 0xcd, 0x30, 0x13, 0x3e, 0x34, 0x7b, 0x8a, 0x3e, 0x71, 0x87, 0xe8, 0xf6, 0x20, 0x16,
+	0xdf, 0xe1, 0x1b, 0xb3, 0x3c, 0x11, 0xd3, 0x56, 0x60, 0x1b, 0x4d, 0x33, 0x43, 0x76, 0xf8, 0x1c,
+	0x56, 0x39, 0xfc, 0xcb, 0x8d, 0xe8, 0x23, 0xcc, 0xe3, 0xcb, 0x4a, 0xf8, 0x7d, 0xd8, 0x6f, 0xeb,
+	0x65, 0xe5, 0x79, 0xff, 0xe4, 0x0a, 0x1a, 0xf6, 0x48, 0x86, 0x16, 0xd9, 0xe3, 0x6e, 0xaa, 0x00,
+	0x7e, 0xd2, 0x66, 0xca, 0x0f, 0x39, 0xe2, 0x48, 0x46, 0x88, 0xb7, 0xeb, 0x21, 0xbf, 0x5c, 0x0d,
+	0x58, 0x0f, 0x19, 0x1b, 0x4a, 0x4c, 0xac, 0x87, 0x10, 0xcc, 0x8e, 0x4e, 0xb7, 0x7a, 0x7c, 0xd7,
+	0x4d, 0xe4, 0x5b, 0x60, 0x74, 0x7a, 0x65, 0x35, 0x10, 0x31, 0x3a, 0x49, 0x18, 0x66, 0x24, 0x1a,
+	0xe4, 0x63, 0x13, 0x8b, 0xe5, 0xc6, 0x90, 0x3b, 0x32, 0xef, 0xf6, 0x83, 0xb0, 0xbf, 0x6a, 0xb1,
+	0x5a, 0xfa, 0xdc, 0x0f, 0x59, 0x00, 0xcb, 0x9f, 0xed, 0x41, 0xac, 0x72, 0xf8, 0xe7, 0xd1, 0xf7,
+	0x3a, 0x15, 0x7b, 0xc6, 0x92, 0x76, 0x59, 0xb3, 0x19, 0x38, 0xfe, 0xdc, 0x2d, 0xb7, 0x06, 0x89,
+	0xe3, 0xcf, 0x41, 0x85, 0x4e, 0x8e, 0xae, 0x39, 0xd9, 0xad, 0x4c, 0x19, 0x1e, 0x85, 0x4c, 0xfa,
+	0x6c, 0x30, 0x47, 0xa7, 0x75, 0x3a, 0xcb, 0x6c, 0xb7, 0x77, 0xed, 0xad, 0x92, 0x2c, 0x17, 0x2f,
+	0x6b, 0x3f, 0x09, 0x19, 0xf5, 0xd0, 0xe0, 0x32, 0x9b, 0x54, 0xe9, 0x44, 0x66, 0x31, 0xc6, 0x9d,
+	0xe5, 0xd9, 0x03, 0x3a, 0x12, 0x20, 0xab, 0xb3, 0x9d, 0x81, 0xb4, 0x72, 0xdb, 0x46, 0x1f, 0xd8,
+	0x3f, 0xbb, 0x9d, 0x1c, 0xf3, 0xaa, 0x54, 0x91, 0x9e, 0xbe, 0x33, 0x90, 0xb6, 0x67, 0xef, 0xbb,
+	0x5e, 0xd5, 0x44, 0xb4, 0xdb, 0x6b, 0x0a, 0xcc, 0x45, 0x0f, 0x87, 0x2b, 0xd8, 0x25, 0xcd, 0x97,
+	0x59, 0xd3, 0x96, 0xf5, 0x9a, 0xbf, 0x70, 0xd2, 0x1f, 0xd2, 0xf8, 0xa3, 0x55, 0x01, 0xb1, 0x43,
+	0x10, 0x4b, 0x1a, 0x9c, 0xec, 0xb8, 0xb2, 0x1f, 0xdc, 0x34, 0x84, 0x2b, 0x87, 0xe8, 0x71, 0xe5,
+	0x93, 0x36, 0x56, 0xe9, 0x5a, 0x19, 0x31, 0x88, 0x55, 0xa6, 0xa8, 0xdd, 0x2f, 0x84, 0xee, 0xf6,
+	0x83, 0x36, 0x63, 0x51, 0xe2, 0x83, 0xec, 0xfc, 0xdc, 0xd4, 0x09, 0x2f, 0xa9, 0x8b, 0x10, 0x19,
+	0x0b, 0x81, 0xda, 0xa8, 0xdf, 0xa9, 0xd5, 0x8b, 0x64, 0x01, 0xa3, 0x7e, 0xb7, 0xc0, 0x1c, 0x22,
+	0xa2, 0x3e, 0x09, 0xdb, 0xbd, 0x12, 0xdb, 0x68, 0x5c, 0x34, 0x33, 0xb5, 0xdc, 0xa1, 0xda, 0xc3,
+	0xc3, 0x88, 0xbd, 0x92, 0x00, 0xde, 0xa9, 0xed, 0x98, 0xf1, 0x7f, 0x98, 0x6e, 0x47, 0xbc, 0xb6,
+	0x3e, 0xd4, 0x53, 0xdb, 0x0e, 0x6c, 0x23, 0x8f, 0xad, 0xed, 0x93, 0xbc, 0x4c, 0x2f, 0xe5, 0x51,
+	0x0a, 0xb8, 0x39, 0xec, 0x94, 0xde, 0xa5, 0x88, 0x18, 0x40, 0xd3, 0x76, 0x35, 0xf5, 0x2c, 0xcb,
+	0x99, 0x78, 0x55, 0xf3, 0xf2, 0xfc, 0x3c, 0x2f, 0x93, 0x19, 0x58, 0x4d, 0x71, 0x71, 0xec, 0xca,
+	0x89, 0xd5, 0x14, 0xc6, 0xd9, 0x43, 0x20, 0x5c, 0x3a, 0x66, 0x69, 0x59, 0xa4, 0x59, 0x0e, 0x8f,
+	0x17, 0x0b, 0x4d, 0x23, 0x24, 0x0e, 0x81, 0x74, 0x20, 0x9b, 0xf1, 0x70, 0x11, 0x8f, 0xe7, 0xba,
+	0xfc, 0x77, 0xba, 0x8a, 0x8e, 0x98, 0xc8, 0x78, 0x10, 0xcc, 0x6e, 0x2a, 0x70, 0xe1, 0x69, 0x25,
+	0x8c, 0xdf, 0xe8, 0x6a, 0x9d, 0x56, 0x9e, 0xdd, 0x9b, 0x01, 0xc2, 0x2e, 0x8e, 0xf9, 0xdf, 0x0f,
+	0xca, 0x37, 0x85, 0x30, 0x7a, 0xab, 0xab, 0xa2, 0x65, 0xc4, 0xe2, 0x18, 0x32, 0xca, 0xf0, 0x4f,
+	0xa3, 0x5f, 0x17, 0x86, 0xeb, 0xb2, 0x1a, 0x5d, 0x43, 0x14, 0x6a, 0xe7, 0x30, 0xee, 0x75, 0x52,
+	0x6e, 0xcf, 0x8c, 0x98, 0xbe, 0x71, 0xda, 0x24, 0x73, 0x78, 0x82, 0xde, 0xb6, 0xb8, 0x90, 0x12,
+	0x67, 0x46, 0xba, 0x94, 0xdf, 0x2b, 0x5e, 0x94, 0x33, 0x65, 0x1d, 0xa9, 0xa1, 0x11, 0x86, 0x7a,
+	0x85, 0x0b, 0xd9, 0x11, 0xfc, 0x22, 0x59, 0x65, 0x73, 0x93, 0x49, 0xc8, 0x09, 0xa9, 0x01, 0x23,
+	0xd8, 0x32, 0xb1, 0x03, 0x11, 0x23, 0x98, 0x84, 0x95, 0xcf, 0x7f, 0xd9, 0x88, 0x6e, 0x58, 0xe6,
+	0x50, 0x6f, 0xc3, 0xf2, 0xcf, 0x2a, 0x78, 0x4e, 0xcb, 0x37, 0xbf, 0x9a, 0xd1, 0xe7, 0x94, 0x49,
+	0x9c, 0x37, 0x45, 0xf9, 0xe2, 0xca, 0x7a, 0x76, 0x39, 0xa2, 0xf7, 0x28, 0xed, 0x41, 0x05, 0xa9,
+	0x01, 0x96, 0x23, 0x1a, 0x8b, 0x21, 0x47, 0x2c, 0x47, 0x42, 0xbc, 0x6d, 0x62, 0xe3, 0x3c, 0x2f,
+	0x0b, 0xd8, 0xc4, 0xd6, 0x02, 0x17, 0x12, 0x4d, 0xdc, 0x81, 0xec, 0x44, 0xab, 0x45, 0x72, 0x3b,
+	0x8d, 0x7f, 0x69, 0xb3, 0x85, 0xab, 0x1a, 0x80, 0x98, 0x68, 0x51, 0x50, 0xf9, 0x19, 0x47, 0xdf,
+	0xe2, 0x8f, 0xf4, 0xa4, 0x66, 0x2b, 0x7e, 0xea, 0xd5, 0x1f, 0xff, 0x8e, 0x84, 0x18, 0xff, 0x3e,
+	0x61, 0x47, 0xd6, 0x69, 0xd1, 0x54, 0x79, 0xd2, 0x5c, 0xa8, 0x53, 0x16, 0x7e, 0x9d, 0xb5, 0x10,
+	0x9e, 0xb3, 0xb8, 0xd3, 0x43, 0xd9, 0xa0, 0xae, 0x65, 0x26, 0xc4, 0x6c, 0xe2, 0xaa, 0x9d, 0x30,
+	0xb3, 0xd5, 0xcb, 0xd9, 0xe9, 0xf9, 0x30, 0xc9, 0x73, 0x56, 0xaf, 0xb5, 0xec, 0x38, 0x29, 0xb2,
+	0x73, 0xd6, 0xb4, 0x60, 0x7a, 0x56, 0x54, 0x0c, 0x31, 0x62, 0x7a, 0x0e, 0xe0, 0x76, 0x99, 0x06,
+	0x3c, 0x1f, 0x15, 0x33, 0xf6, 0x16, 0x2c, 0xd3, 0xa0, 0x1d, 0xc1, 0x10, 0xcb, 0x34, 0x8a, 0xb5,
+	0x5b, 0xfa, 0x62, 0xf6, 0x54, 0x53, 0x80, 0xdf, 0xc0, 0x42, 0x02, 0xe7, 0x80, 0x5b, 0x21, 0xc4,
+	0x4e, 0x02, 0x42, 0x30, 0x66, 0x55, 0x9e, 0xa4, 0xf0, 0x60, 0x95, 0xd4, 0x51, 0x32, 0x62, 0x12,
+	0x80, 0x0c, 0x28, 0xae, 0x3a, 0xb0, 0x85, 0x15, 0x17, 0x9c, 0xd7, 0xba, 0x15, 0x42, 0xec, 0x34,
+	0x28, 0x04, 0x93, 0x2a, 0xcf, 0x5a, 0x30, 0x0c, 0xa4, 0x86, 0x90, 0x10, 0xc3, 0xc0, 0x27, 0x80,
+	0xc9, 0x63, 0x56, 0xcf, 0x19, 0x6a, 0x52, 0x48, 0x82, 0x26, 0x35, 0x61, 0x4f, 0x91, 0xcb, 0xba,
+	0x97, 0xd5, 0x1a, 0x9c, 0x22, 0x57, 0xd5, 0x2a, 0xab, 0x35, 0x71, 0x8a, 0xdc, 0x03, 0x40, 0x11,
+	0x4f, 0x92, 0xa6, 0xc5, 0x8b, 0x28, 0x24, 0xc1, 0x22, 0x6a, 0xc2, 0xce, 0xd1, 0xb2, 0x88, 0xcb,
+	0x16, 0xcc, 0xd1, 0xaa, 0x00, 0xce, 0xd1, 0x82, 0xeb, 0xa4, 0xdc, 0x46, 0x12, 0xd9, 0x2a, 0xac,
+	0x7d, 0x96, 0xb1, 0x7c, 0xd6, 0x80, 0x48, 0xa2, 0x9e, 0xbb, 0x96, 0x12, 0x91, 0xa4, 0x4b, 0x81,
+	0xae, 0xa4, 0x5e, 0x7c, 0x60, 0xb5, 0x03, 0xef, 0x3c, 0x6e, 0x85, 0x10, 0x1b, 0x9f, 0x74, 0xa1,
+	0xf7, 0x93, 0xba, 0xce, 0xf8, 0xe4, 0xbf, 0x89, 0x17, 0x48, 0xcb, 0x89, 0xf8, 0x84, 0x71, 0x60,
+	0x78, 0xe9, 0xc0, 0x8d, 0x15, 0x0c, 0x86, 0xee, 0x8f, 0x83, 0x8c, 0xcd, 0x38, 0x85, 0xc4, 0x79,
+	0x37, 0x8e, 0x3d, 0x4d, 0xe4, 0xd5, 0xf8, 0x66, 0x1f, 0xe6, 0x7c, 0x62, 0x66, 0x5c, 0xf0, 0xef,
+	0x98, 0xa6, 0xe5, 0xd3, 0xb7, 0x59, 0xd3, 0x66, 0xc5, 0x5c, 0xcd, 0xdc, 0x8f, 0x09, 0x4b, 0x18,
+	0x4c, 0x7c, 0x62, 0xd6, 0xab, 0x64, 0x13, 0x08, 0x50, 0x96, 0x17, 0xec, 0x0d, 0x9a, 0x40, 0x40,
+	0x8b, 0x86, 0x23, 0x12, 0x88, 0x10, 0x6f, 0x37, 0xc8, 0x8c, 0x73, 0x75, 0xb3, 0xc2, 0xb4, 0xd4,
+	0xb9, 0x1c, 0x65, 0x0d, 0x82, 0xc4, 0x1e, 0x45, 0x50, 0xc1, 0x6e, 0x1c, 0x18, 0xff, 0x76, 0x88,
+	0xdd, 0x25, 0xec, 0x74, 0x87, 0xd9, 0xbd, 0x01, 0x24, 0xe2, 0xca, 0x1e, 0xf0, 0xa0, 0x5c, 0x75,
+	0xcf, 0x77, 0xdc, 0x1b, 0x40, 0x3a, 0x9b, 0x6d, 0x6e, 0xb5, 0x9e, 0x24, 0xe9, 0xe5, 0xbc, 0x2e,
+	0x97, 0xc5, 0x6c, 0xbf, 0xcc, 0xcb, 0x1a, 0x6c, 0xb6, 0x79, 0xa5, 0x06, 0x28, 0xb1, 0xd9, 0xd6,
+	0xa3, 0x62, 0x33, 0x38, 0xb7, 0x14, 0x7b, 0x79, 0x36, 0x87, 0x5b, 0x25, 0x9e, 0x21, 0x01, 0x10,
+	0x19, 0x1c, 0x0a, 0x22, 0x9d, 0x48, 0x6e, 0x36, 0xb4, 0x59, 0x9a, 0xe4, 0xd2, 0xdf, 0x2e, 0x6d,
+	0xc6, 0x03, 0x7b, 0x3b, 0x11, 0xa2, 0x80, 0xd4, 0x73, 0xba, 0xac, 0x8b, 0xa3, 0xa2, 0x2d, 0xc9,
+	0x7a, 0x6a, 0xa0, 0xb7, 0x9e, 0x0e, 0x08, 0xc2, 0xea, 0x94, 0xbd, 0xe5, 0xa5, 0xe1, 0xff, 0x60,
+	0x61, 0x95, 0xff, 0x3d, 0x56, 0xf2, 0x50, 0x58, 0x05, 0x1c, 0xa8, 0x8c, 0x72, 0x22, 0x3b, 0x4c,
+	0x40, 0xdb, 0xef, 0x26, 0x77, 0xfb, 0x41, 0xdc, 0xcf, 0xa4, 0x5d, 0xe7, 0x2c, 0xe4, 0x47, 0x00,
+	0x43, 0xfc, 0x68, 0xd0, 0xee, 0xa3, 0x79, 0xf5, 0xb9, 0x60, 0xe9, 0x65, 0xe7, 0xbc, 0x9a, 0x5f,
+	0x50, 0x89, 0x10, 0xfb, 0x68, 0x04, 0x8a, 0x37, 0xd1, 0x51, 0x5a, 0x16, 0xa1, 0x26, 0xe2, 0xf2,
+	0x21, 0x4d, 0xa4, 0x38, 0xbb, 0xf8, 0x35, 0x52, 0xd5, 0x33, 0x65, 0x33, 0x6d, 0x13, 0x16, 0x5c,
+	0x88, 0x58, 0xfc, 0x92, 0xb0, 0xcd, 0xc9, 0xa1, 0xcf, 0xe3, 0xee, 0x61, 0xfe, 0x8e, 0x95, 0x63,
+	0xfa, 0x30, 0x3f, 0xc5, 0xd2, 0x95, 0x94, 0x7d, 0xa4, 0xc7, 0x8a, 0xdf, 0x4f, 0x1e, 0x0c, 0x83,
+	0xed, 0x92, 0xc7, 0xf3, 0xb9, 0x9f, 0xb3, 0xa4, 0x96, 0x5e, 0x77, 0x02, 0x86, 0x2c, 0x46, 0x2c,
+	0x79, 0x02, 0x38, 0x08, 0x61, 0x9e, 0xe7, 0xfd, 0xb2, 0x68, 0x59, 0xd1, 0x62, 0x21, 0xcc, 0x37,
+	0xa6, 0xc0, 0x50, 0x08, 0xa3, 0x14, 0x40, 0xbf, 0x15, 0xfb, 0x41, 0x72, 0xdf, 0x14, 0xeb, 0xb7,
+	0x72, 0xaf, 0x47, 0xca, 0x43, 0xfd, 0x16, 0x70, 0xce, 0xdb, 0x5b, 0xd7, 0xcb, 0x34, 0xa9, 0xe7,
+	0x66, 0x77, 0x63, 0x36, 0x7a, 0x48, 0xdb, 0xf1, 0x49, 0xe2, 0xed, 0x6d, 0x58, 0x03, 0x84, 0x9d,
+	0xa3, 0x45, 0x32, 0x37, 0x35, 0x45, 0x6a, 0x20, 0xe4, 0x9d, 0xaa, 0xde, 0xed, 0x07, 0x81, 0x9f,
+	0x57, 0xd9, 0x8c, 0x95, 0x01, 0x3f, 0x42, 0x3e, 0xc4, 0x0f, 0x04, 0x41, 0xf6, 0xc6, 0xeb, 0x2d,
+	0x57, 0x74, 0x7b, 0xc5, 0x4c, 0xad, 0x63, 0x63, 0xe2, 0xf1, 0x00, 0x2e, 0x94, 0xbd, 0x11, 0x3c,
+	0x18, 0xa3, 0x7a, 0x83, 0x36, 0x34, 0x46, 0xcd, 0xfe, 0xeb, 0x90, 0x31, 0x8a, 0xc1, 0xca, 0xe7,
+	0xcf, 0xd5, 0x18, 0x3d, 0x48, 0xda, 0x84, 0xe7, 0xed, 0xfc, 0x23, 0x63, 0xb5, 0x10, 0x46, 0xea,
+	0xab, 0xa9, 0x98, 0x63, 0x70, 0x55, 0xbc, 0x3b, 0x98, 0x0f, 0xf8, 0x56, 0x2b, 0x84, 0x5e, 0xdf,
+	0x60, 0xa9, 0xb0, 0x3b, 0x98, 0x0f, 0xf8, 0x56, 0x97, 0x1c, 0xf4, 0xfa, 0x06, 0x37, 0x1d, 0xec,
+	0x0e, 0xe6, 0x95, 0xef, 0xbf, 0xd2, 0x03, 0xd7, 0x75, 0xce, 0xf3, 0xb0, 0xb4, 0xcd, 0x56, 0x0c,
+	0x4b, 0x27, 0x7d, 0x7b, 0x06, 0x0d, 0xa5, 0x93, 0xb4, 0x8a, 0x73, 0xd1, 0x1a, 0x56, 0x8a, 0x93,
+	0xb2, 0xc9, 0xc4, 0xe9, 0x8b, 0xc7, 0x03, 0x8c, 0x6a, 0x38, 0xb4, 0x68, 0x0a, 0x29, 0xd9, 0xb7,
+	0x39, 0x1e, 0x6a, 0x8f, 0xa7, 0x3f, 0x08, 0xd8, 0xeb, 0x9e, 0x52, 0xdf, 0x19, 0x48, 0xdb, 0x37,
+	0xba, 0x1e, 0xe3, 0xbe, 0x4a, 0x0e, 0xb5, 0x2a, 0xfa, 0x36, 0xf9, 0xe1, 0x70, 0x05, 0xe5, 0xfe,
+	0x6f, 0xf4, 0xba, 0x02, 0xfa, 0x57, 0x83, 0xe0, 0xd1, 0x10, 0x8b, 0x60, 0x20, 0x3c, 0xbe, 0x92,
+	0x8e, 0x2a, 0xc8, 0x3f, 0xe8, 0x05, 0xb4, 0x46, 0xc5, 0x47, 0x3a, 0xe2, 0xe3, 0x5e, 0x35, 0x26,
+	0x42, 0xcd, 0x6a, 0x61, 0x38, 0x32, 0x3e, 0xbb, 0xa2, 0x96, 0x73, 0xed, 0x9e, 0x07, 0xab, 0x8f,
+	0x49, 0x9d, 0xf2, 0x84, 0x2c, 0x3b, 0x34, 0x2c, 0xd0, 0xe7, 0x57, 0x55, 0xa3, 0xc6, 0x8a, 0x03,
+	0x8b, 0x6b, 0x57, 0x1e, 0x0f, 0x34, 0xec, 0x5d, 0xc4, 0xf2, 0xe9, 0xd5, 0x94, 0x54, 0x59, 0xfe,
+	0x73, 0x23, 0xba, 0xe3, 0xb1, 0xf6, 0x7d, 0x02, 0xd8, 0xf5, 0xf8, 0x71, 0xc0, 0x3e, 0xa5, 0x64,
+	0x0a, 0xf7, 0xfb, 0xbf, 0x9a, 0xb2, 0xbd, 0xa1, 0xcd, 0x53, 0x79, 0x96, 0xe5, 0x2d, 0xab, 0xbb,
+	0x37, 0xb4, 0xf9, 0x76, 0x25, 0x15, 0xd3, 0x37, 0xb4, 0x05, 0x70, 0xe7, 0x86, 0x36, 0xc4, 0x33,
+	0x7a, 0x43, 0x1b, 0x6a, 0x2d, 0x78, 0x43, 0x5b, 0x58, 0x83, 0x0a, 0xef, 0xba, 0x08, 0x72, 0xdf,
+	0x7a, 0x90, 0x45, 0x7f, 0x1b, 0xfb, 0xd1, 0x55, 0x54, 0x88, 0x09, 0x4e, 0x72, 0xe2, 0x00, 0xe3,
+	0x80, 0x67, 0xea, 0x1d, 0x62, 0xdc, 0x1d, 0xcc, 0x2b, 0xdf, 0x3f, 0x8b, 0xbe, 0xe3, 0x51, 0x5c,
+	0xca, 0xdb, 0x7e, 0x3b, 0x14, 0x9e, 0xb9, 0x05, 0xb7, 0xe5, 0x1f, 0x0c, 0x83, 0x89, 0xea, 0x72,
+	0x42, 0x35, 0x7a, 0xdc, 0x67, 0x08, 0x34, 0xf9, 0xee, 0x60, 0x9e, 0x98, 0x46, 0xa4, 0x6f, 0xd9,
+	0xda, 0x03, 0x8c, 0xf9, 0x6d, 0xfd, 0x70, 0xb8, 0x82, 0x72, 0xbf, 0x8a, 0x3e, 0xf0, 0x30, 0x4e,
+	0xf1, 0xff, 0x82, 0x43, 0x4d, 0x98, 0x9a, 0x78, 0xcd, 0x1c, 0x0f, 0xc5, 0x43, 0x09, 0x84, 0x3b,
+	0x85, 0xf6, 0x25, 0x10, 0xe8, 0x34, 0xfa, 0xe9, 0xd5, 0x94, 0x54, 0x59, 0xfe, 0x79, 0x23, 0xba,
+	0x4e, 0x96, 0x45, 0xf5, 0x83, 0xcf, 0x87, 0x5a, 0x06, 0xfd, 0xe1, 0x8b, 0x2b, 0xeb, 0xa9, 0x42,
+	0xfd, 0xdb, 0x46, 0x74, 0x23, 0x50, 0x28, 0xd9, 0x41, 0xae, 0x60, 0xdd, 0xef, 0x28, 0x3f, 0xbc,
+	0xba, 0x22, 0x35, 0xdd, 0xbb, 0xf8, 0xa4, 0x7b, 0xdb, 0x56, 0xc0, 0xf6, 0x84, 0xbe, 0x6d, 0xab,
+	0x5f, 0x0b, 0x6e, 0xf2, 0x24, 0x67, 0x7a, 0xd1, 0x85, 0x6e, 0xf2, 0x70, 0x71, 0xf8, 0xd6, 0x10,
+	0x8c, 0xc3, 0x9c, 0x3c, 0x7d, 0x5b, 0x25, 0xc5, 0x8c, 0x76, 0x22, 0xe5, 0xfd, 0x4e, 0x0c, 0x07,
+	0x37, 0xc7, 0xb8, 0x74, 0x5c, 0xea, 0x85, 0xd4, 0x3d, 0x4a, 0xdf, 0x20, 0xc1, 0xcd, 0xb1, 0x0e,
+	0x4a, 0x78, 0x53, 0x59, 0x63, 0xc8, 0x1b, 0x48, 0x16, 0xef, 0x0f, 0x41, 0x41, 0x8a, 0x6e, 0xbc,
+	0x99, 0x3d, 0xf7, 0x07, 0x21, 0x2b, 0x9d, 0x7d, 0xf7, 0x9d, 0x81, 0x34, 0xe1, 0x76, 0xc2, 0xda,
+	0x2f, 0x59, 0xc2, 0xef, 0xae, 0x09, 0xb9, 0x35, 0xd4, 0x20, 0xb7, 0x2e, 0x8d, 0xb9, 0xdd, 0x2f,
+	0xf3, 0xe5, 0xa2, 0x50, 0x8d, 0x49, 0xba, 0x75, 0xa9, 0x7e, 0xb7, 0x80, 0x86, 0xdb, 0x82, 0xd6,
+	0xad, 0x48, 0x2f, 0xef, 0x87, 0xcd, 0x78, 0x59, 0xe5, 0xf6, 0x20, 0x96, 0xae, 0xa7, 0xea, 0x46,
+	0x3d, 0xf5, 0x04, 0x3d, 0x69, 0x67, 0x20, 0x0d, 0xf7, 0xe7, 0x1c, 0xb7, 0xa6, 0x3f, 0xed, 0xf6,
+	0xd8, 0xea, 0x74, 0xa9, 0x87, 0xc3, 0x15, 0xe0, 0x6e, 0xa8, 0xea, 0x55, 0x7c, 0x6f, 0xe4, 0x59,
+	0x96, 0xe7, 0xa3, 0xed, 0x40, 0x37, 0xd1, 0x50, 0x70, 0x37, 0x14, 0x81, 0x89, 0x9e, 0xac, 0x77,
+	0x0f, 0x8b, 0x51, 0x9f, 0x1d, 0x41, 0x0d, 0xea, 0xc9, 0x2e, 0x0d, 0x76, 0xb4, 0x9c, 0x47, 0x6d,
+	0x6a, 0x1b, 0x87, 0x1f, 0x5c, 0xa7, 0xc2, 0xbb, 0x83, 0x79, 0xf0, 0xba, 0x5d, 0x50, 0x62, 0x66,
+	0xb9, 0x4d, 0x99, 0xf0, 0x66, 0x92, 0x3b, 0x3d, 0x14, 0x78, 0xb5, 0x2c, 0x64, 0xd3, 0x72, 0xbf,
+	0x59, 0x8d, 0x48, 0x4d, 0x21, 0x0e, 0xbd, 0x5a, 0xf6, 0x31, 0xb0, 0xef, 0x28, 0x07, 0xea, 0xeb,
+	0x6c, 0x36, 0x67, 0x2d, 0xfa, 0x2e, 0xca, 0x05, 0x82, 0xef, 0xa2, 0x00, 0x08, 0x3a, 0x87, 0xfc,
+	0xbb, 0xd9, 0x70, 0x3d, 0x9a, 0x61, 0x9d, 0x43, 0x29, 0x3b, 0x54, 0xa8, 0x73, 0xa0, 0x34, 0x88,
+	0x37, 0xc6, 0xad, 0xba, 0xc9, 0xe1, 0x7e, 0xc8, 0x0c, 0xb8, 0xce, 0x61, 0x7b, 0x10, 0x0b, 0xe6,
+	0x2c, 0xeb, 0x30, 0x5b, 0x64, 0x2d, 0x36, 0x67, 0x39, 0x36, 0x38, 0x12, 0x9a, 0xb3, 0xba, 0x28,
+	0x55, 0x3d, 0x9e, 0x85, 0x1c, 0xcd, 0xc2, 0xd5, 0x93, 0xcc, 0xb0, 0xea, 0x19, 0xb6, 0xf3, 0xea,
+	0xb4, 0x30, 0x5d, 0xa6, 0xbd, 0x50, 0xcb, 0x71, 0x64, 0xf4, 0x70, 0x2e, 0x86, 0x60, 0x28, 0xae,
+	0x51, 0x0a, 0xf0, 0x95, 0x80, 0xfe, 0x61, 0x00, 0xbe, 0xef, 0x57, 0x55, 0x2c, 0xa9, 0x93, 0x22,
+	0x45, 0x97, 0xbf, 0xe6, 0xa2, 0x7f, 0x8f, 0x0c, 0x2d, 0x7f, 0x49, 0x0d, 0xf0, 0x62, 0xde, 0xff,
+	0x36, 0x17, 0x19, 0x0a, 0x1a, 0x88, 0xfd, 0x4f, 0x73, 0xef, 0x0d, 0x20, 0xe1, 0x8b, 0x79, 0x0d,
+	0x98, 0xad, 0x75, 0xe9, 0xf4, 0x93, 0x80, 0x29, 0x1f, 0x0d, 0x2d, 0xb5, 0x69, 0x15, 0xd0, 0xa9,
+	0x4d, 0x0a, 0xcd, 0xda, 0x9f, 0xb2, 0x35, 0xd6, 0xa9, 0x6d, 0x06, 0x2c, 0x90, 0x50, 0xa7, 0xee,
+	0xa2, 0x20, 0x93, 0x75, 0x57, 0x5a, 0x9b, 0x01, 0x7d, 0x77, 0x71, 0xb5, 0xd5, 0xcb, 0x81, 0x91,
+	0x73, 0x90, 0xad, 0xbc, 0x37, 0x11, 0x48, 0x41, 0x0f, 0xb2, 0x15, 0xfe, 0x22, 0x62, 0x7b, 0x10,
+	0x0b, 0x5f, 0xfa, 0x27, 0x2d, 0x7b, 0xab, 0xdf, 0xc6, 0x23, 0xc5, 0x15, 0xf2, 0xce, 0xeb, 0xf8,
+	0xbb, 0xfd, 0xa0, 0x3d, 0x62, 0x7b, 0x52, 0x97, 0x29, 0x6b, 0x1a, 0x75, 0xc9, 0xa9, 0x7f, 0x86,
+	0x49, 0xc9, 0x62, 0x70, 0xc5, 0xe9, 0xed, 0x30, 0xe4, 0xdc, 0x4c, 0x28, 0x45, 0xf6, 0xc2, 0xa4,
+	0x4d, 0x54, 0xb3, 0x7b, 0x57, 0xd2, 0x56, 0x2f, 0x67, 0x87, 0x97, 0x92, 0xba, 0x37, 0x24, 0xdd,
+	0x45, 0xd5, 0xb1, 0xcb, 0x91, 0xee, 0x0d, 0x20, 0x95, 0xab, 0x2f, 0xa3, 0x77, 0x9f, 0x97, 0xf3,
+	0x09, 0x2b, 0x66, 0xa3, 0x1f, 0x78, 0x5a, 0xcf, 0xcb, 0x79, 0xcc, 0xff, 0x6c, 0x8c, 0x5e, 0xa3,
+	0xc4, 0xf6, 0x98, 0xe1, 0x01, 0x3b, 0x5b, 0xce, 0x27, 0x6d, 0xd2, 0x82, 0x63, 0x86, 0xe2, 0xef,
+	0x31, 0x17, 0x10, 0xc7, 0x0c, 0x3d, 0x00, 0xd8, 0x9b, 0xd6, 0x8c, 0xa1, 0xf6, 0xb8, 0x20, 0x68,
+	0x4f, 0x01, 0x36, 0x4f, 0x31, 0xf6, 0xf8, 0x52, 0x00, 0x1e, 0x0b, 0xb4, 0x3a, 0x42, 0x4a, 0xe4,
+	0x29, 0x5d, 0xca, 0x76, 0x6e, 0x59, 0x7d, 0x71, 0x61, 0xcd, 0x72, 0xb1, 0x48, 0xea, 0x35, 0xe8,
+	0xdc, 0xaa, 0x96, 0x0e, 0x40, 0x74, 0x6e, 0x14, 0xb4, 0xa3, 0x56, 0x3f, 0xe6, 0xf4, 0xf2, 0xb0,
+	0xac, 0xcb, 0x65, 0x9b, 0x15, 0x0c, 0x5e, 0x5a, 0x62, 0x1e, 0xa8, 0xcb, 0x10, 0xa3, 0x96, 0x62,
+	0x6d, 0x1e, 0x2d, 0x08, 0x79, 0x62, 0x51, 0xdc, 0xbb, 0x2e, 0x3e, 0xd7, 0x19, 0x61, 0x56, 0x20,
+	0x44, 0xe4, 0xd1, 0x24, 0x0c, 0xda, 0xfe, 0x84, 0xdf, 0x1f, 0x8c, 0xb5, 0xfd, 0x89, 0x7b, 0x71,
+	0xf0, 0x0d, 0x1a, 0xb0, 0x03, 0x4a, 0x3e, 0x34, 0x39, 0x00, 0xd4, 0x67, 0xc0, 0xe8, 0x43, 0x77,
+	0x09, 0x62, 0x40, 0xe1, 0x24, 0x70, 0xf5, 0xb2, 0x62, 0x05, 0x9b, 0xe9, 0x73, 0x79, 0x98, 0x2b,
+	0x8f, 0x08, 0xba, 0x82, 0xa4, 0x8d, 0x45, 0x42, 0x3e, 0x5e, 0x16, 0x27, 0x75, 0x79, 0x9e, 0xe5,
+	0xac, 0x06, 0xb1, 0x48, 0xaa, 0x3b, 0x72, 0x22, 0x16, 0x61, 0x9c, 0x3d, 0xe0, 0x21, 0xa4, 0xde,
+	0x8f, 0x07, 0x4c, 0xeb, 0x24, 0x85, 0x07, 0x3c, 0xa4, 0x8d, 0x2e, 0x46, 0xec, 0x3d, 0x06, 0x70,
+	0x27, 0xd1, 0x91, 0xae, 0x8b, 0xb5, 0xe8, 0x1f, 0xea, 0x33, 0x54, 0xf5, 0x0d, 0xd8, 0x43, 0xcc,
+	0x1c, 0x46, 0x12, 0x89, 0x4e, 0x58, 0xc3, 0x4e, 0x25, 0x82, 0x7b, 0xa1, 0x0e, 0x2e, 0x81, 0xa9,
+	0x44, 0xda, 0xd0, 0x42, 0x62, 0x2a, 0xe9, 0x40, 0x20, 0x20, 0xe9, 0x61, 0x30, 0x47, 0x03, 0x92,
+	0x91, 0x06, 0x03, 0x92, 0x4b, 0xd9, 0x40, 0x71, 0x54, 0x64, 0x6d, 0x96, 0xe4, 0xfc, 0x75, 0x6c,
+	0x52, 0x27, 0x0b, 0xd6, 0xb2, 0x1a, 0x06, 0x0a, 0x85, 0xc4, 0x1e, 0x43, 0x04, 0x0a, 0x8a, 0x55,
+	0x0e, 0xff, 0x20, 0x7a, 0x9f, 0xcf, 0xfb, 0xac, 0x50, 0x3f, 0x7b, 0xf4, 0x54, 0xfc, 0x62, 0xdc,
+	0xe8, 0x43, 0x63, 0x63, 0xd2, 0xd6, 0x2c, 0x59, 0x68, 0xdb, 0xef, 0x99, 0xbf, 0x0b, 0xf0, 0xe1,
+	0x06, 0xef, 0xcf, 0xfc, 0xae, 0x8f, 0xf3, 0x2c, 0x35, 0xdf, 0x28, 0x81, 0xfe, 0xec, 0x8a, 0xe3,
+	0xc0, 0x35, 0x26, 0x18, 0x67, 0xe3, 0xb4, 0x2b, 0x1d, 0xb3, 0x2a, 0x87, 0x71, 0xda, 0xd3, 0x16,
+	0x00, 0x11, 0xa7, 0x51, 0xd0, 0x0e, 0x4e, 0x57, 0x3c, 0x65, 0xe1, 0xca, 0x4c, 0xd9, 0xb0, 0xca,
+	0x4c, 0xbd, 0xcf, 0x3e, 0xf2, 0xe8, 0xfd, 0x63, 0xb6, 0x38, 0x63, 0x75, 0x73, 0x91, 0x55, 0xd4,
+	0x95, 0xbf, 0x96, 0xe8, 0xbd, 0xf2, 0x97, 0x40, 0xed, 0x4c, 0x60, 0x81, 0xa3, 0x86, 0x9f, 0xaa,
+	0x11, 0x97, 0xb2, 0x80, 0x99, 0xc0, 0x31, 0xe2, 0x40, 0xc4, 0x4c, 0x40, 0xc2, 0xce, 0x17, 0x64,
+	0x96, 0x19, 0xb3, 0x39, 0xef, 0x61, 0xf5, 0x49, 0xb2, 0x5e, 0xb0, 0xa2, 0x55, 0x26, 0xc1, 0xae,
+	0xbf, 0x63, 0x12, 0xe7, 0x89, 0x5d, 0xff, 0x21, 0x7a, 0x4e, 0x68, 0xf2, 0x1e, 0xfc, 0x49, 0x59,
+	0xb7, 0xf2, 0xf7, 0xcc, 0xf8, 0xf5, 0xb9, 0x0f, 0x03, 0x0f, 0xd5, 0x23, 0x89, 0xd0, 0x14, 0xd6,
+	0x70, 0x7e, 0xc0, 0xc2, 0x2b, 0xc3, 0x2b, 0x56, 0x9b, 0x7e, 0xf2, 0x74, 0x91, 0x64, 0xb9, 0xea,
+	0x0d, 0x3f, 0x0a, 0xd8, 0x26, 0x74, 0x88, 0x1f, 0xb0, 0x18, 0xaa, 0xeb, 0xfc, 0xe4, 0x47, 0xb8,
+	0x84, 0xe0, 0x25, 0x44, 0x8f, 0x7d, 0xe2, 0x25, 0x44, 0xbf, 0x96, 0x5d, 0xb9, 0x5b, 0x56, 0x70,
+	0x6b, 0x41, 0xec, 0x97, 0x33, 0xb8, 0x23, 0xe9, 0xd8, 0x04, 0x20, 0xb1, 0x72, 0x0f, 0x2a, 0xd8,
+	0xd4, 0xc0, 0x62, 0xcf, 0xb2, 0x22, 0xc9, 0xb3, 0x9f, 0xc3, 0xb4, 0xde, 0xb1, 0xa3, 0x09, 0x22,
+	0x35, 0xc0, 0x49, 0xcc, 0xd5, 0x21, 0x6b, 0xa7, 0x19, 0x0f, 0xfd, 0x77, 0x03, 0xcf, 0x4d, 0x10,
+	0xfd, 0xae, 0x1c, 0xd2, 0xb9, 0xde, 0x17, 0x3e, 0x56, 0xfe, 0x23, 0x9a, 0x7c, 0x56, 0x1d, 0xb3,
+	0x94, 0x65, 0x55, 0x3b, 0xfa, 0x2c, 0xfc, 0xac, 0x00, 0x4e, 0x1c, 0xe5, 0x18, 0xa0, 0xe6, 0x1c,
+	0x10, 0xe0, 0xb1, 0x64, 0x22, 0x7f, 0xef, 0xf4, 0xb4, 0x61, 0xb5, 0x4a, 0x34, 0x0e, 0x59, 0x0b,
+	0x46, 0xa7, 0xc3, 0xc5, 0x0e, 0xc8, 0x2b, 0x4a, 0x8c, 0xce, 0xb0, 0x86, 0xdd, 0xec, 0x73, 0x38,
+	0x75, 0x5d, 0x3b, 0xff, 0xcb, 0xe8, 0x01, 0x69, 0xcc, 0xa1, 0x88, 0xcd, 0x3e, 0x9a, 0xb6, 0xd9,
+	0x5a, 0xd7, 0xed, 0x5e, 0xb1, 0x3e, 0x82, 0x87, 0x32, 0x10, 0x4b, 0x02, 0x23, 0xb2, 0xb5, 0x00,
+	0xee, 0x6c, 0xb7, 0xd7, 0x65, 0x32, 0x4b, 0x93, 0xa6, 0x3d, 0x49, 0xd6, 0xfc, 0xd4, 0xa3, 0x98,
+	0xd7, 0xe1, 0x76, 0xbb, 0x66, 0x62, 0x17, 0xa2, 0xb6, 0xdb, 0x29, 0xd8, 0xcd, 0xce, 0x78, 0x99,
+	0xf4, 0x69, 0x51, 0x98, 0x9d, 0x71, 0x59, 0xe7, 0xa4, 0xe8, 0xed, 0x30, 0x64, 0xbf, 0x72, 0x93,
+	0x22, 0x91, 0x86, 0xdc, 0xc0, 0x74, 0xbc, 0x04, 0xe4, 0x66, 0x80, 0xb0, 0x57, 0x9a, 0xc8, 0xbf,
+	0xeb, 0xdf, 0xad, 0x6a, 0xd5, 0x25, 0xe8, 0x0f, 0x30, 0x5d, 0x17, 0x8a, 0xdd, 0xbb, 0x11, 0x77,
+	0x06, 0xd2, 0x36, 0xcd, 0xdc, 0xbf, 0x48, 0xf8, 0xd9, 0x8c, 0x63, 0xd6, 0x20, 0x9f, 0xac, 0x73,
+	0x61, 0x6c, 0xa5, 0x44, 0x9a, 0xd9, 0xa5, 0x6c, 0x47, 0xe7, 0xb2, 0xa7, 0xb3, 0xac, 0x55, 0x32,
+	0x7d, 0x06, 0xfb, 0x41, 0xd7, 0x40, 0x97, 0x22, 0x6a, 0x45, 0xd3, 0x36, 0x96, 0x73, 0x66, 0x5a,
+	0xce, 0xe7, 0x39, 0x53, 0xd0, 0x98, 0x25, 0xf2, 0x0e, 0xc8, 0xdd, 0xae, 0x2d, 0x14, 0x24, 0x62,
+	0x79, 0x50, 0xc1, 0xa6, 0x91, 0x1c, 0x93, 0x2f, 0xbd, 0xf4, 0x83, 0xdd, 0xea, 0x9a, 0xf1, 0x00,
+	0x22, 0x8d, 0x44, 0x41, 0xfb, 0xfa, 0x83, 0x8b, 0x0f, 0x99, 0x7e, 0x12, 0xf0, 0xf6, 0x2a, 0xa1,
+	0xec, 0x88, 0x89, 0xd7, 0x1f, 0x08, 0x66, 0xd7, 0x09, 0xc0, 0xc3, 0x93, 0x35, 0xbf, 0x74, 0xfc,
+	0x7e, 0x50, 0x5f, 0x30, 0xc4, 0x3a, 0x81, 0x62, 0xfd, 0xa6, 0x33, 0xfb, 0x5e, 0xcf, 0x93, 0xc6,
+	0x56, 0x0e, 0x69, 0x3a, 0x14, 0x0c, 0x35, 0x1d, 0xa5, 0xe0, 0x3f, 0x52, 0x77, 0x6b, 0x0d, 0x79,
+	0xa4, 0xd8, 0xbe, 0xda, 0x66, 0x1f, 0x66, 0xe3, 0x92, 0x59, 0x4f, 0x8a, 0x43, 0x51, 0xf8, 0x8f,
+	0x3f, 0x48, 0x21, 0x11, 0x97, 0x3a, 0x90, 0xb3, 0x89, 0x9a, 0xa4, 0x97, 0xcb, 0x6a, 0xc2, 0x5a,
+	0x7e, 0x2a, 0xaf, 0x99, 0x74, 0xdf, 0x56, 0x09, 0x79, 0xec, 0x00, 0xd4, 0x26, 0x2a, 0x06, 0x52,
+	0x7e, 0x0e, 0xfb, 0xfc, 0x1c, 0x0e, 0xf5, 0xe3, 0x4d, 0x94, 0xfc, 0xeb, 0x64, 0x21, 0x1e, 0x2f,
+	0xe1, 0x6f, 0x5c, 0x29, 0xb5, 0xf1, 0x92, 0xfa, 0x8d, 0x2b, 0x0f, 0x70, 0xbe, 0x4e, 0x16, 0x7f,
+	0x46, 0xe2, 0xb6, 0xe2, 0x03, 0x71, 0xdb, 0x27, 0xa4, 0xc9, 0x27, 0x37, 0xff, 0xeb, 0xeb, 0x6b,
+	0x1b, 0xbf, 0xfc, 0xfa, 0xda, 0xc6, 0xff, 0x7c, 0x7d, 0x6d, 0xe3, 0x17, 0xdf, 0x5c, 0x7b, 0xe7,
+	0x97, 0xdf, 0x5c, 0x7b, 0xe7, 0xbf, 0xbf, 0xb9, 0xf6, 0xce, 0x57, 0xef, 0xaa, 0x5f, 0x54, 0x3f,
+	0xfb, 0x35, 0xf1, 0xbb, 0xe8, 0x8f, 0xff, 0x7f, 0x00, 0xb6, 0xcc, 0x43, 0x1b, 0x75, 0x7d, 0x00,
+	0x00,
 }
 
 // This is a compile-time assertion to ensure that this generated file
@@ -384,6 +396,8 @@ type ClientCommandsHandler interface {
 	WalletConvert(context.Context, *pb.RpcWalletConvertRequest) *pb.RpcWalletConvertResponse
 	AccountLocalLinkNewChallenge(context.Context, *pb.RpcAccountLocalLinkNewChallengeRequest) *pb.RpcAccountLocalLinkNewChallengeResponse
 	AccountLocalLinkSolveChallenge(context.Context, *pb.RpcAccountLocalLinkSolveChallengeRequest) *pb.RpcAccountLocalLinkSolveChallengeResponse
+	AccountLocalLinkListApps(context.Context, *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse
+	AccountLocalLinkRevokeApp(context.Context, *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse
 	WalletCreateSession(context.Context, *pb.RpcWalletCreateSessionRequest) *pb.RpcWalletCreateSessionResponse
 	WalletCloseSession(context.Context, *pb.RpcWalletCloseSessionRequest) *pb.RpcWalletCloseSessionResponse
 	// Workspace
@@ -520,6 +534,10 @@ type ClientCommandsHandler interface {
 	HistoryGetVersions(context.Context, *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse
 	HistorySetVersion(context.Context, *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse
 	HistoryDiffVersions(context.Context, *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse
+	HistorySetVersionName(context.Context, *pb.RpcHistorySetVersionNameRequest) *pb.RpcHistorySetVersionNameResponse
+	HistoryGetNamedVersions(context.Context, *pb.RpcHistoryGetNamedVersionsRequest) *pb.RpcHistoryGetNamedVersionsResponse
+	HistoryRestoreVersion(context.Context, *pb.RpcHistoryRestoreVersionRequest) *pb.RpcHistoryRestoreVersionResponse
+	HistoryGetBlockChanges(context.Context, *pb.RpcHistoryGetBlockChangesRequest) *pb.RpcHistoryGetBlockChangesResponse
 	// Files
 	// ***
 	FileSpaceOffload(context.Context, *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse
@@ -628,6 +646,7 @@ type ClientCommandsHandler interface {
 	BlockTableRowListClean(context.Context, *pb.RpcBlockTableRowListCleanRequest) *pb.RpcBlockTableRowListCleanResponse
 	BlockTableColumnListFill(context.Context, *pb.RpcBlockTableColumnListFillRequest) *pb.RpcBlockTableColumnListFillResponse
 	BlockTableSort(context.Context, *pb.RpcBlockTableSortRequest) *pb.RpcBlockTableSortResponse
+	BlockTableToCsv(context.Context, *pb.RpcBlockTableToCsvRequest) *pb.RpcBlockTableToCsvResponse
 	// Widget commands
 	// ***
 	BlockCreateWidget(context.Context, *pb.RpcBlockCreateWidgetRequest) *pb.RpcBlockCreateWidgetResponse
@@ -715,6 +734,12 @@ type ClientCommandsHandler interface {
 	ChatSubscribeLastMessages(context.Context, *pb.RpcChatSubscribeLastMessagesRequest) *pb.RpcChatSubscribeLastMessagesResponse
 	ChatUnsubscribe(context.Context, *pb.RpcChatUnsubscribeRequest) *pb.RpcChatUnsubscribeResponse
 	ObjectChatAdd(context.Context, *pb.RpcObjectChatAddRequest) *pb.RpcObjectChatAddResponse
+	// Backups
+	// ***
+	BackupSettingsSet(context.Context, *pb.RpcBackupSettingsSetRequest) *pb.RpcBackupSettingsSetResponse
+	BackupSettingsGet(context.Context, *pb.RpcBackupSettingsGetRequest) *pb.RpcBackupSettingsGetResponse
+	BackupRun(context.Context, *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse
+	BackupList(context.Context, *pb.RpcBackupListRequest) *pb.RpcBackupListResponse
 }
 
 func registerClientCommandsHandler(srv ClientCommandsHandler) {
@@ -881,6 +906,46 @@ func AccountLocalLinkSolveChallenge(b []byte) (resp []byte) {
 	return resp
 }
 
+func AccountLocalLinkListApps(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcAccountLocalLinkListAppsResponse{Error: &pb.RpcAccountLocalLinkListAppsResponseError{Code: pb.RpcAccountLocalLinkListAppsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcAccountLocalLinkListAppsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcAccountLocalLinkListAppsResponse{Error: &pb.RpcAccountLocalLinkListAppsResponseError{Code: pb.RpcAccountLocalLinkListAppsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.AccountLocalLinkListApps(context.Background(), in).Marshal()
+	return resp
+}
+
+func AccountLocalLinkRevokeApp(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcAccountLocalLinkRevokeAppResponse{Error: &pb.RpcAccountLocalLinkRevokeAppResponseError{Code: pb.RpcAccountLocalLinkRevokeAppResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcAccountLocalLinkRevokeAppRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcAccountLocalLinkRevokeAppResponse{Error: &pb.RpcAccountLocalLinkRevokeAppResponseError{Code: pb.RpcAccountLocalLinkRevokeAppResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.AccountLocalLinkRevokeApp(context.Background(), in).Marshal()
+	return resp
+}
+
 func WalletCreateSession(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -3161,6 +3226,86 @@ func HistoryDiffVersions(b []byte) (resp []byte) {
 	return resp
 }
 
+func HistorySetVersionName(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcHistorySetVersionNameResponse{Error: &pb.RpcHistorySetVersionNameResponseError{Code: pb.RpcHistorySetVersionNameResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcHistorySetVersionNameRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcHistorySetVersionNameResponse{Error: &pb.RpcHistorySetVersionNameResponseError{Code: pb.RpcHistorySetVersionNameResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.HistorySetVersionName(context.Background(), in).Marshal()
+	return resp
+}
+
+func HistoryGetNamedVersions(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcHistoryGetNamedVersionsResponse{Error: &pb.RpcHistoryGetNamedVersionsResponseError{Code: pb.RpcHistoryGetNamedVersionsResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcHistoryGetNamedVersionsRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcHistoryGetNamedVersionsResponse{Error: &pb.RpcHistoryGetNamedVersionsResponseError{Code: pb.RpcHistoryGetNamedVersionsResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.HistoryGetNamedVersions(context.Background(), in).Marshal()
+	return resp
+}
+
+func HistoryRestoreVersion(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcHistoryRestoreVersionResponse{Error: &pb.RpcHistoryRestoreVersionResponseError{Code: pb.RpcHistoryRestoreVersionResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcHistoryRestoreVersionRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcHistoryRestoreVersionResponse{Error: &pb.RpcHistoryRestoreVersionResponseError{Code: pb.RpcHistoryRestoreVersionResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.HistoryRestoreVersion(context.Background(), in).Marshal()
+	return resp
+}
+
+func HistoryGetBlockChanges(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcHistoryGetBlockChangesResponse{Error: &pb.RpcHistoryGetBlockChangesResponseError{Code: pb.RpcHistoryGetBlockChangesResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcHistoryGetBlockChangesRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcHistoryGetBlockChangesResponse{Error: &pb.RpcHistoryGetBlockChangesResponseError{Code: pb.RpcHistoryGetBlockChangesResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.HistoryGetBlockChanges(context.Background(), in).Marshal()
+	return resp
+}
+
 func FileSpaceOffload(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -5041,6 +5186,26 @@ func BlockTableSort(b []byte) (resp []byte) {
 	return resp
 }
 
+func BlockTableToCsv(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBlockTableToCsvResponse{Error: &pb.RpcBlockTableToCsvResponseError{Code: pb.RpcBlockTableToCsvResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBlockTableToCsvRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBlockTableToCsvResponse{Error: &pb.RpcBlockTableToCsvResponseError{Code: pb.RpcBlockTableToCsvResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BlockTableToCsv(context.Background(), in).Marshal()
+	return resp
+}
+
 func BlockCreateWidget(b []byte) (resp []byte) {
 	defer func() {
 		if PanicHandler != nil {
@@ -6261,6 +6426,86 @@ func ObjectChatAdd(b []byte) (resp []byte) {
 	return resp
 }
 
+func BackupSettingsSet(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBackupSettingsSetResponse{Error: &pb.RpcBackupSettingsSetResponseError{Code: pb.RpcBackupSettingsSetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBackupSettingsSetRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBackupSettingsSetResponse{Error: &pb.RpcBackupSettingsSetResponseError{Code: pb.RpcBackupSettingsSetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BackupSettingsSet(context.Background(), in).Marshal()
+	return resp
+}
+
+func BackupSettingsGet(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBackupSettingsGetResponse{Error: &pb.RpcBackupSettingsGetResponseError{Code: pb.RpcBackupSettingsGetResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBackupSettingsGetRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBackupSettingsGetResponse{Error: &pb.RpcBackupSettingsGetResponseError{Code: pb.RpcBackupSettingsGetResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BackupSettingsGet(context.Background(), in).Marshal()
+	return resp
+}
+
+func BackupRun(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBackupRunResponse{Error: &pb.RpcBackupRunResponseError{Code: pb.RpcBackupRunResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBackupRunRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBackupRunResponse{Error: &pb.RpcBackupRunResponseError{Code: pb.RpcBackupRunResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BackupRun(context.Background(), in).Marshal()
+	return resp
+}
+
+func BackupList(b []byte) (resp []byte) {
+	defer func() {
+		if PanicHandler != nil {
+			if r := recover(); r != nil {
+				resp, _ = (&pb.RpcBackupListResponse{Error: &pb.RpcBackupListResponseError{Code: pb.RpcBackupListResponseError_UNKNOWN_ERROR, Description: "panic recovered"}}).Marshal()
+				PanicHandler(r)
+			}
+		}
+	}()
+
+	in := new(pb.RpcBackupListRequest)
+	if err := in.Unmarshal(b); err != nil {
+		resp, _ = (&pb.RpcBackupListResponse{Error: &pb.RpcBackupListResponseError{Code: pb.RpcBackupListResponseError_BAD_INPUT, Description: err.Error()}}).Marshal()
+		return resp
+	}
+
+	resp, _ = clientCommandsHandler.BackupList(context.Background(), in).Marshal()
+	return resp
+}
+
 var PanicHandler func(v interface{})
 
 func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
@@ -6283,6 +6528,10 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = AccountLocalLinkNewChallenge(data)
 		case "AccountLocalLinkSolveChallenge":
 			cd = AccountLocalLinkSolveChallenge(data)
+		case "AccountLocalLinkListApps":
+			cd = AccountLocalLinkListApps(data)
+		case "AccountLocalLinkRevokeApp":
+			cd = AccountLocalLinkRevokeApp(data)
 		case "WalletCreateSession":
 			cd = WalletCreateSession(data)
 		case "WalletCloseSession":
@@ -6511,6 +6760,14 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = HistorySetVersion(data)
 		case "HistoryDiffVersions":
 			cd = HistoryDiffVersions(data)
+		case "HistorySetVersionName":
+			cd = HistorySetVersionName(data)
+		case "HistoryGetNamedVersions":
+			cd = HistoryGetNamedVersions(data)
+		case "HistoryRestoreVersion":
+			cd = HistoryRestoreVersion(data)
+		case "HistoryGetBlockChanges":
+			cd = HistoryGetBlockChanges(data)
 		case "FileSpaceOffload":
 			cd = FileSpaceOffload(data)
 		case "FileReconcile":
@@ -6699,6 +6956,8 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = BlockTableColumnListFill(data)
 		case "BlockTableSort":
 			cd = BlockTableSort(data)
+		case "BlockTableToCsv":
+			cd = BlockTableToCsv(data)
 		case "BlockCreateWidget":
 			cd = BlockCreateWidget(data)
 		case "BlockWidgetSetTargetId":
@@ -6821,6 +7080,14 @@ func CommandAsync(cmd string, data []byte, callback func(data []byte)) {
 			cd = ChatUnsubscribe(data)
 		case "ObjectChatAdd":
 			cd = ObjectChatAdd(data)
+		case "BackupSettingsSet":
+			cd = BackupSettingsSet(data)
+		case "BackupSettingsGet":
+			cd = BackupSettingsGet(data)
+		case "BackupRun":
+			cd = BackupRun(data)
+		case "BackupList":
+			cd = BackupList(data)
 		default:
 			log.Errorf("unknown command type: %s\n", cmd)
 		}
@@ -6955,6 +7222,34 @@ func (h *ClientCommandsHandlerProxy) AccountLocalLinkSolveChallenge(ctx context.
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcAccountLocalLinkSolveChallengeResponse)
 }
+func (h *ClientCommandsHandlerProxy) AccountLocalLinkListApps(ctx context.Context, req *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountLocalLinkListApps(ctx, req.(*pb.RpcAccountLocalLinkListAppsRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountLocalLinkListApps", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountLocalLinkListAppsResponse)
+}
+func (h *ClientCommandsHandlerProxy) AccountLocalLinkRevokeApp(ctx context.Context, req *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.AccountLocalLinkRevokeApp(ctx, req.(*pb.RpcAccountLocalLinkRevokeAppRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "AccountLocalLinkRevokeApp", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcAccountLocalLinkRevokeAppResponse)
+}
 func (h *ClientCommandsHandlerProxy) WalletCreateSession(ctx context.Context, req *pb.RpcWalletCreateSessionRequest) *pb.RpcWalletCreateSessionResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.WalletCreateSession(ctx, req.(*pb.RpcWalletCreateSessionRequest)), nil
@@ -8551,6 +8846,62 @@ func (h *ClientCommandsHandlerProxy) HistoryDiffVersions(ctx context.Context, re
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcHistoryDiffVersionsResponse)
 }
+func (h *ClientCommandsHandlerProxy) HistorySetVersionName(ctx context.Context, req *pb.RpcHistorySetVersionNameRequest) *pb.RpcHistorySetVersionNameResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.HistorySetVersionName(ctx, req.(*pb.RpcHistorySetVersionNameRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "HistorySetVersionName", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcHistorySetVersionNameResponse)
+}
+func (h *ClientCommandsHandlerProxy) HistoryGetNamedVersions(ctx context.Context, req *pb.RpcHistoryGetNamedVersionsRequest) *pb.RpcHistoryGetNamedVersionsResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.HistoryGetNamedVersions(ctx, req.(*pb.RpcHistoryGetNamedVersionsRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "HistoryGetNamedVersions", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcHistoryGetNamedVersionsResponse)
+}
+func (h *ClientCommandsHandlerProxy) HistoryRestoreVersion(ctx context.Context, req *pb.RpcHistoryRestoreVersionRequest) *pb.RpcHistoryRestoreVersionResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.HistoryRestoreVersion(ctx, req.(*pb.RpcHistoryRestoreVersionRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "HistoryRestoreVersion", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcHistoryRestoreVersionResponse)
+}
+func (h *ClientCommandsHandlerProxy) HistoryGetBlockChanges(ctx context.Context, req *pb.RpcHistoryGetBlockChangesRequest) *pb.RpcHistoryGetBlockChangesResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.HistoryGetBlockChanges(ctx, req.(*pb.RpcHistoryGetBlockChangesRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "HistoryGetBlockChanges", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcHistoryGetBlockChangesResponse)
+}
 func (h *ClientCommandsHandlerProxy) FileSpaceOffload(ctx context.Context, req *pb.RpcFileSpaceOffloadRequest) *pb.RpcFileSpaceOffloadResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.FileSpaceOffload(ctx, req.(*pb.RpcFileSpaceOffloadRequest)), nil
@@ -9867,6 +10218,20 @@ func (h *ClientCommandsHandlerProxy) BlockTableSort(ctx context.Context, req *pb
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcBlockTableSortResponse)
 }
+func (h *ClientCommandsHandlerProxy) BlockTableToCsv(ctx context.Context, req *pb.RpcBlockTableToCsvRequest) *pb.RpcBlockTableToCsvResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.BlockTableToCsv(ctx, req.(*pb.RpcBlockTableToCsvRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "BlockTableToCsv", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcBlockTableToCsvResponse)
+}
 func (h *ClientCommandsHandlerProxy) BlockCreateWidget(ctx context.Context, req *pb.RpcBlockCreateWidgetRequest) *pb.RpcBlockCreateWidgetResponse {
 	actualCall := func(ctx context.Context, req any) (any, error) {
 		return h.client.BlockCreateWidget(ctx, req.(*pb.RpcBlockCreateWidgetRequest)), nil
@@ -10721,3 +11086,59 @@ func (h *ClientCommandsHandlerProxy) ObjectChatAdd(ctx context.Context, req *pb.
 	call, _ := actualCall(ctx, req)
 	return call.(*pb.RpcObjectChatAddResponse)
 }
+func (h *ClientCommandsHandlerProxy) BackupSettingsSet(ctx context.Context, req *pb.RpcBackupSettingsSetRequest) *pb.RpcBackupSettingsSetResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.BackupSettingsSet(ctx, req.(*pb.RpcBackupSettingsSetRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "BackupSettingsSet", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcBackupSettingsSetResponse)
+}
+func (h *ClientCommandsHandlerProxy) BackupSettingsGet(ctx context.Context, req *pb.RpcBackupSettingsGetRequest) *pb.RpcBackupSettingsGetResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.BackupSettingsGet(ctx, req.(*pb.RpcBackupSettingsGetRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "BackupSettingsGet", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcBackupSettingsGetResponse)
+}
+func (h *ClientCommandsHandlerProxy) BackupRun(ctx context.Context, req *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.BackupRun(ctx, req.(*pb.RpcBackupRunRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "BackupRun", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcBackupRunResponse)
+}
+func (h *ClientCommandsHandlerProxy) BackupList(ctx context.Context, req *pb.RpcBackupListRequest) *pb.RpcBackupListResponse {
+	actualCall := func(ctx context.Context, req any) (any, error) {
+		return h.client.BackupList(ctx, req.(*pb.RpcBackupListRequest)), nil
+	}
+	for _, interceptor := range h.interceptors {
+		toCall := actualCall
+		currentInterceptor := interceptor
+		actualCall = func(ctx context.Context, req any) (any, error) {
+			return currentInterceptor(ctx, req, "BackupList", toCall)
+		}
+	}
+	call, _ := actualCall(ctx, req)
+	return call.(*pb.RpcBackupListResponse)
+}
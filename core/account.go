@@ -9,7 +9,9 @@ import (
 	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/application"
 	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/space/spacecore/storage/migrator"
 )
 
@@ -245,7 +247,10 @@ func (mw *Middleware) AccountChangeJsonApiAddr(ctx context.Context, req *pb.RpcA
 func (mw *Middleware) AccountLocalLinkNewChallenge(ctx context.Context, request *pb.RpcAccountLocalLinkNewChallengeRequest) *pb.RpcAccountLocalLinkNewChallengeResponse {
 	info := getClientInfo(ctx)
 
-	challengeId, err := mw.applicationService.LinkLocalStartNewChallenge(request.Scope, &info)
+	challengeId, err := mw.applicationService.LinkLocalStartNewChallenge(request.Scope, &info, session.AppPermissions{
+		ReadOnly: request.ReadOnly,
+		SpaceIds: request.SpaceIds,
+	})
 	code := mapErrorCode(err,
 		errToCode(session.ErrTooManyChallengeRequests, pb.RpcAccountLocalLinkNewChallengeResponseError_TOO_MANY_REQUESTS),
 		errToCode(application.ErrApplicationIsNotRunning, pb.RpcAccountLocalLinkNewChallengeResponseError_ACCOUNT_IS_NOT_RUNNING),
@@ -278,6 +283,53 @@ func (mw *Middleware) AccountLocalLinkSolveChallenge(_ context.Context, req *pb.
 	}
 }
 
+func (mw *Middleware) AccountLocalLinkListApps(_ context.Context, _ *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse {
+	apps, err := mw.applicationService.LinkLocalListApps()
+	code := mapErrorCode(err,
+		errToCode(application.ErrApplicationIsNotRunning, pb.RpcAccountLocalLinkListAppsResponseError_ACCOUNT_IS_NOT_RUNNING),
+	)
+	appsInfo := make([]*pb.RpcAccountLocalLinkAppInfo, 0, len(apps))
+	for _, info := range apps {
+		appsInfo = append(appsInfo, convertAppLinkInfo(info))
+	}
+	return &pb.RpcAccountLocalLinkListAppsResponse{
+		App: appsInfo,
+		Error: &pb.RpcAccountLocalLinkListAppsResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) AccountLocalLinkRevokeApp(_ context.Context, req *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse {
+	err := mw.applicationService.LinkLocalRevokeApp(req)
+	code := mapErrorCode(err,
+		errToCode(wallet.ErrAppLinkNotFound, pb.RpcAccountLocalLinkRevokeAppResponseError_NOT_FOUND),
+		errToCode(application.ErrApplicationIsNotRunning, pb.RpcAccountLocalLinkRevokeAppResponseError_ACCOUNT_IS_NOT_RUNNING),
+	)
+	return &pb.RpcAccountLocalLinkRevokeAppResponse{
+		Error: &pb.RpcAccountLocalLinkRevokeAppResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func convertAppLinkInfo(info *wallet.AppLinkInfo) *pb.RpcAccountLocalLinkAppInfo {
+	if info == nil {
+		return nil
+	}
+	return &pb.RpcAccountLocalLinkAppInfo{
+		AppHash:   info.AppHash,
+		AppName:   info.AppName,
+		CreatedAt: info.CreatedAt,
+		ExpireAt:  info.ExpireAt,
+		Scope:     model.AccountAuthLocalApiScope(info.Scope), // nolint:gosec
+		ReadOnly:  info.ReadOnly,
+		SpaceIds:  info.SpaceIds,
+	}
+}
+
 func getClientInfo(ctx context.Context) pb.EventAccountLinkChallengeClientInfo {
 	p, ok := peer.FromContext(ctx)
 	if !ok {
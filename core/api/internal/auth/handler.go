@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/anyproto/anytype-heart/core/api/pagination"
 	"github.com/anyproto/anytype-heart/core/api/util"
 )
 
@@ -15,6 +16,8 @@ import (
 //	@Accept		json
 //	@Produce	json
 //	@Param		app_name	query		string					true	"App name requesting the challenge"
+//	@Param		permission	query		string					false	"Permission level of the issued app key"	Enums(read_only, read_write)	default(read_write)
+//	@Param		space_ids	query		[]string				false	"Spaces the issued app key is restricted to; all spaces if omitted"
 //	@Success	200			{object}	DisplayCodeResponse		"Challenge ID"
 //	@Failure	400			{object}	util.ValidationError	"Invalid input"
 //	@Failure	500			{object}	util.ServerError		"Internal server error"
@@ -22,10 +25,13 @@ import (
 func DisplayCodeHandler(s *AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		appName := c.Query("app_name")
+		permission := c.Query("permission")
+		spaceIds := c.QueryArray("space_ids")
 
-		challengeId, err := s.NewChallenge(c.Request.Context(), appName)
+		challengeId, err := s.NewChallenge(c.Request.Context(), appName, permission, spaceIds)
 		code := util.MapErrorCode(err,
 			util.ErrToCode(ErrMissingAppName, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidPermission, http.StatusBadRequest),
 			util.ErrToCode(ErrFailedGenerateChallenge, http.StatusInternalServerError))
 
 		if code != http.StatusOK {
@@ -73,3 +79,70 @@ func TokenHandler(s *AuthService) gin.HandlerFunc {
 		})
 	}
 }
+
+// GetKeysHandler retrieves the app keys issued for the account
+//
+//	@Summary	List app keys
+//	@Tags		auth
+//	@Accept		json
+//	@Produce	json
+//	@Param		offset	query		int										false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit	query		int										false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200		{object}	pagination.PaginatedResponse[AppKey]	"List of app keys"
+//	@Failure	401		{object}	util.UnauthorizedError					"Unauthorized"
+//	@Failure	403		{object}	util.ForbiddenError						"Forbidden"
+//	@Failure	500		{object}	util.ServerError						"Internal server error"
+//	@Router		/auth/keys [get]
+func GetKeysHandler(s *AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		keys, total, hasMore, err := s.ListKeys(c.Request.Context(), offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrFailedListKeys, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, keys, total, offset, limit, hasMore)
+	}
+}
+
+// RevokeKeyHandler revokes an app key
+//
+//	@Summary	Revoke app key
+//	@Tags		auth
+//	@Accept		json
+//	@Produce	json
+//	@Param		key_id	path		string					true	"App key ID"
+//	@Success	200		{object}	RevokeKeyResponse		"The revoked app key"
+//	@Failure	401		{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403		{object}	util.ForbiddenError		"Forbidden"
+//	@Failure	404		{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500		{object}	util.ServerError		"Internal server error"
+//	@Router		/auth/keys/{key_id} [delete]
+func RevokeKeyHandler(s *AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		keyId := c.Param("key_id")
+
+		key, err := s.RevokeKey(c.Request.Context(), keyId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrKeyNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedListKeys, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRevokeKey, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, RevokeKeyResponse{Key: key})
+	}
+}
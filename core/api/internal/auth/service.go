@@ -3,41 +3,76 @@ package auth
 import (
 	"context"
 	"errors"
+	"slices"
+	"sync"
+	"time"
 
+	"github.com/anyproto/anytype-heart/core/api/pagination"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pb/service"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
+const (
+	PermissionReadOnly  = "read_only"
+	PermissionReadWrite = "read_write"
+)
+
 var (
 	ErrMissingAppName          = errors.New("missing app name")
+	ErrInvalidPermission       = errors.New("invalid permission")
 	ErrFailedGenerateChallenge = errors.New("failed to generate a new challenge")
 	ErrInvalidInput            = errors.New("invalid input")
 	ErrFailedAuthenticate      = errors.New("failed to authenticate user")
+	ErrInvalidAppKey           = errors.New("invalid app key")
+	ErrFailedListKeys          = errors.New("failed to list app keys")
+	ErrKeyNotFound             = errors.New("app key not found")
+	ErrFailedRevokeKey         = errors.New("failed to revoke app key")
 )
 
 type Service interface {
-	NewChallenge(ctx context.Context, appName string) (string, error)
+	NewChallenge(ctx context.Context, appName string, permission string, spaceIds []string) (string, error)
 	SolveChallenge(ctx context.Context, challengeId string, code string) (sessionToken, appKey string, err error)
+	Authenticate(ctx context.Context, appKey string) (token string, permissions KeyPermissions, err error)
+	ListKeys(ctx context.Context, offset int, limit int) (keys []AppKey, total int, hasMore bool, err error)
+	RevokeKey(ctx context.Context, keyId string) (AppKey, error)
+	CloseKeySessions(ctx context.Context, keyId string)
 }
 
 type AuthService struct {
 	mw service.ClientCommandsServer
+
+	mu           sync.Mutex
+	keyToSession map[string]keySession // appKey -> session
+}
+
+type keySession struct {
+	token       string
+	permissions KeyPermissions
 }
 
 func NewService(mw service.ClientCommandsServer) *AuthService {
-	return &AuthService{mw: mw}
+	return &AuthService{mw: mw, keyToSession: make(map[string]keySession)}
 }
 
-// NewChallenge calls AccountLocalLinkNewChallenge and returns the challenge ID, or an error if it fails.
-func (s *AuthService) NewChallenge(ctx context.Context, appName string) (string, error) {
+// NewChallenge calls AccountLocalLinkNewChallenge with the requested permissions and returns the challenge ID, or an error if it fails.
+func (s *AuthService) NewChallenge(ctx context.Context, appName string, permission string, spaceIds []string) (string, error) {
 	if appName == "" {
 		return "", ErrMissingAppName
 	}
 
+	if permission == "" {
+		permission = PermissionReadWrite
+	}
+	if permission != PermissionReadOnly && permission != PermissionReadWrite {
+		return "", ErrInvalidPermission
+	}
+
 	resp := s.mw.AccountLocalLinkNewChallenge(ctx, &pb.RpcAccountLocalLinkNewChallengeRequest{
-		AppName: appName,
-		Scope:   model.AccountAuth_JsonAPI,
+		AppName:  appName,
+		Scope:    model.AccountAuth_JsonAPI,
+		ReadOnly: permission == PermissionReadOnly,
+		SpaceIds: spaceIds,
 	})
 
 	if resp.Error.Code != pb.RpcAccountLocalLinkNewChallengeResponseError_NULL {
@@ -65,3 +100,128 @@ func (s *AuthService) SolveChallenge(ctx context.Context, challengeId string, co
 
 	return resp.SessionToken, resp.AppKey, nil
 }
+
+// Authenticate returns the session token and permissions for the app key. A new session is created on first use of the key and reused afterwards.
+func (s *AuthService) Authenticate(ctx context.Context, appKey string) (token string, permissions KeyPermissions, err error) {
+	s.mu.Lock()
+	session, exists := s.keyToSession[appKey]
+	s.mu.Unlock()
+	if exists {
+		return session.token, session.permissions, nil
+	}
+
+	resp := s.mw.WalletCreateSession(ctx, &pb.RpcWalletCreateSessionRequest{Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{AppKey: appKey}})
+	if resp.Error.Code != pb.RpcWalletCreateSessionResponseError_NULL || resp.AppInfo == nil {
+		return "", KeyPermissions{}, ErrInvalidAppKey
+	}
+
+	session = keySession{
+		token: resp.Token,
+		permissions: KeyPermissions{
+			KeyId:    resp.AppInfo.AppHash,
+			ReadOnly: resp.AppInfo.ReadOnly,
+			SpaceIds: resp.AppInfo.SpaceIds,
+		},
+	}
+
+	s.mu.Lock()
+	s.keyToSession[appKey] = session
+	s.mu.Unlock()
+
+	return session.token, session.permissions, nil
+}
+
+// ListKeys returns a paginated list of the app keys issued for the account.
+func (s *AuthService) ListKeys(ctx context.Context, offset int, limit int) (keys []AppKey, total int, hasMore bool, err error) {
+	apps, err := s.listApiApps(ctx)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	total = len(apps)
+	paginatedApps, hasMore := pagination.Paginate(apps, offset, limit)
+	keys = make([]AppKey, 0, len(paginatedApps))
+
+	for _, app := range paginatedApps {
+		keys = append(keys, mapAppKey(app))
+	}
+
+	return keys, total, hasMore, nil
+}
+
+// RevokeKey deletes the app key with the given id, closes the sessions opened with it and returns the revoked key.
+func (s *AuthService) RevokeKey(ctx context.Context, keyId string) (AppKey, error) {
+	apps, err := s.listApiApps(ctx)
+	if err != nil {
+		return AppKey{}, err
+	}
+
+	// links of other scopes, e.g. the desktop client's own one, are not visible to api keys
+	idx := slices.IndexFunc(apps, func(app *pb.RpcAccountLocalLinkAppInfo) bool {
+		return app.AppHash == keyId
+	})
+	if idx == -1 {
+		return AppKey{}, ErrKeyNotFound
+	}
+
+	resp := s.mw.AccountLocalLinkRevokeApp(ctx, &pb.RpcAccountLocalLinkRevokeAppRequest{AppHash: keyId})
+	switch resp.Error.Code {
+	case pb.RpcAccountLocalLinkRevokeAppResponseError_NULL:
+	case pb.RpcAccountLocalLinkRevokeAppResponseError_NOT_FOUND:
+		return AppKey{}, ErrKeyNotFound
+	default:
+		return AppKey{}, ErrFailedRevokeKey
+	}
+
+	s.CloseKeySessions(ctx, keyId)
+	return mapAppKey(apps[idx]), nil
+}
+
+// CloseKeySessions closes the sessions opened with the app key and drops them from the cache, so the key is checked again on its next use.
+func (s *AuthService) CloseKeySessions(ctx context.Context, keyId string) {
+	s.mu.Lock()
+	var tokens []string
+	for appKey, session := range s.keyToSession {
+		if session.permissions.KeyId == keyId {
+			tokens = append(tokens, session.token)
+			delete(s.keyToSession, appKey)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, token := range tokens {
+		s.mw.WalletCloseSession(ctx, &pb.RpcWalletCloseSessionRequest{Token: token})
+	}
+}
+
+// listApiApps returns the app links issued with the JsonAPI scope.
+func (s *AuthService) listApiApps(ctx context.Context) ([]*pb.RpcAccountLocalLinkAppInfo, error) {
+	resp := s.mw.AccountLocalLinkListApps(ctx, &pb.RpcAccountLocalLinkListAppsRequest{})
+	if resp.Error.Code != pb.RpcAccountLocalLinkListAppsResponseError_NULL {
+		return nil, ErrFailedListKeys
+	}
+
+	apps := make([]*pb.RpcAccountLocalLinkAppInfo, 0, len(resp.App))
+	for _, app := range resp.App {
+		if app.Scope == model.AccountAuth_JsonAPI {
+			apps = append(apps, app)
+		}
+	}
+	return apps, nil
+}
+
+// mapAppKey converts the app info returned by the middleware into an AppKey.
+func mapAppKey(app *pb.RpcAccountLocalLinkAppInfo) AppKey {
+	permission := PermissionReadWrite
+	if app.ReadOnly {
+		permission = PermissionReadOnly
+	}
+
+	return AppKey{
+		Id:         app.AppHash,
+		AppName:    app.AppName,
+		Permission: permission,
+		SpaceIds:   app.SpaceIds,
+		CreatedAt:  time.Unix(app.CreatedAt, 0).UTC().Format(time.RFC3339),
+	}
+}
@@ -18,6 +18,8 @@ const (
 	mockedCode         = "mocked-mockedCode"
 	mockedSessionToken = "mocked-session-token"
 	mockedAppKey       = "mocked-app-key"
+	mockedKeyId        = "mocked-key-id"
+	mockedSpaceId      = "mocked-space-id"
 )
 
 type fixture struct {
@@ -51,7 +53,7 @@ func TestAuthService_GenerateNewChallenge(t *testing.T) {
 			}).Once()
 
 		// when
-		challengeId, err := fx.NewChallenge(ctx, mockedAppName)
+		challengeId, err := fx.NewChallenge(ctx, mockedAppName, "", nil)
 
 		// then
 		require.NoError(t, err)
@@ -64,7 +66,7 @@ func TestAuthService_GenerateNewChallenge(t *testing.T) {
 		fx := newFixture(t)
 
 		// when
-		challengeId, err := fx.NewChallenge(ctx, "")
+		challengeId, err := fx.NewChallenge(ctx, "", "", nil)
 
 		// then
 		require.Error(t, err)
@@ -72,6 +74,44 @@ func TestAuthService_GenerateNewChallenge(t *testing.T) {
 		require.Empty(t, challengeId)
 	})
 
+	t.Run("read-only challenge restricted to spaces", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("AccountLocalLinkNewChallenge", mock.Anything, &pb.RpcAccountLocalLinkNewChallengeRequest{
+			AppName:  mockedAppName,
+			Scope:    model.AccountAuth_JsonAPI,
+			ReadOnly: true,
+			SpaceIds: []string{mockedSpaceId},
+		}).
+			Return(&pb.RpcAccountLocalLinkNewChallengeResponse{
+				ChallengeId: mockedChallengeId,
+				Error:       &pb.RpcAccountLocalLinkNewChallengeResponseError{Code: pb.RpcAccountLocalLinkNewChallengeResponseError_NULL},
+			}).Once()
+
+		// when
+		challengeId, err := fx.NewChallenge(ctx, mockedAppName, PermissionReadOnly, []string{mockedSpaceId})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedChallengeId, challengeId)
+	})
+
+	t.Run("bad request - invalid permission", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		challengeId, err := fx.NewChallenge(ctx, mockedAppName, "admin", nil)
+
+		// then
+		require.Error(t, err)
+		require.Equal(t, ErrInvalidPermission, err)
+		require.Empty(t, challengeId)
+	})
+
 	t.Run("failed challenge creation", func(t *testing.T) {
 		// given
 		ctx := context.Background()
@@ -86,7 +126,7 @@ func TestAuthService_GenerateNewChallenge(t *testing.T) {
 			}).Once()
 
 		// when
-		challengeId, err := fx.NewChallenge(ctx, mockedAppName)
+		challengeId, err := fx.NewChallenge(ctx, mockedAppName, "", nil)
 
 		// then
 		require.Error(t, err)
@@ -159,3 +199,219 @@ func TestAuthService_SolveChallengeForToken(t *testing.T) {
 		require.Empty(t, appKey)
 	})
 }
+
+func TestAuthService_Authenticate(t *testing.T) {
+	t.Run("successful authentication reuses session", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("WalletCreateSession", mock.Anything, &pb.RpcWalletCreateSessionRequest{
+			Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{AppKey: mockedAppKey},
+		}).
+			Return(&pb.RpcWalletCreateSessionResponse{
+				Token: mockedSessionToken,
+				AppInfo: &pb.RpcAccountLocalLinkAppInfo{
+					AppHash:  mockedKeyId,
+					ReadOnly: true,
+					SpaceIds: []string{mockedSpaceId},
+				},
+				Error: &pb.RpcWalletCreateSessionResponseError{Code: pb.RpcWalletCreateSessionResponseError_NULL},
+			}).Once()
+
+		// when
+		token, permissions, err := fx.Authenticate(ctx, mockedAppKey)
+		_, _, errAgain := fx.Authenticate(ctx, mockedAppKey)
+
+		// then
+		require.NoError(t, err)
+		require.NoError(t, errAgain)
+		require.Equal(t, mockedSessionToken, token)
+		require.Equal(t, KeyPermissions{KeyId: mockedKeyId, ReadOnly: true, SpaceIds: []string{mockedSpaceId}}, permissions)
+		require.True(t, permissions.CanAccessSpace(mockedSpaceId))
+		require.False(t, permissions.CanAccessSpace("other-space-id"))
+	})
+
+	t.Run("invalid app key", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("WalletCreateSession", mock.Anything, mock.Anything).
+			Return(&pb.RpcWalletCreateSessionResponse{
+				Error: &pb.RpcWalletCreateSessionResponseError{Code: pb.RpcWalletCreateSessionResponseError_APP_TOKEN_NOT_FOUND_IN_THE_CURRENT_ACCOUNT},
+			}).Once()
+
+		// when
+		token, _, err := fx.Authenticate(ctx, mockedAppKey)
+
+		// then
+		require.Error(t, err)
+		require.Equal(t, ErrInvalidAppKey, err)
+		require.Empty(t, token)
+	})
+}
+
+func TestAuthService_ListKeys(t *testing.T) {
+	t.Run("successful listing", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("AccountLocalLinkListApps", mock.Anything, &pb.RpcAccountLocalLinkListAppsRequest{}).
+			Return(&pb.RpcAccountLocalLinkListAppsResponse{
+				App: []*pb.RpcAccountLocalLinkAppInfo{
+					{AppHash: mockedKeyId, AppName: mockedAppName, CreatedAt: 1731512374, Scope: model.AccountAuth_JsonAPI, ReadOnly: true, SpaceIds: []string{mockedSpaceId}},
+					{AppHash: "desktop-key-id", AppName: "desktop", CreatedAt: 1731512374, Scope: model.AccountAuth_Full},
+					{AppHash: "other-key-id", AppName: "other-app", CreatedAt: 1731512374, Scope: model.AccountAuth_JsonAPI},
+				},
+				Error: &pb.RpcAccountLocalLinkListAppsResponseError{Code: pb.RpcAccountLocalLinkListAppsResponseError_NULL},
+			}).Once()
+
+		// when
+		keys, total, hasMore, err := fx.ListKeys(ctx, 0, 1)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.True(t, hasMore)
+		require.Len(t, keys, 1)
+		require.Equal(t, AppKey{
+			Id:         mockedKeyId,
+			AppName:    mockedAppName,
+			Permission: PermissionReadOnly,
+			SpaceIds:   []string{mockedSpaceId},
+			CreatedAt:  "2024-11-13T15:39:34Z",
+		}, keys[0])
+	})
+
+	t.Run("failed listing", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("AccountLocalLinkListApps", mock.Anything, &pb.RpcAccountLocalLinkListAppsRequest{}).
+			Return(&pb.RpcAccountLocalLinkListAppsResponse{
+				Error: &pb.RpcAccountLocalLinkListAppsResponseError{Code: pb.RpcAccountLocalLinkListAppsResponseError_UNKNOWN_ERROR},
+			}).Once()
+
+		// when
+		keys, _, _, err := fx.ListKeys(ctx, 0, 10)
+
+		// then
+		require.Error(t, err)
+		require.Equal(t, ErrFailedListKeys, err)
+		require.Empty(t, keys)
+	})
+}
+
+func TestAuthService_RevokeKey(t *testing.T) {
+	t.Run("successful revocation closes cached session", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("WalletCreateSession", mock.Anything, mock.Anything).
+			Return(&pb.RpcWalletCreateSessionResponse{
+				Token:   mockedSessionToken,
+				AppInfo: &pb.RpcAccountLocalLinkAppInfo{AppHash: mockedKeyId},
+				Error:   &pb.RpcWalletCreateSessionResponseError{Code: pb.RpcWalletCreateSessionResponseError_NULL},
+			}).Twice()
+		fx.mwMock.On("AccountLocalLinkListApps", mock.Anything, &pb.RpcAccountLocalLinkListAppsRequest{}).
+			Return(&pb.RpcAccountLocalLinkListAppsResponse{
+				App:   []*pb.RpcAccountLocalLinkAppInfo{{AppHash: mockedKeyId, AppName: mockedAppName, Scope: model.AccountAuth_JsonAPI}},
+				Error: &pb.RpcAccountLocalLinkListAppsResponseError{Code: pb.RpcAccountLocalLinkListAppsResponseError_NULL},
+			}).Once()
+		fx.mwMock.On("AccountLocalLinkRevokeApp", mock.Anything, &pb.RpcAccountLocalLinkRevokeAppRequest{AppHash: mockedKeyId}).
+			Return(&pb.RpcAccountLocalLinkRevokeAppResponse{
+				Error: &pb.RpcAccountLocalLinkRevokeAppResponseError{Code: pb.RpcAccountLocalLinkRevokeAppResponseError_NULL},
+			}).Once()
+		fx.mwMock.On("WalletCloseSession", mock.Anything, &pb.RpcWalletCloseSessionRequest{Token: mockedSessionToken}).
+			Return(&pb.RpcWalletCloseSessionResponse{
+				Error: &pb.RpcWalletCloseSessionResponseError{Code: pb.RpcWalletCloseSessionResponseError_NULL},
+			}).Once()
+
+		_, _, err := fx.Authenticate(ctx, mockedAppKey)
+		require.NoError(t, err)
+
+		// when
+		key, err := fx.RevokeKey(ctx, mockedKeyId)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedKeyId, key.Id)
+		require.Equal(t, mockedAppName, key.AppName)
+
+		// the revoked key is not served from the cache anymore
+		_, _, err = fx.Authenticate(ctx, mockedAppKey)
+		require.NoError(t, err)
+	})
+
+	t.Run("key revoked outside of the api is rejected after its sessions are closed", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("WalletCreateSession", mock.Anything, mock.Anything).
+			Return(&pb.RpcWalletCreateSessionResponse{
+				Token:   mockedSessionToken,
+				AppInfo: &pb.RpcAccountLocalLinkAppInfo{AppHash: mockedKeyId},
+				Error:   &pb.RpcWalletCreateSessionResponseError{Code: pb.RpcWalletCreateSessionResponseError_NULL},
+			}).Once()
+		fx.mwMock.On("WalletCloseSession", mock.Anything, &pb.RpcWalletCloseSessionRequest{Token: mockedSessionToken}).
+			Return(&pb.RpcWalletCloseSessionResponse{
+				Error: &pb.RpcWalletCloseSessionResponseError{Code: pb.RpcWalletCloseSessionResponseError_NULL},
+			}).Once()
+		fx.mwMock.On("WalletCreateSession", mock.Anything, mock.Anything).
+			Return(&pb.RpcWalletCreateSessionResponse{
+				Error: &pb.RpcWalletCreateSessionResponseError{Code: pb.RpcWalletCreateSessionResponseError_UNKNOWN_ERROR},
+			}).Once()
+
+		_, _, err := fx.Authenticate(ctx, mockedAppKey)
+		require.NoError(t, err)
+
+		// when
+		fx.CloseKeySessions(ctx, mockedKeyId)
+		_, _, err = fx.Authenticate(ctx, mockedAppKey)
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidAppKey)
+	})
+
+	t.Run("key not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("AccountLocalLinkListApps", mock.Anything, &pb.RpcAccountLocalLinkListAppsRequest{}).
+			Return(&pb.RpcAccountLocalLinkListAppsResponse{
+				Error: &pb.RpcAccountLocalLinkListAppsResponseError{Code: pb.RpcAccountLocalLinkListAppsResponseError_NULL},
+			}).Once()
+
+		// when
+		_, err := fx.RevokeKey(ctx, mockedKeyId)
+
+		// then
+		require.Error(t, err)
+		require.Equal(t, ErrKeyNotFound, err)
+	})
+
+	t.Run("key of other scope is not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("AccountLocalLinkListApps", mock.Anything, &pb.RpcAccountLocalLinkListAppsRequest{}).
+			Return(&pb.RpcAccountLocalLinkListAppsResponse{
+				App:   []*pb.RpcAccountLocalLinkAppInfo{{AppHash: mockedKeyId, AppName: "desktop", Scope: model.AccountAuth_Full}},
+				Error: &pb.RpcAccountLocalLinkListAppsResponseError{Code: pb.RpcAccountLocalLinkListAppsResponseError_NULL},
+			}).Once()
+
+		// when
+		_, err := fx.RevokeKey(ctx, mockedKeyId)
+
+		// then
+		require.Error(t, err)
+		require.Equal(t, ErrKeyNotFound, err)
+	})
+}
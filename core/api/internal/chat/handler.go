@@ -0,0 +1,272 @@
+package chat
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// GetChatsHandler retrieves the chats of a space
+//
+//	@Summary	List chats
+//	@Tags		chats
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string								true	"Space ID"
+//	@Param		offset		query		int									false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit		query		int									false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200			{object}	pagination.PaginatedResponse[Chat]	"List of chats"
+//	@Failure	401			{object}	util.UnauthorizedError				"Unauthorized"
+//	@Failure	500			{object}	util.ServerError					"Internal server error"
+//	@Router		/spaces/{space_id}/chats [get]
+func GetChatsHandler(s *ChatService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		chats, total, hasMore, err := s.ListChats(c.Request.Context(), spaceId, offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrFailedRetrieveChats, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, chats, total, offset, limit, hasMore)
+	}
+}
+
+// GetMessagesHandler retrieves a page of messages of a chat
+//
+//	@Summary	List messages
+//	@Tags		chats
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		chat_id		path		string					true	"Chat ID"
+//	@Param		before		query		string					false	"Order ID of the message before which to get messages"
+//	@Param		after		query		string					false	"Order ID of the message after which to get messages"
+//	@Param		limit		query		int						false	"The number of items to return"	default(100)	maximum(1000)
+//	@Success	200			{object}	MessagesResponse		"List of messages"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/chats/{chat_id}/messages [get]
+func GetMessagesHandler(s *ChatService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		chatId := c.Param("chat_id")
+		before := c.Query("before")
+		after := c.Query("after")
+		limit := c.GetInt("limit")
+
+		messages, err := s.GetMessages(c.Request.Context(), spaceId, chatId, before, after, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrBothOrderIdsSet, http.StatusBadRequest),
+			util.ErrToCode(ErrChatNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveChats, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveMessages, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessagesResponse{Data: messages})
+	}
+}
+
+// AddMessageHandler posts a new message to a chat
+//
+//	@Summary	Add message
+//	@Tags		chats
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		chat_id		path		string					true	"Chat ID"
+//	@Param		message		body		AddMessageRequest		true	"Message to add"
+//	@Success	200			{object}	MessageResponse			"The added message"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/chats/{chat_id}/messages [post]
+func AddMessageHandler(s *ChatService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		chatId := c.Param("chat_id")
+
+		request := AddMessageRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		message, err := s.AddMessage(c.Request.Context(), spaceId, chatId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrMessageTextMissing, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidAttachmentType, http.StatusBadRequest),
+			util.ErrToCode(ErrChatNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveChats, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedAddMessage, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveMessages, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: message})
+	}
+}
+
+// EditMessageHandler replaces the text of a message
+//
+//	@Summary	Edit message
+//	@Tags		chats
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		chat_id		path		string					true	"Chat ID"
+//	@Param		message_id	path		string					true	"Message ID"
+//	@Param		message		body		EditMessageRequest		true	"New message content"
+//	@Success	200			{object}	MessageResponse			"The edited message"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/chats/{chat_id}/messages/{message_id} [patch]
+func EditMessageHandler(s *ChatService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		chatId := c.Param("chat_id")
+		messageId := c.Param("message_id")
+
+		request := EditMessageRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		message, err := s.EditMessage(c.Request.Context(), spaceId, chatId, messageId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrMessageTextMissing, http.StatusBadRequest),
+			util.ErrToCode(ErrChatNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrMessageNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveChats, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedEditMessage, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveMessages, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: message})
+	}
+}
+
+// DeleteMessageHandler deletes a message from a chat
+//
+//	@Summary	Delete message
+//	@Tags		chats
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		chat_id		path		string					true	"Chat ID"
+//	@Param		message_id	path		string					true	"Message ID"
+//	@Success	200			{object}	MessageResponse			"The deleted message"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403			{object}	util.ForbiddenError		"Forbidden"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/chats/{chat_id}/messages/{message_id} [delete]
+func DeleteMessageHandler(s *ChatService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		chatId := c.Param("chat_id")
+		messageId := c.Param("message_id")
+
+		message, err := s.DeleteMessage(c.Request.Context(), spaceId, chatId, messageId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrChatNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrMessageNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedDeleteMessage, http.StatusForbidden),
+			util.ErrToCode(ErrFailedRetrieveChats, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveMessages, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: message})
+	}
+}
+
+// ToggleReactionHandler adds or removes a reaction of the current account on a message
+//
+//	@Summary	Toggle reaction
+//	@Tags		chats
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		chat_id		path		string					true	"Chat ID"
+//	@Param		message_id	path		string					true	"Message ID"
+//	@Param		reaction	body		ToggleReactionRequest	true	"Reaction to toggle"
+//	@Success	200			{object}	MessageResponse			"The message with updated reactions"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/chats/{chat_id}/messages/{message_id}/reactions [post]
+func ToggleReactionHandler(s *ChatService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		chatId := c.Param("chat_id")
+		messageId := c.Param("message_id")
+
+		request := ToggleReactionRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		message, err := s.ToggleReaction(c.Request.Context(), spaceId, chatId, messageId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrEmojiMissing, http.StatusBadRequest),
+			util.ErrToCode(ErrChatNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrMessageNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveChats, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedToggleReaction, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveMessages, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: message})
+	}
+}
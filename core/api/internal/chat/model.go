@@ -0,0 +1,49 @@
+package chat
+
+type Chat struct {
+	Type     string `json:"type" example:"chat"`
+	Id       string `json:"id" example:"bafyreihsorr4uoxd2rnofkn2jhqkylxq3uj3oqrahayzyyxqfikyk4nofy"`
+	ObjectId string `json:"object_id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`
+	Name     string `json:"name" example:"Team chat"`
+}
+
+type Message struct {
+	Type             string              `json:"type" example:"message"`
+	Id               string              `json:"id" example:"bafyreif3bz3a5qzqqgnpcsrojxjlvgv7ns3nujnydbk7zdkzrxt4bjpsdq"`
+	OrderId          string              `json:"order_id" example:"!!!!!!!!!!!!!!!!!!!!!!"`
+	Creator          string              `json:"creator" example:"AAjLbEDkYhYw4XKxUPfwm7Tqf3bwqFQafVNUwmyZ2vswamnf"`
+	CreatedAt        string              `json:"created_at" example:"2024-11-13T15:39:34Z"`
+	ModifiedAt       string              `json:"modified_at" example:"2024-11-13T15:41:02Z"`
+	ReplyToMessageId string              `json:"reply_to_message_id" example:""`
+	Text             string              `json:"text" example:"Hello from the API!"`
+	Style            string              `json:"style" enums:"Paragraph,Header1,Header2,Header3,Header4,Quote,Code,Title,Checkbox,Marked,Numbered,Toggle,Description,Callout" example:"Paragraph"`
+	Attachments      []Attachment        `json:"attachments"`
+	Reactions        map[string][]string `json:"reactions"`
+}
+
+type Attachment struct {
+	Target string `json:"target" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`
+	Type   string `json:"type" enums:"FILE,IMAGE,LINK" example:"LINK"`
+}
+
+type MessageResponse struct {
+	Message Message `json:"message"`
+}
+
+type MessagesResponse struct {
+	Data []Message `json:"data"`
+}
+
+type AddMessageRequest struct {
+	Text             string       `json:"text" example:"Hello from the API!"`
+	ReplyToMessageId string       `json:"reply_to_message_id" example:""`
+	Attachments      []Attachment `json:"attachments"`
+}
+
+type EditMessageRequest struct {
+	Text string `json:"text" example:"Hello again from the API!"`
+}
+
+type ToggleReactionRequest struct {
+	Emoji string `json:"emoji" example:"👍"`
+}
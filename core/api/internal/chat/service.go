@@ -0,0 +1,331 @@
+package chat
+
+import (
+	"context"
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var (
+	ErrChatNotFound           = errors.New("chat not found")
+	ErrFailedRetrieveChats    = errors.New("failed to retrieve chats")
+	ErrMessageNotFound        = errors.New("message not found")
+	ErrFailedRetrieveMessages = errors.New("failed to retrieve messages")
+	ErrBothOrderIdsSet        = errors.New("only one of before and after can be set")
+	ErrMessageTextMissing     = errors.New("message text is missing")
+	ErrInvalidAttachmentType  = errors.New("invalid attachment type")
+	ErrEmojiMissing           = errors.New("emoji is missing")
+	ErrFailedAddMessage       = errors.New("failed to add message")
+	ErrFailedEditMessage      = errors.New("failed to edit message")
+	ErrFailedDeleteMessage    = errors.New("failed to delete message")
+	ErrFailedToggleReaction   = errors.New("failed to toggle reaction")
+)
+
+type Service interface {
+	ListChats(ctx context.Context, spaceId string, offset int, limit int) ([]Chat, int, bool, error)
+	GetMessages(ctx context.Context, spaceId string, chatId string, beforeOrderId string, afterOrderId string, limit int) ([]Message, error)
+	AddMessage(ctx context.Context, spaceId string, chatId string, request AddMessageRequest) (Message, error)
+	EditMessage(ctx context.Context, spaceId string, chatId string, messageId string, request EditMessageRequest) (Message, error)
+	DeleteMessage(ctx context.Context, spaceId string, chatId string, messageId string) (Message, error)
+	ToggleReaction(ctx context.Context, spaceId string, chatId string, messageId string, request ToggleReactionRequest) (Message, error)
+}
+
+type ChatService struct {
+	mw          service.ClientCommandsServer
+	AccountInfo *model.AccountInfo
+}
+
+func NewService(mw service.ClientCommandsServer) *ChatService {
+	return &ChatService{mw: mw}
+}
+
+// ListChats returns a paginated list of chats in a specific space, including the space chat.
+func (s *ChatService) ListChats(ctx context.Context, spaceId string, offset int, limit int) (chats []Chat, total int, hasMore bool, err error) {
+	resp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
+		SpaceId: spaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyChatId.String(),
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			},
+		},
+		Sorts: []*model.BlockContentDataviewSort{
+			{
+				RelationKey: bundle.RelationKeyName.String(),
+				Type:        model.BlockContentDataviewSort_Asc,
+			},
+		},
+		Keys: []string{bundle.RelationKeyId.String(), bundle.RelationKeyName.String(), bundle.RelationKeyChatId.String()},
+	})
+
+	if resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
+		return nil, 0, false, ErrFailedRetrieveChats
+	}
+
+	total = len(resp.Records)
+	paginatedChats, hasMore := pagination.Paginate(resp.Records, offset, limit)
+	chats = make([]Chat, 0, len(paginatedChats))
+
+	for _, record := range paginatedChats {
+		chats = append(chats, Chat{
+			Type:     "chat",
+			Id:       record.Fields[bundle.RelationKeyChatId.String()].GetStringValue(),
+			ObjectId: record.Fields[bundle.RelationKeyId.String()].GetStringValue(),
+			Name:     record.Fields[bundle.RelationKeyName.String()].GetStringValue(),
+		})
+	}
+	return chats, total, hasMore, nil
+}
+
+// GetMessages returns a page of messages of a chat. Without order ids the latest messages are returned.
+func (s *ChatService) GetMessages(ctx context.Context, spaceId string, chatId string, beforeOrderId string, afterOrderId string, limit int) ([]Message, error) {
+	if beforeOrderId != "" && afterOrderId != "" {
+		return nil, ErrBothOrderIdsSet
+	}
+
+	if err := s.ensureChat(ctx, spaceId, chatId); err != nil {
+		return nil, err
+	}
+
+	resp := s.mw.ChatGetMessages(ctx, &pb.RpcChatGetMessagesRequest{
+		ChatObjectId:  chatId,
+		BeforeOrderId: beforeOrderId,
+		AfterOrderId:  afterOrderId,
+		Limit:         int32(limit),
+	})
+
+	if resp.Error.Code != pb.RpcChatGetMessagesResponseError_NULL {
+		return nil, ErrFailedRetrieveMessages
+	}
+
+	messages := make([]Message, 0, len(resp.Messages))
+	for _, msg := range resp.Messages {
+		messages = append(messages, s.MapMessage(msg))
+	}
+	return messages, nil
+}
+
+// AddMessage posts a new text message to a chat.
+func (s *ChatService) AddMessage(ctx context.Context, spaceId string, chatId string, request AddMessageRequest) (Message, error) {
+	if request.Text == "" {
+		return Message{}, ErrMessageTextMissing
+	}
+
+	attachments := make([]*model.ChatMessageAttachment, 0, len(request.Attachments))
+	for _, attachment := range request.Attachments {
+		attachmentType, ok := model.ChatMessageAttachmentAttachmentType_value[attachment.Type]
+		if !ok {
+			return Message{}, ErrInvalidAttachmentType
+		}
+		attachments = append(attachments, &model.ChatMessageAttachment{
+			Target: attachment.Target,
+			Type:   model.ChatMessageAttachmentAttachmentType(attachmentType),
+		})
+	}
+
+	if err := s.ensureChat(ctx, spaceId, chatId); err != nil {
+		return Message{}, err
+	}
+
+	resp := s.mw.ChatAddMessage(ctx, &pb.RpcChatAddMessageRequest{
+		ChatObjectId: chatId,
+		Message: &model.ChatMessage{
+			ReplyToMessageId: request.ReplyToMessageId,
+			Message: &model.ChatMessageMessageContent{
+				Text:  request.Text,
+				Style: model.BlockContentText_Paragraph,
+			},
+			Attachments: attachments,
+		},
+	})
+
+	if resp.Error.Code != pb.RpcChatAddMessageResponseError_NULL {
+		return Message{}, ErrFailedAddMessage
+	}
+
+	return s.getMessage(ctx, chatId, resp.MessageId)
+}
+
+// EditMessage replaces the text of an existing message.
+func (s *ChatService) EditMessage(ctx context.Context, spaceId string, chatId string, messageId string, request EditMessageRequest) (Message, error) {
+	if request.Text == "" {
+		return Message{}, ErrMessageTextMissing
+	}
+
+	if err := s.ensureChat(ctx, spaceId, chatId); err != nil {
+		return Message{}, err
+	}
+
+	existing, err := s.getChatMessage(ctx, chatId, messageId)
+	if err != nil {
+		return Message{}, err
+	}
+
+	// the edited message replaces the whole content, so the reply and attachments are kept from the existing message
+	resp := s.mw.ChatEditMessageContent(ctx, &pb.RpcChatEditMessageContentRequest{
+		ChatObjectId: chatId,
+		MessageId:    messageId,
+		EditedMessage: &model.ChatMessage{
+			ReplyToMessageId: existing.ReplyToMessageId,
+			Message: &model.ChatMessageMessageContent{
+				Text:  request.Text,
+				Style: model.BlockContentText_Paragraph,
+			},
+			Attachments: existing.Attachments,
+		},
+	})
+
+	if resp.Error.Code != pb.RpcChatEditMessageContentResponseError_NULL {
+		return Message{}, ErrFailedEditMessage
+	}
+
+	return s.getMessage(ctx, chatId, messageId)
+}
+
+// DeleteMessage deletes a message from a chat.
+func (s *ChatService) DeleteMessage(ctx context.Context, spaceId string, chatId string, messageId string) (Message, error) {
+	if err := s.ensureChat(ctx, spaceId, chatId); err != nil {
+		return Message{}, err
+	}
+
+	message, err := s.getMessage(ctx, chatId, messageId)
+	if err != nil {
+		return Message{}, err
+	}
+
+	resp := s.mw.ChatDeleteMessage(ctx, &pb.RpcChatDeleteMessageRequest{
+		ChatObjectId: chatId,
+		MessageId:    messageId,
+	})
+
+	if resp.Error.Code != pb.RpcChatDeleteMessageResponseError_NULL {
+		return Message{}, ErrFailedDeleteMessage
+	}
+
+	return message, nil
+}
+
+// ToggleReaction adds the reaction of the current account to a message or removes it if it's already there.
+func (s *ChatService) ToggleReaction(ctx context.Context, spaceId string, chatId string, messageId string, request ToggleReactionRequest) (Message, error) {
+	if request.Emoji == "" {
+		return Message{}, ErrEmojiMissing
+	}
+
+	if err := s.ensureChat(ctx, spaceId, chatId); err != nil {
+		return Message{}, err
+	}
+
+	resp := s.mw.ChatToggleMessageReaction(ctx, &pb.RpcChatToggleMessageReactionRequest{
+		ChatObjectId: chatId,
+		MessageId:    messageId,
+		Emoji:        request.Emoji,
+	})
+
+	if resp.Error.Code != pb.RpcChatToggleMessageReactionResponseError_NULL {
+		return Message{}, ErrFailedToggleReaction
+	}
+
+	return s.getMessage(ctx, chatId, messageId)
+}
+
+// ensureChat checks that the chat belongs to the given space, since chat commands don't take a space id.
+func (s *ChatService) ensureChat(ctx context.Context, spaceId string, chatId string) error {
+	resp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
+		SpaceId: spaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyChatId.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(chatId),
+			},
+		},
+		Keys: []string{bundle.RelationKeyId.String()},
+	})
+
+	if resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
+		return ErrFailedRetrieveChats
+	}
+
+	if len(resp.Records) == 0 {
+		return ErrChatNotFound
+	}
+
+	return nil
+}
+
+// getMessage returns a single message of a chat by its ID.
+func (s *ChatService) getMessage(ctx context.Context, chatId string, messageId string) (Message, error) {
+	msg, err := s.getChatMessage(ctx, chatId, messageId)
+	if err != nil {
+		return Message{}, err
+	}
+
+	return s.MapMessage(msg), nil
+}
+
+func (s *ChatService) getChatMessage(ctx context.Context, chatId string, messageId string) (*model.ChatMessage, error) {
+	resp := s.mw.ChatGetMessagesByIds(ctx, &pb.RpcChatGetMessagesByIdsRequest{
+		ChatObjectId: chatId,
+		MessageIds:   []string{messageId},
+	})
+
+	if resp.Error.Code != pb.RpcChatGetMessagesByIdsResponseError_NULL {
+		return nil, ErrFailedRetrieveMessages
+	}
+
+	if len(resp.Messages) == 0 {
+		return nil, ErrMessageNotFound
+	}
+
+	return resp.Messages[0], nil
+}
+
+// MapMessage converts a chat message into the API representation.
+func (s *ChatService) MapMessage(msg *model.ChatMessage) Message {
+	attachments := make([]Attachment, 0, len(msg.Attachments))
+	for _, attachment := range msg.Attachments {
+		attachments = append(attachments, Attachment{
+			Target: attachment.Target,
+			Type:   attachment.Type.String(),
+		})
+	}
+
+	reactions := make(map[string][]string)
+	if msg.Reactions != nil {
+		for emoji, identities := range msg.Reactions.Reactions {
+			reactions[emoji] = identities.Ids
+		}
+	}
+
+	var text, style string
+	if msg.Message != nil {
+		text = msg.Message.Text
+		style = msg.Message.Style.String()
+	}
+
+	var modifiedAt string
+	if msg.ModifiedAt != 0 {
+		modifiedAt = object.PosixToISO8601(float64(msg.ModifiedAt))
+	}
+
+	return Message{
+		Type:             "message",
+		Id:               msg.Id,
+		OrderId:          msg.OrderId,
+		Creator:          msg.Creator,
+		CreatedAt:        object.PosixToISO8601(float64(msg.CreatedAt)),
+		ModifiedAt:       modifiedAt,
+		ReplyToMessageId: msg.ReplyToMessageId,
+		Text:             text,
+		Style:            style,
+		Attachments:      attachments,
+		Reactions:        reactions,
+	}
+}
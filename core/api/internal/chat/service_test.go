@@ -0,0 +1,326 @@
+package chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service/mock_service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	offset                = 0
+	limit                 = 100
+	mockedSpaceId         = "mocked-space-id"
+	mockedChatId          = "mocked-chat-id"
+	mockedChatObjectId    = "mocked-chat-object-id"
+	mockedChatName        = "mocked-chat-name"
+	mockedMessageId       = "mocked-message-id"
+	mockedMessageOrderId  = "mocked-message-order-id"
+	mockedMessageText     = "mocked-message-text"
+	mockedMessageCreator  = "mocked-message-creator"
+	mockedMessageReaction = "👍"
+)
+
+type fixture struct {
+	*ChatService
+	mwMock *mock_service.MockClientCommandsServer
+}
+
+func newFixture(t *testing.T) *fixture {
+	mw := mock_service.NewMockClientCommandsServer(t)
+	chatService := NewService(mw)
+
+	return &fixture{
+		ChatService: chatService,
+		mwMock:      mw,
+	}
+}
+
+func (fx *fixture) expectChatExists(found bool) {
+	records := []*types.Struct{}
+	if found {
+		records = append(records, &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyId.String(): pbtypes.String(mockedChatObjectId),
+		}})
+	}
+
+	fx.mwMock.On("ObjectSearch", mock.Anything, &pb.RpcObjectSearchRequest{
+		SpaceId: mockedSpaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyChatId.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(mockedChatId),
+			},
+		},
+		Keys: []string{bundle.RelationKeyId.String()},
+	}).Return(&pb.RpcObjectSearchResponse{
+		Records: records,
+		Error:   &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+	}).Once()
+}
+
+func mockedMessage(text string, reactions map[string]*model.ChatMessageReactionsIdentityList) *model.ChatMessage {
+	return &model.ChatMessage{
+		Id:        mockedMessageId,
+		OrderId:   mockedMessageOrderId,
+		Creator:   mockedMessageCreator,
+		CreatedAt: 1731512374,
+		Message: &model.ChatMessageMessageContent{
+			Text:  text,
+			Style: model.BlockContentText_Paragraph,
+		},
+		Reactions: &model.ChatMessageReactions{Reactions: reactions},
+	}
+}
+
+func TestChatService_ListChats(t *testing.T) {
+	t.Run("chats found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).
+			Return(&pb.RpcObjectSearchResponse{
+				Records: []*types.Struct{
+					{
+						Fields: map[string]*types.Value{
+							bundle.RelationKeyId.String():     pbtypes.String(mockedChatObjectId),
+							bundle.RelationKeyName.String():   pbtypes.String(mockedChatName),
+							bundle.RelationKeyChatId.String(): pbtypes.String(mockedChatId),
+						},
+					},
+				},
+				Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+			}).Once()
+
+		// when
+		chats, total, hasMore, err := fx.ListChats(ctx, mockedSpaceId, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, []Chat{{Type: "chat", Id: mockedChatId, ObjectId: mockedChatObjectId, Name: mockedChatName}}, chats)
+		require.Equal(t, 1, total)
+		require.False(t, hasMore)
+	})
+}
+
+func TestChatService_GetMessages(t *testing.T) {
+	t.Run("messages found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.expectChatExists(true)
+		fx.mwMock.On("ChatGetMessages", mock.Anything, &pb.RpcChatGetMessagesRequest{
+			ChatObjectId:  mockedChatId,
+			BeforeOrderId: mockedMessageOrderId,
+			Limit:         limit,
+		}).Return(&pb.RpcChatGetMessagesResponse{
+			Messages: []*model.ChatMessage{mockedMessage(mockedMessageText, nil)},
+			Error:    &pb.RpcChatGetMessagesResponseError{Code: pb.RpcChatGetMessagesResponseError_NULL},
+		}).Once()
+
+		// when
+		messages, err := fx.GetMessages(ctx, mockedSpaceId, mockedChatId, mockedMessageOrderId, "", limit)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, messages, 1)
+		require.Equal(t, mockedMessageId, messages[0].Id)
+		require.Equal(t, mockedMessageText, messages[0].Text)
+		require.Equal(t, "2024-11-13T15:39:34Z", messages[0].CreatedAt)
+		require.Empty(t, messages[0].ModifiedAt)
+	})
+
+	t.Run("both order ids set", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		messages, err := fx.GetMessages(ctx, mockedSpaceId, mockedChatId, "a", "b", limit)
+
+		// then
+		require.ErrorIs(t, err, ErrBothOrderIdsSet)
+		require.Empty(t, messages)
+	})
+
+	t.Run("chat not in space", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.expectChatExists(false)
+
+		// when
+		messages, err := fx.GetMessages(ctx, mockedSpaceId, mockedChatId, "", "", limit)
+
+		// then
+		require.ErrorIs(t, err, ErrChatNotFound)
+		require.Empty(t, messages)
+	})
+}
+
+func TestChatService_AddMessage(t *testing.T) {
+	t.Run("successfully add message", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.expectChatExists(true)
+		fx.mwMock.On("ChatAddMessage", mock.Anything, &pb.RpcChatAddMessageRequest{
+			ChatObjectId: mockedChatId,
+			Message: &model.ChatMessage{
+				Message: &model.ChatMessageMessageContent{
+					Text:  mockedMessageText,
+					Style: model.BlockContentText_Paragraph,
+				},
+				Attachments: []*model.ChatMessageAttachment{
+					{Target: mockedChatObjectId, Type: model.ChatMessageAttachment_LINK},
+				},
+			},
+		}).Return(&pb.RpcChatAddMessageResponse{
+			MessageId: mockedMessageId,
+			Error:     &pb.RpcChatAddMessageResponseError{Code: pb.RpcChatAddMessageResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ChatGetMessagesByIds", mock.Anything, &pb.RpcChatGetMessagesByIdsRequest{
+			ChatObjectId: mockedChatId,
+			MessageIds:   []string{mockedMessageId},
+		}).Return(&pb.RpcChatGetMessagesByIdsResponse{
+			Messages: []*model.ChatMessage{mockedMessage(mockedMessageText, nil)},
+			Error:    &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_NULL},
+		}).Once()
+
+		// when
+		message, err := fx.AddMessage(ctx, mockedSpaceId, mockedChatId, AddMessageRequest{
+			Text:        mockedMessageText,
+			Attachments: []Attachment{{Target: mockedChatObjectId, Type: "LINK"}},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedMessageId, message.Id)
+		require.Equal(t, mockedMessageText, message.Text)
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		message, err := fx.AddMessage(ctx, mockedSpaceId, mockedChatId, AddMessageRequest{})
+
+		// then
+		require.ErrorIs(t, err, ErrMessageTextMissing)
+		require.Empty(t, message)
+	})
+}
+
+func TestChatService_EditMessage(t *testing.T) {
+	t.Run("reply and attachments are kept", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		existing := mockedMessage(mockedMessageText, nil)
+		existing.ReplyToMessageId = "reply-to-id"
+		existing.Attachments = []*model.ChatMessageAttachment{{Target: mockedChatObjectId, Type: model.ChatMessageAttachment_LINK}}
+		edited := mockedMessage("edited", nil)
+		edited.ReplyToMessageId = existing.ReplyToMessageId
+		edited.Attachments = existing.Attachments
+
+		fx.expectChatExists(true)
+		fx.mwMock.On("ChatGetMessagesByIds", mock.Anything, mock.Anything).Return(&pb.RpcChatGetMessagesByIdsResponse{
+			Messages: []*model.ChatMessage{existing},
+			Error:    &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ChatEditMessageContent", mock.Anything, &pb.RpcChatEditMessageContentRequest{
+			ChatObjectId: mockedChatId,
+			MessageId:    mockedMessageId,
+			EditedMessage: &model.ChatMessage{
+				ReplyToMessageId: "reply-to-id",
+				Message: &model.ChatMessageMessageContent{
+					Text:  "edited",
+					Style: model.BlockContentText_Paragraph,
+				},
+				Attachments: []*model.ChatMessageAttachment{{Target: mockedChatObjectId, Type: model.ChatMessageAttachment_LINK}},
+			},
+		}).Return(&pb.RpcChatEditMessageContentResponse{
+			Error: &pb.RpcChatEditMessageContentResponseError{Code: pb.RpcChatEditMessageContentResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ChatGetMessagesByIds", mock.Anything, mock.Anything).Return(&pb.RpcChatGetMessagesByIdsResponse{
+			Messages: []*model.ChatMessage{edited},
+			Error:    &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_NULL},
+		}).Once()
+
+		// when
+		message, err := fx.EditMessage(ctx, mockedSpaceId, mockedChatId, mockedMessageId, EditMessageRequest{Text: "edited"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, "edited", message.Text)
+		require.Equal(t, "reply-to-id", message.ReplyToMessageId)
+		require.Equal(t, []Attachment{{Target: mockedChatObjectId, Type: "LINK"}}, message.Attachments)
+	})
+}
+
+func TestChatService_ToggleReaction(t *testing.T) {
+	t.Run("reaction is returned with the message", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.expectChatExists(true)
+		fx.mwMock.On("ChatToggleMessageReaction", mock.Anything, &pb.RpcChatToggleMessageReactionRequest{
+			ChatObjectId: mockedChatId,
+			MessageId:    mockedMessageId,
+			Emoji:        mockedMessageReaction,
+		}).Return(&pb.RpcChatToggleMessageReactionResponse{
+			Error: &pb.RpcChatToggleMessageReactionResponseError{Code: pb.RpcChatToggleMessageReactionResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ChatGetMessagesByIds", mock.Anything, mock.Anything).Return(&pb.RpcChatGetMessagesByIdsResponse{
+			Messages: []*model.ChatMessage{mockedMessage(mockedMessageText, map[string]*model.ChatMessageReactionsIdentityList{
+				mockedMessageReaction: {Ids: []string{mockedMessageCreator}},
+			})},
+			Error: &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_NULL},
+		}).Once()
+
+		// when
+		message, err := fx.ToggleReaction(ctx, mockedSpaceId, mockedChatId, mockedMessageId, ToggleReactionRequest{Emoji: mockedMessageReaction})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{mockedMessageReaction: {mockedMessageCreator}}, message.Reactions)
+	})
+}
+
+func TestChatService_DeleteMessage(t *testing.T) {
+	t.Run("message not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.expectChatExists(true)
+		fx.mwMock.On("ChatGetMessagesByIds", mock.Anything, mock.Anything).Return(&pb.RpcChatGetMessagesByIdsResponse{
+			Messages: []*model.ChatMessage{},
+			Error:    &pb.RpcChatGetMessagesByIdsResponseError{Code: pb.RpcChatGetMessagesByIdsResponseError_NULL},
+		}).Once()
+
+		// when
+		message, err := fx.DeleteMessage(ctx, mockedSpaceId, mockedChatId, mockedMessageId)
+
+		// then
+		require.ErrorIs(t, err, ErrMessageNotFound)
+		require.Empty(t, message)
+	})
+}
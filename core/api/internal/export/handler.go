@@ -1,32 +1,41 @@
 package export
 
 import (
+	"context"
 	"net/http"
+	"os"
+	"path/filepath"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/anyproto/anytype-heart/core/api/internal/list"
 	"github.com/anyproto/anytype-heart/core/api/util"
 )
 
 // GetObjectExportHandler exports an object in specified format
 //
+// When no path is given, the export is zipped into a temporary directory and returned as the response body.
+// Read-only app keys can't export to a path.
+//
 //	@Summary	Export object
 //	@Tags		export
 //	@Accept		json
 //	@Produce	json
+//	@Produce	application/zip
 //	@Param		space_id	path		string					true	"Space ID"
 //	@Param		object_id	path		string					true	"Object ID"
-//	@Param		format		path		string					true	"Export format" Enums(markdown,protobuf)
-//	@Success	200			{object}	ObjectExportResponse	"Object exported successfully"
+//	@Param		format		path		string					true	"Export format"	Enums(markdown,protobuf,json,dot,svg,graph_json,html,csv,tsv,opml)
+//	@Success	200			{object}	ObjectExportResponse	"Object exported successfully, or the zip archive if no path was given"
 //	@Failure	400			{object}	util.ValidationError	"Bad request"
 //	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403			{object}	util.ForbiddenError		"Forbidden"
 //	@Failure	500			{object}	util.ServerError		"Internal server error"
 //	@Router		/spaces/{space_id}/objects/{object_id}/export/{format} [post]
 func GetObjectExportHandler(s *ExportService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		spaceId := c.Param("space_id")
 		objectId := c.Param("object_id")
-		format := c.Query("format")
+		format := c.Param("format")
 
 		objectAsRequest := ObjectExportRequest{}
 		if err := c.ShouldBindJSON(&objectAsRequest); err != nil {
@@ -35,7 +44,16 @@ func GetObjectExportHandler(s *ExportService) gin.HandlerFunc {
 			return
 		}
 
-		outputPath, err := s.GetObjectExport(c.Request.Context(), spaceId, objectId, format, objectAsRequest.Path)
+		path, stream, cleanup, err := resolveExportPath(c.Request.Context(), objectAsRequest.Path)
+		if err != nil {
+			code := util.MapErrorCode(err, util.ErrToCode(ErrPathNotAllowed, http.StatusForbidden))
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+		defer cleanup()
+
+		outputPath, err := s.GetObjectExport(c.Request.Context(), spaceId, objectId, format, path, stream)
 		code := util.MapErrorCode(err, util.ErrToCode(ErrFailedExportObjectAsMarkdown, http.StatusInternalServerError))
 
 		if code != http.StatusOK {
@@ -44,6 +62,97 @@ func GetObjectExportHandler(s *ExportService) gin.HandlerFunc {
 			return
 		}
 
+		if stream {
+			c.FileAttachment(outputPath, filepath.Base(outputPath))
+			return
+		}
+
 		c.JSON(http.StatusOK, ObjectExportResponse{Path: outputPath})
 	}
 }
+
+// resolveExportPath returns the directory to export into. Without the requested path the export goes into a temporary
+// directory to be streamed in the response, removed by cleanup. Read-only keys can't write to the host filesystem.
+func resolveExportPath(ctx context.Context, requestedPath string) (path string, stream bool, cleanup func(), err error) {
+	if requestedPath != "" {
+		if util.IsReadOnly(ctx) {
+			return "", false, nil, ErrPathNotAllowed
+		}
+		return requestedPath, false, func() {}, nil
+	}
+
+	tempDir, err := os.MkdirTemp("", "anytype-api-export-")
+	if err != nil {
+		return "", false, nil, ErrFailedExport
+	}
+	return tempDir, true, func() { os.RemoveAll(tempDir) }, nil
+}
+
+// ExportHandler exports several objects, the objects of a list view or a whole space
+//
+// When no path is given, the export is zipped into a temporary directory and returned as the response body.
+// Read-only app keys can't export to a path.
+//
+//	@Summary	Export objects
+//	@Tags		export
+//	@Accept		json
+//	@Produce	json
+//	@Produce	application/zip
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		request		body		ExportRequest			true	"Objects to export; the whole space is exported if neither object_ids nor list_id is set"
+//	@Success	200			{object}	ExportResponse			"Objects exported successfully, or the zip archive if no path was given"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403			{object}	util.ForbiddenError		"Forbidden"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/export [post]
+func ExportHandler(s *ExportService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+
+		request := ExportRequest{}
+		if err := c.ShouldBindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, ErrBadInput.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		path, stream, cleanup, err := resolveExportPath(c.Request.Context(), request.Path)
+		if err != nil {
+			code := util.MapErrorCode(err, util.ErrToCode(ErrPathNotAllowed, http.StatusForbidden))
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+		defer cleanup()
+		if stream {
+			request.Zip = true
+		}
+
+		outputPath, err := s.Export(c.Request.Context(), spaceId, request, path)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrInvalidExportScope, http.StatusBadRequest),
+			util.ErrToCode(ErrListRequired, http.StatusBadRequest),
+			util.ErrToCode(list.ErrListNotFound, http.StatusNotFound),
+			util.ErrToCode(list.ErrViewNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrNoObjectsToExport, http.StatusNotFound),
+			util.ErrToCode(list.ErrFailedRetrieveList, http.StatusInternalServerError),
+			util.ErrToCode(list.ErrFailedRetrieveObjects, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedExport, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		if stream {
+			c.FileAttachment(outputPath, filepath.Base(outputPath))
+			return
+		}
+
+		c.JSON(http.StatusOK, ExportResponse{Path: outputPath})
+	}
+}
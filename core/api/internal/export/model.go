@@ -7,3 +7,19 @@ type ObjectExportRequest struct {
 type ObjectExportResponse struct {
 	Path string `json:"path" example:"/path/to/export"`
 }
+
+type ExportRequest struct {
+	ObjectIds         []string `json:"object_ids" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ,bafyreiapey2g6e6za4zfxvlgwdy4hbbfu676gmwrhnqvjbxvrchr7elr3y"`
+	ListId            string   `json:"list_id" example:"bafyreigyb6l5szohs32ts26ku2j42yd65e6hqy2u3gtzgdwqv6hzftsetu"`
+	ViewId            string   `json:"view_id" example:"67bf3f21cda9134102e2422c"`
+	Format            string   `json:"format" enums:"markdown,protobuf,json,dot,svg,graph_json,html,csv,tsv,opml" example:"markdown"`
+	Zip               bool     `json:"zip" example:"true"`
+	IncludeNested     bool     `json:"include_nested" example:"true"`
+	IncludeFiles      bool     `json:"include_files" example:"true"`
+	IncludeProperties bool     `json:"include_properties" example:"true"`
+	Path              string   `json:"path" example:"/path/to/export"`
+}
+
+type ExportResponse struct {
+	Path string `json:"path" example:"/path/to/export/Anytype.20241113.153934.zip"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/anyproto/anytype-heart/core/api/internal/list"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pb/service"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -11,30 +12,37 @@ import (
 
 var (
 	ErrFailedExportObjectAsMarkdown = errors.New("failed to export object as markdown")
+	ErrFailedExport                 = errors.New("failed to export objects")
+	ErrInvalidExportScope           = errors.New("object ids and list id can't be combined")
+	ErrNoObjectsToExport            = errors.New("no objects to export")
+	ErrListRequired                 = errors.New("list id is required for csv and tsv export")
 	ErrBadInput                     = errors.New("bad input")
+	ErrPathNotAllowed               = errors.New("read-only app keys can't export to a path, omit it to receive the export in the response")
 )
 
 type Service interface {
-	GetObjectExport(ctx context.Context, spaceId string, objectId string, format string, path string) (string, error)
+	GetObjectExport(ctx context.Context, spaceId string, objectId string, format string, path string, zip bool) (string, error)
+	Export(ctx context.Context, spaceId string, request ExportRequest, path string) (string, error)
 }
 
 type ExportService struct {
 	mw          service.ClientCommandsServer
+	listService *list.ListService
 	AccountInfo *model.AccountInfo
 }
 
-func NewService(mw service.ClientCommandsServer) *ExportService {
-	return &ExportService{mw: mw}
+func NewService(mw service.ClientCommandsServer, listService *list.ListService) *ExportService {
+	return &ExportService{mw: mw, listService: listService}
 }
 
 // GetObjectExport retrieves an object from a space and exports it as a specific format.
-func (s *ExportService) GetObjectExport(ctx context.Context, spaceId string, objectId string, format string, path string) (string, error) {
+func (s *ExportService) GetObjectExport(ctx context.Context, spaceId string, objectId string, format string, path string, zip bool) (string, error) {
 	resp := s.mw.ObjectListExport(ctx, &pb.RpcObjectListExportRequest{
 		SpaceId:         spaceId,
 		Path:            path,
 		ObjectIds:       []string{objectId},
 		Format:          s.mapStringToFormat(format),
-		Zip:             false,
+		Zip:             zip,
 		IncludeNested:   false,
 		IncludeFiles:    true,
 		IsJson:          false,
@@ -49,6 +57,57 @@ func (s *ExportService) GetObjectExport(ctx context.Context, spaceId string, obj
 	return resp.Path, nil
 }
 
+// Export exports the given objects, the result of a set or collection view, or the whole space when neither is given,
+// into the directory at path and returns the path of the exported file or directory.
+func (s *ExportService) Export(ctx context.Context, spaceId string, request ExportRequest, path string) (string, error) {
+	if len(request.ObjectIds) > 0 && request.ListId != "" {
+		return "", ErrInvalidExportScope
+	}
+
+	format := s.mapStringToFormat(request.Format)
+	objectIds := request.ObjectIds
+	viewId := ""
+	if format == model.Export_CSV || format == model.Export_TSV {
+		// Table formats export the rows of the list view itself
+		if request.ListId == "" {
+			return "", ErrListRequired
+		}
+		objectIds = []string{request.ListId}
+		viewId = request.ViewId
+	} else if request.ListId != "" {
+		var err error
+		objectIds, err = s.listService.GetObjectIdsInList(ctx, spaceId, request.ListId, request.ViewId)
+		if err != nil {
+			return "", err
+		}
+		// An empty id list would export the whole space
+		if len(objectIds) == 0 {
+			return "", ErrNoObjectsToExport
+		}
+	}
+
+	resp := s.mw.ObjectListExport(ctx, &pb.RpcObjectListExportRequest{
+		SpaceId:             spaceId,
+		Path:                path,
+		ObjectIds:           objectIds,
+		Format:              format,
+		Zip:                 request.Zip,
+		IncludeNested:       request.IncludeNested,
+		IncludeFiles:        request.IncludeFiles,
+		IsJson:              false,
+		IncludeArchived:     false,
+		NoProgress:          true,
+		MdIncludeProperties: request.IncludeProperties,
+		ViewId:              viewId,
+	})
+
+	if resp.Error.Code != pb.RpcObjectListExportResponseError_NULL {
+		return "", ErrFailedExport
+	}
+
+	return resp.Path, nil
+}
+
 // mapStringToFormat maps a format string to an ExportFormat enum.
 func (s *ExportService) mapStringToFormat(format string) model.ExportFormat {
 	switch format {
@@ -56,6 +115,22 @@ func (s *ExportService) mapStringToFormat(format string) model.ExportFormat {
 		return model.Export_Markdown
 	case "protobuf":
 		return model.Export_Protobuf
+	case "json":
+		return model.Export_JSON
+	case "dot":
+		return model.Export_DOT
+	case "svg":
+		return model.Export_SVG
+	case "graph_json":
+		return model.Export_GRAPH_JSON
+	case "html":
+		return model.Export_HTML
+	case "csv":
+		return model.Export_CSV
+	case "tsv":
+		return model.Export_TSV
+	case "opml":
+		return model.Export_OPML
 	default:
 		return model.Export_Markdown
 	}
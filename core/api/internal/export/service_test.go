@@ -4,12 +4,16 @@ import (
 	"context"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anyproto/anytype-heart/core/api/internal/list"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pb/service/mock_service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 const (
@@ -18,6 +22,10 @@ const (
 	exportFormat       = "markdown"
 	unrecognizedFormat = "unrecognized"
 	exportPath         = "/some/dir/myexport"
+	zipPath            = "/some/dir/myexport/Anytype.20241113.153934.zip"
+	listID             = "list-789"
+	viewID             = "view-1"
+	subID              = "sub-1"
 )
 
 type fixture struct {
@@ -27,7 +35,7 @@ type fixture struct {
 
 func newFixture(t *testing.T) *fixture {
 	mw := mock_service.NewMockClientCommandsServer(t)
-	exportService := NewService(mw)
+	exportService := NewService(mw, list.NewService(mw, nil))
 
 	return &fixture{
 		ExportService: exportService,
@@ -64,7 +72,7 @@ func TestExportService_GetObjectExport(t *testing.T) {
 			Once()
 
 		// When
-		gotPath, err := fx.GetObjectExport(ctx, spaceID, objectID, exportFormat, exportPath)
+		gotPath, err := fx.GetObjectExport(ctx, spaceID, objectID, exportFormat, exportPath, false)
 
 		// Then
 		require.NoError(t, err)
@@ -89,7 +97,7 @@ func TestExportService_GetObjectExport(t *testing.T) {
 			Once()
 
 		// When
-		gotPath, err := fx.GetObjectExport(ctx, spaceID, objectID, exportFormat, exportPath)
+		gotPath, err := fx.GetObjectExport(ctx, spaceID, objectID, exportFormat, exportPath, false)
 
 		// Then
 		require.Error(t, err)
@@ -124,7 +132,7 @@ func TestExportService_GetObjectExport(t *testing.T) {
 			Once()
 
 		// When
-		gotPath, err := fx.GetObjectExport(ctx, spaceID, objectID, unrecognizedFormat, exportPath) //
+		gotPath, err := fx.GetObjectExport(ctx, spaceID, objectID, unrecognizedFormat, exportPath, false) //
 
 		// Then
 		require.NoError(t, err)
@@ -132,3 +140,186 @@ func TestExportService_GetObjectExport(t *testing.T) {
 		fx.mwMock.AssertExpectations(t)
 	})
 }
+
+func TestExportService_Export(t *testing.T) {
+	t.Run("export several objects as zipped json", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.
+			On("ObjectListExport", mock.Anything, &pb.RpcObjectListExportRequest{
+				SpaceId:       spaceID,
+				Path:          exportPath,
+				ObjectIds:     []string{objectID, "obj-789"},
+				Format:        model.Export_JSON,
+				Zip:           true,
+				IncludeNested: true,
+				NoProgress:    true,
+			}).
+			Return(&pb.RpcObjectListExportResponse{
+				Path:  zipPath,
+				Error: &pb.RpcObjectListExportResponseError{Code: pb.RpcObjectListExportResponseError_NULL},
+			}).
+			Once()
+
+		// When
+		gotPath, err := fx.Export(ctx, spaceID, ExportRequest{
+			ObjectIds:     []string{objectID, "obj-789"},
+			Format:        "json",
+			Zip:           true,
+			IncludeNested: true,
+		}, exportPath)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, zipPath, gotPath)
+	})
+
+	t.Run("export whole space as graph", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.
+			On("ObjectListExport", mock.Anything, &pb.RpcObjectListExportRequest{
+				SpaceId:    spaceID,
+				Path:       exportPath,
+				Format:     model.Export_GRAPH_JSON,
+				NoProgress: true,
+			}).
+			Return(&pb.RpcObjectListExportResponse{
+				Path:  exportPath,
+				Error: &pb.RpcObjectListExportResponseError{Code: pb.RpcObjectListExportResponseError_NULL},
+			}).
+			Once()
+
+		// When
+		gotPath, err := fx.Export(ctx, spaceID, ExportRequest{Format: "graph_json"}, exportPath)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, exportPath, gotPath)
+	})
+
+	t.Run("export objects of a set view", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  spaceID,
+			ObjectId: listID,
+		}).Return(&pb.RpcObjectShowResponse{
+			Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+			ObjectView: &model.ObjectView{
+				Details: []*model.ObjectViewDetailsSet{
+					{
+						Details: &types.Struct{
+							Fields: map[string]*types.Value{
+								bundle.RelationKeyLayout.String(): pbtypes.Int64(int64(model.ObjectType_set)),
+								bundle.RelationKeySetOf.String():  pbtypes.StringList([]string{"type-1"}),
+							},
+						},
+					},
+				},
+			},
+		}).Once()
+
+		fx.mwMock.On("ObjectSearchSubscribe", mock.Anything, &pb.RpcObjectSearchSubscribeRequest{
+			SpaceId:           spaceID,
+			Keys:              []string{bundle.RelationKeyId.String()},
+			NoDepSubscription: true,
+			Source:            []string{"type-1"},
+		}).Return(&pb.RpcObjectSearchSubscribeResponse{
+			SubId: subID,
+			Records: []*types.Struct{
+				{Fields: map[string]*types.Value{bundle.RelationKeyId.String(): pbtypes.String(objectID)}},
+			},
+			Counters: &pb.EventObjectSubscriptionCounters{Total: 1},
+			Error:    &pb.RpcObjectSearchSubscribeResponseError{Code: pb.RpcObjectSearchSubscribeResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("ObjectSearchUnsubscribe", mock.Anything, &pb.RpcObjectSearchUnsubscribeRequest{
+			SubIds: []string{subID},
+		}).Return(&pb.RpcObjectSearchUnsubscribeResponse{
+			Error: &pb.RpcObjectSearchUnsubscribeResponseError{Code: pb.RpcObjectSearchUnsubscribeResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.
+			On("ObjectListExport", mock.Anything, &pb.RpcObjectListExportRequest{
+				SpaceId:    spaceID,
+				Path:       exportPath,
+				ObjectIds:  []string{objectID},
+				Format:     model.Export_Markdown,
+				Zip:        true,
+				NoProgress: true,
+			}).
+			Return(&pb.RpcObjectListExportResponse{
+				Path:  zipPath,
+				Error: &pb.RpcObjectListExportResponseError{Code: pb.RpcObjectListExportResponseError_NULL},
+			}).
+			Once()
+
+		// When
+		gotPath, err := fx.Export(ctx, spaceID, ExportRequest{ListId: listID, Format: "markdown", Zip: true}, exportPath)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, zipPath, gotPath)
+	})
+
+	t.Run("object ids and list id can't be combined", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// When
+		gotPath, err := fx.Export(ctx, spaceID, ExportRequest{ObjectIds: []string{objectID}, ListId: listID}, exportPath)
+
+		// Then
+		require.ErrorIs(t, err, ErrInvalidExportScope)
+		require.Empty(t, gotPath)
+	})
+
+	t.Run("successful csv export of a list view", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.
+			On("ObjectListExport", mock.Anything, &pb.RpcObjectListExportRequest{
+				SpaceId:    spaceID,
+				Path:       exportPath,
+				ObjectIds:  []string{listID},
+				Format:     model.Export_CSV,
+				ViewId:     viewID,
+				NoProgress: true,
+			}).
+			Return(&pb.RpcObjectListExportResponse{
+				Path:  exportPath,
+				Error: &pb.RpcObjectListExportResponseError{Code: pb.RpcObjectListExportResponseError_NULL},
+			}).
+			Once()
+
+		// When
+		gotPath, err := fx.Export(ctx, spaceID, ExportRequest{ListId: listID, ViewId: viewID, Format: "csv"}, exportPath)
+
+		// Then
+		require.NoError(t, err)
+		require.Equal(t, exportPath, gotPath)
+	})
+
+	t.Run("csv export requires a list", func(t *testing.T) {
+		// Given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// When
+		gotPath, err := fx.Export(ctx, spaceID, ExportRequest{ObjectIds: []string{objectID}, Format: "tsv"}, exportPath)
+
+		// Then
+		require.ErrorIs(t, err, ErrListRequired)
+		require.Empty(t, gotPath)
+	})
+}
@@ -0,0 +1,116 @@
+package file
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// UploadFileHandler uploads a file into a space
+//
+//	@Summary	Upload file
+//	@Tags		files
+//	@Accept		multipart/form-data
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		file		formData	file					true	"File to upload"
+//	@Success	200			{object}	UploadFileResponse		"File uploaded successfully"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/files [post]
+func UploadFileHandler(s *FileService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+
+		header, err := c.FormFile("file")
+		if err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		content, err := header.Open()
+		if err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+		defer content.Close()
+
+		file, err := s.UploadFile(c.Request.Context(), spaceId, header.Filename, content)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrMissingFileName, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedUploadFile, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, UploadFileResponse{File: file})
+	}
+}
+
+// DownloadFileHandler downloads the content of a file
+//
+// Images can be resized by passing the wanted width; the closest stored variant is returned.
+//
+//	@Summary	Download file
+//	@Tags		files
+//	@Produce	application/octet-stream
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		file_id		path		string					true	"File ID"
+//	@Param		width		query		int						false	"Wanted image width in pixels"
+//	@Success	200			{file}		file					"File content"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/files/{file_id} [get]
+func DownloadFileHandler(s *FileService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		fileId := c.Param("file_id")
+
+		width := 0
+		if widthStr := c.Query("width"); widthStr != "" {
+			var err error
+			width, err = strconv.Atoi(widthStr)
+			if err != nil {
+				apiErr := util.CodeToAPIError(http.StatusBadRequest, ErrInvalidWidth.Error())
+				c.JSON(http.StatusBadRequest, apiErr)
+				return
+			}
+		}
+
+		file, reader, err := s.DownloadFile(c.Request.Context(), spaceId, fileId, width)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrInvalidWidth, http.StatusBadRequest),
+			util.ErrToCode(ErrFileNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedDownloadFile, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		meta := file.Meta()
+		c.Header("Content-Type", meta.Media)
+		// the name is quoted and escaped, names which are not ascii are encoded as defined by RFC 2231
+		disposition := mime.FormatMediaType("attachment", map[string]string{"filename": meta.Name})
+		if disposition == "" {
+			disposition = "attachment"
+		}
+		c.Header("Content-Disposition", disposition)
+		http.ServeContent(c.Writer, c.Request, meta.Name, meta.Added, reader)
+	}
+}
@@ -0,0 +1,16 @@
+package file
+
+type File struct {
+	Type     string `json:"type" example:"file"`
+	Id       string `json:"id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`
+	SpaceId  string `json:"space_id" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"`
+	Name     string `json:"name" example:"photo"`
+	Ext      string `json:"extension" example:"png"`
+	Mime     string `json:"mime" example:"image/png"`
+	Size     int64  `json:"size_in_bytes" example:"1024"`
+	FileType string `json:"file_type" enums:"file,image,video,audio,pdf" example:"image"`
+}
+
+type UploadFileResponse struct {
+	File File `json:"file"`
+}
@@ -0,0 +1,154 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/constant"
+	"github.com/anyproto/anytype-heart/util/svg"
+)
+
+var (
+	ErrMissingFileName    = errors.New("missing file name")
+	ErrFailedUploadFile   = errors.New("failed to upload file")
+	ErrFileNotFound       = errors.New("file not found")
+	ErrInvalidWidth       = errors.New("invalid image width")
+	ErrFailedDownloadFile = errors.New("failed to download file")
+)
+
+type Service interface {
+	UploadFile(ctx context.Context, spaceId string, name string, reader io.Reader) (File, error)
+	DownloadFile(ctx context.Context, spaceId string, fileId string, width int) (files.File, io.ReadSeeker, error)
+}
+
+type FileService struct {
+	mw                service.ClientCommandsServer
+	fileService       files.Service
+	fileObjectService fileobject.Service
+	AccountInfo       *model.AccountInfo
+}
+
+func NewService(mw service.ClientCommandsServer, fileService files.Service, fileObjectService fileobject.Service) *FileService {
+	return &FileService{mw: mw, fileService: fileService, fileObjectService: fileObjectService}
+}
+
+// UploadFile stores the content of reader under the given file name in the space and returns the created file object.
+func (s *FileService) UploadFile(ctx context.Context, spaceId string, name string, reader io.Reader) (File, error) {
+	name = filepath.Base(name)
+	if name == "" || name == "." || name == string(filepath.Separator) {
+		return File{}, ErrMissingFileName
+	}
+
+	// The file uploader works with local paths, so the content is staged in a temporary directory first
+	tempDir, err := os.MkdirTemp("", "anytype-api-upload-")
+	if err != nil {
+		return File{}, ErrFailedUploadFile
+	}
+	defer os.RemoveAll(tempDir)
+
+	localPath := filepath.Join(tempDir, name)
+	if err := writeFile(localPath, reader); err != nil {
+		return File{}, ErrFailedUploadFile
+	}
+
+	resp := s.mw.FileUpload(ctx, &pb.RpcFileUploadRequest{
+		SpaceId:   spaceId,
+		LocalPath: localPath,
+		Origin:    model.ObjectOrigin_api,
+	})
+
+	if resp.Error.Code != pb.RpcFileUploadResponseError_NULL {
+		return File{}, ErrFailedUploadFile
+	}
+
+	file := File{
+		Type:    "file",
+		Id:      resp.ObjectId,
+		SpaceId: spaceId,
+		Name:    name,
+	}
+
+	if resp.Details != nil {
+		fields := resp.Details.Fields
+		if fileName := fields[bundle.RelationKeyName.String()].GetStringValue(); fileName != "" {
+			file.Name = fileName
+		}
+		file.Ext = fields[bundle.RelationKeyFileExt.String()].GetStringValue()
+		file.Mime = fields[bundle.RelationKeyFileMimeType.String()].GetStringValue()
+		file.Size = int64(fields[bundle.RelationKeySizeInBytes.String()].GetNumberValue())
+		file.FileType = model.ObjectTypeLayout_name[int32(fields[bundle.RelationKeyLayout.String()].GetNumberValue())]
+	}
+
+	return file, nil
+}
+
+// DownloadFile returns the file behind the file object together with a reader of its content.
+// A positive width selects the closest image variant, in the same way as the gateway's image handler does.
+func (s *FileService) DownloadFile(ctx context.Context, spaceId string, fileId string, width int) (files.File, io.ReadSeeker, error) {
+	if width < 0 {
+		return nil, nil, ErrInvalidWidth
+	}
+
+	id, err := s.fileObjectService.GetFileIdFromObjectWaitLoad(ctx, fileId)
+	if err != nil || id.SpaceId != spaceId {
+		return nil, nil, ErrFileNotFound
+	}
+
+	if width == 0 {
+		file, err := s.fileService.FileByHash(ctx, id)
+		if err != nil {
+			return nil, nil, ErrFileNotFound
+		}
+		reader, err := file.Reader(ctx)
+		if err != nil {
+			return nil, nil, ErrFailedDownloadFile
+		}
+		return file, reader, nil
+	}
+
+	image, err := s.fileService.ImageByHash(ctx, id)
+	if err != nil {
+		return nil, nil, ErrFileNotFound
+	}
+
+	file, err := image.GetFileForWidth(width)
+	if err != nil {
+		return nil, nil, ErrFailedDownloadFile
+	}
+
+	var reader io.ReadSeeker
+	if filepath.Ext(file.Info().Name) == constant.SvgExt {
+		reader, err = svg.ProcessSvg(ctx, file)
+	} else {
+		reader, err = file.Reader(ctx)
+	}
+	if err != nil {
+		return nil, nil, ErrFailedDownloadFile
+	}
+
+	return file, reader, nil
+}
+
+// writeFile copies the content of reader into a new file at path.
+func writeFile(path string, reader io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, reader); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
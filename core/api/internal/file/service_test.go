@@ -0,0 +1,238 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/fileobject/mock_fileobject"
+	"github.com/anyproto/anytype-heart/core/files/mock_files"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service/mock_service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/storage"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	spaceId      = "space-123"
+	otherSpaceId = "space-456"
+	fileObjectId = "file-object-789"
+	fileName     = "photo.png"
+	fileContent  = "file content"
+)
+
+var fileId = domain.FileId("bafybeifileid")
+
+type fixture struct {
+	*FileService
+	mwMock                *mock_service.MockClientCommandsServer
+	fileServiceMock       *mock_files.MockService
+	fileObjectServiceMock *mock_fileobject.MockService
+}
+
+func newFixture(t *testing.T) *fixture {
+	mw := mock_service.NewMockClientCommandsServer(t)
+	fileService := mock_files.NewMockService(t)
+	fileObjectService := mock_fileobject.NewMockService(t)
+
+	return &fixture{
+		FileService:           NewService(mw, fileService, fileObjectService),
+		mwMock:                mw,
+		fileServiceMock:       fileService,
+		fileObjectServiceMock: fileObjectService,
+	}
+}
+
+func TestFileService_UploadFile(t *testing.T) {
+	t.Run("successful upload", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("FileUpload", mock.Anything, mock.MatchedBy(func(req *pb.RpcFileUploadRequest) bool {
+			content, err := os.ReadFile(req.LocalPath)
+			return err == nil &&
+				string(content) == fileContent &&
+				filepath.Base(req.LocalPath) == fileName &&
+				req.SpaceId == spaceId &&
+				req.Origin == model.ObjectOrigin_api
+		})).Return(&pb.RpcFileUploadResponse{
+			ObjectId: fileObjectId,
+			Details: &types.Struct{
+				Fields: map[string]*types.Value{
+					bundle.RelationKeyName.String():         pbtypes.String("photo"),
+					bundle.RelationKeyFileExt.String():      pbtypes.String("png"),
+					bundle.RelationKeyFileMimeType.String(): pbtypes.String("image/png"),
+					bundle.RelationKeySizeInBytes.String():  pbtypes.Int64(int64(len(fileContent))),
+					bundle.RelationKeyLayout.String():       pbtypes.Int64(int64(model.ObjectType_image)),
+				},
+			},
+			Error: &pb.RpcFileUploadResponseError{Code: pb.RpcFileUploadResponseError_NULL},
+		}).Once()
+
+		// when
+		file, err := fx.UploadFile(ctx, spaceId, fileName, strings.NewReader(fileContent))
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, File{
+			Type:     "file",
+			Id:       fileObjectId,
+			SpaceId:  spaceId,
+			Name:     "photo",
+			Ext:      "png",
+			Mime:     "image/png",
+			Size:     int64(len(fileContent)),
+			FileType: "image",
+		}, file)
+	})
+
+	t.Run("path components are stripped from the file name", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("FileUpload", mock.Anything, mock.MatchedBy(func(req *pb.RpcFileUploadRequest) bool {
+			return filepath.Base(req.LocalPath) == fileName && !strings.Contains(req.LocalPath, "..")
+		})).Return(&pb.RpcFileUploadResponse{
+			ObjectId: fileObjectId,
+			Error:    &pb.RpcFileUploadResponseError{Code: pb.RpcFileUploadResponseError_NULL},
+		}).Once()
+
+		// when
+		file, err := fx.UploadFile(ctx, spaceId, "../../"+fileName, strings.NewReader(fileContent))
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, fileName, file.Name)
+	})
+
+	t.Run("missing file name", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, err := fx.UploadFile(ctx, spaceId, "", strings.NewReader(fileContent))
+
+		// then
+		require.ErrorIs(t, err, ErrMissingFileName)
+	})
+
+	t.Run("failed upload", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("FileUpload", mock.Anything, mock.Anything).Return(&pb.RpcFileUploadResponse{
+			Error: &pb.RpcFileUploadResponseError{Code: pb.RpcFileUploadResponseError_UNKNOWN_ERROR},
+		}).Once()
+
+		// when
+		_, err := fx.UploadFile(ctx, spaceId, fileName, strings.NewReader(fileContent))
+
+		// then
+		require.ErrorIs(t, err, ErrFailedUploadFile)
+	})
+}
+
+func TestFileService_DownloadFile(t *testing.T) {
+	t.Run("successful download of the original file", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		id := domain.FullFileId{SpaceId: spaceId, FileId: fileId}
+
+		file := mock_files.NewMockFile(t)
+		file.EXPECT().Reader(mock.Anything).Return(bytes.NewReader([]byte(fileContent)), nil).Once()
+
+		fx.fileObjectServiceMock.EXPECT().GetFileIdFromObjectWaitLoad(mock.Anything, fileObjectId).Return(id, nil).Once()
+		fx.fileServiceMock.EXPECT().FileByHash(mock.Anything, id).Return(file, nil).Once()
+
+		// when
+		gotFile, reader, err := fx.DownloadFile(ctx, spaceId, fileObjectId, 0)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, file, gotFile)
+		require.NotNil(t, reader)
+	})
+
+	t.Run("successful download of a resized image", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		id := domain.FullFileId{SpaceId: spaceId, FileId: fileId}
+
+		file := mock_files.NewMockFile(t)
+		file.EXPECT().Info().Return(&storage.FileInfo{Name: fileName}).Once()
+		file.EXPECT().Reader(mock.Anything).Return(bytes.NewReader([]byte(fileContent)), nil).Once()
+
+		image := mock_files.NewMockImage(t)
+		image.EXPECT().GetFileForWidth(320).Return(file, nil).Once()
+
+		fx.fileObjectServiceMock.EXPECT().GetFileIdFromObjectWaitLoad(mock.Anything, fileObjectId).Return(id, nil).Once()
+		fx.fileServiceMock.EXPECT().ImageByHash(mock.Anything, id).Return(image, nil).Once()
+
+		// when
+		gotFile, reader, err := fx.DownloadFile(ctx, spaceId, fileObjectId, 320)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, file, gotFile)
+		require.NotNil(t, reader)
+	})
+
+	t.Run("file from another space", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.fileObjectServiceMock.EXPECT().GetFileIdFromObjectWaitLoad(mock.Anything, fileObjectId).
+			Return(domain.FullFileId{SpaceId: otherSpaceId, FileId: fileId}, nil).Once()
+
+		// when
+		_, _, err := fx.DownloadFile(ctx, spaceId, fileObjectId, 0)
+
+		// then
+		require.ErrorIs(t, err, ErrFileNotFound)
+	})
+
+	t.Run("unknown file object", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.fileObjectServiceMock.EXPECT().GetFileIdFromObjectWaitLoad(mock.Anything, fileObjectId).
+			Return(domain.FullFileId{}, errors.New("not found")).Once()
+
+		// when
+		_, _, err := fx.DownloadFile(ctx, spaceId, fileObjectId, 0)
+
+		// then
+		require.ErrorIs(t, err, ErrFileNotFound)
+	})
+
+	t.Run("negative width", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, err := fx.DownloadFile(ctx, spaceId, fileObjectId, -1)
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidWidth)
+	})
+}
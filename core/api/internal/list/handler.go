@@ -0,0 +1,203 @@
+package list
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// GetListViewsHandler retrieves the views of a set or collection
+//
+//	@Summary	Get list views
+//	@Tags		lists
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string								true	"Space ID"
+//	@Param		list_id		path		string								true	"List ID"
+//	@Param		offset		query		int									false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit		query		int									false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200			{object}	pagination.PaginatedResponse[View]	"List of views"
+//	@Failure	401			{object}	util.UnauthorizedError				"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError					"Resource not found"
+//	@Failure	500			{object}	util.ServerError					"Internal server error"
+//	@Router		/spaces/{space_id}/lists/{list_id}/views [get]
+func GetListViewsHandler(s *ListService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		listId := c.Param("list_id")
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		views, total, hasMore, err := s.GetListViews(c.Request.Context(), spaceId, listId, offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrListNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveList, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, views, total, offset, limit, hasMore)
+	}
+}
+
+// GetObjectsInListHandler retrieves the objects of a set or collection as shown by one of its views
+//
+//	@Summary	Get objects in list
+//	@Tags		lists
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string										true	"Space ID"
+//	@Param		list_id		path		string										true	"List ID"
+//	@Param		view_id		path		string										true	"View ID"
+//	@Param		offset		query		int											false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit		query		int											false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200			{object}	pagination.PaginatedResponse[object.Object]	"List of objects"
+//	@Failure	401			{object}	util.UnauthorizedError						"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError							"Resource not found"
+//	@Failure	500			{object}	util.ServerError							"Internal server error"
+//	@Router		/spaces/{space_id}/lists/{list_id}/views/{view_id}/objects [get]
+func GetObjectsInListHandler(s *ListService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		respondWithObjectsInList(c, s, c.Param("view_id"))
+	}
+}
+
+// GetObjectsInDefaultViewHandler retrieves the objects of a set or collection as shown by its first view
+//
+//	@Summary	Get objects in list by default view
+//	@Tags		lists
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string										true	"Space ID"
+//	@Param		list_id		path		string										true	"List ID"
+//	@Param		offset		query		int											false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit		query		int											false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200			{object}	pagination.PaginatedResponse[object.Object]	"List of objects"
+//	@Failure	401			{object}	util.UnauthorizedError						"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError							"Resource not found"
+//	@Failure	500			{object}	util.ServerError							"Internal server error"
+//	@Router		/spaces/{space_id}/lists/{list_id}/objects [get]
+func GetObjectsInDefaultViewHandler(s *ListService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		respondWithObjectsInList(c, s, "")
+	}
+}
+
+// respondWithObjectsInList responds with the page of objects of the list view, an empty viewId stands for the first view
+func respondWithObjectsInList(c *gin.Context, s *ListService, viewId string) {
+	spaceId := c.Param("space_id")
+	listId := c.Param("list_id")
+	offset := c.GetInt("offset")
+	limit := c.GetInt("limit")
+
+	objects, total, hasMore, err := s.GetObjectsInList(c.Request.Context(), spaceId, listId, viewId, offset, limit)
+	code := util.MapErrorCode(err,
+		util.ErrToCode(ErrListNotFound, http.StatusNotFound),
+		util.ErrToCode(ErrViewNotFound, http.StatusNotFound),
+		util.ErrToCode(ErrFailedRetrieveList, http.StatusInternalServerError),
+		util.ErrToCode(ErrFailedRetrieveObjects, http.StatusInternalServerError),
+		util.ErrToCode(object.ErrObjectNotFound, http.StatusInternalServerError),
+		util.ErrToCode(object.ErrFailedRetrieveObject, http.StatusInternalServerError),
+	)
+
+	if code != http.StatusOK {
+		apiErr := util.CodeToAPIError(code, err.Error())
+		c.JSON(code, apiErr)
+		return
+	}
+
+	pagination.RespondWithPagination(c, http.StatusOK, objects, total, offset, limit, hasMore)
+}
+
+// AddObjectsToListHandler adds objects to a collection
+//
+//	@Summary	Add objects to list
+//	@Tags		lists
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		list_id		path		string					true	"List ID"
+//	@Param		objects		body		AddObjectsToListRequest	true	"Objects to add"
+//	@Success	200			{object}	string					"Objects added successfully"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/lists/{list_id}/objects [post]
+func AddObjectsToListHandler(s *ListService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		listId := c.Param("list_id")
+
+		request := AddObjectsToListRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		err := s.AddObjectsToList(c.Request.Context(), spaceId, listId, request.Objects)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrObjectsMissing, http.StatusBadRequest),
+			util.ErrToCode(ErrNotCollection, http.StatusBadRequest),
+			util.ErrToCode(ErrListNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveList, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedAddObjectsToList, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, "Objects added successfully")
+	}
+}
+
+// RemoveObjectFromListHandler removes an object from a collection
+//
+//	@Summary	Remove object from list
+//	@Tags		lists
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		list_id		path		string					true	"List ID"
+//	@Param		object_id	path		string					true	"Object ID"
+//	@Success	200			{object}	string					"Object removed successfully"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/lists/{list_id}/objects/{object_id} [delete]
+func RemoveObjectFromListHandler(s *ListService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		listId := c.Param("list_id")
+		objectId := c.Param("object_id")
+
+		err := s.RemoveObjectFromList(c.Request.Context(), spaceId, listId, objectId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrNotCollection, http.StatusBadRequest),
+			util.ErrToCode(ErrListNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveList, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRemoveObjectFromList, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, "Object removed successfully")
+	}
+}
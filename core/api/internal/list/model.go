@@ -0,0 +1,27 @@
+package list
+
+type View struct {
+	Id      string   `json:"id" example:"67bf3f21cda9134102e2422c"`
+	Name    string   `json:"name" example:"All"`
+	Layout  string   `json:"layout" enums:"Table,List,Gallery,Kanban,Calendar,Graph" example:"Table"`
+	Filters []Filter `json:"filters"`
+	Sorts   []Sort   `json:"sorts"`
+}
+
+type Filter struct {
+	Id          string      `json:"id" example:"67bf3f21cda9134102e2422d"`
+	RelationKey string      `json:"relation_key" example:"dueDate"`
+	Condition   string      `json:"condition" enums:"None,Equal,NotEqual,Greater,Less,GreaterOrEqual,LessOrEqual,Like,NotLike,In,NotIn,Empty,NotEmpty,AllIn,NotAllIn,ExactIn,NotExactIn,Exists" example:"Greater"`
+	Format      string      `json:"format" example:"date"`
+	Value       interface{} `json:"value"`
+}
+
+type Sort struct {
+	Id          string `json:"id" example:"67bf3f21cda9134102e2422e"`
+	RelationKey string `json:"relation_key" example:"name"`
+	SortType    string `json:"sort_type" enums:"Asc,Desc,Custom" example:"Asc"`
+}
+
+type AddObjectsToListRequest struct {
+	Objects []string `json:"objects" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`
+}
@@ -0,0 +1,280 @@
+package list
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var (
+	ErrListNotFound               = errors.New("list not found")
+	ErrFailedRetrieveList         = errors.New("failed to retrieve list")
+	ErrViewNotFound               = errors.New("view not found")
+	ErrFailedRetrieveObjects      = errors.New("failed to retrieve list objects")
+	ErrObjectsMissing             = errors.New("no objects given")
+	ErrNotCollection              = errors.New("objects can only be added to or removed from collections")
+	ErrFailedAddObjectsToList     = errors.New("failed to add objects to list")
+	ErrFailedRemoveObjectFromList = errors.New("failed to remove object from list")
+)
+
+type Service interface {
+	GetListViews(ctx context.Context, spaceId string, listId string, offset int, limit int) ([]View, int, bool, error)
+	GetObjectsInList(ctx context.Context, spaceId string, listId string, viewId string, offset int, limit int) ([]object.Object, int, bool, error)
+	AddObjectsToList(ctx context.Context, spaceId string, listId string, objectIds []string) error
+	RemoveObjectFromList(ctx context.Context, spaceId string, listId string, objectId string) error
+}
+
+type ListService struct {
+	mw            service.ClientCommandsServer
+	objectService *object.ObjectService
+	AccountInfo   *model.AccountInfo
+}
+
+func NewService(mw service.ClientCommandsServer, objectService *object.ObjectService) *ListService {
+	return &ListService{mw: mw, objectService: objectService}
+}
+
+// list is the internal representation of a set or collection with its dataview
+type list struct {
+	layout   model.ObjectTypeLayout
+	setOf    []string
+	dataview *model.BlockContentDataview
+}
+
+// GetListViews returns a paginated list of views defined for a set or collection.
+func (s *ListService) GetListViews(ctx context.Context, spaceId string, listId string, offset int, limit int) (views []View, total int, hasMore bool, err error) {
+	l, err := s.getList(ctx, spaceId, listId)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	total = len(l.dataview.Views)
+	paginatedViews, hasMore := pagination.Paginate(l.dataview.Views, offset, limit)
+	views = make([]View, 0, len(paginatedViews))
+
+	for _, view := range paginatedViews {
+		views = append(views, s.mapView(view))
+	}
+	return views, total, hasMore, nil
+}
+
+// GetObjectsInList returns a paginated list of objects of a set or collection with the filters and sorts of the given view applied.
+// An empty viewId falls back to the first view of the list.
+func (s *ListService) GetObjectsInList(ctx context.Context, spaceId string, listId string, viewId string, offset int, limit int) (objects []object.Object, total int, hasMore bool, err error) {
+	objectIds, total, err := s.searchList(ctx, spaceId, listId, viewId, offset, limit)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	hasMore = offset+len(objectIds) < total
+	objects = make([]object.Object, 0, len(objectIds))
+
+	for _, objectId := range objectIds {
+		obj, err := s.objectService.GetObject(ctx, spaceId, objectId)
+		if err != nil {
+			return nil, 0, false, err
+		}
+
+		objects = append(objects, obj)
+	}
+	return objects, total, hasMore, nil
+}
+
+// GetObjectIdsInList returns the ids of all objects of a set or collection with the filters and sorts of the given view applied.
+func (s *ListService) GetObjectIdsInList(ctx context.Context, spaceId string, listId string, viewId string) ([]string, error) {
+	objectIds, _, err := s.searchList(ctx, spaceId, listId, viewId, 0, 0)
+	return objectIds, err
+}
+
+// searchList evaluates the view of a set or collection once and returns the ids of the matching objects along with their total count.
+func (s *ListService) searchList(ctx context.Context, spaceId string, listId string, viewId string, offset int, limit int) (objectIds []string, total int, err error) {
+	l, err := s.getList(ctx, spaceId, listId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var view *model.BlockContentDataviewView
+	for _, v := range l.dataview.Views {
+		if v.Id == viewId || (viewId == "" && view == nil) {
+			view = v
+		}
+	}
+	if view == nil && viewId != "" {
+		return nil, 0, ErrViewNotFound
+	}
+	// a search without the source matches every object, while a set without the source shows none
+	if l.layout != model.ObjectType_collection && len(l.setOf) == 0 {
+		return []string{}, 0, nil
+	}
+
+	request := &pb.RpcObjectSearchSubscribeRequest{
+		SpaceId:           spaceId,
+		Limit:             int64(limit),
+		Offset:            int64(offset),
+		Keys:              []string{bundle.RelationKeyId.String()},
+		NoDepSubscription: true,
+	}
+	if view != nil {
+		request.Filters = view.Filters
+		request.Sorts = view.Sorts
+	}
+	if l.layout == model.ObjectType_collection {
+		request.CollectionId = listId
+	} else {
+		request.Source = l.setOf
+	}
+
+	resp := s.mw.ObjectSearchSubscribe(ctx, request)
+	if resp.Error.Code != pb.RpcObjectSearchSubscribeResponseError_NULL {
+		return nil, 0, ErrFailedRetrieveObjects
+	}
+
+	// The subscription is only used to evaluate the view once, so it's dropped right away
+	defer s.mw.ObjectSearchUnsubscribe(context.Background(), &pb.RpcObjectSearchUnsubscribeRequest{SubIds: []string{resp.SubId}})
+
+	if resp.Counters != nil {
+		total = int(resp.Counters.Total)
+	}
+	objectIds = make([]string, 0, len(resp.Records))
+	for _, record := range resp.Records {
+		objectIds = append(objectIds, record.Fields[bundle.RelationKeyId.String()].GetStringValue())
+	}
+	return objectIds, total, nil
+}
+
+// AddObjectsToList adds objects to a collection.
+func (s *ListService) AddObjectsToList(ctx context.Context, spaceId string, listId string, objectIds []string) error {
+	if len(objectIds) == 0 {
+		return ErrObjectsMissing
+	}
+
+	if err := s.ensureCollection(ctx, spaceId, listId); err != nil {
+		return err
+	}
+
+	resp := s.mw.ObjectCollectionAdd(ctx, &pb.RpcObjectCollectionAddRequest{
+		ContextId: listId,
+		ObjectIds: objectIds,
+	})
+
+	if resp.Error.Code != pb.RpcObjectCollectionAddResponseError_NULL {
+		return ErrFailedAddObjectsToList
+	}
+
+	return nil
+}
+
+// RemoveObjectFromList removes an object from a collection.
+func (s *ListService) RemoveObjectFromList(ctx context.Context, spaceId string, listId string, objectId string) error {
+	if err := s.ensureCollection(ctx, spaceId, listId); err != nil {
+		return err
+	}
+
+	resp := s.mw.ObjectCollectionRemove(ctx, &pb.RpcObjectCollectionRemoveRequest{
+		ContextId: listId,
+		ObjectIds: []string{objectId},
+	})
+
+	if resp.Error.Code != pb.RpcObjectCollectionRemoveResponseError_NULL {
+		return ErrFailedRemoveObjectFromList
+	}
+
+	return nil
+}
+
+// ensureCollection returns an error if the list isn't a collection, as sets are populated by their query only.
+func (s *ListService) ensureCollection(ctx context.Context, spaceId string, listId string) error {
+	l, err := s.getList(ctx, spaceId, listId)
+	if err != nil {
+		return err
+	}
+
+	if l.layout != model.ObjectType_collection {
+		return ErrNotCollection
+	}
+
+	return nil
+}
+
+// getList opens a set or collection and returns its layout, source and dataview.
+func (s *ListService) getList(ctx context.Context, spaceId string, listId string) (*list, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: listId,
+	})
+
+	if resp.Error.Code == pb.RpcObjectShowResponseError_NOT_FOUND {
+		return nil, ErrListNotFound
+	}
+
+	if resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+		return nil, ErrFailedRetrieveList
+	}
+
+	details := resp.ObjectView.Details[0].Details
+	layout := model.ObjectTypeLayout(details.Fields[bundle.RelationKeyLayout.String()].GetNumberValue())
+	if layout != model.ObjectType_set && layout != model.ObjectType_collection {
+		return nil, ErrListNotFound
+	}
+
+	l := &list{
+		layout: layout,
+		setOf:  lo.Compact(pbtypes.GetStringListValue(details.Fields[bundle.RelationKeySetOf.String()])),
+	}
+
+	for _, block := range resp.ObjectView.Blocks {
+		if dataview := block.GetDataview(); dataview != nil {
+			l.dataview = dataview
+			break
+		}
+	}
+	if l.dataview == nil {
+		l.dataview = &model.BlockContentDataview{}
+	}
+
+	return l, nil
+}
+
+// mapView converts a dataview view into the API representation.
+func (s *ListService) mapView(view *model.BlockContentDataviewView) View {
+	filters := make([]Filter, 0, len(view.Filters))
+	for _, f := range view.Filters {
+		var value interface{}
+		if f.Value != nil {
+			value = pbtypes.ValueToInterface(f.Value)
+		}
+		filters = append(filters, Filter{
+			Id:          f.Id,
+			RelationKey: f.RelationKey,
+			Condition:   f.Condition.String(),
+			Format:      f.Format.String(),
+			Value:       value,
+		})
+	}
+
+	sorts := make([]Sort, 0, len(view.Sorts))
+	for _, sort := range view.Sorts {
+		sorts = append(sorts, Sort{
+			Id:          sort.Id,
+			RelationKey: sort.RelationKey,
+			SortType:    sort.Type.String(),
+		})
+	}
+
+	return View{
+		Id:      view.Id,
+		Name:    view.Name,
+		Layout:  view.Type.String(),
+		Filters: filters,
+		Sorts:   sorts,
+	}
+}
@@ -0,0 +1,332 @@
+package list
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/internal/space"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service/mock_service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	offset            = 0
+	limit             = 100
+	mockedTechSpaceId = "mocked-tech-space-id"
+	gatewayUrl        = "http://localhost:31006"
+	mockedSpaceId     = "mocked-space-id"
+	mockedListId      = "mocked-list-id"
+	mockedViewId      = "mocked-view-id"
+	mockedViewName    = "mocked-view-name"
+	mockedObjectId    = "mocked-object-id"
+	mockedTypeId      = "mocked-type-id"
+	mockedSubId       = "mocked-sub-id"
+)
+
+type fixture struct {
+	*ListService
+	mwMock *mock_service.MockClientCommandsServer
+}
+
+func newFixture(t *testing.T) *fixture {
+	mw := mock_service.NewMockClientCommandsServer(t)
+
+	spaceService := space.NewService(mw)
+	objectService := object.NewService(mw, spaceService)
+	accountInfo := &model.AccountInfo{
+		TechSpaceId: mockedTechSpaceId,
+		GatewayUrl:  gatewayUrl,
+	}
+	objectService.AccountInfo = accountInfo
+	listService := NewService(mw, objectService)
+	listService.AccountInfo = accountInfo
+
+	return &fixture{
+		ListService: listService,
+		mwMock:      mw,
+	}
+}
+
+var mockedView = &model.BlockContentDataviewView{
+	Id:   mockedViewId,
+	Type: model.BlockContentDataviewView_Table,
+	Name: mockedViewName,
+	Filters: []*model.BlockContentDataviewFilter{
+		{
+			Id:          "filter-1",
+			RelationKey: bundle.RelationKeyDone.String(),
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Format:      model.RelationFormat_checkbox,
+			Value:       pbtypes.Bool(false),
+		},
+	},
+	Sorts: []*model.BlockContentDataviewSort{
+		{
+			Id:          "sort-1",
+			RelationKey: bundle.RelationKeyName.String(),
+			Type:        model.BlockContentDataviewSort_Asc,
+		},
+	},
+}
+
+func listShowResponse(layout model.ObjectTypeLayout, setOf ...string) *pb.RpcObjectShowResponse {
+	if setOf == nil {
+		setOf = []string{mockedTypeId}
+	}
+	return &pb.RpcObjectShowResponse{
+		Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+		ObjectView: &model.ObjectView{
+			RootId: mockedListId,
+			Details: []*model.ObjectViewDetailsSet{
+				{
+					Details: &types.Struct{
+						Fields: map[string]*types.Value{
+							bundle.RelationKeyId.String():     pbtypes.String(mockedListId),
+							bundle.RelationKeyLayout.String(): pbtypes.Int64(int64(layout)),
+							bundle.RelationKeySetOf.String():  pbtypes.StringList(setOf),
+						},
+					},
+				},
+			},
+			Blocks: []*model.Block{
+				{Id: mockedListId, ChildrenIds: []string{"dataview"}},
+				{
+					Id: "dataview",
+					Content: &model.BlockContentOfDataview{
+						Dataview: &model.BlockContentDataview{
+							Views: []*model.BlockContentDataviewView{mockedView},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestListService_GetListViews(t *testing.T) {
+	t.Run("views found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedListId,
+		}).Return(listShowResponse(model.ObjectType_set)).Once()
+
+		// when
+		views, total, hasMore, err := fx.GetListViews(ctx, mockedSpaceId, mockedListId, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, []View{
+			{
+				Id:     mockedViewId,
+				Name:   mockedViewName,
+				Layout: "Table",
+				Filters: []Filter{
+					{Id: "filter-1", RelationKey: bundle.RelationKeyDone.String(), Condition: "Equal", Format: "checkbox", Value: false},
+				},
+				Sorts: []Sort{
+					{Id: "sort-1", RelationKey: bundle.RelationKeyName.String(), SortType: "Asc"},
+				},
+			},
+		}, views)
+		require.Equal(t, 1, total)
+		require.False(t, hasMore)
+	})
+
+	t.Run("object is not a list", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(listShowResponse(model.ObjectType_basic)).Once()
+
+		// when
+		views, _, _, err := fx.GetListViews(ctx, mockedSpaceId, mockedListId, offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrListNotFound)
+		require.Empty(t, views)
+	})
+}
+
+func TestListService_GetObjectsInList(t *testing.T) {
+	t.Run("collection is queried with view filters and sorts", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedListId,
+		}).Return(listShowResponse(model.ObjectType_collection)).Once()
+
+		fx.mwMock.On("ObjectSearchSubscribe", mock.Anything, &pb.RpcObjectSearchSubscribeRequest{
+			SpaceId:           mockedSpaceId,
+			Limit:             limit,
+			Offset:            offset,
+			Keys:              []string{bundle.RelationKeyId.String()},
+			NoDepSubscription: true,
+			Filters:           mockedView.Filters,
+			Sorts:             mockedView.Sorts,
+			CollectionId:      mockedListId,
+		}).Return(&pb.RpcObjectSearchSubscribeResponse{
+			SubId:    mockedSubId,
+			Records:  []*types.Struct{},
+			Counters: &pb.EventObjectSubscriptionCounters{Total: 0},
+			Error:    &pb.RpcObjectSearchSubscribeResponseError{Code: pb.RpcObjectSearchSubscribeResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("ObjectSearchUnsubscribe", mock.Anything, &pb.RpcObjectSearchUnsubscribeRequest{
+			SubIds: []string{mockedSubId},
+		}).Return(&pb.RpcObjectSearchUnsubscribeResponse{
+			Error: &pb.RpcObjectSearchUnsubscribeResponseError{Code: pb.RpcObjectSearchUnsubscribeResponseError_NULL},
+		}).Once()
+
+		// when
+		objects, total, hasMore, err := fx.GetObjectsInList(ctx, mockedSpaceId, mockedListId, mockedViewId, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Empty(t, objects)
+		require.Equal(t, 0, total)
+		require.False(t, hasMore)
+	})
+
+	t.Run("set is queried by its source with the first view when view id is empty", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(listShowResponse(model.ObjectType_set)).Once()
+
+		fx.mwMock.On("ObjectSearchSubscribe", mock.Anything, &pb.RpcObjectSearchSubscribeRequest{
+			SpaceId:           mockedSpaceId,
+			Limit:             limit,
+			Offset:            offset,
+			Keys:              []string{bundle.RelationKeyId.String()},
+			NoDepSubscription: true,
+			Filters:           mockedView.Filters,
+			Sorts:             mockedView.Sorts,
+			Source:            []string{mockedTypeId},
+		}).Return(&pb.RpcObjectSearchSubscribeResponse{
+			SubId:    mockedSubId,
+			Records:  []*types.Struct{},
+			Counters: &pb.EventObjectSubscriptionCounters{Total: 0},
+			Error:    &pb.RpcObjectSearchSubscribeResponseError{Code: pb.RpcObjectSearchSubscribeResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("ObjectSearchUnsubscribe", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchUnsubscribeResponse{
+			Error: &pb.RpcObjectSearchUnsubscribeResponseError{Code: pb.RpcObjectSearchUnsubscribeResponseError_NULL},
+		}).Once()
+
+		// when
+		objects, total, hasMore, err := fx.GetObjectsInList(ctx, mockedSpaceId, mockedListId, "", offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Empty(t, objects)
+		require.Equal(t, 0, total)
+		require.False(t, hasMore)
+	})
+
+	t.Run("set without source has no objects", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(listShowResponse(model.ObjectType_set, "")).Once()
+
+		// when
+		objects, total, hasMore, err := fx.GetObjectsInList(ctx, mockedSpaceId, mockedListId, mockedViewId, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Empty(t, objects)
+		require.Equal(t, 0, total)
+		require.False(t, hasMore)
+	})
+
+	t.Run("view not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(listShowResponse(model.ObjectType_set)).Once()
+
+		// when
+		objects, _, _, err := fx.GetObjectsInList(ctx, mockedSpaceId, mockedListId, "unknown-view", offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrViewNotFound)
+		require.Empty(t, objects)
+	})
+}
+
+func TestListService_AddObjectsToList(t *testing.T) {
+	t.Run("successfully add objects to collection", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(listShowResponse(model.ObjectType_collection)).Once()
+		fx.mwMock.On("ObjectCollectionAdd", mock.Anything, &pb.RpcObjectCollectionAddRequest{
+			ContextId: mockedListId,
+			ObjectIds: []string{mockedObjectId},
+		}).Return(&pb.RpcObjectCollectionAddResponse{
+			Error: &pb.RpcObjectCollectionAddResponseError{Code: pb.RpcObjectCollectionAddResponseError_NULL},
+		}).Once()
+
+		// when
+		err := fx.AddObjectsToList(ctx, mockedSpaceId, mockedListId, []string{mockedObjectId})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("sets can't be modified", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(listShowResponse(model.ObjectType_set)).Once()
+
+		// when
+		err := fx.AddObjectsToList(ctx, mockedSpaceId, mockedListId, []string{mockedObjectId})
+
+		// then
+		require.ErrorIs(t, err, ErrNotCollection)
+	})
+}
+
+func TestListService_RemoveObjectFromList(t *testing.T) {
+	t.Run("successfully remove object from collection", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(listShowResponse(model.ObjectType_collection)).Once()
+		fx.mwMock.On("ObjectCollectionRemove", mock.Anything, &pb.RpcObjectCollectionRemoveRequest{
+			ContextId: mockedListId,
+			ObjectIds: []string{mockedObjectId},
+		}).Return(&pb.RpcObjectCollectionRemoveResponse{
+			Error: &pb.RpcObjectCollectionRemoveResponseError{Code: pb.RpcObjectCollectionRemoveResponseError_NULL},
+		}).Once()
+
+		// when
+		err := fx.RemoveObjectFromList(ctx, mockedSpaceId, mockedListId, mockedObjectId)
+
+		// then
+		require.NoError(t, err)
+	})
+}
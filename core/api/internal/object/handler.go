@@ -145,6 +145,8 @@ func CreateObjectHandler(s *ObjectService) gin.HandlerFunc {
 			util.ErrToCode(ErrFailedCreateObject, http.StatusInternalServerError),
 			util.ErrToCode(ErrFailedSetRelationFeatured, http.StatusInternalServerError),
 			util.ErrToCode(ErrFailedFetchBookmark, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedCreateBlock, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedPasteBody, http.StatusInternalServerError),
 			util.ErrToCode(ErrObjectNotFound, http.StatusInternalServerError),
 			util.ErrToCode(ErrFailedRetrieveObject, http.StatusInternalServerError),
 		)
@@ -159,6 +161,56 @@ func CreateObjectHandler(s *ObjectService) gin.HandlerFunc {
 	}
 }
 
+// UpdateObjectHandler updates an object in a space
+//
+//	@Summary	Update object
+//	@Tags		objects
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		object_id	path		string					true	"Object ID"
+//	@Param		object		body		UpdateObjectRequest		true	"Fields to update"
+//	@Success	200			{object}	ObjectResponse			"The updated object"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/objects/{object_id} [patch]
+func UpdateObjectHandler(s *ObjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+
+		request := UpdateObjectRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		object, err := s.UpdateObject(c.Request.Context(), spaceId, objectId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrInvalidBodyMode, http.StatusBadRequest),
+			util.ErrToCode(ErrReadOnlyDetail, http.StatusBadRequest),
+			util.ErrToCode(ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveObject, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedUpdateObject, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedSetRelationFeatured, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedDeleteBody, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedCreateBlock, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedPasteBody, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, ObjectResponse{Object: object})
+	}
+}
+
 // GetTypesHandler retrieves a list of types in a space
 //
 //	@Summary	List types
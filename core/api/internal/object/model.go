@@ -10,6 +10,20 @@ type CreateObjectRequest struct {
 	ObjectTypeUniqueKey string `json:"object_type_unique_key" example:"ot-page"`
 }
 
+const (
+	BodyModeAppend  = "append"
+	BodyModeReplace = "replace"
+)
+
+type UpdateObjectRequest struct {
+	Name        *string                `json:"name,omitempty" example:"Object Name"`
+	Icon        *string                `json:"icon,omitempty" example:"📄"`
+	Description *string                `json:"description,omitempty" example:"Object Description"`
+	Details     map[string]interface{} `json:"details,omitempty"`
+	Body        *string                `json:"body,omitempty" example:"Object Body"`
+	BodyMode    string                 `json:"body_mode,omitempty" enums:"append,replace" default:"append"`
+}
+
 type ObjectResponse struct {
 	Object Object `json:"object"`
 }
@@ -17,6 +17,26 @@ import (
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
+const headerBlockId = "header"
+
+var (
+	// readOnlyDetailKeys are details that are maintained by the middleware and can't be set via the API
+	readOnlyDetailKeys = map[string]struct{}{
+		bundle.RelationKeyId.String():               {},
+		bundle.RelationKeySpaceId.String():          {},
+		bundle.RelationKeyType.String():             {},
+		bundle.RelationKeyLayout.String():           {},
+		bundle.RelationKeyOrigin.String():           {},
+		bundle.RelationKeyCreator.String():          {},
+		bundle.RelationKeyCreatedDate.String():      {},
+		bundle.RelationKeyLastModifiedBy.String():   {},
+		bundle.RelationKeyLastModifiedDate.String(): {},
+		bundle.RelationKeyLastOpenedDate.String():   {},
+		bundle.RelationKeyIsArchived.String():       {},
+		bundle.RelationKeyIsDeleted.String():        {},
+	}
+)
+
 var (
 	// objects
 	ErrObjectNotFound            = errors.New("object not found")
@@ -27,7 +47,12 @@ var (
 	ErrInputMissingSource        = errors.New("source is missing for bookmark")
 	ErrFailedSetRelationFeatured = errors.New("failed to set relation featured")
 	ErrFailedFetchBookmark       = errors.New("failed to fetch bookmark")
+	ErrFailedCreateBlock         = errors.New("failed to create block")
 	ErrFailedPasteBody           = errors.New("failed to paste body")
+	ErrFailedUpdateObject        = errors.New("failed to update object")
+	ErrFailedDeleteBody          = errors.New("failed to delete body")
+	ErrInvalidBodyMode           = errors.New("invalid body mode")
+	ErrReadOnlyDetail            = errors.New("detail is read-only")
 
 	// types
 	ErrFailedRetrieveTypes        = errors.New("failed to retrieve types")
@@ -45,6 +70,7 @@ type Service interface {
 	GetObject(ctx context.Context, spaceId string, objectId string) (Object, error)
 	DeleteObject(ctx context.Context, spaceId string, objectId string) (Object, error)
 	CreateObject(ctx context.Context, spaceId string, request CreateObjectRequest) (Object, error)
+	UpdateObject(ctx context.Context, spaceId string, objectId string, request UpdateObjectRequest) (Object, error)
 	ListTypes(ctx context.Context, spaceId string, offset int, limit int) ([]Type, int, bool, error)
 	GetType(ctx context.Context, spaceId string, typeId string) (Type, error)
 	ListTemplates(ctx context.Context, spaceId string, typeId string, offset int, limit int) ([]Template, int, bool, error)
@@ -220,48 +246,164 @@ func (s *ObjectService) CreateObject(ctx context.Context, spaceId string, reques
 		}
 	}
 
-	// First call BlockCreate at top, then BlockPaste to paste the body
 	if request.Body != "" {
-		blockCreateResp := s.mw.BlockCreate(ctx, &pb.RpcBlockCreateRequest{
-			ContextId: resp.ObjectId,
-			TargetId:  "",
-			Block: &model.Block{
-				Id:              "",
-				BackgroundColor: "",
-				Align:           model.Block_AlignLeft,
-				VerticalAlign:   model.Block_VerticalAlignTop,
-				Content: &model.BlockContentOfText{
-					Text: &model.BlockContentText{
-						Text:      "",
-						Style:     model.BlockContentText_Paragraph,
-						Checked:   false,
-						Color:     "",
-						IconEmoji: "",
-						IconImage: "",
-					},
-				},
-			},
-			Position: model.Block_Bottom,
+		if err := s.appendBody(ctx, resp.ObjectId, request.Body); err != nil {
+			object, _ := s.GetObject(ctx, spaceId, resp.ObjectId) // nolint:errcheck
+			return object, err
+		}
+	}
+
+	return s.GetObject(ctx, spaceId, resp.ObjectId)
+}
+
+// UpdateObject updates the details and body of an existing object in a specific space.
+func (s *ObjectService) UpdateObject(ctx context.Context, spaceId string, objectId string, request UpdateObjectRequest) (Object, error) {
+	if request.BodyMode != "" && request.BodyMode != BodyModeAppend && request.BodyMode != BodyModeReplace {
+		return Object{}, ErrInvalidBodyMode
+	}
+
+	object, err := s.GetObject(ctx, spaceId, objectId)
+	if err != nil {
+		return Object{}, err
+	}
+
+	details, err := s.prepareUpdateDetails(request)
+	if err != nil {
+		return Object{}, err
+	}
+
+	if len(details) > 0 {
+		resp := s.mw.ObjectSetDetails(ctx, &pb.RpcObjectSetDetailsRequest{
+			ContextId: objectId,
+			Details:   details,
 		})
 
-		if blockCreateResp.Error.Code != pb.RpcBlockCreateResponseError_NULL {
-			object, _ := s.GetObject(ctx, spaceId, resp.ObjectId) // nolint:errcheck
-			return object, ErrFailedCreateObject
+		if resp.Error.Code != pb.RpcObjectSetDetailsResponseError_NULL {
+			return Object{}, ErrFailedUpdateObject
 		}
+	}
 
-		blockPasteResp := s.mw.BlockPaste(ctx, &pb.RpcBlockPasteRequest{
-			ContextId:      resp.ObjectId,
-			FocusedBlockId: blockCreateResp.BlockId,
-			TextSlot:       request.Body,
+	// ObjectRelationAddFeatured if description was set, like it's done on creation
+	if request.Description != nil && *request.Description != "" {
+		relAddFeatResp := s.mw.ObjectRelationAddFeatured(ctx, &pb.RpcObjectRelationAddFeaturedRequest{
+			ContextId: objectId,
+			Relations: []string{bundle.RelationKeyDescription.String()},
 		})
 
-		if blockPasteResp.Error.Code != pb.RpcBlockPasteResponseError_NULL {
-			object, _ := s.GetObject(ctx, spaceId, resp.ObjectId) // nolint:errcheck
-			return object, ErrFailedPasteBody
+		if relAddFeatResp.Error.Code != pb.RpcObjectRelationAddFeaturedResponseError_NULL {
+			return Object{}, ErrFailedSetRelationFeatured
 		}
 	}
 
-	return s.GetObject(ctx, spaceId, resp.ObjectId)
+	if request.Body != nil {
+		if request.BodyMode == BodyModeReplace {
+			if err := s.deleteBody(ctx, object); err != nil {
+				return Object{}, err
+			}
+		}
+
+		if *request.Body != "" {
+			if err := s.appendBody(ctx, objectId, *request.Body); err != nil {
+				return Object{}, err
+			}
+		}
+	}
+
+	return s.GetObject(ctx, spaceId, objectId)
+}
+
+// prepareUpdateDetails converts the fields set in the update request into a list of details.
+func (s *ObjectService) prepareUpdateDetails(request UpdateObjectRequest) ([]*model.Detail, error) {
+	details := make([]*model.Detail, 0, len(request.Details)+3)
+	for key, value := range request.Details {
+		if _, readOnly := readOnlyDetailKeys[key]; readOnly {
+			return nil, ErrReadOnlyDetail
+		}
+		details = append(details, &model.Detail{Key: key, Value: pbtypes.InterfaceToValue(value)})
+	}
+
+	if request.Name != nil {
+		details = append(details, &model.Detail{Key: bundle.RelationKeyName.String(), Value: pbtypes.String(*request.Name)})
+	}
+	if request.Icon != nil {
+		details = append(details, &model.Detail{Key: bundle.RelationKeyIconEmoji.String(), Value: pbtypes.String(*request.Icon)})
+	}
+	if request.Description != nil {
+		details = append(details, &model.Detail{Key: bundle.RelationKeyDescription.String(), Value: pbtypes.String(*request.Description)})
+	}
+
+	return details, nil
+}
+
+// appendBody creates an empty text block at the bottom of the object and pastes the markdown body into it.
+func (s *ObjectService) appendBody(ctx context.Context, objectId string, body string) error {
+	blockCreateResp := s.mw.BlockCreate(ctx, &pb.RpcBlockCreateRequest{
+		ContextId: objectId,
+		TargetId:  "",
+		Block: &model.Block{
+			Id:              "",
+			BackgroundColor: "",
+			Align:           model.Block_AlignLeft,
+			VerticalAlign:   model.Block_VerticalAlignTop,
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{
+					Text:      "",
+					Style:     model.BlockContentText_Paragraph,
+					Checked:   false,
+					Color:     "",
+					IconEmoji: "",
+					IconImage: "",
+				},
+			},
+		},
+		Position: model.Block_Bottom,
+	})
+
+	if blockCreateResp.Error.Code != pb.RpcBlockCreateResponseError_NULL {
+		return ErrFailedCreateBlock
+	}
+
+	blockPasteResp := s.mw.BlockPaste(ctx, &pb.RpcBlockPasteRequest{
+		ContextId:      objectId,
+		FocusedBlockId: blockCreateResp.BlockId,
+		TextSlot:       body,
+	})
+
+	if blockPasteResp.Error.Code != pb.RpcBlockPasteResponseError_NULL {
+		return ErrFailedPasteBody
+	}
+
+	return nil
+}
+
+// deleteBody removes all top-level blocks of the object except the header.
+func (s *ObjectService) deleteBody(ctx context.Context, object Object) error {
+	blockIds := make([]string, 0)
+	for _, block := range object.Blocks {
+		if block.Id != object.RootId {
+			continue
+		}
+		for _, childId := range block.ChildrenIds {
+			if childId != headerBlockId {
+				blockIds = append(blockIds, childId)
+			}
+		}
+	}
+
+	if len(blockIds) == 0 {
+		return nil
+	}
+
+	resp := s.mw.BlockListDelete(ctx, &pb.RpcBlockListDeleteRequest{
+		ContextId: object.Id,
+		BlockIds:  blockIds,
+	})
+
+	if resp.Error.Code != pb.RpcBlockListDeleteResponseError_NULL {
+		return ErrFailedDeleteBody
+	}
+
+	return nil
 }
 
 // ListTypes returns a paginated list of types in a specific space.
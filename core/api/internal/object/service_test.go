@@ -521,6 +521,216 @@ func TestObjectService_CreateObject(t *testing.T) {
 	})
 }
 
+func TestObjectService_UpdateObject(t *testing.T) {
+	t.Run("successful update of name and body", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		name := "updated-name"
+		body := "# Heading"
+
+		// Mock object show before and after the update
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedObjectId,
+		}).Return(&pb.RpcObjectShowResponse{
+			ObjectView: &model.ObjectView{
+				RootId: mockedObjectId,
+				Blocks: []*model.Block{
+					{
+						Id:          mockedObjectId,
+						ChildrenIds: []string{"header", "text-block"},
+					},
+				},
+				Details: []*model.ObjectViewDetailsSet{
+					{
+						Details: &types.Struct{
+							Fields: map[string]*types.Value{
+								bundle.RelationKeyId.String():      pbtypes.String(mockedObjectId),
+								bundle.RelationKeyName.String():    pbtypes.String(name),
+								bundle.RelationKeyType.String():    pbtypes.String(mockedObjectTypeUniqueKey),
+								bundle.RelationKeySpaceId.String(): pbtypes.String(mockedSpaceId),
+							},
+						},
+					},
+				},
+			},
+			Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+		}).Twice()
+
+		// Mock type resolution and participant details
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+			Records: []*types.Struct{
+				{
+					Fields: map[string]*types.Value{
+						bundle.RelationKeyName.String(): pbtypes.String(mockedObjectType),
+					},
+				},
+			},
+		}).Times(6)
+
+		fx.mwMock.On("ObjectSetDetails", mock.Anything, &pb.RpcObjectSetDetailsRequest{
+			ContextId: mockedObjectId,
+			Details: []*model.Detail{
+				{Key: bundle.RelationKeyName.String(), Value: pbtypes.String(name)},
+			},
+		}).Return(&pb.RpcObjectSetDetailsResponse{
+			Error: &pb.RpcObjectSetDetailsResponseError{Code: pb.RpcObjectSetDetailsResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("BlockListDelete", mock.Anything, &pb.RpcBlockListDeleteRequest{
+			ContextId: mockedObjectId,
+			BlockIds:  []string{"text-block"},
+		}).Return(&pb.RpcBlockListDeleteResponse{
+			Error: &pb.RpcBlockListDeleteResponseError{Code: pb.RpcBlockListDeleteResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("BlockCreate", mock.Anything, mock.Anything).Return(&pb.RpcBlockCreateResponse{
+			BlockId: "new-block",
+			Error:   &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("BlockPaste", mock.Anything, &pb.RpcBlockPasteRequest{
+			ContextId:      mockedObjectId,
+			FocusedBlockId: "new-block",
+			TextSlot:       body,
+		}).Return(&pb.RpcBlockPasteResponse{
+			Error: &pb.RpcBlockPasteResponseError{Code: pb.RpcBlockPasteResponseError_NULL},
+		}).Once()
+
+		// when
+		object, err := fx.UpdateObject(ctx, mockedSpaceId, mockedObjectId, UpdateObjectRequest{
+			Name:     &name,
+			Body:     &body,
+			BodyMode: BodyModeReplace,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedObjectId, object.Id)
+		require.Equal(t, name, object.Name)
+	})
+
+	t.Run("description is made featured", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		description := "updated-description"
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(&pb.RpcObjectShowResponse{
+			ObjectView: &model.ObjectView{
+				RootId: mockedObjectId,
+				Details: []*model.ObjectViewDetailsSet{
+					{Details: &types.Struct{Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String(): pbtypes.String(mockedObjectId),
+					}}},
+				},
+			},
+			Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{{Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String(): pbtypes.String(mockedObjectType),
+			}}},
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		}).Maybe()
+		fx.mwMock.On("ObjectSetDetails", mock.Anything, &pb.RpcObjectSetDetailsRequest{
+			ContextId: mockedObjectId,
+			Details: []*model.Detail{
+				{Key: bundle.RelationKeyDescription.String(), Value: pbtypes.String(description)},
+			},
+		}).Return(&pb.RpcObjectSetDetailsResponse{
+			Error: &pb.RpcObjectSetDetailsResponseError{Code: pb.RpcObjectSetDetailsResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ObjectRelationAddFeatured", mock.Anything, &pb.RpcObjectRelationAddFeaturedRequest{
+			ContextId: mockedObjectId,
+			Relations: []string{bundle.RelationKeyDescription.String()},
+		}).Return(&pb.RpcObjectRelationAddFeaturedResponse{
+			Error: &pb.RpcObjectRelationAddFeaturedResponseError{Code: pb.RpcObjectRelationAddFeaturedResponseError_UNKNOWN_ERROR},
+		}).Once()
+
+		// when
+		object, err := fx.UpdateObject(ctx, mockedSpaceId, mockedObjectId, UpdateObjectRequest{
+			Description: &description,
+		})
+
+		// then
+		require.ErrorIs(t, err, ErrFailedSetRelationFeatured)
+		require.Empty(t, object)
+	})
+
+	t.Run("failed block creation", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		body := "text"
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(&pb.RpcObjectShowResponse{
+			ObjectView: &model.ObjectView{
+				RootId: mockedObjectId,
+				Details: []*model.ObjectViewDetailsSet{
+					{Details: &types.Struct{Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String(): pbtypes.String(mockedObjectId),
+					}}},
+				},
+			},
+			Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+		}).Once()
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{{Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String(): pbtypes.String(mockedObjectType),
+			}}},
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		}).Maybe()
+		fx.mwMock.On("BlockCreate", mock.Anything, mock.Anything).Return(&pb.RpcBlockCreateResponse{
+			Error: &pb.RpcBlockCreateResponseError{Code: pb.RpcBlockCreateResponseError_UNKNOWN_ERROR},
+		}).Once()
+
+		// when
+		object, err := fx.UpdateObject(ctx, mockedSpaceId, mockedObjectId, UpdateObjectRequest{
+			Body: &body,
+		})
+
+		// then
+		require.ErrorIs(t, err, ErrFailedCreateBlock)
+		require.Empty(t, object)
+	})
+
+	t.Run("invalid body mode", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		object, err := fx.UpdateObject(ctx, mockedSpaceId, mockedObjectId, UpdateObjectRequest{
+			BodyMode: "prepend",
+		})
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidBodyMode)
+		require.Empty(t, object)
+	})
+
+	t.Run("object not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).
+			Return(&pb.RpcObjectShowResponse{
+				Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NOT_FOUND},
+			}).Once()
+
+		// when
+		object, err := fx.UpdateObject(ctx, mockedSpaceId, "missing-obj", UpdateObjectRequest{})
+
+		// then
+		require.ErrorIs(t, err, ErrObjectNotFound)
+		require.Empty(t, object)
+	})
+}
+
 func TestObjectService_ListTypes(t *testing.T) {
 	t.Run("types found", func(t *testing.T) {
 		// given
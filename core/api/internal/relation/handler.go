@@ -0,0 +1,471 @@
+package relation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// GetRelationsHandler retrieves a list of relations in a space
+//
+//	@Summary	List relations
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string									true	"Space ID"
+//	@Param		offset		query		int										false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit		query		int										false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200			{object}	pagination.PaginatedResponse[Relation]	"List of relations"
+//	@Failure	401			{object}	util.UnauthorizedError					"Unauthorized"
+//	@Failure	500			{object}	util.ServerError						"Internal server error"
+//	@Router		/spaces/{space_id}/relations [get]
+func GetRelationsHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		relations, total, hasMore, err := s.ListRelations(c.Request.Context(), spaceId, offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrFailedRetrieveRelations, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, relations, total, offset, limit, hasMore)
+	}
+}
+
+// GetRelationHandler retrieves a relation in a space
+//
+//	@Summary	Get relation
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation_id	path		string					true	"Relation ID"
+//	@Success	200			{object}	RelationResponse		"The requested relation"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id} [get]
+func GetRelationHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+
+		relation, err := s.GetRelation(c.Request.Context(), spaceId, relationId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedRetrieveRelation, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, RelationResponse{Relation: relation})
+	}
+}
+
+// CreateRelationHandler creates a new relation in a space
+//
+//	@Summary	Create relation
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation	body		CreateRelationRequest	true	"Relation to create"
+//	@Success	200			{object}	RelationResponse		"The created relation"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations [post]
+func CreateRelationHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+
+		request := CreateRelationRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		relation, err := s.CreateRelation(c.Request.Context(), spaceId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrInvalidRelationName, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidRelationFormat, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedCreateRelation, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveRelation, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, RelationResponse{Relation: relation})
+	}
+}
+
+// UpdateRelationHandler updates a relation in a space
+//
+//	@Summary	Update relation
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation_id	path		string					true	"Relation ID"
+//	@Param		relation	body		UpdateRelationRequest	true	"Fields to update"
+//	@Success	200			{object}	RelationResponse		"The updated relation"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id} [patch]
+func UpdateRelationHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+
+		request := UpdateRelationRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		relation, err := s.UpdateRelation(c.Request.Context(), spaceId, relationId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrInvalidRelationName, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedUpdateRelation, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveRelation, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, RelationResponse{Relation: relation})
+	}
+}
+
+// DeleteRelationHandler deletes a relation in a space
+//
+//	@Summary	Delete relation
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation_id	path		string					true	"Relation ID"
+//	@Success	200			{object}	RelationResponse		"The deleted relation"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403			{object}	util.ForbiddenError		"Forbidden"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id} [delete]
+func DeleteRelationHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+
+		relation, err := s.DeleteRelation(c.Request.Context(), spaceId, relationId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedDeleteRelation, http.StatusForbidden),
+			util.ErrToCode(ErrFailedRetrieveRelation, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, RelationResponse{Relation: relation})
+	}
+}
+
+// GetOptionsHandler retrieves the options of a tag or status relation
+//
+//	@Summary	List options
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string									true	"Space ID"
+//	@Param		relation_id	path		string									true	"Relation ID"
+//	@Param		offset		query		int										false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit		query		int										false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200			{object}	pagination.PaginatedResponse[Option]	"List of options"
+//	@Failure	400			{object}	util.ValidationError					"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError					"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError						"Resource not found"
+//	@Failure	500			{object}	util.ServerError						"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id}/options [get]
+func GetOptionsHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		options, total, hasMore, err := s.ListOptions(c.Request.Context(), spaceId, relationId, offset, limit)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrRelationHasNoOptions, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedRetrieveRelation, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveOptions, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, options, total, offset, limit, hasMore)
+	}
+}
+
+// GetOptionHandler retrieves an option of a tag or status relation
+//
+//	@Summary	Get option
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation_id	path		string					true	"Relation ID"
+//	@Param		option_id	path		string					true	"Option ID"
+//	@Success	200			{object}	OptionResponse			"The requested option"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id}/options/{option_id} [get]
+func GetOptionHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+		optionId := c.Param("option_id")
+
+		option, err := s.GetOption(c.Request.Context(), spaceId, relationId, optionId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrOptionNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrRelationHasNoOptions, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedRetrieveRelation, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveOption, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, OptionResponse{Option: option})
+	}
+}
+
+// CreateOptionHandler creates a new option for a tag or status relation
+//
+//	@Summary	Create option
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation_id	path		string					true	"Relation ID"
+//	@Param		option		body		CreateOptionRequest		true	"Option to create"
+//	@Success	200			{object}	OptionResponse			"The created option"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id}/options [post]
+func CreateOptionHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+
+		request := CreateOptionRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		option, err := s.CreateOption(c.Request.Context(), spaceId, relationId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrRelationHasNoOptions, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidOptionName, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidOptionColor, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedCreateOption, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveOption, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, OptionResponse{Option: option})
+	}
+}
+
+// UpdateOptionHandler updates an option of a tag or status relation
+//
+//	@Summary	Update option
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation_id	path		string					true	"Relation ID"
+//	@Param		option_id	path		string					true	"Option ID"
+//	@Param		option		body		UpdateOptionRequest		true	"Fields to update"
+//	@Success	200			{object}	OptionResponse			"The updated option"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id}/options/{option_id} [patch]
+func UpdateOptionHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+		optionId := c.Param("option_id")
+
+		request := UpdateOptionRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		option, err := s.UpdateOption(c.Request.Context(), spaceId, relationId, optionId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrOptionNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrRelationHasNoOptions, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidOptionName, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidOptionColor, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedUpdateOption, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveOption, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, OptionResponse{Option: option})
+	}
+}
+
+// DeleteOptionHandler deletes an option of a tag or status relation
+//
+//	@Summary	Delete option
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		relation_id	path		string					true	"Relation ID"
+//	@Param		option_id	path		string					true	"Option ID"
+//	@Success	200			{object}	OptionResponse			"The deleted option"
+//	@Failure	400			{object}	util.ValidationError	"Bad request"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403			{object}	util.ForbiddenError		"Forbidden"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/relations/{relation_id}/options/{option_id} [delete]
+func DeleteOptionHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		relationId := c.Param("relation_id")
+		optionId := c.Param("option_id")
+
+		option, err := s.DeleteOption(c.Request.Context(), spaceId, relationId, optionId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrOptionNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrRelationHasNoOptions, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedDeleteOption, http.StatusForbidden),
+			util.ErrToCode(ErrFailedRetrieveOption, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, OptionResponse{Option: option})
+	}
+}
+
+// SetRelationValueHandler sets the value of a relation on an object
+//
+//	@Summary	Set relation value
+//	@Tags		relations
+//	@Accept		json
+//	@Produce	json
+//	@Param		space_id		path		string					true	"Space ID"
+//	@Param		object_id		path		string					true	"Object ID"
+//	@Param		relation_key	path		string					true	"Relation key"
+//	@Param		value			body		SetRelationValueRequest	true	"Value to set; null clears the relation"
+//	@Success	200				{object}	object.ObjectResponse	"The updated object"
+//	@Failure	400				{object}	util.ValidationError	"Bad request"
+//	@Failure	401				{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	404				{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500				{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/objects/{object_id}/relations/{relation_key} [put]
+func SetRelationValueHandler(s *RelationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		objectId := c.Param("object_id")
+		relationKey := c.Param("relation_key")
+
+		request := SetRelationValueRequest{}
+		if err := c.BindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		obj, err := s.SetRelationValue(c.Request.Context(), spaceId, objectId, relationKey, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(object.ErrObjectNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrRelationNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrInvalidRelationValue, http.StatusBadRequest),
+			util.ErrToCode(ErrRelationValueNotSupported, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedSetRelationValue, http.StatusInternalServerError),
+			util.ErrToCode(ErrFailedRetrieveRelation, http.StatusInternalServerError),
+			util.ErrToCode(object.ErrFailedRetrieveObject, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, object.ObjectResponse{Object: obj})
+	}
+}
@@ -0,0 +1,48 @@
+package relation
+
+type Relation struct {
+	Type   string `json:"type" example:"relation"`
+	Id     string `json:"id" example:"bafyreids36kpw5ppuwm3ce2p4ezb3ab7cihhkq6yfbwzwpp4mln7rcgw7a"`
+	Key    string `json:"key" example:"6780f1d8b0d1e2b71bd2c7f1"`
+	Name   string `json:"name" example:"Due date"`
+	Format string `json:"format" enums:"longtext,shorttext,number,status,tag,date,file,checkbox,url,email,phone,emoji,object" example:"date"`
+}
+
+type RelationResponse struct {
+	Relation Relation `json:"relation"`
+}
+
+type CreateRelationRequest struct {
+	Name   string `json:"name" example:"Due date"`
+	Format string `json:"format" enums:"longtext,shorttext,number,status,tag,date,file,checkbox,url,email,phone,emoji,object" example:"date"`
+}
+
+type UpdateRelationRequest struct {
+	Name *string `json:"name,omitempty" example:"Deadline"`
+}
+
+type Option struct {
+	Type        string `json:"type" example:"option"`
+	Id          string `json:"id" example:"bafyreidxbk5uv6kwbhmewpf2ivi5bhk5bplcuhdpi4vgafylmoqh7cnwfm"`
+	RelationKey string `json:"relation_key" example:"tag"`
+	Name        string `json:"name" example:"In progress"`
+	Color       string `json:"color" enums:"grey,yellow,orange,red,pink,purple,blue,ice,teal,lime" example:"yellow"`
+}
+
+type OptionResponse struct {
+	Option Option `json:"option"`
+}
+
+type CreateOptionRequest struct {
+	Name  string `json:"name" example:"In progress"`
+	Color string `json:"color" enums:"grey,yellow,orange,red,pink,purple,blue,ice,teal,lime" example:"yellow"`
+}
+
+type UpdateOptionRequest struct {
+	Name  *string `json:"name,omitempty" example:"Done"`
+	Color *string `json:"color,omitempty" enums:"grey,yellow,orange,red,pink,purple,blue,ice,teal,lime" example:"lime"`
+}
+
+type SetRelationValueRequest struct {
+	Value interface{} `json:"value"`
+}
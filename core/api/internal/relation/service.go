@@ -0,0 +1,544 @@
+package relation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+var (
+	// relations
+	ErrRelationNotFound          = errors.New("relation not found")
+	ErrFailedRetrieveRelation    = errors.New("failed to retrieve relation")
+	ErrFailedRetrieveRelations   = errors.New("failed to retrieve relations")
+	ErrFailedCreateRelation      = errors.New("failed to create relation")
+	ErrFailedUpdateRelation      = errors.New("failed to update relation")
+	ErrFailedDeleteRelation      = errors.New("failed to delete relation")
+	ErrInvalidRelationFormat     = errors.New("invalid relation format")
+	ErrInvalidRelationName       = errors.New("relation name must not be empty")
+	ErrRelationHasNoOptions      = errors.New("relation format does not support options")
+	ErrInvalidRelationValue      = errors.New("invalid value for relation format")
+	ErrFailedSetRelationValue    = errors.New("failed to set relation value")
+	ErrRelationValueNotSupported = errors.New("relation value is managed by the system")
+
+	// options
+	ErrOptionNotFound        = errors.New("option not found")
+	ErrFailedRetrieveOption  = errors.New("failed to retrieve option")
+	ErrFailedRetrieveOptions = errors.New("failed to retrieve options")
+	ErrFailedCreateOption    = errors.New("failed to create option")
+	ErrFailedUpdateOption    = errors.New("failed to update option")
+	ErrFailedDeleteOption    = errors.New("failed to delete option")
+	ErrInvalidOptionName     = errors.New("option name must not be empty")
+	ErrInvalidOptionColor    = errors.New("invalid option color")
+)
+
+var (
+	// optionColors are the colors the desktop app offers for tag and status options
+	optionColors = map[string]struct{}{
+		"grey": {}, "yellow": {}, "orange": {}, "red": {}, "pink": {}, "purple": {}, "blue": {}, "ice": {}, "teal": {}, "lime": {},
+	}
+
+	relationKeys = []string{
+		bundle.RelationKeyId.String(),
+		bundle.RelationKeyRelationKey.String(),
+		bundle.RelationKeyName.String(),
+		bundle.RelationKeyRelationFormat.String(),
+	}
+
+	optionKeys = []string{
+		bundle.RelationKeyId.String(),
+		bundle.RelationKeyRelationKey.String(),
+		bundle.RelationKeyName.String(),
+		bundle.RelationKeyRelationOptionColor.String(),
+	}
+)
+
+type Service interface {
+	ListRelations(ctx context.Context, spaceId string, offset int, limit int) ([]Relation, int, bool, error)
+	GetRelation(ctx context.Context, spaceId string, relationId string) (Relation, error)
+	CreateRelation(ctx context.Context, spaceId string, request CreateRelationRequest) (Relation, error)
+	UpdateRelation(ctx context.Context, spaceId string, relationId string, request UpdateRelationRequest) (Relation, error)
+	DeleteRelation(ctx context.Context, spaceId string, relationId string) (Relation, error)
+	ListOptions(ctx context.Context, spaceId string, relationId string, offset int, limit int) ([]Option, int, bool, error)
+	GetOption(ctx context.Context, spaceId string, relationId string, optionId string) (Option, error)
+	CreateOption(ctx context.Context, spaceId string, relationId string, request CreateOptionRequest) (Option, error)
+	UpdateOption(ctx context.Context, spaceId string, relationId string, optionId string, request UpdateOptionRequest) (Option, error)
+	DeleteOption(ctx context.Context, spaceId string, relationId string, optionId string) (Option, error)
+	SetRelationValue(ctx context.Context, spaceId string, objectId string, relationKey string, request SetRelationValueRequest) (object.Object, error)
+}
+
+type RelationService struct {
+	mw            service.ClientCommandsServer
+	objectService *object.ObjectService
+	AccountInfo   *model.AccountInfo
+}
+
+func NewService(mw service.ClientCommandsServer, objectService *object.ObjectService) *RelationService {
+	return &RelationService{mw: mw, objectService: objectService}
+}
+
+// ListRelations returns a paginated list of relations in a specific space.
+func (s *RelationService) ListRelations(ctx context.Context, spaceId string, offset int, limit int) (relations []Relation, total int, hasMore bool, err error) {
+	resp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
+		SpaceId: spaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.Int64(int64(model.ObjectType_relation)),
+			},
+			{
+				RelationKey: bundle.RelationKeyIsHidden.String(),
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       pbtypes.Bool(true),
+			},
+		},
+		Sorts: []*model.BlockContentDataviewSort{
+			{
+				RelationKey: bundle.RelationKeyName.String(),
+				Type:        model.BlockContentDataviewSort_Asc,
+			},
+		},
+		Keys: relationKeys,
+	})
+
+	if resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
+		return nil, 0, false, ErrFailedRetrieveRelations
+	}
+
+	total = len(resp.Records)
+	paginatedRelations, hasMore := pagination.Paginate(resp.Records, offset, limit)
+	relations = make([]Relation, 0, len(paginatedRelations))
+
+	for _, record := range paginatedRelations {
+		relations = append(relations, s.mapRelation(record))
+	}
+	return relations, total, hasMore, nil
+}
+
+// GetRelation returns a single relation by its ID in a specific space.
+func (s *RelationService) GetRelation(ctx context.Context, spaceId string, relationId string) (Relation, error) {
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: relationId,
+	})
+
+	if resp.Error.Code == pb.RpcObjectShowResponseError_NOT_FOUND {
+		return Relation{}, ErrRelationNotFound
+	}
+
+	if resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+		return Relation{}, ErrFailedRetrieveRelation
+	}
+
+	details := resp.ObjectView.Details[0].Details
+	if model.ObjectTypeLayout(details.Fields[bundle.RelationKeyLayout.String()].GetNumberValue()) != model.ObjectType_relation {
+		return Relation{}, ErrRelationNotFound
+	}
+
+	return s.mapRelation(details), nil
+}
+
+// CreateRelation creates a new relation with the given name and format in a specific space.
+func (s *RelationService) CreateRelation(ctx context.Context, spaceId string, request CreateRelationRequest) (Relation, error) {
+	if request.Name == "" {
+		return Relation{}, ErrInvalidRelationName
+	}
+
+	format, ok := model.RelationFormat_value[request.Format]
+	if !ok || model.RelationFormat(format) == model.RelationFormat_relations {
+		return Relation{}, ErrInvalidRelationFormat
+	}
+
+	resp := s.mw.ObjectCreateRelation(ctx, &pb.RpcObjectCreateRelationRequest{
+		SpaceId: spaceId,
+		Details: &types.Struct{
+			Fields: map[string]*types.Value{
+				bundle.RelationKeyName.String():           pbtypes.String(request.Name),
+				bundle.RelationKeyRelationFormat.String(): pbtypes.Int64(int64(format)),
+			},
+		},
+	})
+
+	if resp.Error.Code != pb.RpcObjectCreateRelationResponseError_NULL {
+		return Relation{}, ErrFailedCreateRelation
+	}
+
+	return s.GetRelation(ctx, spaceId, resp.ObjectId)
+}
+
+// UpdateRelation renames an existing relation in a specific space. The format of a relation can't be changed.
+func (s *RelationService) UpdateRelation(ctx context.Context, spaceId string, relationId string, request UpdateRelationRequest) (Relation, error) {
+	if _, err := s.GetRelation(ctx, spaceId, relationId); err != nil {
+		return Relation{}, err
+	}
+
+	if request.Name != nil {
+		if *request.Name == "" {
+			return Relation{}, ErrInvalidRelationName
+		}
+
+		resp := s.mw.ObjectSetDetails(ctx, &pb.RpcObjectSetDetailsRequest{
+			ContextId: relationId,
+			Details:   []*model.Detail{{Key: bundle.RelationKeyName.String(), Value: pbtypes.String(*request.Name)}},
+		})
+
+		if resp.Error.Code != pb.RpcObjectSetDetailsResponseError_NULL {
+			return Relation{}, ErrFailedUpdateRelation
+		}
+	}
+
+	return s.GetRelation(ctx, spaceId, relationId)
+}
+
+// DeleteRelation moves a relation of a specific space to the bin.
+func (s *RelationService) DeleteRelation(ctx context.Context, spaceId string, relationId string) (Relation, error) {
+	relation, err := s.GetRelation(ctx, spaceId, relationId)
+	if err != nil {
+		return Relation{}, err
+	}
+
+	resp := s.mw.ObjectSetIsArchived(ctx, &pb.RpcObjectSetIsArchivedRequest{
+		ContextId:  relationId,
+		IsArchived: true,
+	})
+
+	if resp.Error.Code != pb.RpcObjectSetIsArchivedResponseError_NULL {
+		return Relation{}, ErrFailedDeleteRelation
+	}
+
+	return relation, nil
+}
+
+// ListOptions returns a paginated list of tag or status options of a relation.
+func (s *RelationService) ListOptions(ctx context.Context, spaceId string, relationId string, offset int, limit int) (options []Option, total int, hasMore bool, err error) {
+	relation, err := s.getOptionRelation(ctx, spaceId, relationId)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	resp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
+		SpaceId: spaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.Int64(int64(model.ObjectType_relationOption)),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationKey.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(relation.Key),
+			},
+		},
+		Sorts: []*model.BlockContentDataviewSort{
+			{
+				RelationKey: bundle.RelationKeyName.String(),
+				Type:        model.BlockContentDataviewSort_Asc,
+			},
+		},
+		Keys: optionKeys,
+	})
+
+	if resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
+		return nil, 0, false, ErrFailedRetrieveOptions
+	}
+
+	total = len(resp.Records)
+	paginatedOptions, hasMore := pagination.Paginate(resp.Records, offset, limit)
+	options = make([]Option, 0, len(paginatedOptions))
+
+	for _, record := range paginatedOptions {
+		options = append(options, s.mapOption(record))
+	}
+	return options, total, hasMore, nil
+}
+
+// GetOption returns a single option of a relation by its ID.
+func (s *RelationService) GetOption(ctx context.Context, spaceId string, relationId string, optionId string) (Option, error) {
+	relation, err := s.getOptionRelation(ctx, spaceId, relationId)
+	if err != nil {
+		return Option{}, err
+	}
+
+	resp := s.mw.ObjectShow(ctx, &pb.RpcObjectShowRequest{
+		SpaceId:  spaceId,
+		ObjectId: optionId,
+	})
+
+	if resp.Error.Code == pb.RpcObjectShowResponseError_NOT_FOUND {
+		return Option{}, ErrOptionNotFound
+	}
+
+	if resp.Error.Code != pb.RpcObjectShowResponseError_NULL {
+		return Option{}, ErrFailedRetrieveOption
+	}
+
+	details := resp.ObjectView.Details[0].Details
+	if details.Fields[bundle.RelationKeyRelationKey.String()].GetStringValue() != relation.Key {
+		return Option{}, ErrOptionNotFound
+	}
+
+	return s.mapOption(details), nil
+}
+
+// CreateOption creates a new tag or status option for a relation.
+func (s *RelationService) CreateOption(ctx context.Context, spaceId string, relationId string, request CreateOptionRequest) (Option, error) {
+	relation, err := s.getOptionRelation(ctx, spaceId, relationId)
+	if err != nil {
+		return Option{}, err
+	}
+
+	if request.Name == "" {
+		return Option{}, ErrInvalidOptionName
+	}
+
+	if _, ok := optionColors[request.Color]; request.Color != "" && !ok {
+		return Option{}, ErrInvalidOptionColor
+	}
+
+	resp := s.mw.ObjectCreateRelationOption(ctx, &pb.RpcObjectCreateRelationOptionRequest{
+		SpaceId: spaceId,
+		Details: &types.Struct{
+			Fields: map[string]*types.Value{
+				bundle.RelationKeyRelationKey.String():         pbtypes.String(relation.Key),
+				bundle.RelationKeyName.String():                pbtypes.String(request.Name),
+				bundle.RelationKeyRelationOptionColor.String(): pbtypes.String(request.Color),
+			},
+		},
+	})
+
+	if resp.Error.Code != pb.RpcObjectCreateRelationOptionResponseError_NULL {
+		return Option{}, ErrFailedCreateOption
+	}
+
+	return s.GetOption(ctx, spaceId, relationId, resp.ObjectId)
+}
+
+// UpdateOption renames or recolors an existing option of a relation.
+func (s *RelationService) UpdateOption(ctx context.Context, spaceId string, relationId string, optionId string, request UpdateOptionRequest) (Option, error) {
+	if _, err := s.GetOption(ctx, spaceId, relationId, optionId); err != nil {
+		return Option{}, err
+	}
+
+	var details []*model.Detail
+	if request.Name != nil {
+		if *request.Name == "" {
+			return Option{}, ErrInvalidOptionName
+		}
+		details = append(details, &model.Detail{Key: bundle.RelationKeyName.String(), Value: pbtypes.String(*request.Name)})
+	}
+	if request.Color != nil {
+		if _, ok := optionColors[*request.Color]; !ok {
+			return Option{}, ErrInvalidOptionColor
+		}
+		details = append(details, &model.Detail{Key: bundle.RelationKeyRelationOptionColor.String(), Value: pbtypes.String(*request.Color)})
+	}
+
+	if len(details) > 0 {
+		resp := s.mw.ObjectSetDetails(ctx, &pb.RpcObjectSetDetailsRequest{
+			ContextId: optionId,
+			Details:   details,
+		})
+
+		if resp.Error.Code != pb.RpcObjectSetDetailsResponseError_NULL {
+			return Option{}, ErrFailedUpdateOption
+		}
+	}
+
+	return s.GetOption(ctx, spaceId, relationId, optionId)
+}
+
+// DeleteOption removes an option from a relation. Objects keep referencing the option id until they're edited.
+func (s *RelationService) DeleteOption(ctx context.Context, spaceId string, relationId string, optionId string) (Option, error) {
+	option, err := s.GetOption(ctx, spaceId, relationId, optionId)
+	if err != nil {
+		return Option{}, err
+	}
+
+	resp := s.mw.RelationListRemoveOption(ctx, &pb.RpcRelationListRemoveOptionRequest{
+		OptionIds: []string{optionId},
+	})
+
+	if resp.Error.Code != pb.RpcRelationListRemoveOptionResponseError_NULL {
+		return Option{}, ErrFailedDeleteOption
+	}
+
+	return option, nil
+}
+
+// SetRelationValue sets the value of a relation on an object, converting the JSON value according to the relation format.
+func (s *RelationService) SetRelationValue(ctx context.Context, spaceId string, objectId string, relationKey string, request SetRelationValueRequest) (object.Object, error) {
+	if _, err := s.objectService.GetObject(ctx, spaceId, objectId); err != nil {
+		return object.Object{}, err
+	}
+
+	if rel, err := bundle.GetRelation(domain.RelationKey(relationKey)); err == nil && rel.ReadOnly {
+		return object.Object{}, ErrRelationValueNotSupported
+	}
+
+	relation, err := s.getRelationByKey(ctx, spaceId, relationKey)
+	if err != nil {
+		return object.Object{}, err
+	}
+
+	value, err := s.convertValue(model.RelationFormat(model.RelationFormat_value[relation.Format]), request.Value)
+	if err != nil {
+		return object.Object{}, err
+	}
+
+	resp := s.mw.ObjectSetDetails(ctx, &pb.RpcObjectSetDetailsRequest{
+		ContextId: objectId,
+		Details:   []*model.Detail{{Key: relationKey, Value: value}},
+	})
+
+	if resp.Error.Code != pb.RpcObjectSetDetailsResponseError_NULL {
+		return object.Object{}, ErrFailedSetRelationValue
+	}
+
+	return s.objectService.GetObject(ctx, spaceId, objectId)
+}
+
+// getOptionRelation returns the relation and ensures it supports options.
+func (s *RelationService) getOptionRelation(ctx context.Context, spaceId string, relationId string) (Relation, error) {
+	relation, err := s.GetRelation(ctx, spaceId, relationId)
+	if err != nil {
+		return Relation{}, err
+	}
+
+	if relation.Format != model.RelationFormat_tag.String() && relation.Format != model.RelationFormat_status.String() {
+		return Relation{}, ErrRelationHasNoOptions
+	}
+
+	return relation, nil
+}
+
+// getRelationByKey looks up a relation in a specific space by its key.
+func (s *RelationService) getRelationByKey(ctx context.Context, spaceId string, relationKey string) (Relation, error) {
+	resp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
+		SpaceId: spaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.Int64(int64(model.ObjectType_relation)),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationKey.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(relationKey),
+			},
+		},
+		Keys: relationKeys,
+	})
+
+	if resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
+		return Relation{}, ErrFailedRetrieveRelation
+	}
+
+	if len(resp.Records) == 0 {
+		return Relation{}, ErrRelationNotFound
+	}
+
+	return s.mapRelation(resp.Records[0]), nil
+}
+
+// convertValue converts a JSON-decoded value into a protobuf value matching the relation format. A null value clears the relation.
+func (s *RelationService) convertValue(format model.RelationFormat, value interface{}) (*types.Value, error) {
+	if value == nil {
+		return pbtypes.Null(), nil
+	}
+
+	switch format {
+	case model.RelationFormat_longtext, model.RelationFormat_shorttext, model.RelationFormat_url,
+		model.RelationFormat_email, model.RelationFormat_phone, model.RelationFormat_emoji:
+		if v, ok := value.(string); ok {
+			return pbtypes.String(v), nil
+		}
+	case model.RelationFormat_number:
+		if v, ok := value.(float64); ok {
+			return pbtypes.Float64(v), nil
+		}
+	case model.RelationFormat_checkbox:
+		if v, ok := value.(bool); ok {
+			return pbtypes.Bool(v), nil
+		}
+	case model.RelationFormat_date:
+		switch v := value.(type) {
+		case float64:
+			return pbtypes.Float64(v), nil
+		case string:
+			if t, err := util.ParseDate(v); err == nil {
+				return pbtypes.Float64(float64(t.Unix())), nil
+			}
+		}
+	case model.RelationFormat_status:
+		if v, ok := value.(string); ok {
+			return pbtypes.StringList([]string{v}), nil
+		}
+		if ids, ok := toStringList(value); ok && len(ids) <= 1 {
+			return pbtypes.StringList(ids), nil
+		}
+	case model.RelationFormat_tag, model.RelationFormat_object, model.RelationFormat_file:
+		if v, ok := value.(string); ok {
+			return pbtypes.StringList([]string{v}), nil
+		}
+		if ids, ok := toStringList(value); ok {
+			return pbtypes.StringList(ids), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrInvalidRelationValue, format.String())
+}
+
+// mapRelation converts relation details into the API representation.
+func (s *RelationService) mapRelation(details *types.Struct) Relation {
+	return Relation{
+		Type:   "relation",
+		Id:     details.Fields[bundle.RelationKeyId.String()].GetStringValue(),
+		Key:    details.Fields[bundle.RelationKeyRelationKey.String()].GetStringValue(),
+		Name:   details.Fields[bundle.RelationKeyName.String()].GetStringValue(),
+		Format: model.RelationFormat_name[int32(details.Fields[bundle.RelationKeyRelationFormat.String()].GetNumberValue())],
+	}
+}
+
+// mapOption converts option details into the API representation.
+func (s *RelationService) mapOption(details *types.Struct) Option {
+	return Option{
+		Type:        "option",
+		Id:          details.Fields[bundle.RelationKeyId.String()].GetStringValue(),
+		RelationKey: details.Fields[bundle.RelationKeyRelationKey.String()].GetStringValue(),
+		Name:        details.Fields[bundle.RelationKeyName.String()].GetStringValue(),
+		Color:       details.Fields[bundle.RelationKeyRelationOptionColor.String()].GetStringValue(),
+	}
+}
+
+// toStringList converts a JSON array of strings into a string slice.
+func toStringList(value interface{}) ([]string, bool) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		str, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		result = append(result, str)
+	}
+	return result, true
+}
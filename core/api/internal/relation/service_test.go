@@ -0,0 +1,409 @@
+package relation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/internal/space"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service/mock_service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	offset              = 0
+	limit               = 100
+	mockedTechSpaceId   = "mocked-tech-space-id"
+	gatewayUrl          = "http://localhost:31006"
+	mockedSpaceId       = "mocked-space-id"
+	mockedObjectId      = "mocked-object-id"
+	mockedObjectTypeId  = "mocked-object-type-id"
+	mockedRelationId    = "mocked-relation-id"
+	mockedRelationKey   = "mocked-relation-key"
+	mockedRelationName  = "mocked-relation-name"
+	mockedOptionId      = "mocked-option-id"
+	mockedOptionName    = "mocked-option-name"
+	mockedOptionColor   = "lime"
+	mockedNewRelationId = "mocked-new-relation-id"
+)
+
+type fixture struct {
+	*RelationService
+	mwMock *mock_service.MockClientCommandsServer
+}
+
+func newFixture(t *testing.T) *fixture {
+	mw := mock_service.NewMockClientCommandsServer(t)
+
+	spaceService := space.NewService(mw)
+	objectService := object.NewService(mw, spaceService)
+	accountInfo := &model.AccountInfo{
+		TechSpaceId: mockedTechSpaceId,
+		GatewayUrl:  gatewayUrl,
+	}
+	objectService.AccountInfo = accountInfo
+	relationService := NewService(mw, objectService)
+	relationService.AccountInfo = accountInfo
+
+	return &fixture{
+		RelationService: relationService,
+		mwMock:          mw,
+	}
+}
+
+func relationShowResponse(id string, key string, format model.RelationFormat) *pb.RpcObjectShowResponse {
+	return &pb.RpcObjectShowResponse{
+		Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+		ObjectView: &model.ObjectView{
+			Details: []*model.ObjectViewDetailsSet{
+				{
+					Details: &types.Struct{
+						Fields: map[string]*types.Value{
+							bundle.RelationKeyId.String():             pbtypes.String(id),
+							bundle.RelationKeyRelationKey.String():    pbtypes.String(key),
+							bundle.RelationKeyName.String():           pbtypes.String(mockedRelationName),
+							bundle.RelationKeyLayout.String():         pbtypes.Int64(int64(model.ObjectType_relation)),
+							bundle.RelationKeyRelationFormat.String(): pbtypes.Int64(int64(format)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func objectShowResponse() *pb.RpcObjectShowResponse {
+	return &pb.RpcObjectShowResponse{
+		Error: &pb.RpcObjectShowResponseError{Code: pb.RpcObjectShowResponseError_NULL},
+		ObjectView: &model.ObjectView{
+			RootId: mockedObjectId,
+			Details: []*model.ObjectViewDetailsSet{
+				{
+					Details: &types.Struct{
+						Fields: map[string]*types.Value{
+							bundle.RelationKeyId.String():      pbtypes.String(mockedObjectId),
+							bundle.RelationKeyType.String():    pbtypes.String(mockedObjectTypeId),
+							bundle.RelationKeySpaceId.String(): pbtypes.String(mockedSpaceId),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRelationService_ListRelations(t *testing.T) {
+	t.Run("relations found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).
+			Return(&pb.RpcObjectSearchResponse{
+				Records: []*types.Struct{
+					{
+						Fields: map[string]*types.Value{
+							bundle.RelationKeyId.String():             pbtypes.String(mockedRelationId),
+							bundle.RelationKeyRelationKey.String():    pbtypes.String(mockedRelationKey),
+							bundle.RelationKeyName.String():           pbtypes.String(mockedRelationName),
+							bundle.RelationKeyRelationFormat.String(): pbtypes.Int64(int64(model.RelationFormat_date)),
+						},
+					},
+				},
+				Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+			}).Once()
+
+		// when
+		relations, total, hasMore, err := fx.ListRelations(ctx, mockedSpaceId, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, relations, 1)
+		require.Equal(t, mockedRelationId, relations[0].Id)
+		require.Equal(t, mockedRelationKey, relations[0].Key)
+		require.Equal(t, mockedRelationName, relations[0].Name)
+		require.Equal(t, "date", relations[0].Format)
+		require.Equal(t, 1, total)
+		require.False(t, hasMore)
+	})
+}
+
+func TestRelationService_GetRelation(t *testing.T) {
+	t.Run("relation found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedRelationId,
+		}).Return(relationShowResponse(mockedRelationId, mockedRelationKey, model.RelationFormat_tag)).Once()
+
+		// when
+		relation, err := fx.GetRelation(ctx, mockedSpaceId, mockedRelationId)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, Relation{
+			Type:   "relation",
+			Id:     mockedRelationId,
+			Key:    mockedRelationKey,
+			Name:   mockedRelationName,
+			Format: "tag",
+		}, relation)
+	})
+
+	t.Run("object is not a relation", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(objectShowResponse()).Once()
+
+		// when
+		relation, err := fx.GetRelation(ctx, mockedSpaceId, mockedObjectId)
+
+		// then
+		require.ErrorIs(t, err, ErrRelationNotFound)
+		require.Empty(t, relation)
+	})
+}
+
+func TestRelationService_CreateRelation(t *testing.T) {
+	t.Run("successful relation creation", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectCreateRelation", mock.Anything, &pb.RpcObjectCreateRelationRequest{
+			SpaceId: mockedSpaceId,
+			Details: &types.Struct{
+				Fields: map[string]*types.Value{
+					bundle.RelationKeyName.String():           pbtypes.String(mockedRelationName),
+					bundle.RelationKeyRelationFormat.String(): pbtypes.Int64(int64(model.RelationFormat_status)),
+				},
+			},
+		}).Return(&pb.RpcObjectCreateRelationResponse{
+			ObjectId: mockedNewRelationId,
+			Key:      mockedRelationKey,
+			Error:    &pb.RpcObjectCreateRelationResponseError{Code: pb.RpcObjectCreateRelationResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedNewRelationId,
+		}).Return(relationShowResponse(mockedNewRelationId, mockedRelationKey, model.RelationFormat_status)).Once()
+
+		// when
+		relation, err := fx.CreateRelation(ctx, mockedSpaceId, CreateRelationRequest{Name: mockedRelationName, Format: "status"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedNewRelationId, relation.Id)
+		require.Equal(t, "status", relation.Format)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		relation, err := fx.CreateRelation(ctx, mockedSpaceId, CreateRelationRequest{Name: mockedRelationName, Format: "unknown"})
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidRelationFormat)
+		require.Empty(t, relation)
+	})
+}
+
+func TestRelationService_ListOptions(t *testing.T) {
+	t.Run("options found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).
+			Return(relationShowResponse(mockedRelationId, mockedRelationKey, model.RelationFormat_tag)).Once()
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectSearchRequest) bool {
+			return req.Filters[1].Value.GetStringValue() == mockedRelationKey
+		})).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{
+				{
+					Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String():                  pbtypes.String(mockedOptionId),
+						bundle.RelationKeyRelationKey.String():         pbtypes.String(mockedRelationKey),
+						bundle.RelationKeyName.String():                pbtypes.String(mockedOptionName),
+						bundle.RelationKeyRelationOptionColor.String(): pbtypes.String(mockedOptionColor),
+					},
+				},
+			},
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		}).Once()
+
+		// when
+		options, total, hasMore, err := fx.ListOptions(ctx, mockedSpaceId, mockedRelationId, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, options, 1)
+		require.Equal(t, Option{
+			Type:        "option",
+			Id:          mockedOptionId,
+			RelationKey: mockedRelationKey,
+			Name:        mockedOptionName,
+			Color:       mockedOptionColor,
+		}, options[0])
+		require.Equal(t, 1, total)
+		require.False(t, hasMore)
+	})
+
+	t.Run("relation does not support options", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).
+			Return(relationShowResponse(mockedRelationId, mockedRelationKey, model.RelationFormat_date)).Once()
+
+		// when
+		options, _, _, err := fx.ListOptions(ctx, mockedSpaceId, mockedRelationId, offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrRelationHasNoOptions)
+		require.Empty(t, options)
+	})
+}
+
+func TestRelationService_CreateOption(t *testing.T) {
+	t.Run("invalid color", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).
+			Return(relationShowResponse(mockedRelationId, mockedRelationKey, model.RelationFormat_status)).Once()
+
+		// when
+		option, err := fx.CreateOption(ctx, mockedSpaceId, mockedRelationId, CreateOptionRequest{Name: mockedOptionName, Color: "magenta"})
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidOptionColor)
+		require.Empty(t, option)
+	})
+}
+
+func TestRelationService_SetRelationValue(t *testing.T) {
+	t.Run("date value is converted to a timestamp", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, &pb.RpcObjectShowRequest{
+			SpaceId:  mockedSpaceId,
+			ObjectId: mockedObjectId,
+		}).Return(objectShowResponse()).Twice()
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectSearchRequest) bool {
+			return len(req.Filters) == 2 && req.Filters[1].Value.GetStringValue() == mockedRelationKey
+		})).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{
+				{
+					Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String():             pbtypes.String(mockedRelationId),
+						bundle.RelationKeyRelationKey.String():    pbtypes.String(mockedRelationKey),
+						bundle.RelationKeyRelationFormat.String(): pbtypes.Int64(int64(model.RelationFormat_date)),
+					},
+				},
+			},
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{
+				{
+					Fields: map[string]*types.Value{
+						bundle.RelationKeyName.String(): pbtypes.String("Page"),
+					},
+				},
+			},
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		})
+
+		fx.mwMock.On("ObjectSetDetails", mock.Anything, &pb.RpcObjectSetDetailsRequest{
+			ContextId: mockedObjectId,
+			Details:   []*model.Detail{{Key: mockedRelationKey, Value: pbtypes.Float64(1735689600)}},
+		}).Return(&pb.RpcObjectSetDetailsResponse{
+			Error: &pb.RpcObjectSetDetailsResponseError{Code: pb.RpcObjectSetDetailsResponseError_NULL},
+		}).Once()
+
+		// when
+		obj, err := fx.SetRelationValue(ctx, mockedSpaceId, mockedObjectId, mockedRelationKey, SetRelationValueRequest{Value: "2025-01-01T00:00:00Z"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, mockedObjectId, obj.Id)
+	})
+
+	t.Run("value does not match format", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(objectShowResponse()).Once()
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectSearchRequest) bool {
+			return len(req.Filters) == 2 && req.Filters[1].Value.GetStringValue() == mockedRelationKey
+		})).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{
+				{
+					Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String():             pbtypes.String(mockedRelationId),
+						bundle.RelationKeyRelationKey.String():    pbtypes.String(mockedRelationKey),
+						bundle.RelationKeyRelationFormat.String(): pbtypes.Int64(int64(model.RelationFormat_checkbox)),
+					},
+				},
+			},
+			Error: &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		}).Once()
+
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{{Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Page")}}},
+			Error:   &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		})
+
+		// when
+		obj, err := fx.SetRelationValue(ctx, mockedSpaceId, mockedObjectId, mockedRelationKey, SetRelationValueRequest{Value: "yes"})
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidRelationValue)
+		require.Empty(t, obj)
+	})
+
+	t.Run("system relation is rejected", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.mwMock.On("ObjectShow", mock.Anything, mock.Anything).Return(objectShowResponse()).Once()
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.Anything).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{{Fields: map[string]*types.Value{bundle.RelationKeyName.String(): pbtypes.String("Page")}}},
+			Error:   &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		})
+
+		// when
+		obj, err := fx.SetRelationValue(ctx, mockedSpaceId, mockedObjectId, bundle.RelationKeyCreatedDate.String(), SetRelationValueRequest{Value: "2025-01-01"})
+
+		// then
+		require.ErrorIs(t, err, ErrRelationValueNotSupported)
+		require.Empty(t, obj)
+	})
+}
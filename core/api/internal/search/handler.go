@@ -1,7 +1,11 @@
 package search
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -11,6 +15,9 @@ import (
 
 // GlobalSearchHandler searches and retrieves objects across all spaces
 //
+// Besides the free-text query and types, objects can be narrowed down with a filter expression: a condition on a
+// relation, or a group of nested filters combined with "and" or "or". Several sorts can be given, applied in order.
+//
 //	@Summary	Search objects across all spaces
 //	@Tags		search
 //	@Accept		json
@@ -19,6 +26,7 @@ import (
 //	@Param		limit	query		int											false	"The number of items to return"											default(100)	maximum(1000)
 //	@Param		request	body		SearchRequest								true	"Search parameters"
 //	@Success	200		{object}	pagination.PaginatedResponse[object.Object]	"List of objects"
+//	@Failure	400		{object}	util.ValidationError						"Bad request"
 //	@Failure	401		{object}	util.UnauthorizedError						"Unauthorized"
 //	@Failure	500		{object}	util.ServerError							"Internal server error"
 //	@Router		/search [post]
@@ -36,6 +44,8 @@ func GlobalSearchHandler(s *SearchService) gin.HandlerFunc {
 
 		objects, total, hasMore, err := s.GlobalSearch(c, request, offset, limit)
 		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrInvalidFilter, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidSort, http.StatusBadRequest),
 			util.ErrToCode(ErrFailedSearchObjects, http.StatusInternalServerError),
 		)
 
@@ -60,6 +70,7 @@ func GlobalSearchHandler(s *SearchService) gin.HandlerFunc {
 //	@Param		limit		query		int											false	"The number of items to return"											default(100)	maximum(1000)
 //	@Param		request		body		SearchRequest								true	"Search parameters"
 //	@Success	200			{object}	pagination.PaginatedResponse[object.Object]	"List of objects"
+//	@Failure	400			{object}	util.ValidationError						"Bad request"
 //	@Failure	401			{object}	util.UnauthorizedError						"Unauthorized"
 //	@Failure	500			{object}	util.ServerError							"Internal server error"
 //	@Router		/spaces/{space_id}/search [post]
@@ -78,6 +89,8 @@ func SearchHandler(s *SearchService) gin.HandlerFunc {
 
 		objects, total, hasMore, err := s.Search(c, spaceID, request, offset, limit)
 		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrInvalidFilter, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidSort, http.StatusBadRequest),
 			util.ErrToCode(ErrFailedSearchObjects, http.StatusInternalServerError),
 		)
 
@@ -90,3 +103,60 @@ func SearchHandler(s *SearchService) gin.HandlerFunc {
 		pagination.RespondWithPagination(c, http.StatusOK, objects, total, offset, limit, hasMore)
 	}
 }
+
+// streamKeepaliveInterval is the time after which a comment is sent to the idle stream, so proxies don't close it
+var streamKeepaliveInterval = 15 * time.Second
+
+// StreamHandler streams changes of objects within a space that match the search parameters as server-sent events.
+// Idle streams receive a keepalive comment periodically
+//
+//	@Summary	Stream object changes within a space
+//	@Tags		search
+//	@Produce	text/event-stream
+//	@Param		space_id	path		string					true	"Space ID"
+//	@Param		query		query		string					false	"Search term matched against object name and snippet"
+//	@Param		types		query		[]string				false	"Object types to include, as unique keys or type IDs"	collectionFormat(multi)
+//	@Success	200			{object}	StreamEvent				"Stream of add, remove and change events"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/spaces/{space_id}/search/stream [get]
+func StreamHandler(s *SearchService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spaceId := c.Param("space_id")
+		request := SearchRequest{
+			Query: c.Query("query"),
+			Types: c.QueryArray("types"),
+		}
+
+		stream, err := s.Stream(c.Request.Context(), spaceId, request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrFailedStreamObjects, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+		defer stream.Close()
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+
+		c.Stream(func(w io.Writer) bool {
+			ctx, cancel := context.WithTimeout(c.Request.Context(), streamKeepaliveInterval)
+			defer cancel()
+			event, err := stream.Next(ctx)
+			if errors.Is(err, context.DeadlineExceeded) && c.Request.Context().Err() == nil {
+				_, err = io.WriteString(w, ": keepalive\n\n")
+				return err == nil
+			}
+			if err != nil {
+				return false
+			}
+			c.SSEvent(event.Type, event)
+			return true
+		})
+	}
+}
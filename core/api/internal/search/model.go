@@ -1,12 +1,36 @@
 package search
 
 type SearchRequest struct {
-	Query string      `json:"query"`
-	Types []string    `json:"types"`
-	Sort  SortOptions `json:"sort"`
+	Query  string      `json:"query"`
+	Types  []string    `json:"types"`
+	Sort   SortOptions `json:"sort"`
+	Filter *FilterItem `json:"filter"`
+	Sorts  []SortItem  `json:"sorts"`
+}
+
+// FilterItem is either a condition on a relation or a group of nested filters combined with "and" or "or"
+type FilterItem struct {
+	Operator    string       `json:"operator,omitempty" enums:"and,or" example:"and"`
+	Filters     []FilterItem `json:"filters,omitempty"`
+	RelationKey string       `json:"relation_key,omitempty" example:"dueDate"`
+	Condition   string       `json:"condition,omitempty" enums:"eq,ne,gt,gte,lt,lte,contains,not_contains,in,not_in,all_in,not_all_in,exact_in,not_exact_in,empty,not_empty,exists" example:"eq"`
+	Value       interface{}  `json:"value,omitempty"`
+	QuickOption string       `json:"quick_option,omitempty" enums:"exact_date,yesterday,today,tomorrow,last_week,current_week,next_week,last_month,current_month,next_month,number_of_days_ago,number_of_days_now" example:"current_week"`
+}
+
+type SortItem struct {
+	RelationKey string `json:"relation_key" example:"lastModifiedDate"`
+	Direction   string `json:"direction" enums:"asc,desc" default:"desc"`
 }
 
 type SortOptions struct {
 	Direction string `json:"direction" enums:"asc,desc" default:"desc"`
 	Timestamp string `json:"timestamp" enums:"created_date,last_modified_date,last_opened_date" default:"last_modified_date"`
 }
+
+type StreamEvent struct {
+	Type    string                 `json:"type" enums:"add,remove,change" example:"change"`
+	Id      string                 `json:"id" example:"bafyreie6n5l5nkbjal37su54cha4coy7qzuhrnajluzv5qd5jvtsrxkequ"`
+	AfterId string                 `json:"after_id,omitempty" example:"bafyreicypzj6uvu54664ucv3hmbsd5cmdy2dv4fwua26sciq74khzpyn4u"`
+	Details map[string]interface{} `json:"details,omitempty"`
+}
@@ -3,16 +3,23 @@ package search
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"strings"
 
+	"github.com/cheggaaa/mb/v3"
+	"github.com/gogo/protobuf/types"
+
 	"github.com/anyproto/anytype-heart/core/api/internal/object"
 	"github.com/anyproto/anytype-heart/core/api/internal/space"
 	"github.com/anyproto/anytype-heart/core/api/pagination"
 	"github.com/anyproto/anytype-heart/core/api/util"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pb/service"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
@@ -20,22 +27,117 @@ import (
 var (
 	spaceLimit             = 64
 	ErrFailedSearchObjects = errors.New("failed to retrieve objects from space")
+	ErrFailedStreamObjects = errors.New("failed to subscribe to objects in space")
+	ErrInvalidFilter       = errors.New("invalid filter")
+	ErrInvalidSort         = errors.New("invalid sort")
+
+	filterConditions = map[string]model.BlockContentDataviewFilterCondition{
+		"eq":           model.BlockContentDataviewFilter_Equal,
+		"ne":           model.BlockContentDataviewFilter_NotEqual,
+		"gt":           model.BlockContentDataviewFilter_Greater,
+		"gte":          model.BlockContentDataviewFilter_GreaterOrEqual,
+		"lt":           model.BlockContentDataviewFilter_Less,
+		"lte":          model.BlockContentDataviewFilter_LessOrEqual,
+		"contains":     model.BlockContentDataviewFilter_Like,
+		"not_contains": model.BlockContentDataviewFilter_NotLike,
+		"in":           model.BlockContentDataviewFilter_In,
+		"not_in":       model.BlockContentDataviewFilter_NotIn,
+		"all_in":       model.BlockContentDataviewFilter_AllIn,
+		"not_all_in":   model.BlockContentDataviewFilter_NotAllIn,
+		"exact_in":     model.BlockContentDataviewFilter_ExactIn,
+		"not_exact_in": model.BlockContentDataviewFilter_NotExactIn,
+		"empty":        model.BlockContentDataviewFilter_Empty,
+		"not_empty":    model.BlockContentDataviewFilter_NotEmpty,
+		"exists":       model.BlockContentDataviewFilter_Exists,
+	}
+
+	filterQuickOptions = map[string]model.BlockContentDataviewFilterQuickOption{
+		"exact_date":         model.BlockContentDataviewFilter_ExactDate,
+		"yesterday":          model.BlockContentDataviewFilter_Yesterday,
+		"today":              model.BlockContentDataviewFilter_Today,
+		"tomorrow":           model.BlockContentDataviewFilter_Tomorrow,
+		"last_week":          model.BlockContentDataviewFilter_LastWeek,
+		"current_week":       model.BlockContentDataviewFilter_CurrentWeek,
+		"next_week":          model.BlockContentDataviewFilter_NextWeek,
+		"last_month":         model.BlockContentDataviewFilter_LastMonth,
+		"current_month":      model.BlockContentDataviewFilter_CurrentMonth,
+		"next_month":         model.BlockContentDataviewFilter_NextMonth,
+		"number_of_days_ago": model.BlockContentDataviewFilter_NumberOfDaysAgo,
+		"number_of_days_now": model.BlockContentDataviewFilter_NumberOfDaysNow,
+	}
+
+	// streamKeys are the details sent along with stream events
+	streamKeys = []string{
+		bundle.RelationKeyId.String(),
+		bundle.RelationKeyName.String(),
+		bundle.RelationKeyType.String(),
+		bundle.RelationKeyLayout.String(),
+		bundle.RelationKeyIconEmoji.String(),
+		bundle.RelationKeySnippet.String(),
+		bundle.RelationKeyLastModifiedDate.String(),
+		bundle.RelationKeyCreatedDate.String(),
+		bundle.RelationKeyIsArchived.String(),
+		bundle.RelationKeyIsDeleted.String(),
+	}
 )
 
 type Service interface {
 	GlobalSearch(ctx context.Context, request SearchRequest, offset int, limit int) (objects []object.Object, total int, hasMore bool, err error)
 	Search(ctx context.Context, spaceId string, request SearchRequest, offset int, limit int) (objects []object.Object, total int, hasMore bool, err error)
+	Stream(ctx context.Context, spaceId string, request SearchRequest) (*Stream, error)
 }
 
 type SearchService struct {
-	mw            service.ClientCommandsServer
-	spaceService  *space.SpaceService
-	objectService *object.ObjectService
-	AccountInfo   *model.AccountInfo
+	mw                  service.ClientCommandsServer
+	spaceService        *space.SpaceService
+	objectService       *object.ObjectService
+	subscriptionService subscription.Service
+	AccountInfo         *model.AccountInfo
+}
+
+func NewService(mw service.ClientCommandsServer, spaceService *space.SpaceService, objectService *object.ObjectService, subscriptionService subscription.Service) *SearchService {
+	return &SearchService{mw: mw, spaceService: spaceService, objectService: objectService, subscriptionService: subscriptionService}
+}
+
+// Stream is a live search within a space. Its events are read with Next until the stream is closed.
+type Stream struct {
+	subId               string
+	subscriptionService subscription.Service
+	events              *mb.MB[*pb.EventMessage]
+	pending             []StreamEvent
+	// details of the objects in the result set and of the objects about to be added to it,
+	// as their details are sent before SubscriptionAdd, which has no details
+	details map[string]map[string]interface{}
+	members map[string]bool
 }
 
-func NewService(mw service.ClientCommandsServer, spaceService *space.SpaceService, objectService *object.ObjectService) *SearchService {
-	return &SearchService{mw: mw, spaceService: spaceService, objectService: objectService}
+// Next blocks until the next event of the stream is available or ctx is done.
+func (st *Stream) Next(ctx context.Context) (StreamEvent, error) {
+	for len(st.pending) == 0 {
+		msg, err := st.events.WaitOne(ctx)
+		if err != nil {
+			return StreamEvent{}, err
+		}
+		if event, ok := st.mapEvent(msg); ok {
+			st.pending = append(st.pending, event)
+		}
+	}
+
+	event := st.pending[0]
+	st.pending = st.pending[1:]
+	return event, nil
+}
+
+// TakePending returns and drops the events that haven't been read yet, such as the initial matches of the stream.
+func (st *Stream) TakePending() []StreamEvent {
+	pending := st.pending
+	st.pending = nil
+	return pending
+}
+
+// Close stops the underlying subscription.
+func (st *Stream) Close() error {
+	return st.subscriptionService.Unsubscribe(st.subId)
 }
 
 // GlobalSearch retrieves a paginated list of objects from all spaces that match the search parameters.
@@ -47,20 +149,28 @@ func (s *SearchService) GlobalSearch(ctx context.Context, request SearchRequest,
 
 	baseFilters := s.prepareBaseFilters()
 	queryFilters := s.prepareQueryFilter(request.Query)
-	sorts := s.prepareSorts(request.Sort)
-	dateToSortAfter := sorts.RelationKey
+	// sorts are shared by all spaces, so only bundled relations are known
+	sorts, err := s.prepareSortList("", request)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	keys := s.prepareSearchKeys(sorts)
 
-	allResponses := make([]*pb.RpcObjectSearchResponse, 0, len(spaces))
+	combinedRecords := make([]*types.Struct, 0)
 	for _, space := range spaces {
 		// Resolve object type IDs per space, as they are unique per space
 		typeFilters := s.prepareObjectTypeFilters(space.Id, request.Types)
-		filters := s.combineFilters(model.BlockContentDataviewFilter_And, baseFilters, queryFilters, typeFilters)
+		expressionFilters, err := s.prepareFilterExpression(space.Id, request.Filter)
+		if err != nil {
+			return nil, 0, false, err
+		}
+		filters := s.combineFilters(model.BlockContentDataviewFilter_And, baseFilters, queryFilters, typeFilters, expressionFilters)
 
 		objResp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
 			SpaceId: space.Id,
 			Filters: filters,
-			Sorts:   []*model.BlockContentDataviewSort{sorts},
-			Keys:    []string{bundle.RelationKeyId.String(), bundle.RelationKeySpaceId.String(), dateToSortAfter},
+			Sorts:   sorts,
+			Keys:    keys,
 			Limit:   int32(offset + limit), // nolint: gosec
 		})
 
@@ -68,31 +178,12 @@ func (s *SearchService) GlobalSearch(ctx context.Context, request SearchRequest,
 			return nil, 0, false, ErrFailedSearchObjects
 		}
 
-		allResponses = append(allResponses, objResp)
-	}
-
-	combinedRecords := make([]struct {
-		Id              string
-		SpaceId         string
-		DateToSortAfter float64
-	}, 0)
-	for _, objResp := range allResponses {
-		for _, record := range objResp.Records {
-			combinedRecords = append(combinedRecords, struct {
-				Id              string
-				SpaceId         string
-				DateToSortAfter float64
-			}{
-				Id:              record.Fields[bundle.RelationKeyId.String()].GetStringValue(),
-				SpaceId:         record.Fields[bundle.RelationKeySpaceId.String()].GetStringValue(),
-				DateToSortAfter: record.Fields[dateToSortAfter].GetNumberValue(),
-			})
-		}
+		combinedRecords = append(combinedRecords, objResp.Records...)
 	}
 
-	// sort after posix last_modified_date to achieve descending sort order across all spaces
-	sort.Slice(combinedRecords, func(i, j int) bool {
-		return combinedRecords[i].DateToSortAfter > combinedRecords[j].DateToSortAfter
+	// merge the per-space results by the requested sorts to achieve a consistent order across all spaces
+	sort.SliceStable(combinedRecords, func(i, j int) bool {
+		return compareRecords(combinedRecords[i], combinedRecords[j], sorts) < 0
 	})
 
 	total = len(combinedRecords)
@@ -100,7 +191,7 @@ func (s *SearchService) GlobalSearch(ctx context.Context, request SearchRequest,
 
 	results := make([]object.Object, 0, len(paginatedRecords))
 	for _, record := range paginatedRecords {
-		object, err := s.objectService.GetObject(ctx, record.SpaceId, record.Id)
+		object, err := s.objectService.GetObject(ctx, record.Fields[bundle.RelationKeySpaceId.String()].GetStringValue(), record.Fields[bundle.RelationKeyId.String()].GetStringValue())
 		if err != nil {
 			return nil, 0, false, err
 		}
@@ -115,16 +206,22 @@ func (s *SearchService) Search(ctx context.Context, spaceId string, request Sear
 	baseFilters := s.prepareBaseFilters()
 	queryFilters := s.prepareQueryFilter(request.Query)
 	typeFilters := s.prepareObjectTypeFilters(spaceId, request.Types)
-	filters := s.combineFilters(model.BlockContentDataviewFilter_And, baseFilters, queryFilters, typeFilters)
+	expressionFilters, err := s.prepareFilterExpression(spaceId, request.Filter)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	filters := s.combineFilters(model.BlockContentDataviewFilter_And, baseFilters, queryFilters, typeFilters, expressionFilters)
 
-	sorts := s.prepareSorts(request.Sort)
-	dateToSortAfter := sorts.RelationKey
+	sorts, err := s.prepareSortList(spaceId, request)
+	if err != nil {
+		return nil, 0, false, err
+	}
 
 	resp := s.mw.ObjectSearch(ctx, &pb.RpcObjectSearchRequest{
 		SpaceId: spaceId,
 		Filters: filters,
-		Sorts:   []*model.BlockContentDataviewSort{sorts},
-		Keys:    []string{bundle.RelationKeyId.String(), bundle.RelationKeySpaceId.String(), dateToSortAfter},
+		Sorts:   sorts,
+		Keys:    s.prepareSearchKeys(sorts),
 	})
 
 	if resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
@@ -146,6 +243,161 @@ func (s *SearchService) Search(ctx context.Context, spaceId string, request Sear
 	return results, total, hasMore, nil
 }
 
+// Stream subscribes to objects in a specific space that match the search parameters. The current matches are
+// delivered first as "add" events, followed by add, remove and change events as the result set evolves.
+func (s *SearchService) Stream(ctx context.Context, spaceId string, request SearchRequest) (*Stream, error) {
+	return s.stream(spaceId, request, nil)
+}
+
+// StreamWithArchived is like Stream, but archived and deleted objects stay in the result set,
+// so setting isArchived or isDeleted is delivered as a change event.
+func (s *SearchService) StreamWithArchived(ctx context.Context, spaceId string, request SearchRequest) (*Stream, error) {
+	// filters without a condition match every object and disable the default archived and deleted filters.
+	// They are grouped first, as only the first group is checked for the default filters
+	return s.stream(spaceId, request, []*model.BlockContentDataviewFilter{
+		{
+			Operator: model.BlockContentDataviewFilter_And,
+			NestedFilters: []*model.BlockContentDataviewFilter{
+				{
+					Operator:    model.BlockContentDataviewFilter_No,
+					RelationKey: bundle.RelationKeyIsArchived.String(),
+					Condition:   model.BlockContentDataviewFilter_None,
+				},
+				{
+					Operator:    model.BlockContentDataviewFilter_No,
+					RelationKey: bundle.RelationKeyIsDeleted.String(),
+					Condition:   model.BlockContentDataviewFilter_None,
+				},
+			},
+		},
+	})
+}
+
+func (s *SearchService) stream(spaceId string, request SearchRequest, archivedFilters []*model.BlockContentDataviewFilter) (*Stream, error) {
+	baseFilters := s.prepareBaseFilters()
+	queryFilters := s.prepareQueryFilter(request.Query)
+	typeFilters := s.prepareObjectTypeFilters(spaceId, request.Types)
+	expressionFilters, err := s.prepareFilterExpression(spaceId, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	filters := s.combineFilters(model.BlockContentDataviewFilter_And, archivedFilters, baseFilters, queryFilters, typeFilters, expressionFilters)
+	sorts, err := s.prepareSortList(spaceId, request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.subscriptionService.Search(subscription.SubscribeRequest{
+		SpaceId:           spaceId,
+		Filters:           database.FiltersFromProto(filters),
+		Sorts:             database.SortsFromProto(sorts),
+		Keys:              streamKeys,
+		NoDepSubscription: true,
+		Internal:          true,
+	})
+	if err != nil {
+		return nil, ErrFailedStreamObjects
+	}
+
+	st := &Stream{
+		subId:               resp.SubId,
+		subscriptionService: s.subscriptionService,
+		events:              resp.Output,
+		pending:             make([]StreamEvent, 0, len(resp.Records)),
+		details:             make(map[string]map[string]interface{}, len(resp.Records)),
+		members:             make(map[string]bool, len(resp.Records)),
+	}
+	var afterId string
+	for _, record := range resp.Records {
+		id := record.GetString(bundle.RelationKeyId)
+		details := structToMap(record.ToProto())
+		st.updateDetails(id, details, true)
+		st.members[id] = true
+		st.pending = append(st.pending, StreamEvent{
+			Type:    "add",
+			Id:      id,
+			AfterId: afterId,
+			Details: details,
+		})
+		afterId = id
+	}
+
+	return st, nil
+}
+
+// mapEvent converts a subscription event into a stream event. Events that aren't relevant to clients are skipped.
+// Added objects come with their details, changes of the objects which are not in the result set yet are only cached
+func (st *Stream) mapEvent(msg *pb.EventMessage) (StreamEvent, bool) {
+	switch v := msg.Value.(type) {
+	case *pb.EventMessageValueOfSubscriptionAdd:
+		id := v.SubscriptionAdd.Id
+		st.members[id] = true
+		var details map[string]interface{}
+		if cached, ok := st.details[id]; ok {
+			details = make(map[string]interface{}, len(cached))
+			for key, value := range cached {
+				details[key] = value
+			}
+		}
+		return StreamEvent{Type: "add", Id: id, AfterId: v.SubscriptionAdd.AfterId, Details: details}, true
+	case *pb.EventMessageValueOfSubscriptionRemove:
+		delete(st.members, v.SubscriptionRemove.Id)
+		delete(st.details, v.SubscriptionRemove.Id)
+		return StreamEvent{Type: "remove", Id: v.SubscriptionRemove.Id}, true
+	case *pb.EventMessageValueOfObjectDetailsSet:
+		details := structToMap(v.ObjectDetailsSet.Details)
+		st.updateDetails(v.ObjectDetailsSet.Id, details, true)
+		return st.change(v.ObjectDetailsSet.Id, details)
+	case *pb.EventMessageValueOfObjectDetailsAmend:
+		details := make(map[string]interface{}, len(v.ObjectDetailsAmend.Details))
+		for _, detail := range v.ObjectDetailsAmend.Details {
+			details[detail.Key] = pbtypes.ValueToInterface(detail.Value)
+		}
+		st.updateDetails(v.ObjectDetailsAmend.Id, details, false)
+		return st.change(v.ObjectDetailsAmend.Id, details)
+	case *pb.EventMessageValueOfObjectDetailsUnset:
+		details := make(map[string]interface{}, len(v.ObjectDetailsUnset.Keys))
+		for _, key := range v.ObjectDetailsUnset.Keys {
+			details[key] = nil
+		}
+		st.updateDetails(v.ObjectDetailsUnset.Id, details, false)
+		return st.change(v.ObjectDetailsUnset.Id, details)
+	}
+	return StreamEvent{}, false
+}
+
+// updateDetails applies the details to the cached ones, nil values are removed
+func (st *Stream) updateDetails(id string, details map[string]interface{}, replace bool) {
+	cached, ok := st.details[id]
+	if !ok || replace {
+		cached = make(map[string]interface{}, len(details))
+		st.details[id] = cached
+	}
+	for key, value := range details {
+		if value == nil {
+			delete(cached, key)
+		} else {
+			cached[key] = value
+		}
+	}
+}
+
+func (st *Stream) change(id string, details map[string]interface{}) (StreamEvent, bool) {
+	if !st.members[id] {
+		return StreamEvent{}, false
+	}
+	return StreamEvent{Type: "change", Id: id, Details: details}, true
+}
+
+// structToMap converts protobuf details into a plain map for JSON encoding.
+func structToMap(details *types.Struct) map[string]interface{} {
+	result := make(map[string]interface{}, len(details.GetFields()))
+	for key, value := range details.GetFields() {
+		result[key] = pbtypes.ValueToInterface(value)
+	}
+	return result
+}
+
 // makeAndCondition combines multiple filter groups with the given operator.
 func (s *SearchService) combineFilters(operator model.BlockContentDataviewFilterOperator, filterGroups ...[]*model.BlockContentDataviewFilter) []*model.BlockContentDataviewFilter {
 	nestedFilters := make([]*model.BlockContentDataviewFilter, 0)
@@ -261,6 +513,192 @@ func (s *SearchService) prepareObjectTypeFilters(spaceId string, objectTypes []s
 	}
 }
 
+// prepareFilterExpression converts the filter expression of a search request into a dataview filter.
+func (s *SearchService) prepareFilterExpression(spaceId string, filter *FilterItem) ([]*model.BlockContentDataviewFilter, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	f, err := s.mapFilterItem(spaceId, *filter)
+	if err != nil {
+		return nil, err
+	}
+	return []*model.BlockContentDataviewFilter{f}, nil
+}
+
+// mapFilterItem converts a single condition, or a group of nested filters, into a dataview filter.
+func (s *SearchService) mapFilterItem(spaceId string, item FilterItem) (*model.BlockContentDataviewFilter, error) {
+	if item.Operator != "" || len(item.Filters) > 0 {
+		if item.RelationKey != "" || item.Condition != "" {
+			return nil, fmt.Errorf("%w: a filter group can't have a condition", ErrInvalidFilter)
+		}
+
+		var operator model.BlockContentDataviewFilterOperator
+		switch item.Operator {
+		case "and", "":
+			operator = model.BlockContentDataviewFilter_And
+		case "or":
+			operator = model.BlockContentDataviewFilter_Or
+		default:
+			return nil, fmt.Errorf("%w: unknown operator %q", ErrInvalidFilter, item.Operator)
+		}
+
+		nestedFilters := make([]*model.BlockContentDataviewFilter, 0, len(item.Filters))
+		for _, nested := range item.Filters {
+			f, err := s.mapFilterItem(spaceId, nested)
+			if err != nil {
+				return nil, err
+			}
+			nestedFilters = append(nestedFilters, f)
+		}
+
+		return &model.BlockContentDataviewFilter{
+			Operator:      operator,
+			NestedFilters: nestedFilters,
+		}, nil
+	}
+
+	if item.RelationKey == "" {
+		return nil, fmt.Errorf("%w: missing relation key", ErrInvalidFilter)
+	}
+
+	condition, ok := filterConditions[item.Condition]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown condition %q", ErrInvalidFilter, item.Condition)
+	}
+
+	f := &model.BlockContentDataviewFilter{
+		Operator:    model.BlockContentDataviewFilter_No,
+		RelationKey: item.RelationKey,
+		Condition:   condition,
+	}
+
+	if item.QuickOption != "" {
+		quickOption, ok := filterQuickOptions[item.QuickOption]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown quick option %q", ErrInvalidFilter, item.QuickOption)
+		}
+		f.QuickOption = quickOption
+		f.Format = model.RelationFormat_date
+	} else if format, ok := s.relationFormat(spaceId, item.RelationKey); ok && format == model.RelationFormat_date {
+		f.Format = model.RelationFormat_date
+	}
+
+	switch condition {
+	case model.BlockContentDataviewFilter_Empty, model.BlockContentDataviewFilter_NotEmpty, model.BlockContentDataviewFilter_Exists:
+		return f, nil
+	}
+
+	value := item.Value
+	if f.Format == model.RelationFormat_date {
+		if date, ok := value.(string); ok {
+			t, err := util.ParseDate(date)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid date %q", ErrInvalidFilter, date)
+			}
+			value = t.Unix()
+		}
+	}
+
+	// Object types can be referenced by their unique keys, which resolve to different IDs per space
+	if item.RelationKey == bundle.RelationKeyType.String() {
+		value = s.resolveTypeKeys(spaceId, value)
+	}
+
+	if value != nil {
+		f.Value = pbtypes.InterfaceToValue(value)
+	}
+	return f, nil
+}
+
+// resolveTypeKeys replaces the object type unique keys within value by the type IDs of the space.
+func (s *SearchService) resolveTypeKeys(spaceId string, value interface{}) interface{} {
+	resolve := func(v interface{}) interface{} {
+		if key, ok := v.(string); ok && strings.HasPrefix(key, "ot-") {
+			if typeId, err := util.ResolveUniqueKeyToTypeId(s.mw, spaceId, key); err == nil {
+				return typeId
+			}
+		}
+		return v
+	}
+
+	if values, ok := value.([]interface{}); ok {
+		resolved := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			resolved = append(resolved, resolve(v))
+		}
+		return resolved
+	}
+	return resolve(value)
+}
+
+// relationFormat returns the format of the relation of the space, bundled relations are used if it can't be found.
+func (s *SearchService) relationFormat(spaceId string, relationKey string) (model.RelationFormat, bool) {
+	if spaceId != "" {
+		if format, err := util.ResolveRelationKeyToFormat(s.mw, spaceId, relationKey); err == nil {
+			return format, true
+		}
+	}
+	if rel, err := bundle.GetRelation(domain.RelationKey(relationKey)); err == nil {
+		return rel.Format, true
+	}
+	return 0, false
+}
+
+// prepareSortList returns the sorts of a search request. The single sort option is used if no sorts are given.
+func (s *SearchService) prepareSortList(spaceId string, request SearchRequest) ([]*model.BlockContentDataviewSort, error) {
+	if len(request.Sorts) == 0 {
+		return []*model.BlockContentDataviewSort{s.prepareSorts(request.Sort)}, nil
+	}
+
+	sorts := make([]*model.BlockContentDataviewSort, 0, len(request.Sorts))
+	for _, item := range request.Sorts {
+		if item.RelationKey == "" {
+			return nil, fmt.Errorf("%w: missing relation key", ErrInvalidSort)
+		}
+		if item.Direction != "" && item.Direction != "asc" && item.Direction != "desc" {
+			return nil, fmt.Errorf("%w: unknown direction %q", ErrInvalidSort, item.Direction)
+		}
+
+		sort := &model.BlockContentDataviewSort{
+			RelationKey:    item.RelationKey,
+			Type:           s.getSortDirection(item.Direction),
+			EmptyPlacement: model.BlockContentDataviewSort_NotSpecified,
+		}
+		if format, ok := s.relationFormat(spaceId, item.RelationKey); ok {
+			sort.Format = format
+			sort.IncludeTime = format == model.RelationFormat_date
+		}
+		sorts = append(sorts, sort)
+	}
+	return sorts, nil
+}
+
+// prepareSearchKeys returns the detail keys needed to identify the found objects and to order them.
+func (s *SearchService) prepareSearchKeys(sorts []*model.BlockContentDataviewSort) []string {
+	keys := []string{bundle.RelationKeyId.String(), bundle.RelationKeySpaceId.String()}
+	for _, sort := range sorts {
+		keys = append(keys, sort.RelationKey)
+	}
+	return keys
+}
+
+// compareRecords compares two search records by the given sorts.
+func compareRecords(a, b *types.Struct, sorts []*model.BlockContentDataviewSort) int {
+	for _, sort := range sorts {
+		left := domain.ValueFromProto(a.Fields[sort.RelationKey])
+		right := domain.ValueFromProto(b.Fields[sort.RelationKey])
+		result := left.Compare(right)
+		if sort.Type == model.BlockContentDataviewSort_Desc {
+			result = -result
+		}
+		if result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
 // prepareSorts returns a sort filter based on the given sort parameters
 func (s *SearchService) prepareSorts(sort SortOptions) *model.BlockContentDataviewSort {
 	return &model.BlockContentDataviewSort{
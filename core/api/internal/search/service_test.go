@@ -4,12 +4,16 @@ import (
 	"context"
 	"testing"
 
+	"github.com/cheggaaa/mb/v3"
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/core/api/internal/object"
 	"github.com/anyproto/anytype-heart/core/api/internal/space"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/core/subscription/mock_subscription"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pb/service/mock_service"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -45,7 +49,8 @@ const (
 
 type fixture struct {
 	*SearchService
-	mwMock *mock_service.MockClientCommandsServer
+	mwMock           *mock_service.MockClientCommandsServer
+	subscriptionMock *mock_subscription.MockService
 }
 
 func newFixture(t *testing.T) *fixture {
@@ -55,18 +60,40 @@ func newFixture(t *testing.T) *fixture {
 	spaceService.AccountInfo = &model.AccountInfo{TechSpaceId: techSpaceId, GatewayUrl: gatewayUrl}
 	objectService := object.NewService(mw, spaceService)
 	objectService.AccountInfo = &model.AccountInfo{TechSpaceId: techSpaceId}
-	searchService := NewService(mw, spaceService, objectService)
+	subscriptionService := mock_subscription.NewMockService(t)
+	searchService := NewService(mw, spaceService, objectService, subscriptionService)
 	searchService.AccountInfo = &model.AccountInfo{
 		TechSpaceId: techSpaceId,
 		GatewayUrl:  gatewayUrl,
 	}
 
 	return &fixture{
-		SearchService: searchService,
-		mwMock:        mw,
+		SearchService:    searchService,
+		mwMock:           mw,
+		subscriptionMock: subscriptionService,
 	}
 }
 
+// expectRelationFormats answers the relation lookups of the space, other relations are not found
+func (fx *fixture) expectRelationFormats(formats map[string]model.RelationFormat) {
+	fx.mwMock.On("ObjectSearch", mock.Anything, mock.MatchedBy(isRelationLookup)).Return(func(_ context.Context, req *pb.RpcObjectSearchRequest) *pb.RpcObjectSearchResponse {
+		records := []*types.Struct{}
+		if format, ok := formats[req.Filters[1].Value.GetStringValue()]; ok {
+			records = append(records, &types.Struct{Fields: map[string]*types.Value{
+				bundle.RelationKeyRelationFormat.String(): pbtypes.Int64(int64(format)),
+			}})
+		}
+		return &pb.RpcObjectSearchResponse{
+			Records: records,
+			Error:   &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		}
+	}).Maybe()
+}
+
+func isRelationLookup(req *pb.RpcObjectSearchRequest) bool {
+	return len(req.Keys) == 1 && req.Keys[0] == bundle.RelationKeyRelationFormat.String()
+}
+
 func TestSearchService_GlobalSearch(t *testing.T) {
 	t.Run("objects found globally", func(t *testing.T) {
 		// given
@@ -594,3 +621,321 @@ func TestSearchService_Search(t *testing.T) {
 		require.False(t, hasMore)
 	})
 }
+
+func TestSearchService_SearchWithFilterExpression(t *testing.T) {
+	t.Run("filter expression and sorts are passed to the search", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		fx.expectRelationFormats(nil)
+		var got *pb.RpcObjectSearchRequest
+		fx.mwMock.On("ObjectSearch", mock.Anything, mock.MatchedBy(func(req *pb.RpcObjectSearchRequest) bool {
+			if isRelationLookup(req) {
+				return false
+			}
+			got = req
+			return true
+		})).Return(&pb.RpcObjectSearchResponse{
+			Records: []*types.Struct{},
+			Error:   &pb.RpcObjectSearchResponseError{Code: pb.RpcObjectSearchResponseError_NULL},
+		}).Once()
+
+		request := SearchRequest{
+			Filter: &FilterItem{
+				Operator: "or",
+				Filters: []FilterItem{
+					{RelationKey: bundle.RelationKeyDone.String(), Condition: "eq", Value: true},
+					{RelationKey: bundle.RelationKeyTag.String(), Condition: "all_in", Value: []interface{}{mockedTagId1, mockedTagId2}},
+					{
+						Filters: []FilterItem{
+							{RelationKey: bundle.RelationKeyDueDate.String(), Condition: "eq", QuickOption: "current_week"},
+							{RelationKey: bundle.RelationKeyDescription.String(), Condition: "empty"},
+						},
+					},
+				},
+			},
+			Sorts: []SortItem{
+				{RelationKey: bundle.RelationKeyDueDate.String(), Direction: "asc"},
+				{RelationKey: bundle.RelationKeyName.String()},
+			},
+		}
+
+		// when
+		_, _, _, err := fx.Search(ctx, mockedSpaceId, request, offset, limit)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, got.Filters, 1)
+		nested := got.Filters[0].NestedFilters
+		require.Equal(t, &model.BlockContentDataviewFilter{
+			Operator: model.BlockContentDataviewFilter_Or,
+			NestedFilters: []*model.BlockContentDataviewFilter{
+				{
+					Operator:    model.BlockContentDataviewFilter_No,
+					RelationKey: bundle.RelationKeyDone.String(),
+					Condition:   model.BlockContentDataviewFilter_Equal,
+					Value:       pbtypes.Bool(true),
+				},
+				{
+					Operator:    model.BlockContentDataviewFilter_No,
+					RelationKey: bundle.RelationKeyTag.String(),
+					Condition:   model.BlockContentDataviewFilter_AllIn,
+					Value:       pbtypes.StringList([]string{mockedTagId1, mockedTagId2}),
+				},
+				{
+					Operator: model.BlockContentDataviewFilter_And,
+					NestedFilters: []*model.BlockContentDataviewFilter{
+						{
+							Operator:    model.BlockContentDataviewFilter_No,
+							RelationKey: bundle.RelationKeyDueDate.String(),
+							Condition:   model.BlockContentDataviewFilter_Equal,
+							QuickOption: model.BlockContentDataviewFilter_CurrentWeek,
+							Format:      model.RelationFormat_date,
+						},
+						{
+							Operator:    model.BlockContentDataviewFilter_No,
+							RelationKey: bundle.RelationKeyDescription.String(),
+							Condition:   model.BlockContentDataviewFilter_Empty,
+						},
+					},
+				},
+			},
+		}, nested[len(nested)-1])
+		require.Equal(t, []*model.BlockContentDataviewSort{
+			{
+				RelationKey:    bundle.RelationKeyDueDate.String(),
+				Type:           model.BlockContentDataviewSort_Asc,
+				Format:         model.RelationFormat_date,
+				IncludeTime:    true,
+				EmptyPlacement: model.BlockContentDataviewSort_NotSpecified,
+			},
+			{
+				RelationKey:    bundle.RelationKeyName.String(),
+				Type:           model.BlockContentDataviewSort_Desc,
+				Format:         model.RelationFormat_shorttext,
+				EmptyPlacement: model.BlockContentDataviewSort_NotSpecified,
+			},
+		}, got.Sorts)
+		require.Equal(t, []string{
+			bundle.RelationKeyId.String(),
+			bundle.RelationKeySpaceId.String(),
+			bundle.RelationKeyDueDate.String(),
+			bundle.RelationKeyName.String(),
+		}, got.Keys)
+	})
+
+	t.Run("dates can be given as strings", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.expectRelationFormats(nil)
+
+		// when
+		filters, err := fx.prepareFilterExpression(mockedSpaceId, &FilterItem{
+			RelationKey: bundle.RelationKeyCreatedDate.String(),
+			Condition:   "gt",
+			Value:       "2024-11-13T15:39:34Z",
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, model.RelationFormat_date, filters[0].Format)
+		require.Equal(t, float64(1731512374), filters[0].Value.GetNumberValue())
+	})
+
+	t.Run("dates of space relations are converted in UTC", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.expectRelationFormats(map[string]model.RelationFormat{"deadline": model.RelationFormat_date})
+
+		// when
+		filters, err := fx.prepareFilterExpression(mockedSpaceId, &FilterItem{
+			RelationKey: "deadline",
+			Condition:   "lt",
+			Value:       "2024-11-13",
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, model.RelationFormat_date, filters[0].Format)
+		require.Equal(t, float64(1731456000), filters[0].Value.GetNumberValue())
+	})
+
+	t.Run("unknown condition", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, _, err := fx.Search(ctx, mockedSpaceId, SearchRequest{
+			Filter: &FilterItem{RelationKey: bundle.RelationKeyName.String(), Condition: "matches"},
+		}, offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidFilter)
+	})
+
+	t.Run("group with a condition", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, _, err := fx.Search(ctx, mockedSpaceId, SearchRequest{
+			Filter: &FilterItem{Operator: "and", RelationKey: bundle.RelationKeyName.String(), Condition: "eq"},
+		}, offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidFilter)
+	})
+
+	t.Run("sort without relation key", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, _, err := fx.Search(ctx, mockedSpaceId, SearchRequest{Sorts: []SortItem{{Direction: "asc"}}}, offset, limit)
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidSort)
+	})
+}
+
+func TestCompareRecords(t *testing.T) {
+	// given
+	sorts := []*model.BlockContentDataviewSort{
+		{RelationKey: bundle.RelationKeyPriority.String(), Type: model.BlockContentDataviewSort_Desc},
+		{RelationKey: bundle.RelationKeyName.String(), Type: model.BlockContentDataviewSort_Asc},
+	}
+	record := func(priority int64, name string) *types.Struct {
+		return &types.Struct{Fields: map[string]*types.Value{
+			bundle.RelationKeyPriority.String(): pbtypes.Int64(priority),
+			bundle.RelationKeyName.String():     pbtypes.String(name),
+		}}
+	}
+
+	// when
+	higherPriority := compareRecords(record(2, "b"), record(1, "a"), sorts)
+	sameName := compareRecords(record(1, "a"), record(1, "a"), sorts)
+	lowerName := compareRecords(record(1, "a"), record(1, "b"), sorts)
+
+	// then
+	require.Negative(t, higherPriority)
+	require.Zero(t, sameName)
+	require.Negative(t, lowerName)
+}
+
+func TestSearchService_Stream(t *testing.T) {
+	t.Run("initial objects are followed by live changes", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		queue := mb.New[*pb.EventMessage](0)
+
+		fx.subscriptionMock.EXPECT().Search(mock.MatchedBy(func(req subscription.SubscribeRequest) bool {
+			return req.SpaceId == mockedSpaceId && req.Internal && req.NoDepSubscription
+		})).Return(&subscription.SubscribeResponse{
+			SubId: "mocked-sub-id",
+			Records: []*domain.Details{
+				domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+					bundle.RelationKeyId:   domain.String(mockedObjectId),
+					bundle.RelationKeyName: domain.String(mockedObjectName),
+				}),
+			},
+			Output: queue,
+		}, nil).Once()
+		fx.subscriptionMock.EXPECT().Unsubscribe("mocked-sub-id").Return(nil).Once()
+
+		require.NoError(t, queue.Add(ctx,
+			&pb.EventMessage{Value: &pb.EventMessageValueOfSubscriptionCounters{
+				SubscriptionCounters: &pb.EventObjectSubscriptionCounters{Total: 1},
+			}},
+			&pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsAmend{
+				ObjectDetailsAmend: &pb.EventObjectDetailsAmend{
+					Id:      mockedObjectId,
+					Details: []*pb.EventObjectDetailsAmendKeyValue{{Key: bundle.RelationKeyName.String(), Value: pbtypes.String("renamed")}},
+				},
+			}},
+			&pb.EventMessage{Value: &pb.EventMessageValueOfSubscriptionRemove{
+				SubscriptionRemove: &pb.EventObjectSubscriptionRemove{Id: mockedObjectId},
+			}},
+		))
+
+		// when
+		stream, err := fx.Stream(ctx, mockedSpaceId, SearchRequest{})
+		require.NoError(t, err)
+		first, err := stream.Next(ctx)
+		require.NoError(t, err)
+		second, err := stream.Next(ctx)
+		require.NoError(t, err)
+		third, err := stream.Next(ctx)
+		require.NoError(t, err)
+
+		// then
+		require.Equal(t, StreamEvent{Type: "add", Id: mockedObjectId, Details: map[string]interface{}{
+			bundle.RelationKeyId.String():   mockedObjectId,
+			bundle.RelationKeyName.String(): mockedObjectName,
+		}}, first)
+		require.Equal(t, StreamEvent{Type: "change", Id: mockedObjectId, Details: map[string]interface{}{
+			bundle.RelationKeyName.String(): "renamed",
+		}}, second)
+		require.Equal(t, StreamEvent{Type: "remove", Id: mockedObjectId}, third)
+		require.NoError(t, stream.Close())
+	})
+
+	t.Run("added objects come with their details", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		queue := mb.New[*pb.EventMessage](0)
+
+		fx.subscriptionMock.EXPECT().Search(mock.Anything).Return(&subscription.SubscribeResponse{
+			SubId:  "mocked-sub-id",
+			Output: queue,
+		}, nil).Once()
+		fx.subscriptionMock.EXPECT().Unsubscribe("mocked-sub-id").Return(nil).Once()
+
+		require.NoError(t, queue.Add(ctx,
+			&pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsSet{
+				ObjectDetailsSet: &pb.EventObjectDetailsSet{
+					Id: mockedObjectId,
+					Details: &types.Struct{Fields: map[string]*types.Value{
+						bundle.RelationKeyId.String():   pbtypes.String(mockedObjectId),
+						bundle.RelationKeyName.String(): pbtypes.String(mockedObjectName),
+					}},
+				},
+			}},
+			&pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsAmend{
+				ObjectDetailsAmend: &pb.EventObjectDetailsAmend{
+					Id:      mockedObjectId,
+					Details: []*pb.EventObjectDetailsAmendKeyValue{{Key: bundle.RelationKeyName.String(), Value: pbtypes.String("renamed")}},
+				},
+			}},
+			&pb.EventMessage{Value: &pb.EventMessageValueOfSubscriptionAdd{
+				SubscriptionAdd: &pb.EventObjectSubscriptionAdd{Id: mockedObjectId, AfterId: "previous-id"},
+			}},
+			&pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsUnset{
+				ObjectDetailsUnset: &pb.EventObjectDetailsUnset{Id: mockedObjectId, Keys: []string{bundle.RelationKeyName.String()}},
+			}},
+		))
+
+		// when
+		stream, err := fx.Stream(ctx, mockedSpaceId, SearchRequest{})
+		require.NoError(t, err)
+		first, err := stream.Next(ctx)
+		require.NoError(t, err)
+		second, err := stream.Next(ctx)
+		require.NoError(t, err)
+
+		// then
+		require.Equal(t, StreamEvent{Type: "add", Id: mockedObjectId, AfterId: "previous-id", Details: map[string]interface{}{
+			bundle.RelationKeyId.String():   mockedObjectId,
+			bundle.RelationKeyName.String(): "renamed",
+		}}, first)
+		require.Equal(t, StreamEvent{Type: "change", Id: mockedObjectId, Details: map[string]interface{}{
+			bundle.RelationKeyName.String(): nil,
+		}}, second)
+		require.NoError(t, stream.Close())
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"errors"
 	"math/big"
+	"slices"
 
 	"github.com/gogo/protobuf/types"
 
@@ -73,8 +74,13 @@ func (s *SpaceService) ListSpaces(ctx context.Context, offset int, limit int) (s
 		return nil, 0, false, ErrFailedListSpaces
 	}
 
-	total = len(resp.Records)
-	paginatedRecords, hasMore := pagination.Paginate(resp.Records, offset, limit)
+	// Only spaces the app key is allowed to access are listed
+	records := slices.DeleteFunc(resp.Records, func(record *types.Struct) bool {
+		return !util.IsSpaceAllowed(ctx, record.Fields[bundle.RelationKeyTargetSpaceId.String()].GetStringValue())
+	})
+
+	total = len(records)
+	paginatedRecords, hasMore := pagination.Paginate(records, offset, limit)
 	spaces = make([]Space, 0, len(paginatedRecords))
 
 	for _, record := range paginatedRecords {
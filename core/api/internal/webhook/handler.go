@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/api/util"
+)
+
+// CreateWebhookHandler registers a webhook
+//
+// Events are posted as JSON to the webhook URL. Each delivery carries the event type in the X-Anytype-Event header
+// and the hex encoded HMAC-SHA256 of the body, keyed with the returned secret, in the X-Anytype-Signature header as
+// "sha256=<signature>". Failed deliveries are retried.
+//
+//	@Summary	Create webhook
+//	@Tags		webhooks
+//	@Accept		json
+//	@Produce	json
+//	@Param		webhook	body		CreateWebhookRequest	true	"Webhook to create"
+//	@Success	200		{object}	CreateWebhookResponse	"The created webhook and its signing secret"
+//	@Failure	400		{object}	util.ValidationError	"Bad request"
+//	@Failure	401		{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403		{object}	util.ForbiddenError		"Forbidden"
+//	@Failure	500		{object}	util.ServerError		"Internal server error"
+//	@Router		/webhooks [post]
+func CreateWebhookHandler(s *WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request := CreateWebhookRequest{}
+		if err := c.ShouldBindJSON(&request); err != nil {
+			apiErr := util.CodeToAPIError(http.StatusBadRequest, err.Error())
+			c.JSON(http.StatusBadRequest, apiErr)
+			return
+		}
+
+		webhook, secret, err := s.CreateWebhook(c.Request.Context(), request)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrInvalidUrl, http.StatusBadRequest),
+			util.ErrToCode(ErrMissingSpaceId, http.StatusBadRequest),
+			util.ErrToCode(ErrInvalidEvents, http.StatusBadRequest),
+			util.ErrToCode(ErrFailedCreateWebhook, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, CreateWebhookResponse{Webhook: webhook, Secret: secret})
+	}
+}
+
+// GetWebhooksHandler retrieves the registered webhooks
+//
+//	@Summary	List webhooks
+//	@Tags		webhooks
+//	@Produce	json
+//	@Param		offset	query		int										false	"The number of items to skip before starting to collect the result set"	default(0)
+//	@Param		limit	query		int										false	"The number of items to return"											default(100)	maximum(1000)
+//	@Success	200		{object}	pagination.PaginatedResponse[Webhook]	"List of webhooks"
+//	@Failure	401		{object}	util.UnauthorizedError					"Unauthorized"
+//	@Failure	403		{object}	util.ForbiddenError						"Forbidden"
+//	@Failure	500		{object}	util.ServerError						"Internal server error"
+//	@Router		/webhooks [get]
+func GetWebhooksHandler(s *WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		offset := c.GetInt("offset")
+		limit := c.GetInt("limit")
+
+		webhooks, total, hasMore, err := s.ListWebhooks(c.Request.Context(), offset, limit)
+		if err != nil {
+			apiErr := util.CodeToAPIError(http.StatusInternalServerError, err.Error())
+			c.JSON(http.StatusInternalServerError, apiErr)
+			return
+		}
+
+		pagination.RespondWithPagination(c, http.StatusOK, webhooks, total, offset, limit, hasMore)
+	}
+}
+
+// DeleteWebhookHandler deletes a webhook
+//
+//	@Summary	Delete webhook
+//	@Tags		webhooks
+//	@Produce	json
+//	@Param		webhook_id	path		string					true	"Webhook ID"
+//	@Success	200			{object}	WebhookResponse			"The deleted webhook"
+//	@Failure	401			{object}	util.UnauthorizedError	"Unauthorized"
+//	@Failure	403			{object}	util.ForbiddenError		"Forbidden"
+//	@Failure	404			{object}	util.NotFoundError		"Resource not found"
+//	@Failure	500			{object}	util.ServerError		"Internal server error"
+//	@Router		/webhooks/{webhook_id} [delete]
+func DeleteWebhookHandler(s *WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		webhookId := c.Param("webhook_id")
+
+		webhook, err := s.DeleteWebhook(c.Request.Context(), webhookId)
+		code := util.MapErrorCode(err,
+			util.ErrToCode(ErrWebhookNotFound, http.StatusNotFound),
+			util.ErrToCode(ErrFailedDeleteWebhook, http.StatusInternalServerError),
+		)
+
+		if code != http.StatusOK {
+			apiErr := util.CodeToAPIError(code, err.Error())
+			c.JSON(code, apiErr)
+			return
+		}
+
+		c.JSON(http.StatusOK, WebhookResponse{Webhook: webhook})
+	}
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"github.com/anyproto/anytype-heart/core/api/internal/chat"
+	"github.com/anyproto/anytype-heart/core/api/internal/search"
+)
+
+const (
+	EventObjectCreated = "object.created"
+	EventObjectUpdated = "object.updated"
+	EventObjectDeleted = "object.deleted"
+	EventChatMessage   = "chat.message"
+)
+
+type Webhook struct {
+	Type      string   `json:"type" example:"webhook"`
+	Id        string   `json:"id" example:"67b5d3e0ef3a8c6a1d2f9e41"`
+	Url       string   `json:"url" example:"https://example.com/anytype-hook"`
+	SpaceId   string   `json:"space_id" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"`
+	Events    []string `json:"events" enums:"object.created,object.updated,object.deleted,chat.message" example:"object.created"`
+	Filter    Filter   `json:"filter"`
+	ChatId    string   `json:"chat_id,omitempty" example:"bafyreihsorr4uoxd2rnofkn2jhqkylxq3uj3oqrahayzyyxqfikyk4nofy"`
+	CreatedAt string   `json:"created_at" example:"2024-11-13T15:39:34Z"`
+}
+
+type Filter struct {
+	Query string   `json:"query" example:"bug"`
+	Types []string `json:"types" example:"ot-page"`
+}
+
+type CreateWebhookRequest struct {
+	Url     string   `json:"url" example:"https://example.com/anytype-hook"`
+	SpaceId string   `json:"space_id" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"`
+	Events  []string `json:"events" enums:"object.created,object.updated,object.deleted,chat.message" example:"object.created"`
+	Filter  Filter   `json:"filter"`
+	ChatId  string   `json:"chat_id" example:"bafyreihsorr4uoxd2rnofkn2jhqkylxq3uj3oqrahayzyyxqfikyk4nofy"`
+}
+
+type CreateWebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+	Secret  string  `json:"secret" example:"4f1c6d0bd4a2b9e1c8f7a3e5d6b2c1a09f8e7d6c5b4a3f2e1d0c9b8a7f6e5d4c"`
+}
+
+type WebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// Event is the JSON body posted to a webhook URL
+type Event struct {
+	Id        string              `json:"id" example:"67b5d3e0ef3a8c6a1d2f9e42"`
+	Type      string              `json:"type" enums:"object.created,object.updated,object.deleted,chat.message" example:"object.updated"`
+	WebhookId string              `json:"webhook_id" example:"67b5d3e0ef3a8c6a1d2f9e41"`
+	SpaceId   string              `json:"space_id" example:"bafyreigyfkt6rbv24sbv5aq2hko3bhmv5xxlf22b4bypdu6j7hnphm3psq.23me69r569oi1"`
+	CreatedAt string              `json:"created_at" example:"2024-11-13T15:39:34Z"`
+	Object    *search.StreamEvent `json:"object,omitempty"`
+	ChatId    string              `json:"chat_id,omitempty" example:"bafyreihsorr4uoxd2rnofkn2jhqkylxq3uj3oqrahayzyyxqfikyk4nofy"`
+	Message   *chat.Message       `json:"message,omitempty"`
+}
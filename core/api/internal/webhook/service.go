@@ -0,0 +1,590 @@
+package webhook
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/globalsign/mgo/bson"
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/chat"
+	"github.com/anyproto/anytype-heart/core/api/internal/search"
+	"github.com/anyproto/anytype-heart/core/api/pagination"
+	"github.com/anyproto/anytype-heart/core/block/chats"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+	"github.com/anyproto/anytype-heart/util/persistentqueue"
+)
+
+const (
+	storeKey            = "all"
+	deliveryTimeout     = 10 * time.Second
+	minRetryDelay       = 5 * time.Second
+	maxRetryDelay       = time.Hour
+	maxDeliveryAttempts = 10
+
+	HeaderEvent     = "X-Anytype-Event"
+	HeaderDelivery  = "X-Anytype-Delivery"
+	HeaderSignature = "X-Anytype-Signature"
+)
+
+var log = logging.Logger("api-webhook").Desugar()
+
+var (
+	ErrInvalidUrl          = errors.New("invalid webhook url")
+	ErrMissingSpaceId      = errors.New("missing space id")
+	ErrInvalidEvents       = errors.New("invalid webhook events")
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrFailedCreateWebhook = errors.New("failed to create webhook")
+	ErrFailedDeleteWebhook = errors.New("failed to delete webhook")
+)
+
+type Service interface {
+	CreateWebhook(ctx context.Context, request CreateWebhookRequest) (Webhook, string, error)
+	ListWebhooks(ctx context.Context, offset int, limit int) ([]Webhook, int, bool, error)
+	DeleteWebhook(ctx context.Context, webhookId string) (Webhook, error)
+}
+
+// ChatMessages notifies about messages added to chats
+type ChatMessages interface {
+	SubscribeMessages(handler chats.MessageHandler) (unsubscribe func())
+}
+
+type WebhookService struct {
+	searchService *search.SearchService
+	chatService   *chat.ChatService
+	chatMessages  ChatMessages
+	db            *badger.DB
+	store         keyvaluestore.Store[[]*storedWebhook]
+	client        *http.Client
+	minRetryDelay time.Duration
+	// addedMessages passes the added chat messages to the delivery queues outside the chat transaction
+	addedMessages    *mb.MB[addedMessage]
+	unsubscribeChats func()
+
+	mu       sync.Mutex
+	webhooks map[string]*storedWebhook
+	// queues keep the deliveries of every webhook separately, so an unavailable endpoint delays only its own events
+	queues   map[string]*deliveryQueue
+	watchers map[string]context.CancelFunc
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+type deliveryQueue struct {
+	storage persistentqueue.Storage[*delivery]
+	queue   *persistentqueue.Queue[*delivery]
+}
+
+type addedMessage struct {
+	spaceId string
+	chatId  string
+	message *model.ChatMessage
+}
+
+// storedWebhook is a registered webhook together with its signing secret
+type storedWebhook struct {
+	Id        string
+	Url       string
+	Secret    string
+	SpaceId   string
+	Events    []string
+	Filter    Filter
+	ChatId    string
+	CreatedAt int64
+}
+
+// delivery is a signed event waiting in the retry queue
+type delivery struct {
+	Id        string
+	WebhookId string
+	EventType string
+	Payload   json.RawMessage
+	CreatedAt int64
+	// Attempts and NextAttemptAt are stored with the delivery, so the backoff and the limit survive restarts
+	Attempts      int
+	NextAttemptAt int64
+}
+
+func makeDelivery() *delivery {
+	return &delivery{}
+}
+
+func (d *delivery) Key() string {
+	return d.Id
+}
+
+func (d *delivery) Less(other persistentqueue.OrderedItem) bool {
+	return d.CreatedAt < other.(*delivery).CreatedAt
+}
+
+func NewService(db *badger.DB, searchService *search.SearchService, chatService *chat.ChatService, chatMessages ChatMessages) *WebhookService {
+	s := &WebhookService{
+		searchService: searchService,
+		chatService:   chatService,
+		chatMessages:  chatMessages,
+		db:            db,
+		store:         keyvaluestore.NewJson[[]*storedWebhook](db, []byte("api/webhooks/")),
+		client:        &http.Client{Timeout: deliveryTimeout},
+		minRetryDelay: minRetryDelay,
+		addedMessages: mb.New[addedMessage](0),
+		webhooks:      make(map[string]*storedWebhook),
+		queues:        make(map[string]*deliveryQueue),
+		watchers:      make(map[string]context.CancelFunc),
+	}
+	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
+	return s
+}
+
+// Run loads the registered webhooks, starts watching their events and delivering their queued events.
+func (s *WebhookService) Run() error {
+	webhooks, err := s.store.Get(storeKey)
+	if err != nil && !errors.Is(err, keyvaluestore.ErrNotFound) {
+		return fmt.Errorf("load webhooks: %w", err)
+	}
+
+	s.mu.Lock()
+	for _, wh := range webhooks {
+		s.webhooks[wh.Id] = wh
+		s.startQueue(wh)
+		s.startWatchers(wh)
+	}
+	s.mu.Unlock()
+
+	s.unsubscribeChats = s.chatMessages.SubscribeMessages(s.chatMessageAdded)
+	go s.enqueueChatMessages()
+	return nil
+}
+
+// Close stops the watchers and the delivery queues.
+func (s *WebhookService) Close() error {
+	if s.unsubscribeChats != nil {
+		s.unsubscribeChats()
+	}
+	s.ctxCancel()
+	if err := s.addedMessages.Close(); err != nil && !errors.Is(err, mb.ErrClosed) {
+		log.Error("close added messages", zap.Error(err))
+	}
+
+	s.mu.Lock()
+	queues := s.queues
+	s.queues = make(map[string]*deliveryQueue)
+	s.mu.Unlock()
+
+	var err error
+	for _, dq := range queues {
+		err = errors.Join(err, dq.queue.Close())
+	}
+	return err
+}
+
+// CreateWebhook registers a new webhook and returns it together with the secret used to sign its deliveries.
+func (s *WebhookService) CreateWebhook(ctx context.Context, request CreateWebhookRequest) (Webhook, string, error) {
+	parsedUrl, err := url.Parse(request.Url)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		return Webhook{}, "", ErrInvalidUrl
+	}
+
+	if request.SpaceId == "" {
+		return Webhook{}, "", ErrMissingSpaceId
+	}
+
+	if len(request.Events) == 0 {
+		return Webhook{}, "", ErrInvalidEvents
+	}
+	for _, event := range request.Events {
+		if event != EventObjectCreated && event != EventObjectUpdated && event != EventObjectDeleted && event != EventChatMessage {
+			return Webhook{}, "", ErrInvalidEvents
+		}
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return Webhook{}, "", ErrFailedCreateWebhook
+	}
+
+	wh := &storedWebhook{
+		Id:        bson.NewObjectId().Hex(),
+		Url:       request.Url,
+		Secret:    secret,
+		SpaceId:   request.SpaceId,
+		Events:    request.Events,
+		Filter:    request.Filter,
+		ChatId:    request.ChatId,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.webhooks[wh.Id] = wh
+	if err := s.save(); err != nil {
+		delete(s.webhooks, wh.Id)
+		return Webhook{}, "", ErrFailedCreateWebhook
+	}
+	s.startQueue(wh)
+	s.startWatchers(wh)
+
+	return mapWebhook(wh), secret, nil
+}
+
+// ListWebhooks returns a paginated list of the registered webhooks, oldest first.
+func (s *WebhookService) ListWebhooks(ctx context.Context, offset int, limit int) (webhooks []Webhook, total int, hasMore bool, err error) {
+	s.mu.Lock()
+	stored := s.sortedWebhooks()
+	s.mu.Unlock()
+
+	total = len(stored)
+	paginatedWebhooks, hasMore := pagination.Paginate(stored, offset, limit)
+	webhooks = make([]Webhook, 0, len(paginatedWebhooks))
+
+	for _, wh := range paginatedWebhooks {
+		webhooks = append(webhooks, mapWebhook(wh))
+	}
+
+	return webhooks, total, hasMore, nil
+}
+
+// DeleteWebhook unregisters the webhook. Its pending deliveries are dropped.
+func (s *WebhookService) DeleteWebhook(ctx context.Context, webhookId string) (Webhook, error) {
+	s.mu.Lock()
+	wh, ok := s.webhooks[webhookId]
+	if !ok {
+		s.mu.Unlock()
+		return Webhook{}, ErrWebhookNotFound
+	}
+
+	delete(s.webhooks, webhookId)
+	if err := s.save(); err != nil {
+		s.webhooks[webhookId] = wh
+		s.mu.Unlock()
+		return Webhook{}, ErrFailedDeleteWebhook
+	}
+
+	if cancel, ok := s.watchers[webhookId]; ok {
+		cancel()
+		delete(s.watchers, webhookId)
+	}
+	dq, ok := s.queues[webhookId]
+	delete(s.queues, webhookId)
+	s.mu.Unlock()
+
+	// the queue is closed without mu, as it waits for the delivery in progress
+	if ok {
+		if err := dq.drop(); err != nil {
+			log.Error("drop webhook deliveries", zap.String("webhookId", webhookId), zap.Error(err))
+		}
+	}
+
+	return mapWebhook(wh), nil
+}
+
+// save persists the registered webhooks. It must be called with mu held.
+func (s *WebhookService) save() error {
+	return s.store.Set(storeKey, s.sortedWebhooks())
+}
+
+// sortedWebhooks returns the registered webhooks ordered by creation. It must be called with mu held.
+func (s *WebhookService) sortedWebhooks() []*storedWebhook {
+	webhooks := make([]*storedWebhook, 0, len(s.webhooks))
+	for _, wh := range s.webhooks {
+		webhooks = append(webhooks, wh)
+	}
+	slices.SortFunc(webhooks, func(a, b *storedWebhook) int {
+		return cmp.Or(cmp.Compare(a.CreatedAt, b.CreatedAt), strings.Compare(a.Id, b.Id))
+	})
+	return webhooks
+}
+
+// startQueue restores the pending deliveries of the webhook and starts delivering them. It must be called with mu held.
+func (s *WebhookService) startQueue(wh *storedWebhook) {
+	storage := persistentqueue.NewBadgerStorage(s.db, []byte("queue/api/webhook_deliveries/"+wh.Id+"/"), makeDelivery)
+	queue := persistentqueue.New(storage, log, s.deliver, persistentqueue.WithContext(s.ctx))
+	s.queues[wh.Id] = &deliveryQueue{storage: storage, queue: queue}
+	queue.Run()
+}
+
+// drop stops the queue and removes its stored deliveries.
+func (dq *deliveryQueue) drop() error {
+	if err := dq.queue.Close(); err != nil {
+		return err
+	}
+	deliveries, err := dq.storage.List()
+	if err != nil {
+		return err
+	}
+	for _, d := range deliveries {
+		err = errors.Join(err, dq.storage.Delete(d.Key()))
+	}
+	return err
+}
+
+// startWatchers starts following the object events the webhook is subscribed to. It must be called with mu held.
+// Chat messages are received from the chat service for all webhooks together.
+func (s *WebhookService) startWatchers(wh *storedWebhook) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.watchers[wh.Id] = cancel
+
+	if wh.subscribesTo(EventObjectCreated) || wh.subscribesTo(EventObjectUpdated) || wh.subscribesTo(EventObjectDeleted) {
+		go s.watchObjects(ctx, wh)
+	}
+}
+
+// watchObjects enqueues an event for each object matching the webhook filter that is created, changed, archived or deleted.
+// Objects created before watching starts are reported as changed when they start matching the filter.
+func (s *WebhookService) watchObjects(ctx context.Context, wh *storedWebhook) {
+	watcher := newObjectWatcher(time.Now())
+	stream, err := s.searchService.StreamWithArchived(ctx, wh.SpaceId, search.SearchRequest{Query: wh.Filter.Query, Types: wh.Filter.Types})
+	if err != nil {
+		log.Error("watch objects", zap.String("webhookId", wh.Id), zap.Error(err))
+		return
+	}
+	defer stream.Close()
+	for _, event := range stream.TakePending() {
+		watcher.known[event.Id] = event.Details
+	}
+
+	for {
+		event, err := stream.Next(ctx)
+		if err != nil {
+			return
+		}
+
+		eventType, ok := watcher.eventType(event)
+		if !ok || !wh.subscribesTo(eventType) {
+			continue
+		}
+		s.enqueue(wh, Event{Type: eventType, Object: &event})
+	}
+}
+
+// objectWatcher derives the webhook events from the stream events by the details of the objects
+type objectWatcher struct {
+	startedAt int64
+	// known keeps the details of the objects in the result set
+	known map[string]map[string]interface{}
+}
+
+func newObjectWatcher(startedAt time.Time) *objectWatcher {
+	return &objectWatcher{startedAt: startedAt.Unix(), known: make(map[string]map[string]interface{})}
+}
+
+// eventType returns the webhook event of the stream event. Objects entering the result set are created
+// if their createdDate is after the start of watching, objects are deleted when isDeleted or isArchived is set.
+// Objects leaving the result set because they stopped matching the filter are not reported
+func (w *objectWatcher) eventType(event search.StreamEvent) (string, bool) {
+	switch event.Type {
+	case "remove":
+		delete(w.known, event.Id)
+		return "", false
+	case "add", "change":
+	default:
+		return "", false
+	}
+
+	details, known := w.known[event.Id]
+	wasRemoved := known && isRemoved(details)
+	if details == nil {
+		details = make(map[string]interface{}, len(event.Details))
+	}
+	for key, value := range event.Details {
+		if value == nil {
+			delete(details, key)
+		} else {
+			details[key] = value
+		}
+	}
+	w.known[event.Id] = details
+
+	switch {
+	case isRemoved(details) && (!known || wasRemoved):
+		return "", false
+	case isRemoved(details):
+		return EventObjectDeleted, true
+	case !known && detailInt64(details, bundle.RelationKeyCreatedDate.String()) >= w.startedAt:
+		return EventObjectCreated, true
+	default:
+		return EventObjectUpdated, true
+	}
+}
+
+func isRemoved(details map[string]interface{}) bool {
+	archived, _ := details[bundle.RelationKeyIsArchived.String()].(bool)
+	deleted, _ := details[bundle.RelationKeyIsDeleted.String()].(bool)
+	return archived || deleted
+}
+
+func detailInt64(details map[string]interface{}, key string) int64 {
+	value, _ := details[key].(float64)
+	return int64(value)
+}
+
+// chatMessageAdded is called while the message is being added to the chat, so it only passes the message on.
+func (s *WebhookService) chatMessageAdded(spaceId string, chatObjectId string, message *model.ChatMessage) {
+	if err := s.addedMessages.Add(s.ctx, addedMessage{spaceId: spaceId, chatId: chatObjectId, message: message}); err != nil && !errors.Is(err, mb.ErrClosed) {
+		log.Error("add chat message", zap.String("chatId", chatObjectId), zap.Error(err))
+	}
+}
+
+// enqueueChatMessages enqueues an event for each added message to the webhooks of its space, or of its chat if set.
+// Messages posted before the webhook was created are not reported.
+func (s *WebhookService) enqueueChatMessages() {
+	for {
+		added, err := s.addedMessages.WaitOne(s.ctx)
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		var webhooks []*storedWebhook
+		for _, wh := range s.webhooks {
+			if wh.SpaceId == added.spaceId && wh.subscribesTo(EventChatMessage) && (wh.ChatId == "" || wh.ChatId == added.chatId) && added.message.CreatedAt >= wh.CreatedAt {
+				webhooks = append(webhooks, wh)
+			}
+		}
+		s.mu.Unlock()
+
+		message := s.chatService.MapMessage(added.message)
+		for _, wh := range webhooks {
+			s.enqueue(wh, Event{Type: EventChatMessage, ChatId: added.chatId, Message: &message})
+		}
+	}
+}
+
+// enqueue completes the event and adds it to the delivery queue.
+func (s *WebhookService) enqueue(wh *storedWebhook, event Event) {
+	now := time.Now()
+	event.Id = bson.NewObjectId().Hex()
+	event.WebhookId = wh.Id
+	event.SpaceId = wh.SpaceId
+	event.CreatedAt = now.UTC().Format(time.RFC3339)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.Error("marshal webhook event", zap.String("webhookId", wh.Id), zap.Error(err))
+		return
+	}
+
+	s.mu.Lock()
+	dq, ok := s.queues[wh.Id]
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	err = dq.queue.Add(&delivery{
+		Id:        event.Id,
+		WebhookId: wh.Id,
+		EventType: event.Type,
+		Payload:   payload,
+		CreatedAt: now.UnixNano(),
+	})
+	if err != nil {
+		log.Error("enqueue webhook event", zap.String("webhookId", wh.Id), zap.Error(err))
+	}
+}
+
+// deliver posts a queued event to its webhook. Failed deliveries are retried with a growing delay
+// until maxDeliveryAttempts is reached.
+func (s *WebhookService) deliver(ctx context.Context, d *delivery) (persistentqueue.Action, error) {
+	s.mu.Lock()
+	wh, ok := s.webhooks[d.WebhookId]
+	dq := s.queues[d.WebhookId]
+	s.mu.Unlock()
+	if !ok {
+		return persistentqueue.ActionDone, nil
+	}
+
+	if wait := time.Until(time.Unix(0, d.NextAttemptAt)); wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return persistentqueue.ActionRetry, ctx.Err()
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.Url, bytes.NewReader(d.Payload))
+	if err != nil {
+		return persistentqueue.ActionDone, fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, d.EventType)
+	req.Header.Set(HeaderDelivery, d.Id)
+	req.Header.Set(HeaderSignature, "sha256="+sign(wh.Secret, d.Payload))
+
+	resp, err := s.client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return persistentqueue.ActionDone, nil
+		}
+		err = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	d.Attempts++
+	if d.Attempts >= maxDeliveryAttempts {
+		return persistentqueue.ActionDone, fmt.Errorf("deliver webhook event %s, giving up after %d attempts: %w", d.Id, d.Attempts, err)
+	}
+	d.NextAttemptAt = time.Now().Add(s.retryDelay(d.Attempts)).UnixNano()
+	if dq != nil {
+		if putErr := dq.storage.Put(d); putErr != nil {
+			err = errors.Join(err, fmt.Errorf("store attempts: %w", putErr))
+		}
+	}
+	return persistentqueue.ActionRetry, fmt.Errorf("deliver webhook event %s: %w", d.Id, err)
+}
+
+// retryDelay doubles the delay after every failed attempt up to maxRetryDelay.
+func (s *WebhookService) retryDelay(attempts int) time.Duration {
+	return min(s.minRetryDelay<<min(attempts-1, 16), maxRetryDelay)
+}
+
+func (wh *storedWebhook) subscribesTo(eventType string) bool {
+	return slices.Contains(wh.Events, eventType)
+}
+
+// sign returns the hex encoded HMAC-SHA256 of the payload.
+func sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func mapWebhook(wh *storedWebhook) Webhook {
+	return Webhook{
+		Type:      "webhook",
+		Id:        wh.Id,
+		Url:       wh.Url,
+		SpaceId:   wh.SpaceId,
+		Events:    wh.Events,
+		Filter:    wh.Filter,
+		ChatId:    wh.ChatId,
+		CreatedAt: time.Unix(wh.CreatedAt, 0).UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,456 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cheggaaa/mb/v3"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/api/internal/chat"
+	"github.com/anyproto/anytype-heart/core/api/internal/search"
+	"github.com/anyproto/anytype-heart/core/block/chats"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/core/subscription/mock_subscription"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pb/service/mock_service"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/persistentqueue"
+)
+
+const (
+	mockedSpaceId  = "mocked-space-id"
+	mockedChatId   = "mocked-chat-id"
+	mockedObjectId = "mocked-object-id"
+	mockedUrl      = "http://localhost:8080/hook"
+)
+
+type fixture struct {
+	*WebhookService
+	db               *badger.DB
+	mwMock           *mock_service.MockClientCommandsServer
+	subscriptionMock *mock_subscription.MockService
+	chatMessages     *testChatMessages
+}
+
+type testChatMessages struct {
+	handler chats.MessageHandler
+}
+
+func (c *testChatMessages) SubscribeMessages(handler chats.MessageHandler) (unsubscribe func()) {
+	c.handler = handler
+	return func() {
+		c.handler = nil
+	}
+}
+
+func newInMemoryBadger(t *testing.T) *badger.DB {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	return db
+}
+
+func newFixtureWithDb(t *testing.T, db *badger.DB) *fixture {
+	mw := mock_service.NewMockClientCommandsServer(t)
+	subscriptionService := mock_subscription.NewMockService(t)
+	searchService := search.NewService(mw, nil, nil, subscriptionService)
+	chatMessages := &testChatMessages{}
+	webhookService := NewService(db, searchService, chat.NewService(mw), chatMessages)
+	t.Cleanup(func() {
+		webhookService.Close()
+	})
+
+	return &fixture{
+		WebhookService:   webhookService,
+		db:               db,
+		mwMock:           mw,
+		subscriptionMock: subscriptionService,
+		chatMessages:     chatMessages,
+	}
+}
+
+func newFixture(t *testing.T) *fixture {
+	return newFixtureWithDb(t, newInMemoryBadger(t))
+}
+
+// expectNoSubscription lets object watchers start without following any object
+func (fx *fixture) expectNoSubscription() {
+	fx.subscriptionMock.EXPECT().Search(mock.Anything).Return(nil, errors.New("not available")).Maybe()
+}
+
+func TestWebhookService_CreateWebhook(t *testing.T) {
+	t.Run("successful create", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.expectNoSubscription()
+
+		// when
+		webhook, secret, err := fx.CreateWebhook(ctx, CreateWebhookRequest{
+			Url:     mockedUrl,
+			SpaceId: mockedSpaceId,
+			Events:  []string{EventObjectCreated, EventObjectDeleted},
+			Filter:  Filter{Query: "bug"},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.NotEmpty(t, webhook.Id)
+		require.Len(t, secret, 64)
+		require.Equal(t, "webhook", webhook.Type)
+		require.Equal(t, mockedUrl, webhook.Url)
+		require.Equal(t, mockedSpaceId, webhook.SpaceId)
+		require.Equal(t, []string{EventObjectCreated, EventObjectDeleted}, webhook.Events)
+		require.Equal(t, Filter{Query: "bug"}, webhook.Filter)
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: "ftp://example.com", SpaceId: mockedSpaceId, Events: []string{EventObjectCreated}})
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidUrl)
+	})
+
+	t.Run("missing space id", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: mockedUrl, Events: []string{EventObjectCreated}})
+
+		// then
+		require.ErrorIs(t, err, ErrMissingSpaceId)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: mockedUrl, SpaceId: mockedSpaceId, Events: []string{"object.moved"}})
+
+		// then
+		require.ErrorIs(t, err, ErrInvalidEvents)
+	})
+}
+
+func TestWebhookService_ListAndDeleteWebhooks(t *testing.T) {
+	t.Run("webhooks are persisted", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		db := newInMemoryBadger(t)
+		fx := newFixtureWithDb(t, db)
+		fx.expectNoSubscription()
+		first, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: mockedUrl, SpaceId: mockedSpaceId, Events: []string{EventObjectCreated}})
+		require.NoError(t, err)
+		second, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: mockedUrl, SpaceId: mockedSpaceId, Events: []string{EventObjectUpdated}})
+		require.NoError(t, err)
+		require.NoError(t, fx.Close())
+
+		// when
+		restarted := newFixtureWithDb(t, db)
+		restarted.expectNoSubscription()
+		require.NoError(t, restarted.Run())
+		webhooks, total, hasMore, err := restarted.ListWebhooks(ctx, 0, 10)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 2, total)
+		require.False(t, hasMore)
+		require.ElementsMatch(t, []Webhook{first, second}, webhooks)
+	})
+
+	t.Run("delete webhook", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.expectNoSubscription()
+		webhook, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: mockedUrl, SpaceId: mockedSpaceId, Events: []string{EventObjectCreated}})
+		require.NoError(t, err)
+
+		// when
+		deleted, err := fx.DeleteWebhook(ctx, webhook.Id)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, webhook, deleted)
+		webhooks, total, _, err := fx.ListWebhooks(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Zero(t, total)
+		require.Empty(t, webhooks)
+	})
+
+	t.Run("delete unknown webhook", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		_, err := fx.DeleteWebhook(ctx, "unknown")
+
+		// then
+		require.ErrorIs(t, err, ErrWebhookNotFound)
+	})
+}
+
+func TestWebhookService_Delivery(t *testing.T) {
+	t.Run("object changes are delivered signed", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		output := mb.New[*pb.EventMessage](0)
+		fx.subscriptionMock.EXPECT().Search(mock.MatchedBy(func(req subscription.SubscribeRequest) bool {
+			return req.SpaceId == mockedSpaceId
+		})).Return(&subscription.SubscribeResponse{SubId: "mocked-sub-id", Output: output}, nil).Once()
+		fx.subscriptionMock.EXPECT().Unsubscribe("mocked-sub-id").Return(nil).Maybe()
+
+		type received struct {
+			header http.Header
+			body   []byte
+		}
+		requests := make(chan received, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests <- received{header: r.Header, body: body}
+		}))
+		defer server.Close()
+
+		require.NoError(t, fx.Run())
+		webhook, secret, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: server.URL, SpaceId: mockedSpaceId, Events: []string{EventObjectCreated}})
+		require.NoError(t, err)
+
+		// when
+		require.NoError(t, output.Add(ctx,
+			&pb.EventMessage{Value: &pb.EventMessageValueOfObjectDetailsAmend{
+				ObjectDetailsAmend: &pb.EventObjectDetailsAmend{Id: mockedObjectId, Details: []*pb.EventObjectDetailsAmendKeyValue{
+					{Key: bundle.RelationKeyCreatedDate.String(), Value: pbtypes.Int64(time.Now().Unix() + 1)},
+				}},
+			}},
+			&pb.EventMessage{Value: &pb.EventMessageValueOfSubscriptionAdd{
+				SubscriptionAdd: &pb.EventObjectSubscriptionAdd{Id: mockedObjectId},
+			}},
+		))
+
+		// then
+		var got received
+		select {
+		case got = <-requests:
+		case <-time.After(5 * time.Second):
+			t.Fatal("webhook was not called")
+		}
+		require.Equal(t, EventObjectCreated, got.header.Get(HeaderEvent))
+		require.Equal(t, "sha256="+sign(secret, got.body), got.header.Get(HeaderSignature))
+
+		var event Event
+		require.NoError(t, json.Unmarshal(got.body, &event))
+		require.Equal(t, EventObjectCreated, event.Type)
+		require.Equal(t, webhook.Id, event.WebhookId)
+		require.Equal(t, mockedSpaceId, event.SpaceId)
+		require.Equal(t, got.header.Get(HeaderDelivery), event.Id)
+		require.Equal(t, mockedObjectId, event.Object.Id)
+	})
+
+	t.Run("failed deliveries are retried until the limit", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.minRetryDelay = 0
+		fx.expectNoSubscription()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		webhook, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: server.URL, SpaceId: mockedSpaceId, Events: []string{EventObjectCreated}})
+		require.NoError(t, err)
+		d := &delivery{Id: "delivery-id", WebhookId: webhook.Id, EventType: EventObjectCreated, Payload: []byte("{}")}
+
+		// when
+		var actions []persistentqueue.Action
+		for i := 0; i < maxDeliveryAttempts; i++ {
+			action, err := fx.deliver(ctx, d)
+			require.Error(t, err)
+			actions = append(actions, action)
+		}
+
+		// then
+		for _, action := range actions[:maxDeliveryAttempts-1] {
+			require.Equal(t, persistentqueue.ActionRetry, action)
+		}
+		require.Equal(t, persistentqueue.ActionDone, actions[maxDeliveryAttempts-1])
+	})
+
+	t.Run("attempts are stored with the delivery", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		fx.expectNoSubscription()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		webhook, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: server.URL, SpaceId: mockedSpaceId, Events: []string{EventObjectCreated}})
+		require.NoError(t, err)
+		d := &delivery{Id: "delivery-id", WebhookId: webhook.Id, EventType: EventObjectCreated, Payload: []byte("{}")}
+
+		// when
+		action, err := fx.deliver(ctx, d)
+
+		// then
+		require.Error(t, err)
+		require.Equal(t, persistentqueue.ActionRetry, action)
+		stored, err := fx.queues[webhook.Id].storage.List()
+		require.NoError(t, err)
+		require.Len(t, stored, 1)
+		require.Equal(t, 1, stored[0].Attempts)
+		require.Greater(t, stored[0].NextAttemptAt, time.Now().UnixNano())
+	})
+
+	t.Run("retry delay grows up to the limit", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		// then
+		require.Equal(t, minRetryDelay, fx.retryDelay(1))
+		require.Equal(t, 4*minRetryDelay, fx.retryDelay(3))
+		require.Equal(t, maxRetryDelay, fx.retryDelay(maxDeliveryAttempts*10))
+	})
+
+	t.Run("deliveries of deleted webhooks are dropped", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+
+		// when
+		action, err := fx.deliver(ctx, &delivery{Id: "delivery-id", WebhookId: "deleted", Payload: []byte("{}")})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, persistentqueue.ActionDone, action)
+	})
+}
+
+func TestObjectWatcher_EventType(t *testing.T) {
+	startedAt := time.Unix(1000, 0)
+	add := func(id string, details map[string]interface{}) search.StreamEvent {
+		return search.StreamEvent{Type: "add", Id: id, Details: details}
+	}
+	change := func(id string, details map[string]interface{}) search.StreamEvent {
+		return search.StreamEvent{Type: "change", Id: id, Details: details}
+	}
+
+	t.Run("objects created after the start are created", func(t *testing.T) {
+		// given
+		w := newObjectWatcher(startedAt)
+
+		// when
+		eventType, ok := w.eventType(add("new", map[string]interface{}{bundle.RelationKeyCreatedDate.String(): float64(1001)}))
+
+		// then
+		require.True(t, ok)
+		require.Equal(t, EventObjectCreated, eventType)
+	})
+
+	t.Run("old objects entering the result set are updated", func(t *testing.T) {
+		// given
+		w := newObjectWatcher(startedAt)
+
+		// when
+		eventType, ok := w.eventType(add("old", map[string]interface{}{bundle.RelationKeyCreatedDate.String(): float64(10)}))
+
+		// then
+		require.True(t, ok)
+		require.Equal(t, EventObjectUpdated, eventType)
+	})
+
+	t.Run("archived objects are deleted once", func(t *testing.T) {
+		// given
+		w := newObjectWatcher(startedAt)
+		w.known["object"] = map[string]interface{}{bundle.RelationKeyName.String(): "name"}
+
+		// when
+		eventType, ok := w.eventType(change("object", map[string]interface{}{bundle.RelationKeyIsArchived.String(): true}))
+		_, deletedAgain := w.eventType(change("object", map[string]interface{}{bundle.RelationKeyIsDeleted.String(): true}))
+
+		// then
+		require.True(t, ok)
+		require.Equal(t, EventObjectDeleted, eventType)
+		require.False(t, deletedAgain)
+	})
+
+	t.Run("objects leaving the result set are not reported", func(t *testing.T) {
+		// given
+		w := newObjectWatcher(startedAt)
+		w.known["object"] = map[string]interface{}{}
+
+		// when
+		_, ok := w.eventType(search.StreamEvent{Type: "remove", Id: "object"})
+
+		// then
+		require.False(t, ok)
+		require.NotContains(t, w.known, "object")
+	})
+}
+
+func TestWebhookService_ChatMessages(t *testing.T) {
+	t.Run("messages posted after the webhook was created are delivered", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		fx := newFixture(t)
+		bodies := make(chan []byte, 2)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies <- body
+		}))
+		defer server.Close()
+
+		require.NoError(t, fx.Run())
+		_, _, err := fx.CreateWebhook(ctx, CreateWebhookRequest{Url: server.URL, SpaceId: mockedSpaceId, ChatId: mockedChatId, Events: []string{EventChatMessage}})
+		require.NoError(t, err)
+		now := time.Now().Unix()
+
+		// when
+		fx.chatMessages.handler(mockedSpaceId, mockedChatId, &model.ChatMessage{Id: "old", OrderId: "a", CreatedAt: now - 3600})
+		fx.chatMessages.handler(mockedSpaceId, "other-chat", &model.ChatMessage{Id: "other", OrderId: "a", CreatedAt: now + 1})
+		fx.chatMessages.handler(mockedSpaceId, mockedChatId, &model.ChatMessage{Id: "new", OrderId: "b", CreatedAt: now + 1})
+
+		// then
+		var body []byte
+		select {
+		case body = <-bodies:
+		case <-time.After(5 * time.Second):
+			t.Fatal("webhook was not called")
+		}
+		var event Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		require.Equal(t, EventChatMessage, event.Type)
+		require.Equal(t, mockedChatId, event.ChatId)
+		require.Equal(t, "new", event.Message.Id)
+		select {
+		case <-bodies:
+			t.Fatal("only one message is expected")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
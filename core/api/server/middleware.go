@@ -11,8 +11,8 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/anyproto/anytype-heart/core/anytype/account"
-	"github.com/anyproto/anytype-heart/pb"
-	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/core/api/internal/auth"
+	"github.com/anyproto/anytype-heart/core/api/util"
 )
 
 // rateLimit is a middleware that limits the number of requests per second.
@@ -34,7 +34,7 @@ func (s *Server) rateLimit(max float64) gin.HandlerFunc {
 }
 
 // ensureAuthenticated is a middleware that ensures the request is authenticated.
-func (s *Server) ensureAuthenticated(mw service.ClientCommandsServer) gin.HandlerFunc {
+func (s *Server) ensureAuthenticated() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -48,27 +48,68 @@ func (s *Server) ensureAuthenticated(mw service.ClientCommandsServer) gin.Handle
 		}
 		key := strings.TrimPrefix(authHeader, "Bearer ")
 
-		// Validate the key - if the key exists in the KeyToToken map, it is considered valid.
-		// Otherwise, attempt to create a new session using the key and add it to the map upon successful validation.
-		s.mu.Lock()
-		token, exists := s.KeyToToken[key]
-		s.mu.Unlock()
-
-		if !exists {
-			response := mw.WalletCreateSession(context.Background(), &pb.RpcWalletCreateSessionRequest{Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{AppKey: key}})
-			if response.Error.Code != pb.RpcWalletCreateSessionResponseError_NULL {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-				return
-			}
-			token = response.Token
-
-			s.mu.Lock()
-			s.KeyToToken[key] = token
-			s.mu.Unlock()
+		// Validate the key - a new session is created on first use of the key and reused for subsequent requests.
+		token, permissions, err := s.authService.Authenticate(c.Request.Context(), key)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+			return
 		}
 
-		// Add token to request context for downstream services (subscriptions, events, etc.)
+		// Add token and permissions to request context for downstream services (subscriptions, events, etc.)
 		c.Set("token", token)
+		c.Set("permissions", permissions)
+		ctx := util.ContextWithAllowedSpaces(c.Request.Context(), permissions.SpaceIds)
+		c.Request = c.Request.WithContext(util.ContextWithReadOnly(ctx, permissions.ReadOnly))
+		c.Next()
+	}
+}
+
+// readRoutes are the non-GET routes that only read data and are therefore allowed for read-only app keys.
+// Export handlers only stream the result for read-only keys and never write it to the host filesystem.
+var readRoutes = map[string]struct{}{
+	"/v1/search":                  {},
+	"/v1/spaces/:space_id/search": {},
+	"/v1/spaces/:space_id/objects/:object_id/export/:format": {},
+	"/v1/spaces/:space_id/export":                            {},
+}
+
+// ensurePermissions is a middleware that ensures the app key is allowed to perform the request.
+func (s *Server) ensurePermissions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		permissions := c.MustGet("permissions").(auth.KeyPermissions)
+
+		_, isReadRoute := readRoutes[c.FullPath()]
+		isRead := c.Request.Method == http.MethodGet || isReadRoute
+		if permissions.ReadOnly && !isRead {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "App key is read-only"})
+			return
+		}
+
+		spaceId := c.Param("space_id")
+		if spaceId != "" && !permissions.CanAccessSpace(spaceId) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "App key is not allowed to access this space"})
+			return
+		}
+
+		// Writes outside of a space, e.g. creating a new one, are not covered by a space restriction
+		if spaceId == "" && !isRead && len(permissions.SpaceIds) > 0 {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "App key is restricted to specific spaces"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// ensureUnrestrictedKey is a middleware that ensures the app key has full read-write access to all spaces.
+func (s *Server) ensureUnrestrictedKey() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		permissions := c.MustGet("permissions").(auth.KeyPermissions)
+		if permissions.IsRestricted() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "App key is not allowed to manage app keys"})
+			return
+		}
+
 		c.Next()
 	}
 }
@@ -82,8 +123,12 @@ func (s *Server) ensureAccountInfo(accountService account.Service) gin.HandlerFu
 			return
 		}
 
+		s.chatService.AccountInfo = accInfo
 		s.exportService.AccountInfo = accInfo
+		s.fileService.AccountInfo = accInfo
+		s.listService.AccountInfo = accInfo
 		s.objectService.AccountInfo = accInfo
+		s.relationService.AccountInfo = accInfo
 		s.spaceService.AccountInfo = accInfo
 		s.searchService.AccountInfo = accInfo
 
@@ -11,12 +11,16 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/anytype/account"
 	"github.com/anyproto/anytype-heart/core/api/internal/auth"
+	"github.com/anyproto/anytype-heart/core/api/internal/chat"
 	"github.com/anyproto/anytype-heart/core/api/internal/export"
+	"github.com/anyproto/anytype-heart/core/api/internal/file"
+	"github.com/anyproto/anytype-heart/core/api/internal/list"
 	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/internal/relation"
 	"github.com/anyproto/anytype-heart/core/api/internal/search"
 	"github.com/anyproto/anytype-heart/core/api/internal/space"
+	"github.com/anyproto/anytype-heart/core/api/internal/webhook"
 	"github.com/anyproto/anytype-heart/core/api/pagination"
-	"github.com/anyproto/anytype-heart/pb/service"
 )
 
 const (
@@ -28,7 +32,7 @@ const (
 )
 
 // NewRouter builds and returns a *gin.Engine with all routes configured.
-func (s *Server) NewRouter(accountService account.Service, mw service.ClientCommandsServer) *gin.Engine {
+func (s *Server) NewRouter(accountService account.Service) *gin.Engine {
 	debug := os.Getenv("ANYTYPE_API_DEBUG") == "1"
 	if !debug {
 		gin.SetMode(gin.ReleaseMode)
@@ -60,21 +64,61 @@ func (s *Server) NewRouter(accountService account.Service, mw service.ClientComm
 	// API routes
 	v1 := router.Group("/v1")
 	v1.Use(paginator)
-	v1.Use(s.ensureAuthenticated(mw))
+	v1.Use(s.ensureAuthenticated())
+	v1.Use(s.ensurePermissions())
 	v1.Use(s.ensureAccountInfo(accountService))
 	{
+		// Auth
+		v1.GET("/auth/keys", s.ensureUnrestrictedKey(), auth.GetKeysHandler(s.authService))
+		v1.DELETE("/auth/keys/:key_id", s.ensureUnrestrictedKey(), s.rateLimit(maxWriteRequestsPerSecond), auth.RevokeKeyHandler(s.authService))
+
+		// Chat
+		v1.GET("/spaces/:space_id/chats", chat.GetChatsHandler(s.chatService))
+		v1.GET("/spaces/:space_id/chats/:chat_id/messages", chat.GetMessagesHandler(s.chatService))
+		v1.POST("/spaces/:space_id/chats/:chat_id/messages", s.rateLimit(maxWriteRequestsPerSecond), chat.AddMessageHandler(s.chatService))
+		v1.PATCH("/spaces/:space_id/chats/:chat_id/messages/:message_id", s.rateLimit(maxWriteRequestsPerSecond), chat.EditMessageHandler(s.chatService))
+		v1.DELETE("/spaces/:space_id/chats/:chat_id/messages/:message_id", s.rateLimit(maxWriteRequestsPerSecond), chat.DeleteMessageHandler(s.chatService))
+		v1.POST("/spaces/:space_id/chats/:chat_id/messages/:message_id/reactions", s.rateLimit(maxWriteRequestsPerSecond), chat.ToggleReactionHandler(s.chatService))
+
 		// Export
 		v1.POST("/spaces/:space_id/objects/:object_id/export/:format", export.GetObjectExportHandler(s.exportService))
+		v1.POST("/spaces/:space_id/export", export.ExportHandler(s.exportService))
+
+		// File
+		v1.POST("/spaces/:space_id/files", s.rateLimit(maxWriteRequestsPerSecond), file.UploadFileHandler(s.fileService))
+		v1.GET("/spaces/:space_id/files/:file_id", file.DownloadFileHandler(s.fileService))
+
+		// List
+		v1.GET("/spaces/:space_id/lists/:list_id/views", list.GetListViewsHandler(s.listService))
+		v1.GET("/spaces/:space_id/lists/:list_id/views/:view_id/objects", list.GetObjectsInListHandler(s.listService))
+		v1.GET("/spaces/:space_id/lists/:list_id/objects", list.GetObjectsInDefaultViewHandler(s.listService))
+		v1.POST("/spaces/:space_id/lists/:list_id/objects", s.rateLimit(maxWriteRequestsPerSecond), list.AddObjectsToListHandler(s.listService))
+		v1.DELETE("/spaces/:space_id/lists/:list_id/objects/:object_id", s.rateLimit(maxWriteRequestsPerSecond), list.RemoveObjectFromListHandler(s.listService))
 
 		// Object
 		v1.GET("/spaces/:space_id/objects", object.GetObjectsHandler(s.objectService))
 		v1.GET("/spaces/:space_id/objects/:object_id", object.GetObjectHandler(s.objectService))
 		v1.DELETE("/spaces/:space_id/objects/:object_id", s.rateLimit(maxWriteRequestsPerSecond), object.DeleteObjectHandler(s.objectService))
+		v1.PATCH("/spaces/:space_id/objects/:object_id", s.rateLimit(maxWriteRequestsPerSecond), object.UpdateObjectHandler(s.objectService))
 		v1.POST("/spaces/:space_id/objects", s.rateLimit(maxWriteRequestsPerSecond), object.CreateObjectHandler(s.objectService))
+		v1.PUT("/spaces/:space_id/objects/:object_id/relations/:relation_key", s.rateLimit(maxWriteRequestsPerSecond), relation.SetRelationValueHandler(s.relationService))
+
+		// Relation
+		v1.GET("/spaces/:space_id/relations", relation.GetRelationsHandler(s.relationService))
+		v1.GET("/spaces/:space_id/relations/:relation_id", relation.GetRelationHandler(s.relationService))
+		v1.POST("/spaces/:space_id/relations", s.rateLimit(maxWriteRequestsPerSecond), relation.CreateRelationHandler(s.relationService))
+		v1.PATCH("/spaces/:space_id/relations/:relation_id", s.rateLimit(maxWriteRequestsPerSecond), relation.UpdateRelationHandler(s.relationService))
+		v1.DELETE("/spaces/:space_id/relations/:relation_id", s.rateLimit(maxWriteRequestsPerSecond), relation.DeleteRelationHandler(s.relationService))
+		v1.GET("/spaces/:space_id/relations/:relation_id/options", relation.GetOptionsHandler(s.relationService))
+		v1.GET("/spaces/:space_id/relations/:relation_id/options/:option_id", relation.GetOptionHandler(s.relationService))
+		v1.POST("/spaces/:space_id/relations/:relation_id/options", s.rateLimit(maxWriteRequestsPerSecond), relation.CreateOptionHandler(s.relationService))
+		v1.PATCH("/spaces/:space_id/relations/:relation_id/options/:option_id", s.rateLimit(maxWriteRequestsPerSecond), relation.UpdateOptionHandler(s.relationService))
+		v1.DELETE("/spaces/:space_id/relations/:relation_id/options/:option_id", s.rateLimit(maxWriteRequestsPerSecond), relation.DeleteOptionHandler(s.relationService))
 
 		// Search
 		v1.POST("/search", search.GlobalSearchHandler(s.searchService))
 		v1.POST("/spaces/:space_id/search", search.SearchHandler(s.searchService))
+		v1.GET("/spaces/:space_id/search/stream", search.StreamHandler(s.searchService))
 
 		// Space
 		v1.GET("/spaces", space.GetSpacesHandler(s.spaceService))
@@ -86,6 +130,11 @@ func (s *Server) NewRouter(accountService account.Service, mw service.ClientComm
 		v1.GET("/spaces/:space_id/types/:type_id", object.GetTypeHandler(s.objectService))
 		v1.GET("/spaces/:space_id/types/:type_id/templates", object.GetTemplatesHandler(s.objectService))
 		v1.GET("/spaces/:space_id/types/:type_id/templates/:template_id", object.GetTemplateHandler(s.objectService))
+
+		// Webhook
+		v1.GET("/webhooks", s.ensureUnrestrictedKey(), webhook.GetWebhooksHandler(s.webhookService))
+		v1.POST("/webhooks", s.ensureUnrestrictedKey(), s.rateLimit(maxWriteRequestsPerSecond), webhook.CreateWebhookHandler(s.webhookService))
+		v1.DELETE("/webhooks/:webhook_id", s.ensureUnrestrictedKey(), s.rateLimit(maxWriteRequestsPerSecond), webhook.DeleteWebhookHandler(s.webhookService))
 	}
 
 	return router
@@ -1,16 +1,25 @@
 package server
 
 import (
-	"sync"
+	"context"
 
+	"github.com/dgraph-io/badger/v4"
 	"github.com/gin-gonic/gin"
 
 	"github.com/anyproto/anytype-heart/core/anytype/account"
 	"github.com/anyproto/anytype-heart/core/api/internal/auth"
+	"github.com/anyproto/anytype-heart/core/api/internal/chat"
 	"github.com/anyproto/anytype-heart/core/api/internal/export"
+	"github.com/anyproto/anytype-heart/core/api/internal/file"
+	"github.com/anyproto/anytype-heart/core/api/internal/list"
 	"github.com/anyproto/anytype-heart/core/api/internal/object"
+	"github.com/anyproto/anytype-heart/core/api/internal/relation"
 	"github.com/anyproto/anytype-heart/core/api/internal/search"
 	"github.com/anyproto/anytype-heart/core/api/internal/space"
+	"github.com/anyproto/anytype-heart/core/api/internal/webhook"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/pb/service"
 )
 
@@ -18,32 +27,53 @@ import (
 type Server struct {
 	engine *gin.Engine
 
-	authService   *auth.AuthService
-	exportService *export.ExportService
-	objectService *object.ObjectService
-	spaceService  *space.SpaceService
-	searchService *search.SearchService
-
-	mu         sync.Mutex
-	KeyToToken map[string]string // appKey -> token
+	authService     *auth.AuthService
+	chatService     *chat.ChatService
+	exportService   *export.ExportService
+	fileService     *file.FileService
+	listService     *list.ListService
+	objectService   *object.ObjectService
+	relationService *relation.RelationService
+	spaceService    *space.SpaceService
+	searchService   *search.SearchService
+	webhookService  *webhook.WebhookService
 }
 
 // NewServer constructs a new Server with default config and sets up the routes.
-func NewServer(accountService account.Service, mw service.ClientCommandsServer) *Server {
+func NewServer(accountService account.Service, mw service.ClientCommandsServer, subscriptionService subscription.Service, fileService files.Service, fileObjectService fileobject.Service, chatMessages webhook.ChatMessages, db *badger.DB) *Server {
 	s := &Server{
-		authService:   auth.NewService(mw),
-		exportService: export.NewService(mw),
-		spaceService:  space.NewService(mw),
+		authService:  auth.NewService(mw),
+		chatService:  chat.NewService(mw),
+		fileService:  file.NewService(mw, fileService, fileObjectService),
+		spaceService: space.NewService(mw),
 	}
 
 	s.objectService = object.NewService(mw, s.spaceService)
-	s.searchService = search.NewService(mw, s.spaceService, s.objectService)
-	s.engine = s.NewRouter(accountService, mw)
-	s.KeyToToken = make(map[string]string)
+	s.listService = list.NewService(mw, s.objectService)
+	s.exportService = export.NewService(mw, s.listService)
+	s.relationService = relation.NewService(mw, s.objectService)
+	s.searchService = search.NewService(mw, s.spaceService, s.objectService, subscriptionService)
+	s.webhookService = webhook.NewService(db, s.searchService, s.chatService, chatMessages)
+	s.engine = s.NewRouter(accountService)
 
 	return s
 }
 
+// Run starts the background work of the server, such as webhook deliveries.
+func (s *Server) Run() error {
+	return s.webhookService.Run()
+}
+
+// Close stops the background work of the server.
+func (s *Server) Close() error {
+	return s.webhookService.Close()
+}
+
+// CloseKeySessions closes the sessions of the app key, so a key revoked outside of the API is no longer accepted.
+func (s *Server) CloseKeySessions(ctx context.Context, keyId string) {
+	s.authService.CloseKeySessions(ctx, keyId)
+}
+
 // Engine returns the underlying gin.Engine.
 func (s *Server) Engine() *gin.Engine {
 	return s.engine
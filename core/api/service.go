@@ -9,11 +9,17 @@ import (
 	"time"
 
 	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v4"
 
 	"github.com/anyproto/anytype-heart/core/anytype/account"
 	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/api/server"
+	"github.com/anyproto/anytype-heart/core/block/chats"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/fileobject"
+	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/pb/service"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
 )
 
 const (
@@ -28,15 +34,21 @@ var (
 type Service interface {
 	app.ComponentRunnable
 	ReassignAddress(ctx context.Context, listenAddr string) (err error)
+	CloseAppKeySessions(ctx context.Context, appHash string)
 }
 
 type apiService struct {
-	srv            *server.Server
-	httpSrv        *http.Server
-	mw             service.ClientCommandsServer
-	accountService account.Service
-	listenAddr     string
-	lock           sync.Mutex
+	srv                 *server.Server
+	httpSrv             *http.Server
+	mw                  service.ClientCommandsServer
+	accountService      account.Service
+	subscriptionService subscription.Service
+	fileService         files.Service
+	fileObjectService   fileobject.Service
+	chatService         chats.Service
+	db                  *badger.DB
+	listenAddr          string
+	lock                sync.Mutex
 }
 
 func New() Service {
@@ -66,6 +78,14 @@ func (s *apiService) Name() (name string) {
 func (s *apiService) Init(a *app.App) (err error) {
 	s.listenAddr = a.MustComponent(config.CName).(*config.Config).JsonApiListenAddr
 	s.accountService = a.MustComponent(account.CName).(account.Service)
+	s.subscriptionService = a.MustComponent(subscription.CName).(subscription.Service)
+	s.fileService = app.MustComponent[files.Service](a)
+	s.fileObjectService = app.MustComponent[fileobject.Service](a)
+	s.chatService = app.MustComponent[chats.Service](a)
+	s.db, err = app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get badger: %w", err)
+	}
 	return nil
 }
 
@@ -86,7 +106,10 @@ func (s *apiService) runServer() {
 		return
 	}
 
-	s.srv = server.NewServer(s.accountService, s.mw)
+	s.srv = server.NewServer(s.accountService, s.mw, s.subscriptionService, s.fileService, s.fileObjectService, s.chatService, s.db)
+	if err := s.srv.Run(); err != nil {
+		fmt.Printf("API server background services error: %v\n", err)
+	}
 	s.httpSrv = &http.Server{
 		Addr:              s.listenAddr,
 		Handler:           s.srv.Engine(),
@@ -109,14 +132,13 @@ func (s *apiService) shutdown(ctx context.Context) (err error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	// the http server is shut down even if background services fail to close, so the address is released
+	closeErr := s.srv.Close()
+
 	// we don't want graceful shutdown here and block the app close
 	shutdownCtx, cancel := context.WithTimeout(ctx, time.Millisecond)
 	defer cancel()
-	if err := s.httpSrv.Shutdown(shutdownCtx); err != nil {
-		return err
-	}
-
-	return nil
+	return errors.Join(closeErr, s.httpSrv.Shutdown(shutdownCtx))
 }
 
 func (s *apiService) ReassignAddress(ctx context.Context, listenAddr string) (err error) {
@@ -130,6 +152,15 @@ func (s *apiService) ReassignAddress(ctx context.Context, listenAddr string) (er
 	return nil
 }
 
+// CloseAppKeySessions closes the sessions of the revoked app key, which are cached by the server
+func (s *apiService) CloseAppKeySessions(ctx context.Context, appHash string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.srv != nil {
+		s.srv.CloseKeySessions(ctx, appHash)
+	}
+}
+
 func SetMiddlewareParams(mw service.ClientCommandsServer) {
 	mwSrv = mw
 }
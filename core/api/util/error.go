@@ -90,6 +90,15 @@ func CodeToAPIError(code int, message string) any {
 			},
 		}
 
+	case http.StatusForbidden:
+		return ForbiddenError{
+			Error: struct {
+				Message string `json:"message"`
+			}{
+				Message: message,
+			},
+		}
+
 	case http.StatusBadRequest:
 		return ValidationError{
 			Error: struct {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pb/service"
@@ -14,8 +16,10 @@ import (
 )
 
 var (
-	ErrFailedSearchType = errors.New("failed to search for type")
-	ErrorTypeNotFound   = errors.New("type not found")
+	ErrFailedSearchType     = errors.New("failed to search for type")
+	ErrorTypeNotFound       = errors.New("type not found")
+	ErrFailedSearchRelation = errors.New("failed to search for relation")
+	ErrorRelationNotFound   = errors.New("relation not found")
 )
 
 // GetIconFromEmojiOrImage returns the icon to use for the object, which can be either an emoji or an image url
@@ -88,3 +92,73 @@ func ResolveUniqueKeyToTypeId(mw service.ClientCommandsServer, spaceId string, u
 
 	return resp.Records[0].Fields[bundle.RelationKeyId.String()].GetStringValue(), nil
 }
+
+// ResolveRelationKeyToFormat returns the format of the relation of the space, e.g. "dueDate" to date
+func ResolveRelationKeyToFormat(mw service.ClientCommandsServer, spaceId string, relationKey string) (format model.RelationFormat, err error) {
+	resp := mw.ObjectSearch(context.Background(), &pb.RpcObjectSearchRequest{
+		SpaceId: spaceId,
+		Filters: []*model.BlockContentDataviewFilter{
+			{
+				RelationKey: bundle.RelationKeyLayout.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.Int64(int64(model.ObjectType_relation)),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationKey.String(),
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       pbtypes.String(relationKey),
+			},
+		},
+		Keys: []string{bundle.RelationKeyRelationFormat.String()},
+	})
+
+	if resp.Error.Code != pb.RpcObjectSearchResponseError_NULL {
+		return 0, ErrFailedSearchRelation
+	}
+
+	if len(resp.Records) == 0 {
+		return 0, ErrorRelationNotFound
+	}
+
+	return model.RelationFormat(resp.Records[0].Fields[bundle.RelationKeyRelationFormat.String()].GetNumberValue()), nil
+}
+
+// ParseDate parses a date given as RFC 3339 or YYYY-MM-DD. Dates without time are UTC midnight, as date relations keep them
+func ParseDate(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+type allowedSpacesKey struct{}
+
+// ContextWithAllowedSpaces returns a copy of ctx that restricts the request to the given spaces, an empty list allows all spaces
+func ContextWithAllowedSpaces(ctx context.Context, spaceIds []string) context.Context {
+	return context.WithValue(ctx, allowedSpacesKey{}, spaceIds)
+}
+
+type readOnlyKey struct{}
+
+// ContextWithReadOnly returns a copy of ctx that marks the request as made with a read-only app key
+func ContextWithReadOnly(ctx context.Context, readOnly bool) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, readOnly)
+}
+
+// IsReadOnly reports whether the request behind ctx is made with a read-only app key
+func IsReadOnly(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
+}
+
+// IsSpaceAllowed reports whether the request behind ctx is allowed to access the space
+func IsSpaceAllowed(ctx context.Context, spaceId string) bool {
+	if ctx == nil {
+		return true
+	}
+	spaceIds, _ := ctx.Value(allowedSpacesKey{}).([]string)
+	return len(spaceIds) == 0 || slices.Contains(spaceIds, spaceId)
+}
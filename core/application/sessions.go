@@ -1,10 +1,12 @@
 package application
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"github.com/anyproto/anytype-heart/core/api"
 	"github.com/anyproto/anytype-heart/core/event"
 	"github.com/anyproto/anytype-heart/core/session"
 	walletComp "github.com/anyproto/anytype-heart/core/wallet"
@@ -12,7 +14,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-func (s *Service) CreateSession(req *pb.RpcWalletCreateSessionRequest) (token string, accountId string, err error) {
+func (s *Service) CreateSession(req *pb.RpcWalletCreateSessionRequest) (token string, accountId string, appInfo *walletComp.AppLinkInfo, err error) {
 	// test if mnemonic is correct
 	mnemonic := req.GetMnemonic()
 	appKey := req.GetAppKey()
@@ -20,40 +22,53 @@ func (s *Service) CreateSession(req *pb.RpcWalletCreateSessionRequest) (token st
 	if appKey != "" {
 		app := s.GetApp()
 		if app == nil {
-			return "", "", ErrApplicationIsNotRunning
+			return "", "", nil, ErrApplicationIsNotRunning
 		}
 		wallet := app.Component(walletComp.CName)
 		if wallet == nil {
-			return "", "", fmt.Errorf("appToken auth not yet supported for the main app")
+			return "", "", nil, fmt.Errorf("appToken auth not yet supported for the main app")
 		}
 		w := wallet.(walletComp.Wallet)
 		appLink, err := w.ReadAppLink(appKey)
 		if err != nil {
-			return "", "", err
+			return "", "", nil, err
 		}
 		log.Infof("appLink auth %s", appLink.AppName)
 
+		appHash, err := walletComp.AppLinkHash(appKey)
+		if err != nil {
+			return "", "", nil, err
+		}
+
 		token, err := s.sessions.StartSession(s.sessionSigningKey, model.AccountAuthLocalApiScope(appLink.Scope)) // nolint:gosec
 		if err != nil {
-			return "", "", err
+			return "", "", nil, err
 		}
-		return token, w.Account().SignKey.GetPublic().Account(), nil
+		return token, w.Account().SignKey.GetPublic().Account(), &walletComp.AppLinkInfo{
+			AppHash:   appHash,
+			AppName:   appLink.AppName,
+			CreatedAt: appLink.CreatedAt,
+			ExpireAt:  appLink.ExpireAt,
+			Scope:     appLink.Scope,
+			ReadOnly:  appLink.ReadOnly,
+			SpaceIds:  appLink.SpaceIds,
+		}, nil
 	}
 
 	if s.mnemonic == "" {
 		// todo: rewrite this after appKey auth is implemented
 		// we can derive and check the account in this case
-		return "", "", errors.Join(ErrBadInput, fmt.Errorf("app authed without mnemonic"))
+		return "", "", nil, errors.Join(ErrBadInput, fmt.Errorf("app authed without mnemonic"))
 	}
 	if s.mnemonic != mnemonic {
-		return "", "", errors.Join(ErrBadInput, fmt.Errorf("incorrect mnemonic"))
+		return "", "", nil, errors.Join(ErrBadInput, fmt.Errorf("incorrect mnemonic"))
 	}
 	token, err = s.sessions.StartSession(s.sessionSigningKey, model.AccountAuth_Full)
 	if err != nil {
-		return "", "", err
+		return "", "", nil, err
 	}
 	// todo: account is empty, to be implemented with GO-1854
-	return token, "", nil
+	return token, "", nil, nil
 }
 
 func (s *Service) CloseSession(req *pb.RpcWalletCloseSessionRequest) error {
@@ -67,12 +82,12 @@ func (s *Service) ValidateSessionToken(token string) (model.AccountAuthLocalApiS
 	return s.sessions.ValidateToken(s.sessionSigningKey, token)
 }
 
-func (s *Service) LinkLocalStartNewChallenge(scope model.AccountAuthLocalApiScope, clientInfo *pb.EventAccountLinkChallengeClientInfo) (id string, err error) {
+func (s *Service) LinkLocalStartNewChallenge(scope model.AccountAuthLocalApiScope, clientInfo *pb.EventAccountLinkChallengeClientInfo, permissions session.AppPermissions) (id string, err error) {
 	if s.app == nil {
 		return "", ErrApplicationIsNotRunning
 	}
 
-	id, value, err := s.sessions.StartNewChallenge(scope, clientInfo)
+	id, value, err := s.sessions.StartNewChallenge(scope, clientInfo, permissions)
 	if err != nil {
 		return "", err
 	}
@@ -81,6 +96,8 @@ func (s *Service) LinkLocalStartNewChallenge(scope model.AccountAuthLocalApiScop
 			Challenge:  value,
 			ClientInfo: clientInfo,
 			Scope:      scope,
+			ReadOnly:   permissions.ReadOnly,
+			SpaceIds:   permissions.SpaceIds,
 		},
 	}))
 	return id, nil
@@ -90,7 +107,7 @@ func (s *Service) LinkLocalSolveChallenge(req *pb.RpcAccountLocalLinkSolveChalle
 	if s.app == nil {
 		return "", "", ErrApplicationIsNotRunning
 	}
-	clientInfo, token, scope, err := s.sessions.SolveChallenge(req.ChallengeId, req.Answer, s.sessionSigningKey)
+	clientInfo, token, scope, permissions, err := s.sessions.SolveChallenge(req.ChallengeId, req.Answer, s.sessionSigningKey)
 	if err != nil {
 		return "", "", err
 	}
@@ -100,6 +117,8 @@ func (s *Service) LinkLocalSolveChallenge(req *pb.RpcAccountLocalLinkSolveChalle
 		AppPath:   clientInfo.ProcessPath,
 		CreatedAt: time.Now().Unix(),
 		Scope:     int(scope),
+		ReadOnly:  permissions.ReadOnly,
+		SpaceIds:  permissions.SpaceIds,
 	})
 
 	s.eventSender.Broadcast(event.NewEventSingleMessage("", &pb.EventMessageValueOfAccountLinkChallengeHide{
@@ -109,3 +128,24 @@ func (s *Service) LinkLocalSolveChallenge(req *pb.RpcAccountLocalLinkSolveChalle
 	}))
 	return
 }
+
+func (s *Service) LinkLocalListApps() ([]*walletComp.AppLinkInfo, error) {
+	if s.app == nil {
+		return nil, ErrApplicationIsNotRunning
+	}
+	wallet := s.app.Component(walletComp.CName).(walletComp.Wallet)
+	return wallet.ListAppLinks()
+}
+
+func (s *Service) LinkLocalRevokeApp(req *pb.RpcAccountLocalLinkRevokeAppRequest) error {
+	if s.app == nil {
+		return ErrApplicationIsNotRunning
+	}
+	wallet := s.app.Component(walletComp.CName).(walletComp.Wallet)
+	if err := wallet.RevokeAppLink(req.AppHash); err != nil {
+		return err
+	}
+	// the api caches sessions by app keys, so they are closed to stop accepting the revoked key right away
+	s.app.MustComponent(api.CName).(api.Service).CloseAppKeySessions(context.Background(), req.AppHash)
+	return nil
+}
@@ -1,10 +1,15 @@
 package application
 
 import (
+	"context"
 	"testing"
 
+	"github.com/anyproto/any-sync/app"
 	"github.com/stretchr/testify/require"
 
+	"github.com/anyproto/anytype-heart/core/api"
+	walletComp "github.com/anyproto/anytype-heart/core/wallet"
+	"github.com/anyproto/anytype-heart/core/wallet/mock_wallet"
 	"github.com/anyproto/anytype-heart/pb"
 )
 
@@ -13,7 +18,7 @@ func TestCreateSession(t *testing.T) {
 		t.Run("with not initialized app expect error", func(t *testing.T) {
 			s := New()
 
-			_, _, err := s.CreateSession(&pb.RpcWalletCreateSessionRequest{
+			_, _, _, err := s.CreateSession(&pb.RpcWalletCreateSessionRequest{
 				Auth: &pb.RpcWalletCreateSessionRequestAuthOfAppKey{
 					AppKey: "appKey",
 				},
@@ -23,3 +28,54 @@ func TestCreateSession(t *testing.T) {
 		})
 	})
 }
+
+type apiServiceStub struct {
+	api.Service
+	closedAppHashes []string
+}
+
+func (a *apiServiceStub) Name() string {
+	return api.CName
+}
+
+func (a *apiServiceStub) CloseAppKeySessions(ctx context.Context, appHash string) {
+	a.closedAppHashes = append(a.closedAppHashes, appHash)
+}
+
+func TestLinkLocalRevokeApp(t *testing.T) {
+	t.Run("revoked app key is no longer accepted by the api", func(t *testing.T) {
+		// given
+		wallet := mock_wallet.NewMockWallet(t)
+		wallet.EXPECT().Name().Return(walletComp.CName).Maybe()
+		wallet.EXPECT().RevokeAppLink("appHash").Return(nil)
+		apiService := &apiServiceStub{}
+		s := New()
+		s.app = new(app.App)
+		s.app.Register(wallet).Register(apiService)
+
+		// when
+		err := s.LinkLocalRevokeApp(&pb.RpcAccountLocalLinkRevokeAppRequest{AppHash: "appHash"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, []string{"appHash"}, apiService.closedAppHashes)
+	})
+
+	t.Run("api sessions are kept when revocation fails", func(t *testing.T) {
+		// given
+		wallet := mock_wallet.NewMockWallet(t)
+		wallet.EXPECT().Name().Return(walletComp.CName).Maybe()
+		wallet.EXPECT().RevokeAppLink("appHash").Return(walletComp.ErrAppLinkNotFound)
+		apiService := &apiServiceStub{}
+		s := New()
+		s.app = new(app.App)
+		s.app.Register(wallet).Register(apiService)
+
+		// when
+		err := s.LinkLocalRevokeApp(&pb.RpcAccountLocalLinkRevokeAppRequest{AppHash: "appHash"})
+
+		// then
+		require.ErrorIs(t, err, walletComp.ErrAppLinkNotFound)
+		require.Empty(t, apiService.closedAppHashes)
+	})
+}
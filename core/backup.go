@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/backup"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) BackupSettingsSet(_ context.Context, req *pb.RpcBackupSettingsSetRequest) *pb.RpcBackupSettingsSetResponse {
+	err := mustService[backup.Service](mw).SetSettings(req.Settings)
+	code := mapErrorCode(err,
+		errToCode(backup.ErrNotConfigured, pb.RpcBackupSettingsSetResponseError_BAD_INPUT),
+	)
+	return &pb.RpcBackupSettingsSetResponse{
+		Error: &pb.RpcBackupSettingsSetResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) BackupSettingsGet(_ context.Context, _ *pb.RpcBackupSettingsGetRequest) *pb.RpcBackupSettingsGetResponse {
+	settings, lastBackupDate, err := mustService[backup.Service](mw).GetSettings()
+	code := mapErrorCode[pb.RpcBackupSettingsGetResponseErrorCode](err)
+	return &pb.RpcBackupSettingsGetResponse{
+		Error: &pb.RpcBackupSettingsGetResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+		Settings:       settings,
+		LastBackupDate: lastBackupDate,
+	}
+}
+
+func (mw *Middleware) BackupRun(ctx context.Context, _ *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse {
+	archives, err := mustService[backup.Service](mw).Backup(ctx)
+	code := mapErrorCode(err,
+		errToCode(backup.ErrNotConfigured, pb.RpcBackupRunResponseError_BAD_INPUT),
+	)
+	return &pb.RpcBackupRunResponse{
+		Error: &pb.RpcBackupRunResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+		Archives: archives,
+	}
+}
+
+func (mw *Middleware) BackupList(_ context.Context, req *pb.RpcBackupListRequest) *pb.RpcBackupListResponse {
+	archives, err := mustService[backup.Service](mw).List(req.SpaceId)
+	code := mapErrorCode[pb.RpcBackupListResponseErrorCode](err)
+	return &pb.RpcBackupListResponse{
+		Error: &pb.RpcBackupListResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+		Archives: archives,
+	}
+}
@@ -0,0 +1,394 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"github.com/anyproto/any-sync/util/periodicsync"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/export"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const CName = "core.backup"
+
+const (
+	checkIntervalSecs = 60
+	defaultInterval   = 24 * time.Hour
+	retryDelay        = 5 * time.Minute
+	archiveExt        = ".zip"
+	stateKey          = "state"
+)
+
+var log = logging.Logger("anytype-mw-backup")
+
+var (
+	ErrNotConfigured = errors.New("backup directory or spaces are not configured")
+	ErrInProgress    = errors.New("backup is already in progress")
+)
+
+// Service periodically exports the configured spaces into protobuf archives, which can be imported back
+type Service interface {
+	SetSettings(settings *pb.RpcBackupSettings) error
+	GetSettings() (settings *pb.RpcBackupSettings, lastBackupDate int64, err error)
+	// Backup exports all configured spaces right away and returns the created archives
+	Backup(ctx context.Context) ([]*pb.RpcBackupArchive, error)
+	// List returns the archives of the space, or of all configured spaces when spaceId is empty, newest first
+	List(spaceId string) ([]*pb.RpcBackupArchive, error)
+	app.ComponentRunnable
+}
+
+type storedState struct {
+	Settings       *pb.RpcBackupSettings `json:"settings"`
+	LastBackupDate int64                 `json:"lastBackupDate"`
+	// LastAttemptDate is the time of the last backup, successful or not
+	LastAttemptDate int64 `json:"lastAttemptDate"`
+	// FailedSpaceIds are the spaces failed in the last backup, only they are retried with the growing delay
+	FailedSpaceIds []string `json:"failedSpaceIds,omitempty"`
+	FailedAttempts int      `json:"failedAttempts,omitempty"`
+}
+
+type service struct {
+	exporter            export.Export
+	processService      process.Service
+	notificationService notifications.Notifications
+	store               keyvaluestore.Store[*storedState]
+	periodicSync        periodicsync.PeriodicSync
+
+	mu      sync.Mutex
+	state   *storedState
+	running sync.Mutex
+	now     func() time.Time
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.exporter = app.MustComponent[export.Export](a)
+	s.processService = app.MustComponent[process.Service](a)
+	s.notificationService = app.MustComponent[notifications.Notifications](a)
+	db, err := app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get badger: %w", err)
+	}
+	s.store = keyvaluestore.NewJson[*storedState](db, []byte("backup/"))
+	s.periodicSync = periodicsync.NewPeriodicSync(checkIntervalSecs, 0, s.backupIfDue, logger.CtxLogger{Logger: log.Desugar()})
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) error {
+	state, err := s.store.Get(stateKey)
+	if err != nil && !errors.Is(err, keyvaluestore.ErrNotFound) {
+		return fmt.Errorf("load backup settings: %w", err)
+	}
+	if state == nil || state.Settings == nil {
+		state = &storedState{Settings: &pb.RpcBackupSettings{}}
+	}
+	s.mu.Lock()
+	s.state = state
+	s.mu.Unlock()
+	s.periodicSync.Run()
+	return nil
+}
+
+func (s *service) Close(ctx context.Context) error {
+	if s.periodicSync != nil {
+		s.periodicSync.Close()
+	}
+	return nil
+}
+
+func (s *service) SetSettings(settings *pb.RpcBackupSettings) error {
+	if settings == nil {
+		return fmt.Errorf("settings are empty")
+	}
+	if settings.Enabled && (settings.Path == "" || len(settings.SpaceIds) == 0) {
+		return ErrNotConfigured
+	}
+	if settings.IntervalSeconds < 0 {
+		return fmt.Errorf("negative backup interval")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := *s.state
+	state.Settings = settings
+	if err := s.store.Set(stateKey, &state); err != nil {
+		return err
+	}
+	s.state = &state
+	return nil
+}
+
+func (s *service) GetSettings() (*pb.RpcBackupSettings, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.Settings, s.state.LastBackupDate, nil
+}
+
+func (s *service) getState() storedState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return *s.state
+}
+
+func (s *service) backupIfDue(ctx context.Context) error {
+	state := s.getState()
+	if !state.Settings.Enabled {
+		return nil
+	}
+	interval := time.Duration(state.Settings.IntervalSeconds) * time.Second
+	if interval == 0 {
+		interval = defaultInterval
+	}
+	var (
+		spaceIds = state.Settings.SpaceIds
+		since    = time.Unix(state.LastBackupDate, 0)
+	)
+	if failed := configuredSpaces(state.Settings, state.FailedSpaceIds); len(failed) > 0 {
+		// failed spaces are retried with the doubling delay, up to the backup interval
+		delay := retryDelay << min(max(state.FailedAttempts-1, 0), 10)
+		interval = min(delay, interval)
+		spaceIds = failed
+		since = time.Unix(state.LastAttemptDate, 0)
+	}
+	if s.now().Sub(since) < interval {
+		return nil
+	}
+	_, err := s.backup(ctx, spaceIds)
+	if errors.Is(err, ErrInProgress) {
+		return nil
+	}
+	return err
+}
+
+// configuredSpaces returns the spaces which are still configured for backup
+func configuredSpaces(settings *pb.RpcBackupSettings, spaceIds []string) []string {
+	var result []string
+	for _, spaceId := range spaceIds {
+		if slices.Contains(settings.SpaceIds, spaceId) {
+			result = append(result, spaceId)
+		}
+	}
+	return result
+}
+
+func (s *service) Backup(ctx context.Context) ([]*pb.RpcBackupArchive, error) {
+	return s.backup(ctx, s.getState().Settings.SpaceIds)
+}
+
+func (s *service) backup(ctx context.Context, spaceIds []string) ([]*pb.RpcBackupArchive, error) {
+	if !s.running.TryLock() {
+		return nil, ErrInProgress
+	}
+	defer s.running.Unlock()
+
+	settings := s.getState().Settings
+	if settings.Path == "" || len(spaceIds) == 0 {
+		return nil, ErrNotConfigured
+	}
+
+	progress := process.NewNotificationProcess(&pb.ModelProcessMessageOfExport{Export: &pb.ModelProcessExport{}}, s.notificationService)
+	if err := s.processService.Add(progress); err != nil {
+		return nil, err
+	}
+	progress.SetTotal(int64(len(spaceIds)))
+	progress.SetProgressMessage("backup spaces")
+
+	var (
+		archives []*pb.RpcBackupArchive
+		failed   []string
+		errs     []error
+	)
+	for _, spaceId := range spaceIds {
+		archive, err := s.backupSpace(ctx, settings, spaceId)
+		if err != nil {
+			log.With("spaceId", spaceId).Errorf("failed to backup space: %v", err)
+			failed = append(failed, spaceId)
+			errs = append(errs, fmt.Errorf("backup space %s: %w", spaceId, err))
+		} else {
+			archives = append(archives, archive)
+			s.rotate(settings, spaceId)
+		}
+		progress.AddDone(1)
+	}
+	err := errors.Join(errs...)
+	alreadyFailed := s.saveAttempt(failed)
+	if err != nil {
+		// the failure is notified once, not on every retry
+		notificationProgress, ok := progress.(process.Notificationable)
+		if ok && !alreadyFailed {
+			notificationProgress.FinishWithNotification(s.failureNotification(), err)
+		} else {
+			progress.Finish(err)
+		}
+		return archives, err
+	}
+	progress.Finish(nil)
+	return archives, nil
+}
+
+// saveAttempt records the backup attempt with the failed spaces and reports whether the previous attempt failed too
+func (s *service) saveAttempt(failed []string) (alreadyFailed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state := *s.state
+	alreadyFailed = len(state.FailedSpaceIds) > 0
+	state.LastAttemptDate = s.now().Unix()
+	state.FailedSpaceIds = failed
+	if len(failed) > 0 {
+		state.FailedAttempts++
+	} else {
+		state.FailedAttempts = 0
+		state.LastBackupDate = state.LastAttemptDate
+	}
+	if err := s.store.Set(stateKey, &state); err != nil {
+		log.Errorf("failed to save backup state: %v", err)
+	}
+	s.state = &state
+	return alreadyFailed
+}
+
+func (s *service) backupSpace(ctx context.Context, settings *pb.RpcBackupSettings, spaceId string) (*pb.RpcBackupArchive, error) {
+	dir := filepath.Join(settings.Path, spaceId)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	path, _, err := s.exporter.Export(ctx, pb.RpcObjectListExportRequest{
+		SpaceId:         spaceId,
+		Path:            dir,
+		Format:          model.Export_Protobuf,
+		Zip:             true,
+		IncludeNested:   true,
+		IncludeFiles:    true,
+		IncludeArchived: true,
+		NoProgress:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newArchive(spaceId, path)
+}
+
+func (s *service) failureNotification() *model.Notification {
+	return &model.Notification{
+		Id:      uuid.New().String(),
+		Status:  model.Notification_Created,
+		IsLocal: true,
+		Payload: &model.NotificationPayloadOfExport{Export: &model.NotificationExport{
+			ErrorCode:  model.NotificationExport_UNKNOWN_ERROR,
+			ExportType: model.Export_Protobuf,
+		}},
+	}
+}
+
+// rotate removes the archives beyond the configured count and age, the newest archive is always kept
+func (s *service) rotate(settings *pb.RpcBackupSettings, spaceId string) {
+	archives, err := listArchives(settings.Path, spaceId)
+	if err != nil {
+		log.With("spaceId", spaceId).Errorf("failed to list archives for rotation: %v", err)
+		return
+	}
+	maxAge := time.Duration(settings.KeepDays) * 24 * time.Hour
+	for i, archive := range archives {
+		if i == 0 {
+			continue
+		}
+		tooMany := settings.KeepCount > 0 && i >= int(settings.KeepCount)
+		tooOld := settings.KeepDays > 0 && s.now().Sub(time.Unix(archive.CreatedDate, 0)) > maxAge
+		if !tooMany && !tooOld {
+			continue
+		}
+		if err = os.Remove(archive.Path); err != nil {
+			log.With("spaceId", spaceId).Errorf("failed to remove old archive: %v", err)
+		}
+	}
+}
+
+func (s *service) List(spaceId string) ([]*pb.RpcBackupArchive, error) {
+	settings := s.getState().Settings
+	if settings.Path == "" {
+		return nil, nil
+	}
+	spaceIds := settings.SpaceIds
+	if spaceId != "" {
+		spaceIds = []string{spaceId}
+	}
+	var archives []*pb.RpcBackupArchive
+	for _, id := range spaceIds {
+		spaceArchives, err := listArchives(settings.Path, id)
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, spaceArchives...)
+	}
+	sortNewestFirst(archives)
+	return archives, nil
+}
+
+func listArchives(root, spaceId string) ([]*pb.RpcBackupArchive, error) {
+	entries, err := os.ReadDir(filepath.Join(root, spaceId))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	archives := make([]*pb.RpcBackupArchive, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), archiveExt) {
+			continue
+		}
+		archive, err := newArchive(spaceId, filepath.Join(root, spaceId, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, archive)
+	}
+	sortNewestFirst(archives)
+	return archives, nil
+}
+
+func newArchive(spaceId, path string) (*pb.RpcBackupArchive, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RpcBackupArchive{
+		SpaceId:     spaceId,
+		Path:        path,
+		CreatedDate: info.ModTime().Unix(),
+		Size_:       info.Size(),
+	}, nil
+}
+
+func sortNewestFirst(archives []*pb.RpcBackupArchive) {
+	sort.SliceStable(archives, func(i, j int) bool {
+		if archives[i].CreatedDate != archives[j].CreatedDate {
+			return archives[i].CreatedDate > archives[j].CreatedDate
+		}
+		return archives[i].Path > archives[j].Path
+	})
+}
@@ -0,0 +1,254 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/event/mock_event"
+	"github.com/anyproto/anytype-heart/core/notifications/mock_notifications"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/tests/testutil"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+type testExporter struct {
+	requests       []pb.RpcObjectListExportRequest
+	err            error
+	failedSpaceIds []string
+}
+
+func (e *testExporter) Init(*app.App) error { return nil }
+
+func (e *testExporter) Name() string { return "export" }
+
+func (e *testExporter) Export(_ context.Context, req pb.RpcObjectListExportRequest) (string, int, error) {
+	e.requests = append(e.requests, req)
+	if e.err != nil {
+		return "", 0, e.err
+	}
+	if slices.Contains(e.failedSpaceIds, req.SpaceId) {
+		return "", 0, fmt.Errorf("export failed")
+	}
+	path := filepath.Join(req.Path, fmt.Sprintf("Anytype.%d.zip", len(e.requests)))
+	return path, 1, os.WriteFile(path, []byte("archive"), 0600)
+}
+
+type fixture struct {
+	*service
+	exporter      *testExporter
+	notifications *mock_notifications.MockNotifications
+	now           time.Time
+}
+
+func newFixture(t *testing.T) *fixture {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	a := &app.App{}
+	sender := mock_event.NewMockSender(t)
+	sender.EXPECT().Broadcast(mock.Anything).Return().Maybe()
+	a.Register(testutil.PrepareMock(context.Background(), a, sender))
+	processService := process.New()
+	require.NoError(t, processService.Init(a))
+
+	fx := &fixture{
+		exporter:      &testExporter{},
+		notifications: mock_notifications.NewMockNotifications(t),
+		now:           time.Date(2024, 5, 10, 3, 0, 0, 0, time.UTC),
+	}
+	fx.service = &service{
+		exporter:            fx.exporter,
+		processService:      processService,
+		notificationService: fx.notifications,
+		store:               keyvaluestore.NewJson[*storedState](db, []byte("backup/")),
+		state:               &storedState{Settings: &pb.RpcBackupSettings{}},
+		now:                 func() time.Time { return fx.now },
+	}
+	return fx
+}
+
+func writeArchive(t *testing.T, path string, modTime time.Time) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte("archive"), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestService_Backup(t *testing.T) {
+	t.Run("export spaces and rotate old archives", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		dir := t.TempDir()
+		writeArchive(t, filepath.Join(dir, "space1", "old1.zip"), fx.now.Add(-48*time.Hour))
+		writeArchive(t, filepath.Join(dir, "space1", "old2.zip"), fx.now.Add(-72*time.Hour))
+		require.NoError(t, fx.SetSettings(&pb.RpcBackupSettings{
+			Enabled:   true,
+			SpaceIds:  []string{"space1", "space2"},
+			Path:      dir,
+			KeepCount: 2,
+		}))
+
+		// when
+		archives, err := fx.Backup(context.Background())
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, archives, 2)
+		assert.Equal(t, filepath.Join(dir, "space1", "Anytype.1.zip"), archives[0].Path)
+		assert.Equal(t, filepath.Join(dir, "space2", "Anytype.2.zip"), archives[1].Path)
+		for _, req := range fx.exporter.requests {
+			assert.Equal(t, model.Export_Protobuf, req.Format)
+			assert.True(t, req.Zip)
+			assert.True(t, req.IncludeFiles)
+		}
+
+		_, err = os.Stat(filepath.Join(dir, "space1", "old1.zip"))
+		assert.NoError(t, err)
+		_, err = os.Stat(filepath.Join(dir, "space1", "old2.zip"))
+		assert.True(t, os.IsNotExist(err))
+
+		_, lastBackupDate, err := fx.GetSettings()
+		require.NoError(t, err)
+		assert.Equal(t, fx.now.Unix(), lastBackupDate)
+	})
+
+	t.Run("failed export sends notification", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.exporter.err = fmt.Errorf("export failed")
+		require.NoError(t, fx.SetSettings(&pb.RpcBackupSettings{SpaceIds: []string{"space1"}, Path: t.TempDir()}))
+		sent := make(chan *model.Notification, 1)
+		fx.notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(notification *model.Notification) error {
+			sent <- notification
+			return nil
+		})
+
+		// when
+		_, err := fx.Backup(context.Background())
+
+		// then
+		assert.Error(t, err)
+		select {
+		case notification := <-sent:
+			assert.Equal(t, model.NotificationExport_UNKNOWN_ERROR, notification.GetExport().ErrorCode)
+		case <-time.After(time.Second):
+			t.Fatal("notification is not sent")
+		}
+		_, lastBackupDate, err := fx.GetSettings()
+		require.NoError(t, err)
+		assert.Zero(t, lastBackupDate)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		// when
+		_, err := fx.Backup(context.Background())
+
+		// then
+		assert.ErrorIs(t, err, ErrNotConfigured)
+	})
+}
+
+func TestService_backupIfDue(t *testing.T) {
+	t.Run("skip when interval has not passed", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.state = &storedState{
+			Settings:       &pb.RpcBackupSettings{Enabled: true, SpaceIds: []string{"space1"}, Path: t.TempDir(), IntervalSeconds: 3600},
+			LastBackupDate: fx.now.Add(-30 * time.Minute).Unix(),
+		}
+
+		// when
+		err := fx.backupIfDue(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, fx.exporter.requests)
+	})
+
+	t.Run("backup when interval has passed", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.state = &storedState{
+			Settings:       &pb.RpcBackupSettings{Enabled: true, SpaceIds: []string{"space1"}, Path: t.TempDir(), IntervalSeconds: 3600},
+			LastBackupDate: fx.now.Add(-2 * time.Hour).Unix(),
+		}
+
+		// when
+		err := fx.backupIfDue(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, fx.exporter.requests, 1)
+	})
+
+	t.Run("retry only failed spaces with growing delay", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.state = &storedState{
+			Settings: &pb.RpcBackupSettings{Enabled: true, SpaceIds: []string{"space1", "space2"}, Path: t.TempDir(), IntervalSeconds: 3600},
+		}
+		fx.exporter.failedSpaceIds = []string{"space2"}
+		fx.notifications.EXPECT().CreateAndSend(mock.Anything).Return(nil).Once()
+		require.Error(t, fx.backupIfDue(context.Background()))
+		require.Len(t, fx.exporter.requests, 2)
+
+		// when
+		fx.now = fx.now.Add(time.Minute)
+		require.NoError(t, fx.backupIfDue(context.Background()))
+		fx.now = fx.now.Add(5 * time.Minute)
+		require.Error(t, fx.backupIfDue(context.Background()))
+		fx.now = fx.now.Add(5 * time.Minute)
+		require.NoError(t, fx.backupIfDue(context.Background()))
+		fx.exporter.failedSpaceIds = nil
+		fx.now = fx.now.Add(5 * time.Minute)
+		require.NoError(t, fx.backupIfDue(context.Background()))
+
+		// then
+		require.Len(t, fx.exporter.requests, 4)
+		assert.Equal(t, "space2", fx.exporter.requests[2].SpaceId)
+		assert.Equal(t, "space2", fx.exporter.requests[3].SpaceId)
+		_, lastBackupDate, err := fx.GetSettings()
+		require.NoError(t, err)
+		assert.Equal(t, fx.now.Unix(), lastBackupDate)
+		assert.Empty(t, fx.state.FailedSpaceIds)
+	})
+}
+
+func TestService_List(t *testing.T) {
+	// given
+	fx := newFixture(t)
+	dir := t.TempDir()
+	writeArchive(t, filepath.Join(dir, "space1", "a.zip"), fx.now.Add(-2*time.Hour))
+	writeArchive(t, filepath.Join(dir, "space2", "b.zip"), fx.now.Add(-time.Hour))
+	writeArchive(t, filepath.Join(dir, "space2", "notes.txt"), fx.now)
+	require.NoError(t, fx.SetSettings(&pb.RpcBackupSettings{SpaceIds: []string{"space1", "space2"}, Path: dir}))
+
+	// when
+	all, err := fx.List("")
+	require.NoError(t, err)
+	space1, err := fx.List("space1")
+	require.NoError(t, err)
+
+	// then
+	require.Len(t, all, 2)
+	assert.Equal(t, "space2", all[0].SpaceId)
+	assert.Equal(t, "space1", all[1].SpaceId)
+	require.Len(t, space1, 1)
+	assert.Equal(t, filepath.Join(dir, "space1", "a.zip"), space1[0].Path)
+}
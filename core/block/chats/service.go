@@ -2,6 +2,7 @@ package chats
 
 import (
 	"context"
+	"sync"
 
 	"github.com/anyproto/any-sync/app"
 
@@ -22,18 +23,28 @@ type Service interface {
 	GetMessagesByIds(ctx context.Context, chatObjectId string, messageIds []string) ([]*model.ChatMessage, error)
 	SubscribeLastMessages(ctx context.Context, chatObjectId string, limit int) ([]*model.ChatMessage, int, error)
 	Unsubscribe(chatObjectId string) error
+	// SubscribeMessages calls the handler for every added message, including the ones received from other participants.
+	// The handler must not block, as it's called while the message is being added
+	SubscribeMessages(handler MessageHandler) (unsubscribe func())
 
+	chatobject.MessageObserver
 	app.Component
 }
 
+type MessageHandler func(spaceId string, chatObjectId string, message *model.ChatMessage)
+
 var _ Service = (*service)(nil)
 
 type service struct {
 	objectGetter cache.ObjectGetter
+
+	handlersLock  sync.Mutex
+	handlers      map[int]MessageHandler
+	lastHandlerId int
 }
 
 func New() Service {
-	return &service{}
+	return &service{handlers: map[int]MessageHandler{}}
 }
 
 func (s *service) Name() string {
@@ -121,3 +132,28 @@ func (s *service) Unsubscribe(chatObjectId string) error {
 		return sb.Unsubscribe()
 	})
 }
+
+func (s *service) SubscribeMessages(handler MessageHandler) (unsubscribe func()) {
+	s.handlersLock.Lock()
+	defer s.handlersLock.Unlock()
+	s.lastHandlerId++
+	id := s.lastHandlerId
+	s.handlers[id] = handler
+	return func() {
+		s.handlersLock.Lock()
+		defer s.handlersLock.Unlock()
+		delete(s.handlers, id)
+	}
+}
+
+func (s *service) ChatMessageAdded(spaceId string, chatObjectId string, message *model.ChatMessage) {
+	s.handlersLock.Lock()
+	handlers := make([]MessageHandler, 0, len(s.handlers))
+	for _, handler := range s.handlers {
+		handlers = append(handlers, handler)
+	}
+	s.handlersLock.Unlock()
+	for _, handler := range handlers {
+		handler(spaceId, chatObjectId, message)
+	}
+}
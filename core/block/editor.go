@@ -656,6 +656,43 @@ func (s *Service) TableColumnListFill(ctx session.Context, req pb.RpcBlockTableC
 	return err
 }
 
+// TableToCsv uploads the content of the table block as a csv file object and returns its id
+func (s *Service) TableToCsv(ctx context.Context, req pb.RpcBlockTableToCsvRequest) (string, error) {
+	var (
+		data    []byte
+		name    string
+		spaceId string
+	)
+	err := cache.Do(s, req.ContextId, func(sb smartblock.SmartBlock) error {
+		// NewTable normalizes the state it reads, so work on a copy that is never applied
+		tb, err := table.NewTable(sb.NewState(), req.BlockId)
+		if err != nil {
+			return err
+		}
+		if data, err = tb.CSV(); err != nil {
+			return err
+		}
+		name = sb.Details().GetString(bundle.RelationKeyName)
+		spaceId = sb.SpaceID()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		name = "table"
+	}
+	res := s.fileUploaderService.NewUploader(spaceId, objectorigin.None()).
+		SetBytes(data).
+		SetName(name + ".csv").
+		SetType(model.BlockContentFile_File).
+		Upload(ctx)
+	if res.Err != nil {
+		return "", res.Err
+	}
+	return res.FileObjectId, nil
+}
+
 func (s *Service) CreateWidgetBlock(ctx session.Context, req *pb.RpcBlockCreateWidgetRequest) (string, error) {
 	var id string
 	err := cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, w widget.Widget) error {
@@ -6,6 +6,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 type IHistory interface {
@@ -51,6 +52,10 @@ func (h *history) Undo(ctx session.Context) (info HistoryInfo, err error) {
 	if action.Details != nil {
 		s.SetDetails(action.Details.Before.Copy())
 	}
+	if action.Store != nil {
+		// only keys changed by the action are reverted, so store updates made without history are kept
+		s.SetStoreKeys(action.Store.Before, pbtypes.StructDiffKeys(action.Store.Before, action.Store.After)...)
+	}
 	if err = h.Apply(s, smartblock.NoHistory, smartblock.NoRestrictions); err != nil {
 		return
 	}
@@ -83,6 +88,9 @@ func (h *history) Redo(ctx session.Context) (info HistoryInfo, err error) {
 	if action.Details != nil {
 		s.SetDetails(action.Details.After.Copy())
 	}
+	if action.Store != nil {
+		s.SetStoreKeys(action.Store.After, pbtypes.StructDiffKeys(action.Store.Before, action.Store.After)...)
+	}
 	if err = h.Apply(s, smartblock.NoHistory, smartblock.NoRestrictions); err != nil {
 		return
 	}
@@ -53,6 +53,12 @@ type AccountService interface {
 	AccountID() string
 }
 
+// MessageObserver is notified about added messages, including the ones received from other participants.
+// It is called in the background after the messages are committed
+type MessageObserver interface {
+	ChatMessageAdded(spaceId string, chatObjectId string, message *model.ChatMessage)
+}
+
 type storeObject struct {
 	anystoredebug.AnystoreDebug
 	smartblock.SmartBlock
@@ -64,11 +70,12 @@ type storeObject struct {
 	eventSender    event.Sender
 	subscription   *subscription
 	crdtDb         anystore.DB
+	observer       MessageObserver
 
 	arenaPool *anyenc.ArenaPool
 }
 
-func New(sb smartblock.SmartBlock, accountService AccountService, eventSender event.Sender, crdtDb anystore.DB) StoreObject {
+func New(sb smartblock.SmartBlock, accountService AccountService, eventSender event.Sender, crdtDb anystore.DB, observer MessageObserver) StoreObject {
 	return &storeObject{
 		SmartBlock:     sb,
 		locker:         sb.(smartblock.Locker),
@@ -76,6 +83,7 @@ func New(sb smartblock.SmartBlock, accountService AccountService, eventSender ev
 		arenaPool:      &anyenc.ArenaPool{},
 		eventSender:    eventSender,
 		crdtDb:         crdtDb,
+		observer:       observer,
 	}
 }
 
@@ -84,7 +92,7 @@ func (s *storeObject) Init(ctx *smartblock.InitContext) error {
 	if err != nil {
 		return err
 	}
-	s.subscription = newSubscription(s.SpaceID(), s.Id(), s.eventSender)
+	s.subscription = newSubscription(s.SpaceID(), s.Id(), s.eventSender, s.observer)
 
 	stateStore, err := storestate.New(ctx.Ctx, s.Id(), s.crdtDb, ChatHandler{
 		subscription: s.subscription,
@@ -103,6 +111,8 @@ func (s *storeObject) Init(ctx *smartblock.InitContext) error {
 	if err != nil {
 		return fmt.Errorf("read store doc: %w", err)
 	}
+	// messages of the tree read for the first time are committed without the update hook
+	s.subscription.flush()
 
 	s.AnystoreDebug = anystoredebug.New(s.SmartBlock, stateStore)
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	anystore "github.com/anyproto/any-store"
 	"github.com/globalsign/mgo/bson"
@@ -58,7 +59,7 @@ func newFixture(t *testing.T) *fixture {
 
 	sb := smarttest.New("chatId1")
 
-	object := New(sb, accountService, eventSender, db)
+	object := New(sb, accountService, eventSender, db, nil)
 
 	fx := &fixture{
 		storeObject:        object.(*storeObject),
@@ -112,6 +113,39 @@ func TestAddMessage(t *testing.T) {
 	assertMessagesEqual(t, want, got)
 }
 
+type observerStub struct {
+	added chan *model.ChatMessage
+}
+
+func (o observerStub) ChatMessageAdded(spaceId string, chatObjectId string, message *model.ChatMessage) {
+	o.added <- message
+}
+
+func TestAddMessage_Observer(t *testing.T) {
+	// given
+	ctx := context.Background()
+	fx := newFixture(t)
+	observer := observerStub{added: make(chan *model.ChatMessage, 1)}
+	fx.subscription.observer = observer
+
+	// when
+	messageId, err := fx.AddMessage(ctx, nil, givenMessage())
+	require.NoError(t, err)
+
+	// then
+	select {
+	case message := <-observer.added:
+		assert.Equal(t, messageId, message.Id)
+		// the observer is notified after the transaction is committed
+		messages, err := fx.GetMessagesByIds(ctx, []string{message.Id})
+		require.NoError(t, err)
+		assert.Len(t, messages, 1)
+	case <-time.After(time.Second):
+		t.Fatal("observer is not notified")
+	}
+	assert.Empty(t, fx.subscription.addedMessages)
+}
+
 func TestGetMessages(t *testing.T) {
 	ctx := context.Background()
 	fx := newFixture(t)
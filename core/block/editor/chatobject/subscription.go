@@ -18,14 +18,19 @@ type subscription struct {
 
 	eventsBuffer []*pb.EventMessage
 
+	// addedMessages are passed to the observer after the transaction is committed
+	addedMessages []*model.ChatMessage
+	observer      MessageObserver
+
 	enabled bool
 }
 
-func newSubscription(spaceId string, chatId string, eventSender event.Sender) *subscription {
+func newSubscription(spaceId string, chatId string, eventSender event.Sender, observer MessageObserver) *subscription {
 	return &subscription{
 		spaceId:     spaceId,
 		chatId:      chatId,
 		eventSender: eventSender,
+		observer:    observer,
 	}
 }
 
@@ -46,6 +51,7 @@ func (s *subscription) flush() {
 	defer func() {
 		s.eventsBuffer = s.eventsBuffer[:0]
 	}()
+	s.notifyObserver()
 
 	if len(s.eventsBuffer) == 0 {
 		return
@@ -64,7 +70,25 @@ func (s *subscription) flush() {
 	}
 }
 
+// notifyObserver passes the added messages to the observer in the background, so it doesn't block the chat object
+func (s *subscription) notifyObserver() {
+	if len(s.addedMessages) == 0 {
+		return
+	}
+	messages := slices.Clone(s.addedMessages)
+	s.addedMessages = s.addedMessages[:0]
+	if s.observer == nil {
+		return
+	}
+	go func() {
+		for _, message := range messages {
+			s.observer.ChatMessageAdded(s.spaceId, s.chatId, message)
+		}
+	}()
+}
+
 func (s *subscription) add(message *model.ChatMessage) {
+	s.addedMessages = append(s.addedMessages, message)
 	if !s.canSend() {
 		return
 	}
@@ -163,6 +163,7 @@ type SmartBlock interface {
 
 	SendEvent(msgs []*pb.EventMessage)
 	ResetToVersion(s *state.State) (err error)
+	RestoreVersion(s *state.State) (err error)
 	EnableLayouts()
 	EnabledRelationAsDependentObjects()
 	AddHook(f HookCallback, events ...Hook)
@@ -865,7 +866,10 @@ func (sb *smartBlock) Apply(s *state.State, flags ...ApplyFlag) (err error) {
 
 func (sb *smartBlock) ResetToVersion(s *state.State) (err error) {
 	source.NewSubObjectsAndProfileLinksMigration(sb.Type(), sb.space, sb.currentParticipantId, sb.spaceIndex).Migrate(s)
+	store := s.Store()
 	s.SetParent(sb.Doc.(*state.State))
+	// the store of the version is not diffed on apply, so its keys are recorded as changes here
+	s.SetStore(store)
 	sb.storeFileKeys(s)
 	sb.injectLocalDetails(s)
 	if err = sb.Apply(s, NoHistory, DoSnapshot, NoRestrictions); err != nil {
@@ -877,6 +881,15 @@ func (sb *smartBlock) ResetToVersion(s *state.State) (err error) {
 	return
 }
 
+// RestoreVersion applies the state of the version as the regular change, so unlike ResetToVersion it can be undone
+func (sb *smartBlock) RestoreVersion(s *state.State) (err error) {
+	source.NewSubObjectsAndProfileLinksMigration(sb.Type(), sb.space, sb.currentParticipantId, sb.spaceIndex).Migrate(s)
+	s.SetParent(sb.Doc.(*state.State))
+	sb.storeFileKeys(s)
+	sb.injectLocalDetails(s)
+	return sb.Apply(s, NoRestrictions)
+}
+
 func (sb *smartBlock) CheckSubscriptions() (changed bool) {
 	depIDs := sb.dependentSmartIds(sb.includeRelationObjectsAsDependents, true, true)
 	changed = sb.setDependentIDs(depIDs)
@@ -79,6 +79,41 @@ func TestSmartBlock_Apply(t *testing.T) {
 
 }
 
+func TestSmartBlock_RestoreVersion(t *testing.T) {
+	// given
+	fx := newFixture("root", t)
+	fx.space = sharedSpaceStub{}
+	fx.init(t, []*model.Block{
+		{Id: "root", ChildrenIds: []string{"current"}},
+		{Id: "current", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "current"}}},
+	})
+	fx.eventSender.EXPECT().Broadcast(mock.Anything).Maybe()
+	fx.indexer.EXPECT().Index(mock.Anything, mock.Anything).Return(nil)
+	version := state.NewDoc("root", map[string]simple.Block{
+		"root":    simple.New(&model.Block{Id: "root", ChildrenIds: []string{"version"}}),
+		"version": simple.New(&model.Block{Id: "version", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "version"}}}),
+	}).(*state.State)
+
+	// when
+	err := fx.RestoreVersion(version)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, fx.Pick("current"))
+	assert.Equal(t, "version", fx.Pick("version").Model().GetText().Text)
+	require.Equal(t, 1, fx.History().Len())
+	action, err := fx.History().Previous()
+	require.NoError(t, err)
+	require.Len(t, action.Remove, 1)
+	assert.Equal(t, "current", action.Remove[0].Model().Id)
+}
+
+type sharedSpaceStub struct {
+	Space
+}
+
+func (sharedSpaceStub) IsPersonal() bool { return false }
+
 func TestBasic_SetAlign(t *testing.T) {
 	t.Run("with ids", func(t *testing.T) {
 		// given
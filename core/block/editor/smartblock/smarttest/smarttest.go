@@ -379,6 +379,13 @@ func (st *SmartTest) ResetToVersion(s *state.State) (err error) {
 	return nil
 }
 
+func (st *SmartTest) RestoreVersion(s *state.State) (err error) {
+	store := s.Store()
+	s.SetParent(st.Doc.(*state.State))
+	s.SetStore(store)
+	return st.Apply(s, smartblock.NoRestrictions)
+}
+
 func (st *SmartTest) FileRelationKeys(s *state.State) []string {
 	return nil
 }
@@ -721,6 +721,10 @@ func (s *State) apply(spaceId string, fast, one, withLayouts bool) (msgs []simpl
 	}
 
 	if s.parent != nil && s.store != nil {
+		prev := s.parent.Store()
+		if len(pbtypes.StructDiffKeys(prev, s.store)) > 0 {
+			action.Store = &undo.Store{Before: pbtypes.CopyStruct(prev, true), After: pbtypes.CopyStruct(s.store, true)}
+		}
 		s.parent.store = s.store
 	}
 
@@ -1486,6 +1490,24 @@ func (s *State) SetInStore(path []string, value *types.Value) (changed bool) {
 	return
 }
 
+// SetStore replaces the store of the state with the given one. Top-level keys that differ from the store
+// of the parent are recorded as changes, so the replaced store is synced like the regular store update
+func (s *State) SetStore(store *types.Struct) {
+	s.store, s.storeKeyRemoved = nil, nil
+	s.SetStoreKeys(store, pbtypes.StructDiffKeys(s.Store(), store)...)
+}
+
+// SetStoreKeys sets top-level keys of the store to their values in the given store, keys missing there are removed
+func (s *State) SetStoreKeys(store *types.Struct, keys ...string) {
+	for _, key := range keys {
+		if value := store.GetFields()[key]; value != nil {
+			s.SetInStore([]string{key}, pbtypes.CopyVal(value))
+		} else {
+			s.RemoveFromStore([]string{key})
+		}
+	}
+}
+
 func (s *State) UpdateStoreSlice(key string, val []string) {
 	old := s.GetStoreSlice(key)
 	s.setInStore([]string{key}, pbtypes.StringList(val))
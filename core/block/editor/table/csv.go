@@ -0,0 +1,32 @@
+package table
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+)
+
+// CSV renders plain text of the table cells as comma-separated values, row by row
+func (tb Table) CSV() ([]byte, error) {
+	cells := make([][]string, len(tb.RowIDs()))
+	for i := range cells {
+		cells[i] = make([]string, len(tb.ColumnIDs()))
+	}
+	err := tb.Iterate(func(b simple.Block, pos CellPosition) bool {
+		if b != nil {
+			cells[pos.RowNumber][pos.ColNumber] = b.Model().GetText().GetText()
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err = w.WriteAll(cells); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -136,6 +136,28 @@ func TestTable_Iterate(t *testing.T) {
 	})
 }
 
+func TestTable_CSV(t *testing.T) {
+	t.Run("cells text with missing cells", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2"},
+			[][]string{{"row1-col1", "row1-col2"}, {"row2-col2"}},
+			withBlockContents(map[string]*model.Block{
+				"row1-col1": mkTextBlock("Item"),
+				"row1-col2": mkTextBlock("Price"),
+				"row2-col2": mkTextBlock("1,50"),
+			}))
+		tb, err := NewTable(s, "table")
+		require.NoError(t, err)
+
+		// when
+		data, err := tb.CSV()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Item,Price\n,\"1,50\"\n", string(data))
+	})
+}
+
 func TestCheckTableBlocksMove(t *testing.T) {
 	for _, tc := range []struct {
 		name      string
@@ -3,6 +3,7 @@ package export
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -22,18 +23,22 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/cache"
 	sb "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
 	"github.com/anyproto/anytype-heart/core/block/object/objectlink"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/converter"
 	"github.com/anyproto/anytype-heart/core/converter/dot"
 	"github.com/anyproto/anytype-heart/core/converter/graphjson"
+	"github.com/anyproto/anytype-heart/core/converter/html"
 	"github.com/anyproto/anytype-heart/core/converter/md"
+	"github.com/anyproto/anytype-heart/core/converter/opml"
 	"github.com/anyproto/anytype-heart/core/converter/pbc"
 	"github.com/anyproto/anytype-heart/core/converter/pbjson"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/files"
 	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/core/subscription"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
@@ -80,6 +85,7 @@ type export struct {
 	accountService      account.Service
 	notificationService notifications.Notifications
 	processService      process.Service
+	subscriptionService subscription.Service
 }
 
 func New() Export {
@@ -95,6 +101,7 @@ func (e *export) Init(a *app.App) (err error) {
 	e.spaceService = app.MustComponent[space.Service](a)
 	e.accountService = app.MustComponent[account.Service](a)
 	e.notificationService = app.MustComponent[notifications.Notifications](a)
+	e.subscriptionService = app.MustComponent[subscription.Service](a)
 	return
 }
 
@@ -150,56 +157,81 @@ func (d Docs) transformToDetailsMap() map[string]*domain.Details {
 }
 
 type exportContext struct {
-	spaceId          string
-	docs             Docs
-	includeArchive   bool
-	includeNested    bool
-	includeFiles     bool
-	format           model.ExportFormat
-	isJson           bool
-	reqIds           []string
-	zip              bool
-	path             string
-	linkStateFilters *state.Filters
-	isLinkProcess    bool
-	includeBackLinks bool
+	spaceId           string
+	docs              Docs
+	includeArchive    bool
+	includeNested     bool
+	includeFiles      bool
+	format            model.ExportFormat
+	isJson            bool
+	reqIds            []string
+	zip               bool
+	path              string
+	linkStateFilters  *state.Filters
+	isLinkProcess     bool
+	includeBackLinks  bool
+	includeProperties bool
+	viewId            string
+	tablesAsCsv       bool
+
+	incremental          bool
+	previousManifestPath string
+	manifest             *Manifest
+	previousManifest     *Manifest
+	manifestMu           sync.Mutex
+
+	htmlPages   []html.IndexEntry
+	htmlPagesMu sync.Mutex
 
 	*export
 }
 
 func newExportContext(e *export, req pb.RpcObjectListExportRequest) *exportContext {
 	ec := &exportContext{
-		path:             req.Path,
-		spaceId:          req.SpaceId,
-		docs:             map[string]*Doc{},
-		includeArchive:   req.IncludeArchived,
-		includeNested:    req.IncludeNested,
-		includeFiles:     req.IncludeFiles,
-		format:           req.Format,
-		isJson:           req.IsJson,
-		reqIds:           req.ObjectIds,
-		zip:              req.Zip,
-		linkStateFilters: pbFiltersToState(req.LinksStateFilters),
-		includeBackLinks: req.IncludeBacklinks,
-		export:           e,
+		path:              req.Path,
+		spaceId:           req.SpaceId,
+		docs:              map[string]*Doc{},
+		includeArchive:    req.IncludeArchived,
+		includeNested:     req.IncludeNested,
+		includeFiles:      req.IncludeFiles,
+		format:            req.Format,
+		isJson:            req.IsJson,
+		reqIds:            req.ObjectIds,
+		zip:               req.Zip,
+		linkStateFilters:  pbFiltersToState(req.LinksStateFilters),
+		includeBackLinks:  req.IncludeBacklinks,
+		includeProperties: req.MdIncludeProperties,
+		viewId:            req.ViewId,
+		tablesAsCsv:       req.MdIncludeTablesAsCsv,
+		export:            e,
+
+		incremental:          req.Incremental || req.PreviousManifestPath != "",
+		previousManifestPath: req.PreviousManifestPath,
+	}
+	if req.Format == model.Export_HTML {
+		// html pages reference images and files from the files directory
+		ec.includeFiles = true
 	}
 	return ec
 }
 
 func (e *exportContext) copy() *exportContext {
 	return &exportContext{
-		spaceId:          e.spaceId,
-		docs:             e.docs,
-		includeArchive:   e.includeArchive,
-		includeNested:    e.includeNested,
-		includeFiles:     e.includeFiles,
-		format:           e.format,
-		isJson:           e.isJson,
-		reqIds:           e.reqIds,
-		export:           e.export,
-		isLinkProcess:    e.isLinkProcess,
-		linkStateFilters: e.linkStateFilters,
-		includeBackLinks: e.includeBackLinks,
+		spaceId:           e.spaceId,
+		docs:              e.docs,
+		includeArchive:    e.includeArchive,
+		includeNested:     e.includeNested,
+		includeFiles:      e.includeFiles,
+		format:            e.format,
+		isJson:            e.isJson,
+		reqIds:            e.reqIds,
+		export:            e.export,
+		isLinkProcess:     e.isLinkProcess,
+		linkStateFilters:  e.linkStateFilters,
+		includeBackLinks:  e.includeBackLinks,
+		includeProperties: e.includeProperties,
+		viewId:            e.viewId,
+		tablesAsCsv:       e.tablesAsCsv,
 	}
 }
 
@@ -222,6 +254,11 @@ func (e *exportContext) exportObjects(ctx context.Context, queue process.Queue)
 			cleanupFile(wr)
 		}
 	}()
+	if e.incremental {
+		if err = e.prepareManifest(e.previousManifestPath); err != nil {
+			return "", 0, err
+		}
+	}
 	err = e.docsForExport()
 	if err != nil {
 		return "", 0, err
@@ -273,7 +310,14 @@ func (e *exportContext) exportByFormat(ctx context.Context, wr writer, queue pro
 		succeed = e.exportDotAndSVG(ctx, succeed, wr, queue)
 	} else if e.format == model.Export_GRAPH_JSON {
 		succeed = e.exportGraphJson(ctx, succeed, wr, queue)
+	} else if isTableExport(e.format) {
+		succeed = e.exportTables(wr)
 	} else {
+		var indexName string
+		if e.format == model.Export_HTML {
+			// reserve the name of the index page, so no object page takes it
+			indexName = wr.Namer().Get("", html.IndexFileName, "index", ".html")
+		}
 		tasks := make([]process.Task, 0, len(e.docs))
 		var succeedAsync int64
 		tasks = e.exportDocs(ctx, wr, &succeedAsync, tasks)
@@ -283,6 +327,16 @@ func (e *exportContext) exportByFormat(ctx context.Context, wr writer, queue pro
 			return 0, nil
 		}
 		succeed += int(succeedAsync)
+		if e.format == model.Export_HTML {
+			if err = wr.WriteFile(indexName, bytes.NewReader(html.RenderIndex(e.htmlPages)), 0); err != nil {
+				return 0, err
+			}
+		}
+		if e.manifest != nil {
+			if err = e.writeManifest(wr); err != nil {
+				return 0, err
+			}
+		}
 	}
 	return succeed, nil
 }
@@ -296,7 +350,11 @@ func (e *exportContext) exportDocs(ctx context.Context,
 	for docId := range e.docs {
 		did := docId
 		task := func() {
-			if werr := e.writeDoc(ctx, wr, did, docsDetails); werr != nil {
+			werr := e.writeDoc(ctx, wr, did, docsDetails)
+			if errors.Is(werr, errObjectNotChanged) {
+				return
+			}
+			if werr != nil {
 				log.With("objectID", did).Warnf("can't export doc: %v", werr)
 			} else {
 				atomic.AddInt64(succeed, 1)
@@ -1007,6 +1065,11 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 			return nil
 		}
 
+		manifestObject, err := e.checkObjectChanged(docId, b, st)
+		if err != nil {
+			return err
+		}
+
 		st = st.Copy().Filter(e.getStateFilters(docId))
 		if e.includeFiles && b.Type() == smartblock.SmartBlockTypeFileObject {
 			fileName, err := e.saveFile(ctx, wr, b, e.spaceId == "")
@@ -1014,8 +1077,10 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 				return fmt.Errorf("save file: %w", err)
 			}
 			st.SetDetailAndBundledRelation(bundle.RelationKeySource, domain.String(fileName))
-			// Don't save file objects in markdown
-			if e.format == model.Export_Markdown {
+			// Don't save file objects in markdown, html and opml
+			if e.format == model.Export_Markdown || e.format == model.Export_HTML || e.format == model.Export_OPML {
+				manifestObject.Path = fileName
+				e.recordObject(docId, manifestObject)
 				return nil
 			}
 		}
@@ -1023,7 +1088,15 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 		var conv converter.Converter
 		switch e.format {
 		case model.Export_Markdown:
-			conv = md.NewMDConverter(st, wr.Namer())
+			if e.includeProperties {
+				conv = md.NewMDConverterWithFrontMatter(st, wr.Namer(), e.objectStore.SpaceIndex(b.SpaceID()))
+			} else {
+				conv = md.NewMDConverter(st, wr.Namer())
+			}
+		case model.Export_HTML:
+			conv = html.NewExportConverter(st, wr.Namer())
+		case model.Export_OPML:
+			conv = opml.NewConverter(st)
 		case model.Export_Protobuf:
 			conv = pbc.NewConverter(st, e.isJson)
 		case model.Export_JSON:
@@ -1032,8 +1105,11 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 		conv.SetKnownDocs(details)
 		result := conv.Convert(b.Type().ToProto())
 		var filename string
-		if e.format == model.Export_Markdown {
+		if e.format == model.Export_Markdown || e.format == model.Export_OPML {
 			filename = makeMarkdownName(st, wr, docId, conv.Ext(), e.spaceId)
+		} else if e.format == model.Export_HTML {
+			// pages are kept in a single directory, so links between them stay relative
+			filename = wr.Namer().Get("", docId, html.PageTitle(st.Details(), docId), conv.Ext())
 		} else if docId == b.Space().DerivedIDs().Home {
 			filename = "index" + conv.Ext()
 		} else {
@@ -1043,10 +1119,56 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string, d
 		if err = wr.WriteFile(filename, bytes.NewReader(result), lastModifiedDate); err != nil {
 			return err
 		}
+		if e.format == model.Export_Markdown && e.tablesAsCsv {
+			if err = e.writeTablesAsCsv(wr, st, docId, filename, lastModifiedDate); err != nil {
+				return fmt.Errorf("write tables: %w", err)
+			}
+		}
+		if e.format == model.Export_HTML {
+			e.addHTMLPage(html.PageTitle(st.Details(), docId), filename)
+		}
+		manifestObject.Path = filename
+		e.recordObject(docId, manifestObject)
 		return nil
 	})
 }
 
+// writeTablesAsCsv writes every table block of the document to its own csv file next to the markdown file
+func (e *exportContext) writeTablesAsCsv(wr writer, st *state.State, docId, docFilename string, lastModifiedDate int64) error {
+	var tableIds []string
+	st.Iterate(func(b simple.Block) (isContinue bool) {
+		if b.Model().GetTable() != nil {
+			tableIds = append(tableIds, b.Model().Id)
+		}
+		return true
+	})
+	dir := filepath.Dir(docFilename)
+	baseName := strings.TrimSuffix(filepath.Base(docFilename), filepath.Ext(docFilename))
+	for i, id := range tableIds {
+		tb, err := table.NewTable(st, id)
+		if err != nil {
+			log.With("blockId", id).Warnf("skip invalid table: %v", err)
+			continue
+		}
+		data, err := tb.CSV()
+		if err != nil {
+			return err
+		}
+		// block ids are unique only within an object
+		filename := wr.Namer().Get(dir, docId+"/"+id, fmt.Sprintf("%s table %d", baseName, i+1), ".csv")
+		if err = wr.WriteFile(filename, bytes.NewReader(data), lastModifiedDate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exportContext) addHTMLPage(title, filename string) {
+	e.htmlPagesMu.Lock()
+	defer e.htmlPagesMu.Unlock()
+	e.htmlPages = append(e.htmlPages, html.IndexEntry{Title: title, FileName: filename})
+}
+
 func (e *exportContext) saveFile(ctx context.Context, wr writer, fileObject sb.SmartBlock, exportAllSpaces bool) (fileName string, err error) {
 	fullId := domain.FullFileId{
 		SpaceId: fileObject.Space().Id(),
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/gogo/protobuf/jsonpb"
@@ -22,6 +24,8 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/event/mock_event"
 	"github.com/anyproto/anytype-heart/core/notifications/mock_notifications"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/core/subscription/mock_subscription"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
@@ -810,6 +814,505 @@ func TestExport_Export(t *testing.T) {
 		objectPath := filepath.Join(objectsDirectory, link1+".pb.json")
 		assert.False(t, fileNames[objectPath])
 	})
+
+	t.Run("export html with index page", func(t *testing.T) {
+		// given
+		storeFixture := objectstore.NewStoreFixture(t)
+		objectID := "id"
+		storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+			{
+				bundle.RelationKeyId:      domain.String(objectID),
+				bundle.RelationKeyName:    domain.String("Trip"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+			},
+		})
+
+		smartBlockTest := smarttest.New(objectID)
+		doc := smartBlockTest.NewState()
+		doc.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:   domain.String(objectID),
+			bundle.RelationKeyName: domain.String("Trip"),
+		}))
+		doc.Add(simple.New(&model.Block{Id: objectID, ChildrenIds: []string{"text"}}))
+		doc.Add(simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Pack the tent"}}}))
+		smartBlockTest.Doc = doc
+
+		objectGetter := mock_cache.NewMockObjectGetter(t)
+		objectGetter.EXPECT().GetObject(context.Background(), objectID).Return(smartBlockTest, nil)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err := service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:         storeFixture,
+			picker:              objectGetter,
+			processService:      service,
+			notificationService: mock_notifications.NewMockNotifications(t),
+		}
+
+		// when
+		path, success, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:    spaceId,
+			Path:       t.TempDir(),
+			ObjectIds:  []string{objectID},
+			Format:     model.Export_HTML,
+			NoProgress: true,
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, success)
+
+		page, err := os.ReadFile(filepath.Join(path, "trip.html"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(page), "Pack the tent")
+
+		index, err := os.ReadFile(filepath.Join(path, "index.html"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(index), `<a href="trip.html">Trip</a>`)
+	})
+	t.Run("export html object titled index", func(t *testing.T) {
+		// given
+		storeFixture := objectstore.NewStoreFixture(t)
+		objectID := "id"
+		storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+			{
+				bundle.RelationKeyId:      domain.String(objectID),
+				bundle.RelationKeyName:    domain.String("Index"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+			},
+		})
+
+		smartBlockTest := smarttest.New(objectID)
+		doc := smartBlockTest.NewState()
+		doc.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:   domain.String(objectID),
+			bundle.RelationKeyName: domain.String("Index"),
+		}))
+		doc.Add(simple.New(&model.Block{Id: objectID, ChildrenIds: []string{"text"}}))
+		doc.Add(simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Table of contents"}}}))
+		smartBlockTest.Doc = doc
+
+		objectGetter := mock_cache.NewMockObjectGetter(t)
+		objectGetter.EXPECT().GetObject(context.Background(), objectID).Return(smartBlockTest, nil)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err := service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:         storeFixture,
+			picker:              objectGetter,
+			processService:      service,
+			notificationService: mock_notifications.NewMockNotifications(t),
+		}
+
+		// when
+		path, success, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:    spaceId,
+			Path:       t.TempDir(),
+			ObjectIds:  []string{objectID},
+			Format:     model.Export_HTML,
+			NoProgress: true,
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, success)
+
+		entries, err := os.ReadDir(path)
+		assert.Nil(t, err)
+		var pageName string
+		for _, entry := range entries {
+			if !entry.IsDir() && entry.Name() != "index.html" {
+				pageName = entry.Name()
+			}
+		}
+		assert.True(t, strings.HasPrefix(pageName, "index_"))
+		page, err := os.ReadFile(filepath.Join(path, pageName))
+		assert.Nil(t, err)
+		assert.Contains(t, string(page), "Table of contents")
+
+		index, err := os.ReadFile(filepath.Join(path, "index.html"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(index), fmt.Sprintf(`<a href="%s">Index</a>`, pageName))
+		assert.NotContains(t, string(index), "Table of contents")
+	})
+	t.Run("export opml with nested blocks", func(t *testing.T) {
+		// given
+		storeFixture := objectstore.NewStoreFixture(t)
+		objectID := "id"
+		storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+			{
+				bundle.RelationKeyId:      domain.String(objectID),
+				bundle.RelationKeyName:    domain.String("Trip"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+			},
+		})
+
+		smartBlockTest := smarttest.New(objectID)
+		doc := smartBlockTest.NewState()
+		doc.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:   domain.String(objectID),
+			bundle.RelationKeyName: domain.String("Trip"),
+		}))
+		doc.Add(simple.New(&model.Block{Id: objectID, ChildrenIds: []string{"text"}}))
+		doc.Add(simple.New(&model.Block{Id: "text", ChildrenIds: []string{"nested"}, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Pack"}}}))
+		doc.Add(simple.New(&model.Block{Id: "nested", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "the tent"}}}))
+		smartBlockTest.Doc = doc
+
+		objectGetter := mock_cache.NewMockObjectGetter(t)
+		objectGetter.EXPECT().GetObject(context.Background(), objectID).Return(smartBlockTest, nil)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err := service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:         storeFixture,
+			picker:              objectGetter,
+			processService:      service,
+			notificationService: mock_notifications.NewMockNotifications(t),
+		}
+
+		// when
+		path, success, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:    spaceId,
+			Path:       t.TempDir(),
+			ObjectIds:  []string{objectID},
+			Format:     model.Export_OPML,
+			NoProgress: true,
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, success)
+
+		outline, err := os.ReadFile(filepath.Join(path, "trip.opml"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(outline), `<outline text="Pack">
+      <outline text="the tent"></outline>
+    </outline>`)
+	})
+	t.Run("export markdown with tables as csv", func(t *testing.T) {
+		// given
+		storeFixture := objectstore.NewStoreFixture(t)
+		objectID := "id"
+		storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+			{
+				bundle.RelationKeyId:      domain.String(objectID),
+				bundle.RelationKeyName:    domain.String("Budget"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+			},
+		})
+
+		smartBlockTest := smarttest.New(objectID)
+		doc := smartBlockTest.NewState()
+		doc.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:   domain.String(objectID),
+			bundle.RelationKeyName: domain.String("Budget"),
+		}))
+		for _, b := range []*model.Block{
+			{Id: objectID, ChildrenIds: []string{"table"}},
+			{Id: "table", ChildrenIds: []string{"columns", "rows"}, Content: &model.BlockContentOfTable{Table: &model.BlockContentTable{}}},
+			{Id: "columns", ChildrenIds: []string{"col1", "col2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableColumns}}},
+			{Id: "col1", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}},
+			{Id: "col2", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}},
+			{Id: "rows", ChildrenIds: []string{"row1", "row2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableRows}}},
+			{Id: "row1", ChildrenIds: []string{"row1-col1", "row1-col2"}, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{IsHeader: true}}},
+			{Id: "row2", ChildrenIds: []string{"row2-col1", "row2-col2"}, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{}}},
+			{Id: "row1-col1", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Item"}}},
+			{Id: "row1-col2", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Cost"}}},
+			{Id: "row2-col1", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Tent"}}},
+			{Id: "row2-col2", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "120"}}},
+		} {
+			doc.Add(simple.New(b))
+		}
+		smartBlockTest.Doc = doc
+
+		objectGetter := mock_cache.NewMockObjectGetter(t)
+		objectGetter.EXPECT().GetObject(context.Background(), objectID).Return(smartBlockTest, nil)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err := service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:         storeFixture,
+			picker:              objectGetter,
+			processService:      service,
+			notificationService: mock_notifications.NewMockNotifications(t),
+		}
+
+		// when
+		path, success, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:              spaceId,
+			Path:                 t.TempDir(),
+			ObjectIds:            []string{objectID},
+			Format:               model.Export_Markdown,
+			MdIncludeTablesAsCsv: true,
+			NoProgress:           true,
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, success)
+
+		page, err := os.ReadFile(filepath.Join(path, "budget.md"))
+		assert.Nil(t, err)
+		assert.Contains(t, string(page), "| Tent |")
+
+		table, err := os.ReadFile(filepath.Join(path, "budget-table-1.csv"))
+		assert.Nil(t, err)
+		assert.Equal(t, "Item,Cost\nTent,120\n", string(table))
+	})
+	t.Run("incremental export writes only changed objects", func(t *testing.T) {
+		// given
+		storeFixture := objectstore.NewStoreFixture(t)
+		objectGetter := mock_cache.NewMockObjectGetter(t)
+		for _, object := range []struct {
+			id, name         string
+			lastModifiedDate int64
+		}{
+			{"changed", "Changed", 200},
+			{"unchanged", "Unchanged", 100},
+		} {
+			storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+				{
+					bundle.RelationKeyId:      domain.String(object.id),
+					bundle.RelationKeyName:    domain.String(object.name),
+					bundle.RelationKeySpaceId: domain.String(spaceId),
+				},
+			})
+			smartBlockTest := smarttest.New(object.id)
+			doc := smartBlockTest.NewState()
+			doc.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyId:   domain.String(object.id),
+				bundle.RelationKeyName: domain.String(object.name),
+			}))
+			doc.SetLocalDetail(bundle.RelationKeyLastModifiedDate, domain.Int64(object.lastModifiedDate))
+			doc.Add(simple.New(&model.Block{Id: object.id}))
+			smartBlockTest.Doc = doc
+			objectGetter.EXPECT().GetObject(context.Background(), object.id).Return(smartBlockTest, nil)
+		}
+
+		storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+			{
+				bundle.RelationKeyId:      domain.String("failed"),
+				bundle.RelationKeyName:    domain.String("Failed"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+			},
+			{
+				bundle.RelationKeyId:         domain.String("archived"),
+				bundle.RelationKeySpaceId:    domain.String(spaceId),
+				bundle.RelationKeyIsArchived: domain.Bool(true),
+			},
+			{
+				bundle.RelationKeyId:      domain.String("notExported"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+			},
+		})
+		objectGetter.EXPECT().GetObject(context.Background(), "failed").Return(nil, fmt.Errorf("not loaded"))
+
+		previousManifestPath := filepath.Join(t.TempDir(), ManifestFileName)
+		previousManifest := `{"spaceId": "space1", "format": "Markdown", "objects": {
+			"changed": {"lastModifiedDate": 50, "path": "changed.md"},
+			"unchanged": {"lastModifiedDate": 100, "path": "unchanged.md"},
+			"failed": {"lastModifiedDate": 30, "path": "failed.md"},
+			"archived": {"lastModifiedDate": 20, "path": "archived.md"},
+			"notExported": {"lastModifiedDate": 20, "path": "notExported.md"},
+			"removed": {"lastModifiedDate": 10, "path": "removed.md"}
+		}}`
+		err := os.WriteFile(previousManifestPath, []byte(previousManifest), 0600)
+		assert.Nil(t, err)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err = service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:         storeFixture,
+			picker:              objectGetter,
+			processService:      service,
+			notificationService: mock_notifications.NewMockNotifications(t),
+		}
+
+		// when
+		path, success, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:              spaceId,
+			Path:                 t.TempDir(),
+			ObjectIds:            []string{"changed", "unchanged", "failed"},
+			Format:               model.Export_Markdown,
+			PreviousManifestPath: previousManifestPath,
+			NoProgress:           true,
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, success)
+
+		_, err = os.Stat(filepath.Join(path, "changed.md"))
+		assert.Nil(t, err)
+		_, err = os.Stat(filepath.Join(path, "unchanged.md"))
+		assert.True(t, os.IsNotExist(err))
+
+		manifest, err := readManifest(filepath.Join(path, ManifestFileName))
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]ManifestObject{
+			"archived": {LastModifiedDate: 20, Path: "archived.md"},
+			"removed":  {LastModifiedDate: 10, Path: "removed.md"},
+		}, manifest.Deleted)
+		assert.Equal(t, map[string]ManifestObject{
+			"changed":   {LastModifiedDate: 200, Path: "changed.md"},
+			"unchanged": {LastModifiedDate: 100, Path: "unchanged.md"},
+			"failed":    {LastModifiedDate: 30, Path: "failed.md"},
+		}, manifest.Objects)
+	})
+	t.Run("incremental export rejects previous manifest without space", func(t *testing.T) {
+		// given
+		previousManifestPath := filepath.Join(t.TempDir(), ManifestFileName)
+		err := os.WriteFile(previousManifestPath, []byte(`{"format": "Markdown", "objects": {}}`), 0600)
+		assert.Nil(t, err)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err = service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:    objectstore.NewStoreFixture(t),
+			processService: service,
+		}
+
+		// when
+		_, _, err = e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:              spaceId,
+			Path:                 t.TempDir(),
+			Format:               model.Export_Markdown,
+			PreviousManifestPath: previousManifestPath,
+			NoProgress:           true,
+		})
+
+		// then
+		assert.ErrorContains(t, err, "previous manifest has no space")
+	})
+	t.Run("export collection view as csv", func(t *testing.T) {
+		// given
+		storeFixture := objectstore.NewStoreFixture(t)
+		collectionID := "collection"
+		storeFixture.AddObjects(t, spaceId, []spaceindex.TestObject{
+			{
+				bundle.RelationKeyId:      domain.String(collectionID),
+				bundle.RelationKeyName:    domain.String("Books"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+				bundle.RelationKeyLayout:  domain.Int64(model.ObjectType_collection),
+			},
+			{
+				bundle.RelationKeyId:      domain.String("tag1"),
+				bundle.RelationKeyName:    domain.String("fiction"),
+				bundle.RelationKeySpaceId: domain.String(spaceId),
+			},
+		})
+
+		smartBlockTest := smarttest.New(collectionID)
+		smartBlockTest.SetSpaceId(spaceId)
+		doc := smartBlockTest.NewState()
+		doc.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:     domain.String(collectionID),
+			bundle.RelationKeyName:   domain.String("Books"),
+			bundle.RelationKeyLayout: domain.Int64(model.ObjectType_collection),
+		}))
+		doc.Add(simple.New(&model.Block{Id: collectionID, ChildrenIds: []string{"dataview"}}))
+		doc.Add(simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+			Views: []*model.BlockContentDataviewView{
+				{Id: "all", Relations: []*model.BlockContentDataviewRelation{{Key: bundle.RelationKeyName.String(), IsVisible: true}}},
+				{
+					Id: "table",
+					Relations: []*model.BlockContentDataviewRelation{
+						{Key: bundle.RelationKeyName.String(), IsVisible: true},
+						{Key: bundle.RelationKeyTag.String(), IsVisible: true},
+						{Key: bundle.RelationKeyDescription.String()},
+						{Key: bundle.RelationKeyCreatedDate.String(), IsVisible: true},
+					},
+					Sorts: []*model.BlockContentDataviewSort{{RelationKey: bundle.RelationKeyName.String()}},
+				},
+			},
+		}}}))
+		smartBlockTest.Doc = doc
+
+		objectGetter := mock_cache.NewMockObjectGetter(t)
+		objectGetter.EXPECT().GetObject(context.Background(), collectionID).Return(smartBlockTest, nil)
+
+		subscriptionService := mock_subscription.NewMockService(t)
+		subscriptionService.EXPECT().Search(mock.Anything).RunAndReturn(func(req subscription.SubscribeRequest) (*subscription.SubscribeResponse, error) {
+			assert.Equal(t, collectionID, req.CollectionId)
+			assert.Len(t, req.Sorts, 1)
+			assert.Equal(t, []string{"id", "name", "tag", "createdDate"}, req.Keys)
+			return &subscription.SubscribeResponse{
+				SubId: "sub",
+				Records: []*domain.Details{
+					domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+						bundle.RelationKeyId:          domain.String("book1"),
+						bundle.RelationKeyName:        domain.String("Dune, part one"),
+						bundle.RelationKeyTag:         domain.StringList([]string{"tag1"}),
+						bundle.RelationKeyCreatedDate: domain.Int64(time.Date(2024, 5, 1, 12, 0, 0, 0, time.Local).Unix()),
+					}),
+				},
+			}, nil
+		})
+		subscriptionService.EXPECT().Unsubscribe("sub").Return(nil)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err := service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:         storeFixture,
+			picker:              objectGetter,
+			processService:      service,
+			notificationService: mock_notifications.NewMockNotifications(t),
+			subscriptionService: subscriptionService,
+		}
+
+		// when
+		path, success, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:    spaceId,
+			Path:       t.TempDir(),
+			ObjectIds:  []string{collectionID},
+			Format:     model.Export_CSV,
+			ViewId:     "table",
+			NoProgress: true,
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, success)
+
+		table, err := os.ReadFile(filepath.Join(path, "books.csv"))
+		assert.Nil(t, err)
+		assert.Equal(t, "Name,Tag,Creation date\n\"Dune, part one\",fiction,2024-05-01\n", string(table))
+	})
 }
 
 func Test_docsForExport(t *testing.T) {
@@ -2602,4 +3105,59 @@ func Test_queryObjectsFromStoreByIds(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Len(t, records, 2000)
 	})
+	t.Run("export set without source as empty table", func(t *testing.T) {
+		// given
+		setID := "set"
+		smartBlockTest := smarttest.New(setID)
+		smartBlockTest.SetSpaceId(spaceId)
+		doc := smartBlockTest.NewState()
+		doc.SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:     domain.String(setID),
+			bundle.RelationKeyName:   domain.String("Books"),
+			bundle.RelationKeyLayout: domain.Int64(model.ObjectType_set),
+			bundle.RelationKeySetOf:  domain.StringList([]string{""}),
+		}))
+		doc.Add(simple.New(&model.Block{Id: setID, ChildrenIds: []string{"dataview"}}))
+		doc.Add(simple.New(&model.Block{Id: "dataview", Content: &model.BlockContentOfDataview{Dataview: &model.BlockContentDataview{
+			Views: []*model.BlockContentDataviewView{
+				{Id: "all", Relations: []*model.BlockContentDataviewRelation{{Key: bundle.RelationKeyName.String(), IsVisible: true}}},
+			},
+		}}}))
+		smartBlockTest.Doc = doc
+
+		objectGetter := mock_cache.NewMockObjectGetter(t)
+		objectGetter.EXPECT().GetObject(context.Background(), setID).Return(smartBlockTest, nil)
+
+		a := &app.App{}
+		mockSender := mock_event.NewMockSender(t)
+		a.Register(testutil.PrepareMock(context.Background(), a, mockSender))
+		service := process.New()
+		err := service.Init(a)
+		assert.Nil(t, err)
+
+		e := &export{
+			objectStore:         objectstore.NewStoreFixture(t),
+			picker:              objectGetter,
+			processService:      service,
+			notificationService: mock_notifications.NewMockNotifications(t),
+			subscriptionService: mock_subscription.NewMockService(t),
+		}
+
+		// when
+		path, success, err := e.Export(context.Background(), pb.RpcObjectListExportRequest{
+			SpaceId:    spaceId,
+			Path:       t.TempDir(),
+			ObjectIds:  []string{setID},
+			Format:     model.Export_CSV,
+			NoProgress: true,
+		})
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, 1, success)
+
+		table, err := os.ReadFile(filepath.Join(path, "books.csv"))
+		assert.Nil(t, err)
+		assert.Equal(t, "Name\n", string(table))
+	})
 }
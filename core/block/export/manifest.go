@@ -0,0 +1,168 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	sb "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const ManifestFileName = "manifest.json"
+
+var (
+	errIncrementalFormat = errors.New("incremental export is supported only for protobuf and markdown formats")
+	errObjectNotChanged  = errors.New("object is not changed since the previous export")
+)
+
+// Manifest describes the objects of an incremental export, so the next export writes only what has changed since
+type Manifest struct {
+	CreatedDate int64                     `json:"createdDate"`
+	SpaceId     string                    `json:"spaceId,omitempty"`
+	Format      string                    `json:"format"`
+	Objects     map[string]ManifestObject `json:"objects"`
+	// Deleted keeps entries of the previous manifest for objects that are deleted or archived since,
+	// so their files can be removed from the previous export
+	Deleted map[string]ManifestObject `json:"deleted,omitempty"`
+}
+
+type ManifestObject struct {
+	Heads            []string `json:"heads,omitempty"`
+	LastModifiedDate int64    `json:"lastModifiedDate,omitempty"`
+	// Path is the file the object is written to, relative to the root of the export
+	Path string `json:"path,omitempty"`
+}
+
+func (o ManifestObject) isSameVersion(other ManifestObject) bool {
+	if len(o.Heads) > 0 && len(other.Heads) > 0 {
+		return slices.Equal(o.Heads, other.Heads)
+	}
+	return o.LastModifiedDate != 0 && o.LastModifiedDate == other.LastModifiedDate
+}
+
+func newManifestObject(b sb.SmartBlock, st *state.State) ManifestObject {
+	var heads []string
+	if tree := b.Tree(); tree != nil {
+		heads = slices.Clone(tree.Heads())
+	} else if lastChangeId := st.LocalDetails().GetString(bundle.RelationKeyLastChangeId); lastChangeId != "" {
+		heads = []string{lastChangeId}
+	}
+	slices.Sort(heads)
+	return ManifestObject{
+		Heads:            heads,
+		LastModifiedDate: st.LocalDetails().GetInt64(bundle.RelationKeyLastModifiedDate),
+	}
+}
+
+func readManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (e *exportContext) prepareManifest(previousManifestPath string) error {
+	if e.format != model.Export_Protobuf && e.format != model.Export_Markdown {
+		return errIncrementalFormat
+	}
+	e.manifest = &Manifest{
+		SpaceId: e.spaceId,
+		Format:  e.format.String(),
+		Objects: map[string]ManifestObject{},
+	}
+	if previousManifestPath == "" {
+		return nil
+	}
+	previous, err := readManifest(previousManifestPath)
+	if err != nil {
+		return fmt.Errorf("read previous manifest: %w", err)
+	}
+	if previous.SpaceId == "" {
+		return fmt.Errorf("previous manifest has no space")
+	}
+	if previous.SpaceId != e.spaceId || previous.Format != e.manifest.Format {
+		return fmt.Errorf("previous manifest is made for another space or format")
+	}
+	e.previousManifest = previous
+	return nil
+}
+
+// checkObjectChanged returns the manifest entry of the object. errObjectNotChanged is returned when
+// the previous export already has its version, then the object is recorded in the manifest right away with its previous path
+func (e *exportContext) checkObjectChanged(id string, b sb.SmartBlock, st *state.State) (ManifestObject, error) {
+	if e.manifest == nil {
+		return ManifestObject{}, nil
+	}
+	object := newManifestObject(b, st)
+	if e.previousManifest == nil {
+		return object, nil
+	}
+	if previous, ok := e.previousManifest.Objects[id]; ok && previous.isSameVersion(object) {
+		object.Path = previous.Path
+		e.recordObject(id, object)
+		return object, errObjectNotChanged
+	}
+	return object, nil
+}
+
+// recordObject adds the object to the manifest, it's called once the object is written
+func (e *exportContext) recordObject(id string, object ManifestObject) {
+	if e.manifest == nil {
+		return
+	}
+	e.manifestMu.Lock()
+	e.manifest.Objects[id] = object
+	e.manifestMu.Unlock()
+}
+
+func (e *exportContext) writeManifest(wr writer) error {
+	e.manifest.CreatedDate = time.Now().Unix()
+	if e.previousManifest != nil {
+		if err := e.addMissingObjects(); err != nil {
+			return err
+		}
+	}
+	data, err := json.MarshalIndent(e.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return wr.WriteFile(ManifestFileName, bytes.NewReader(data), 0)
+}
+
+// addMissingObjects handles the objects of the previous manifest which are not written this time.
+// Deleted and archived objects are listed as deleted. Objects which failed to export keep their previous entry,
+// so they are exported again next time, other objects are no longer tracked
+func (e *exportContext) addMissingObjects() error {
+	spaceIndex := e.objectStore.SpaceIndex(e.spaceId)
+	for id, previous := range e.previousManifest.Objects {
+		if _, ok := e.manifest.Objects[id]; ok {
+			continue
+		}
+		details, err := spaceIndex.GetDetails(id)
+		if err != nil {
+			return fmt.Errorf("get details of %s: %w", id, err)
+		}
+		switch {
+		case !details.Has(bundle.RelationKeyId) || details.GetBool(bundle.RelationKeyIsDeleted) || details.GetBool(bundle.RelationKeyIsArchived):
+			if e.manifest.Deleted == nil {
+				e.manifest.Deleted = map[string]ManifestObject{}
+			}
+			e.manifest.Deleted[id] = previous
+		case e.docs[id] != nil:
+			e.manifest.Objects[id] = previous
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	sb "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/subscription"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	tableDateFormat     = "2006-01-02"
+	tableDateTimeFormat = "2006-01-02 15:04"
+)
+
+var (
+	errNotSetOrCollection = errors.New("object is not a set or collection")
+	errViewNotFound       = errors.New("view not found")
+)
+
+func isTableExport(format model.ExportFormat) bool {
+	return format == model.Export_CSV || format == model.Export_TSV
+}
+
+type tableSource struct {
+	spaceId      string
+	name         string
+	view         *model.BlockContentDataviewView
+	setOf        []string
+	isCollection bool
+}
+
+// exportTables writes the rows of the chosen view of every requested set and collection to its own table file
+func (e *exportContext) exportTables(wr writer) int {
+	var succeed int
+	for _, id := range e.reqIds {
+		if err := e.exportTable(wr, id); err != nil {
+			log.With("objectID", id).Warnf("can't export table: %v", err)
+			continue
+		}
+		succeed++
+	}
+	return succeed
+}
+
+func (e *exportContext) exportTable(wr writer, id string) error {
+	source, err := e.getTableSource(id)
+	if err != nil {
+		return err
+	}
+	columns := make([]*model.BlockContentDataviewRelation, 0, len(source.view.Relations))
+	keys := []string{bundle.RelationKeyId.String()}
+	for _, relation := range source.view.Relations {
+		if relation.IsVisible {
+			columns = append(columns, relation)
+			keys = append(keys, relation.Key)
+		}
+	}
+
+	var records []*domain.Details
+	// a search without the source matches every object, so a set without the source is exported as an empty table
+	if source.isCollection || len(source.setOf) > 0 {
+		if records, err = e.searchTableRecords(id, source, keys); err != nil {
+			return err
+		}
+	}
+
+	data, err := e.renderTable(source.spaceId, columns, records)
+	if err != nil {
+		return err
+	}
+	ext := ".csv"
+	if e.format == model.Export_TSV {
+		ext = ".tsv"
+	}
+	filename := wr.Namer().Get("", id, source.name, ext)
+	return wr.WriteFile(filename, bytes.NewReader(data), 0)
+}
+
+func (e *exportContext) searchTableRecords(id string, source *tableSource, keys []string) ([]*domain.Details, error) {
+	req := subscription.SubscribeRequest{
+		SpaceId:           source.spaceId,
+		Keys:              keys,
+		Filters:           database.FiltersFromProto(source.view.Filters),
+		Sorts:             database.SortsFromProto(source.view.Sorts),
+		NoDepSubscription: true,
+		Internal:          true,
+	}
+	if source.isCollection {
+		req.CollectionId = id
+	} else {
+		req.Source = source.setOf
+	}
+	resp, err := e.subscriptionService.Search(req)
+	if err != nil {
+		return nil, fmt.Errorf("search view objects: %w", err)
+	}
+	if err = e.subscriptionService.Unsubscribe(resp.SubId); err != nil {
+		log.With("objectID", id).Warnf("failed to unsubscribe: %v", err)
+	}
+	return resp.Records, nil
+}
+
+func (e *exportContext) getTableSource(id string) (*tableSource, error) {
+	source := &tableSource{}
+	err := cache.Do(e.picker, id, func(b sb.SmartBlock) error {
+		st := b.NewState()
+		details := st.CombinedDetails()
+		layout := model.ObjectTypeLayout(details.GetInt64(bundle.RelationKeyLayout))
+		if layout != model.ObjectType_set && layout != model.ObjectType_collection {
+			return errNotSetOrCollection
+		}
+		dataview := findDataview(st)
+		if dataview == nil {
+			return errNotSetOrCollection
+		}
+		for _, view := range dataview.Views {
+			if view.Id == e.viewId || (e.viewId == "" && source.view == nil) {
+				source.view = view
+			}
+		}
+		if source.view == nil {
+			return errViewNotFound
+		}
+		source.spaceId = b.SpaceID()
+		source.name = details.GetString(bundle.RelationKeyName)
+		source.setOf = lo.Compact(details.GetStringList(bundle.RelationKeySetOf))
+		source.isCollection = layout == model.ObjectType_collection
+		return nil
+	})
+	return source, err
+}
+
+func findDataview(st *state.State) *model.BlockContentDataview {
+	var dataview *model.BlockContentDataview
+	st.Iterate(func(b simple.Block) (isContinue bool) {
+		if dv := b.Model().GetDataview(); dv != nil {
+			dataview = dv
+			return false
+		}
+		return true
+	})
+	return dataview
+}
+
+func (e *exportContext) renderTable(spaceId string, columns []*model.BlockContentDataviewRelation, records []*domain.Details) ([]byte, error) {
+	store := e.objectStore.SpaceIndex(spaceId)
+	keys := make([]domain.RelationKey, 0, len(columns))
+	for _, column := range columns {
+		keys = append(keys, domain.RelationKey(column.Key))
+	}
+	relations, err := store.FetchRelationByKeys(keys...)
+	if err != nil {
+		return nil, fmt.Errorf("fetch relations: %w", err)
+	}
+	formats := make(map[string]model.RelationFormat, len(columns))
+	header := make([]string, 0, len(columns))
+	var linkedIds []string
+	for _, column := range columns {
+		relation := relations.GetModelByKey(column.Key)
+		if relation == nil {
+			relation, _ = bundle.GetRelation(domain.RelationKey(column.Key))
+		}
+		if relation == nil {
+			relation = &model.Relation{Key: column.Key, Name: column.Key, Format: model.RelationFormat_longtext}
+		}
+		formats[column.Key] = relation.Format
+		header = append(header, relation.Name)
+		if isLinkFormat(relation.Format) {
+			for _, record := range records {
+				linkedIds = append(linkedIds, record.WrapToStringList(domain.RelationKey(column.Key))...)
+			}
+		}
+	}
+
+	names := make(map[string]string, len(linkedIds))
+	if len(linkedIds) > 0 {
+		linked, err := store.QueryByIds(linkedIds)
+		if err != nil {
+			return nil, fmt.Errorf("query linked objects: %w", err)
+		}
+		for _, record := range linked {
+			names[record.Details.GetString(bundle.RelationKeyId)] = record.Details.GetString(bundle.RelationKeyName)
+		}
+	}
+
+	buf := bytes.NewBuffer(nil)
+	w := csv.NewWriter(buf)
+	if e.format == model.Export_TSV {
+		w.Comma = '\t'
+	}
+	if err = w.Write(header); err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		row := make([]string, 0, len(columns))
+		for _, column := range columns {
+			row = append(row, formatTableValue(record.Get(domain.RelationKey(column.Key)), formats[column.Key], column.DateIncludeTime, names))
+		}
+		if err = w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func isLinkFormat(format model.RelationFormat) bool {
+	return format == model.RelationFormat_object || format == model.RelationFormat_file ||
+		format == model.RelationFormat_tag || format == model.RelationFormat_status
+}
+
+// formatTableValue renders a relation value as human-readable text, linked objects and options are replaced with their names
+func formatTableValue(value domain.Value, format model.RelationFormat, includeTime bool, names map[string]string) string {
+	if value.IsNull() {
+		return ""
+	}
+	switch {
+	case isLinkFormat(format):
+		ids := value.WrapToStringList()
+		values := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if name, ok := names[id]; ok {
+				values = append(values, name)
+			}
+		}
+		return strings.Join(values, ", ")
+	case format == model.RelationFormat_date:
+		timestamp := value.Int64()
+		if timestamp == 0 {
+			return ""
+		}
+		if includeTime {
+			return time.Unix(timestamp, 0).Format(tableDateTimeFormat)
+		}
+		return time.Unix(timestamp, 0).Format(tableDateFormat)
+	case format == model.RelationFormat_checkbox:
+		return strconv.FormatBool(value.Bool())
+	case format == model.RelationFormat_number:
+		if number, ok := value.TryFloat64(); ok {
+			return strconv.FormatFloat(number, 'f', -1, 64)
+		}
+		return ""
+	}
+	if list, ok := value.TryStringList(); ok {
+		return strings.Join(list, ", ")
+	}
+	return value.String()
+}
@@ -18,3 +18,15 @@ func ResetToVersion(sb smartblock.SmartBlock, st *state.State) error {
 	})
 	return nil
 }
+
+// RestoreVersion applies the state of the version as the new change, which can be undone
+func RestoreVersion(sb smartblock.SmartBlock, st *state.State) error {
+	if err := sb.RestoreVersion(st); err != nil {
+		return fmt.Errorf("restoring smartblock version: %w", err)
+	}
+	migration.RunMigrations(sb, &smartblock.InitContext{
+		State:   sb.NewState(),
+		SpaceID: sb.SpaceID(),
+	})
+	return nil
+}
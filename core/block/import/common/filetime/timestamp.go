@@ -0,0 +1,18 @@
+package filetime
+
+import (
+	"strings"
+	"time"
+)
+
+const compactTimestampLayout = "20060102T150405Z"
+
+// ParseCompactTimestamp parses timestamps in the basic ISO 8601 format, like 20240102T150405Z, which are used by
+// exports of other applications instead of file times. It returns 0 if the timestamp can't be parsed
+func ParseCompactTimestamp(value string) int64 {
+	t, err := time.Parse(compactTimestampLayout, strings.TrimSpace(value))
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
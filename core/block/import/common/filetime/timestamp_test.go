@@ -0,0 +1,14 @@
+package filetime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompactTimestamp(t *testing.T) {
+	assert.Equal(t, int64(1704164645), ParseCompactTimestamp("20240102T030405Z"))
+	assert.Equal(t, int64(1704164645), ParseCompactTimestamp(" 20240102T030405Z\n"))
+	assert.Equal(t, int64(0), ParseCompactTimestamp("2024-01-02"))
+	assert.Equal(t, int64(0), ParseCompactTimestamp(""))
+}
@@ -0,0 +1,148 @@
+package common
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// RelationsBuilder collects snapshots of relations and relation options created by importers of files,
+// options with the same name are created once per import
+type RelationsBuilder struct {
+	options   map[string]map[string]string
+	snapshots []*Snapshot
+}
+
+func NewRelationsBuilder() *RelationsBuilder {
+	return &RelationsBuilder{options: make(map[string]map[string]string)}
+}
+
+// Snapshots returns snapshots of all created relations and options
+func (b *RelationsBuilder) Snapshots() []*Snapshot {
+	return b.snapshots
+}
+
+// AddRelation creates the relation with the given name and format
+func (b *RelationsBuilder) AddRelation(name string, format model.RelationFormat) *model.RelationLink {
+	key := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetInt64(bundle.RelationKeyRelationFormat, int64(format))
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, key)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relation))
+	uniqueKey, err := domain.NewUniqueKey(coresb.SmartBlockTypeRelation, key)
+	if err != nil {
+		log.Warnf("failed to create unique key for relation: %v", err)
+	} else {
+		details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	}
+	b.snapshots = append(b.snapshots, &Snapshot{
+		Id: details.GetString(bundle.RelationKeyId),
+		Snapshot: &SnapshotModel{
+			SbType: coresb.SmartBlockTypeRelation,
+			Data: &StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+				Key:         key,
+			},
+		},
+	})
+	return &model.RelationLink{Key: key, Format: format}
+}
+
+// ProvideRelation returns the bundled relation which key or name matches the name and which format can hold the values
+// of the given format, otherwise it creates the relation. Relations already created in the space are matched by
+// their name and format when the objects are created
+func (b *RelationsBuilder) ProvideRelation(name string, format model.RelationFormat) *model.RelationLink {
+	if relation := bundledRelation(name, format); relation != nil {
+		return &model.RelationLink{Key: relation.Key, Format: relation.Format}
+	}
+	return b.AddRelation(name, format)
+}
+
+func bundledRelation(name string, format model.RelationFormat) *model.Relation {
+	keys := bundle.ListRelationsKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, key := range keys {
+		relation, err := bundle.PickRelation(key)
+		if err != nil || relation.Hidden || relation.ReadOnly || key == bundle.RelationKeyName {
+			continue
+		}
+		if !strings.EqualFold(relation.Key, name) && !strings.EqualFold(relation.Name, name) {
+			continue
+		}
+		if isFormatCompatible(relation.Format, format) {
+			return relation
+		}
+	}
+	return nil
+}
+
+var textFormats = []model.RelationFormat{
+	model.RelationFormat_longtext,
+	model.RelationFormat_shorttext,
+	model.RelationFormat_url,
+	model.RelationFormat_email,
+	model.RelationFormat_phone,
+}
+
+// isFormatCompatible tells if values of the format can be put into the relation with the relation format
+func isFormatCompatible(relationFormat, format model.RelationFormat) bool {
+	switch {
+	case relationFormat == format:
+		return true
+	case relationFormat == model.RelationFormat_tag || relationFormat == model.RelationFormat_status:
+		return format == model.RelationFormat_tag || format == model.RelationFormat_longtext
+	case relationFormat == model.RelationFormat_longtext || relationFormat == model.RelationFormat_shorttext:
+		return slices.Contains(textFormats, format)
+	default:
+		return false
+	}
+}
+
+// OptionIds returns the ids of relation options with the given names, creating the options that don't exist yet
+func (b *RelationsBuilder) OptionIds(relationKey string, names []string) []string {
+	if b.options[relationKey] == nil {
+		b.options[relationKey] = make(map[string]string)
+	}
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id, ok := b.options[relationKey][name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		key := bson.NewObjectId().Hex()
+		details := domain.NewDetails()
+		details.SetString(bundle.RelationKeyName, name)
+		details.SetString(bundle.RelationKeyRelationKey, relationKey)
+		details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+		uniqueKey, err := domain.NewUniqueKey(coresb.SmartBlockTypeRelationOption, key)
+		if err != nil {
+			log.Warnf("failed to create unique key for relation option: %v", err)
+			continue
+		}
+		id := uniqueKey.Marshal()
+		details.SetString(bundle.RelationKeyId, id)
+		b.snapshots = append(b.snapshots, &Snapshot{
+			Id: id,
+			Snapshot: &SnapshotModel{
+				SbType: coresb.SmartBlockTypeRelationOption,
+				Data: &StateSnapshot{
+					Details:     details,
+					ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+					Key:         key,
+				},
+			},
+		})
+		b.options[relationKey][name] = id
+		ids = append(ids, id)
+	}
+	return ids
+}
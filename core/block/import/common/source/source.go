@@ -12,7 +12,7 @@ import (
 
 var log = logging.Logger("import-source")
 
-var extensions = []string{".md", ".csv", ".txt", ".pb", ".json", ".html"}
+var extensions = []string{".md", ".csv", ".txt", ".pb", ".json", ".html", ".enex", ".opml"}
 
 type Source interface {
 	Initialize(importPath string) error
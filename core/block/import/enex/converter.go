@@ -0,0 +1,234 @@
+package enex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/filetime"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/import/html"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name                 = "Enex"
+	rootCollectionName   = "Evernote Import"
+	notebookRelationName = "Notebook"
+	enexExtension        = ".enex"
+)
+
+var log = logging.Logger("import-enex")
+
+type Enex struct {
+	collectionService *collection.Service
+	tempDirProvider   core.TempDirProvider
+}
+
+func New(collectionService *collection.Service, tempDirProvider core.TempDirProvider) common.Converter {
+	return &Enex{
+		collectionService: collectionService,
+		tempDirProvider:   tempDirProvider,
+	}
+}
+
+func (e *Enex) Name() string {
+	return Name
+}
+
+func (e *Enex) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetEnexParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (e *Enex) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := e.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	relations := newRelationsBuilder()
+	snapshots, targetObjects := e.getSnapshots(req, progress, paths, relations, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	snapshots = append(snapshots, relations.Snapshots()...)
+	rootCollection := common.NewImportCollection(e.collectionService)
+	settings := common.MakeImportCollectionSetting(rootCollectionName, targetObjects, "", nil, true, true, true)
+	rootCol, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &common.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &common.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (e *Enex) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	relations *relationsBuilder,
+	allErrors *common.ConvertError,
+) ([]*common.Snapshot, []string) {
+	snapshots := make([]*common.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, nil
+		}
+		sn, to := e.handleImportPath(p, len(paths), relations, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+func (e *Enex) handleImportPath(p string, pathsCount int, relations *relationsBuilder, allErrors *common.ConvertError) ([]*common.Snapshot, []string) {
+	importSource := source.GetSource(p)
+	defer importSource.Close()
+	err := importSource.Initialize(p)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Enex) {
+			return nil, nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{enexExtension}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil, nil
+	}
+	var (
+		snapshots     []*common.Snapshot
+		targetObjects []string
+	)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), enexExtension) {
+			return true
+		}
+		notebook := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+		var i int
+		err = readNotes(fileReader, func(n *note) error {
+			sn, noteErr := e.getSnapshot(n, noteSource{importSource: importSource, path: p, fileName: fileName, index: i}, notebook, relations)
+			i++
+			if noteErr != nil {
+				allErrors.Add(noteErr)
+				if allErrors.ShouldAbortImport(pathsCount, model.Import_Enex) {
+					return noteErr
+				}
+				return nil
+			}
+			snapshots = append(snapshots, sn)
+			targetObjects = append(targetObjects, sn.Id)
+			return nil
+		})
+		if err != nil {
+			allErrors.Add(fmt.Errorf("%w: %s", common.ErrWrongHTMLFormat, err.Error()))
+			if allErrors.ShouldAbortImport(pathsCount, model.Import_Enex) {
+				return false
+			}
+		}
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	return snapshots, targetObjects
+}
+
+// noteSource is the place of the note in the imported export
+type noteSource struct {
+	importSource source.Source
+	path         string
+	fileName     string
+	index        int
+}
+
+// getSnapshot converts the note into a page. Notes have no paths of their own, so the source of the note is the path of
+// the export with the position of the note in it
+func (e *Enex) getSnapshot(n *note, src noteSource, notebook string, relations *relationsBuilder) (*common.Snapshot, error) {
+	files, err := restoreResources(e.tempDirProvider.TempDir(), n.Resources)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := e.getBlocks(n.Content, files, src.importSource, src.path)
+	if err != nil {
+		return nil, err
+	}
+	title := strings.TrimSpace(n.Title)
+	if title == "" {
+		title = notebook
+	}
+	sourcePath := fmt.Sprintf("%s#%d", src.fileName, src.index)
+	details := common.GetCommonDetails(src.fileName, title, "", model.ObjectType_basic)
+	details.SetString(bundle.RelationKeySourceFilePath, sourcePath)
+	created := filetime.ParseCompactTimestamp(n.Created)
+	updated := filetime.ParseCompactTimestamp(n.Updated)
+	if updated == 0 {
+		updated = created
+	}
+	if created != 0 {
+		details.SetInt64(bundle.RelationKeyCreatedDate, created)
+	}
+	if updated != 0 {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, updated)
+	}
+	relationLinks := relations.setRelations(details, notebook, n.Tags)
+
+	snapshot := &common.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: sourcePath,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        blocks,
+				Details:       details,
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}
+	return snapshot, nil
+}
+
+// getBlocks converts the note like the html importer does, links to the restored files become file blocks
+func (e *Enex) getBlocks(content string, files map[string]*restoredFile, importSource source.Source, path string) ([]*model.Block, error) {
+	noteHTML, err := enmlToHTML(content, files)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", common.ErrWrongHTMLFormat, err.Error())
+	}
+	return html.ConvertToBlocks([]byte(noteHTML), importSource, path, e.tempDirProvider)
+}
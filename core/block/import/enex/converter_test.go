@@ -0,0 +1,128 @@
+package enex
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type mockTempDirProvider struct {
+	dir string
+}
+
+func (p *mockTempDirProvider) TempDir() string {
+	return p.dir
+}
+
+func importRequest(path string) *pb.RpcObjectImportRequest {
+	return &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfEnexParams{
+			EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: []string{path}},
+		},
+		Type: model.Import_Enex,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}
+}
+
+func TestEnex_GetSnapshots(t *testing.T) {
+	t.Run("notes with resources, tags and notebook", func(t *testing.T) {
+		// given
+		tempDir := t.TempDir()
+		converter := New(nil, &mockTempDirProvider{dir: tempDir})
+
+		// when
+		sn, ce := converter.GetSnapshots(context.Background(), importRequest(filepath.Join("testdata", "Work.enex")), process.NewNoOp())
+
+		// then
+		require.Nil(t, ce)
+		require.NotNil(t, sn)
+		var (
+			pages     = map[string]*common.Snapshot{}
+			relations []*common.Snapshot
+			options   = map[string]*common.Snapshot{}
+		)
+		for _, snapshot := range sn.Snapshots {
+			switch {
+			case snapshot.Id == sn.RootCollectionID:
+			case snapshot.Snapshot.SbType == smartblock.SmartBlockTypePage:
+				pages[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)] = snapshot
+			case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelation:
+				relations = append(relations, snapshot)
+			case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelationOption:
+				options[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)] = snapshot
+			}
+		}
+		require.Len(t, pages, 2)
+		require.Len(t, relations, 1)
+		require.Len(t, options, 3)
+		notebookKey := domain.RelationKey(relations[0].Snapshot.Data.Key)
+		assert.Equal(t, notebookRelationName, relations[0].Snapshot.Data.Details.GetString(bundle.RelationKeyName))
+		assert.Equal(t, int64(model.RelationFormat_status), relations[0].Snapshot.Data.Details.GetInt64(bundle.RelationKeyRelationFormat))
+
+		plan := pages["Plan"]
+		require.NotNil(t, plan)
+		details := plan.Snapshot.Data.Details
+		assert.Equal(t, int64(1704164645), details.GetInt64(bundle.RelationKeyCreatedDate))
+		assert.Equal(t, int64(1706933106), details.GetInt64(bundle.RelationKeyLastModifiedDate))
+		assert.Equal(t, []string{options["Work"].Id}, details.GetStringList(notebookKey))
+		assert.Equal(t, []string{options["project"].Id, options["idea"].Id}, details.GetStringList(bundle.RelationKeyTag))
+		assert.Len(t, plan.Snapshot.Data.RelationLinks, 2)
+
+		var texts []string
+		var files []*model.BlockContentFile
+		for _, block := range plan.Snapshot.Data.Blocks {
+			if text := block.GetText(); text != nil && text.Text != "" {
+				texts = append(texts, text.Text)
+			}
+			if file := block.GetFile(); file != nil {
+				files = append(files, file)
+			}
+		}
+		assert.Equal(t, []string{"First paragraph", "Done task"}, texts)
+		require.Len(t, files, 2)
+		assert.Equal(t, model.BlockContentFile_Image, files[0].Type)
+		assert.Equal(t, filepath.Join(tempDir, "enex", "78805a221a988e79ef3f42d7c5bfd418", "78805a221a988e79ef3f42d7c5bfd418.png"), files[0].Name)
+		assert.Equal(t, filepath.Join(tempDir, "enex", "59ad8ee0e7dabd1c0b69c32673a48410", "report.pdf"), files[1].Name)
+
+		second := pages["Second"]
+		require.NotNil(t, second)
+		assert.Equal(t, int64(1704412800), second.Snapshot.Data.Details.GetInt64(bundle.RelationKeyLastModifiedDate))
+		assert.Equal(t, []string{options["idea"].Id}, second.Snapshot.Data.Details.GetStringList(bundle.RelationKeyTag))
+		assert.NotEqual(t, plan.Snapshot.Data.Details.GetString(bundle.RelationKeySourceFilePath), second.Snapshot.Data.Details.GetString(bundle.RelationKeySourceFilePath))
+	})
+	t.Run("no enex files in directory", func(t *testing.T) {
+		// given
+		converter := New(nil, &mockTempDirProvider{dir: t.TempDir()})
+
+		// when
+		_, ce := converter.GetSnapshots(context.Background(), importRequest(t.TempDir()), process.NewNoOp())
+
+		// then
+		require.NotNil(t, ce)
+		assert.True(t, errors.Is(ce.GetResultError(model.Import_Enex), common.ErrFileImportNoObjectsInDirectory))
+	})
+}
+
+func TestEnmlToHTML(t *testing.T) {
+	// given
+	content := `<en-note><div><en-todo/>Task</div><en-media hash="missing" type="image/png"/></en-note>`
+
+	// when
+	result, err := enmlToHTML(content, nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "<div>[ ] Task</div>", result)
+}
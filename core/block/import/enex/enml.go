@@ -0,0 +1,153 @@
+package enex
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+var selfClosingTagRegexp = regexp.MustCompile(`<(en-media|en-todo)([^>]*?)/>`)
+
+type note struct {
+	Title     string     `xml:"title"`
+	Content   string     `xml:"content"`
+	Created   string     `xml:"created"`
+	Updated   string     `xml:"updated"`
+	Tags      []string   `xml:"tag"`
+	Resources []resource `xml:"resource"`
+}
+
+type resource struct {
+	Data struct {
+		Encoding string `xml:"encoding,attr"`
+		Value    string `xml:",chardata"`
+	} `xml:"data"`
+	Mime       string `xml:"mime"`
+	Attributes struct {
+		FileName string `xml:"file-name"`
+	} `xml:"resource-attributes"`
+}
+
+// restoredFile is a resource of the note written to the temporary directory
+type restoredFile struct {
+	path string
+	name string
+	mime string
+}
+
+// readNotes decodes notes of the export one by one, so the whole export with base64 resources is never kept in memory
+func readNotes(r io.Reader, callback func(n *note) error) error {
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "note" {
+			continue
+		}
+		n := &note{}
+		if err = decoder.DecodeElement(n, &start); err != nil {
+			return err
+		}
+		if err = callback(n); err != nil {
+			return err
+		}
+	}
+}
+
+// restoreResources decodes resources into files of the temporary directory. Resources are referenced
+// from the note content by md5 hashes of their data, so the files are returned by hash
+func restoreResources(tempDir string, resources []resource) (map[string]*restoredFile, error) {
+	files := make(map[string]*restoredFile, len(resources))
+	for _, res := range resources {
+		if res.Data.Encoding != "" && res.Data.Encoding != "base64" {
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(res.Data.Value), ""))
+		if err != nil {
+			return nil, fmt.Errorf("decode resource: %w", err)
+		}
+		sum := md5.Sum(data)
+		hash := hex.EncodeToString(sum[:])
+		name := filepath.Base(res.Attributes.FileName)
+		if name == "" || name == "." || name == string(filepath.Separator) {
+			name = hash + extensionByMime(res.Mime)
+		}
+		dir := filepath.Join(tempDir, "enex", hash)
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+		path := filepath.Join(dir, name)
+		if err = os.WriteFile(path, data, 0600); err != nil {
+			return nil, err
+		}
+		files[hash] = &restoredFile{path: path, name: name, mime: res.Mime}
+	}
+	return files, nil
+}
+
+func extensionByMime(mimeType string) string {
+	extensions, err := mime.ExtensionsByType(mimeType)
+	if err != nil || len(extensions) == 0 {
+		return ""
+	}
+	return extensions[0]
+}
+
+// enmlToHTML turns the note content into html: media become images and links to the restored files,
+// and checkboxes become the text, which is converted into checkbox blocks
+func enmlToHTML(content string, files map[string]*restoredFile) (string, error) {
+	content = selfClosingTagRegexp.ReplaceAllString(content, "<$1$2></$1>")
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	doc.Find("en-media").Each(func(_ int, media *goquery.Selection) {
+		hash, _ := media.Attr("hash")
+		file := files[hash]
+		if file == nil {
+			media.Remove()
+			return
+		}
+		source := html.EscapeString(url.PathEscape(file.path))
+		name := html.EscapeString(file.name)
+		if strings.HasPrefix(file.mime, "image/") {
+			media.ReplaceWithHtml(fmt.Sprintf(`<img src="%s" alt="%s">`, source, name))
+		} else {
+			media.ReplaceWithHtml(fmt.Sprintf(`<div><a href="%s">%s</a></div>`, source, name))
+		}
+	})
+	doc.Find("en-todo").Each(func(_ int, todo *goquery.Selection) {
+		if checked, _ := todo.Attr("checked"); checked == "true" {
+			todo.ReplaceWithHtml("[x] ")
+		} else {
+			todo.ReplaceWithHtml("[ ] ")
+		}
+	})
+	// encrypted text can't be read without the passphrase
+	doc.Find("en-crypt").Remove()
+
+	noteBody := doc.Find("en-note")
+	if noteBody.Length() == 0 {
+		noteBody = doc.Find("body")
+	}
+	return noteBody.Html()
+}
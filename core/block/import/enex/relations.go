@@ -0,0 +1,43 @@
+package enex
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// relationsBuilder creates the notebook relation and options of the notebook and tag relations once per import
+type relationsBuilder struct {
+	*common.RelationsBuilder
+	notebook *model.RelationLink
+}
+
+func newRelationsBuilder() *relationsBuilder {
+	return &relationsBuilder{RelationsBuilder: common.NewRelationsBuilder()}
+}
+
+func (b *relationsBuilder) notebookRelation() *model.RelationLink {
+	if b.notebook == nil {
+		b.notebook = b.AddRelation(notebookRelationName, model.RelationFormat_status)
+	}
+	return b.notebook
+}
+
+// setRelations puts the notebook and the tags of the note into its details
+func (b *relationsBuilder) setRelations(details *domain.Details, notebook string, tags []string) []*model.RelationLink {
+	var links []*model.RelationLink
+	if notebook != "" {
+		relation := b.notebookRelation()
+		details.SetStringList(domain.RelationKey(relation.Key), b.OptionIds(relation.Key, []string{notebook}))
+		links = append(links, relation)
+	}
+	if len(tags) > 0 {
+		details.SetStringList(bundle.RelationKeyTag, b.OptionIds(bundle.RelationKeyTag.String(), tags))
+		links = append(links, &model.RelationLink{
+			Key:    bundle.RelationKeyTag.String(),
+			Format: model.RelationFormat_tag,
+		})
+	}
+	return links
+}
@@ -159,26 +159,32 @@ func (h *HTML) getBlocksForSnapshot(rc io.ReadCloser, filesSource source.Source,
 	if err != nil {
 		return nil, err
 	}
-	blocks, _, err := anymark.HTMLToBlocks(b, "")
+	return ConvertToBlocks(b, filesSource, path, h.tempDirProvider)
+}
+
+// ConvertToBlocks converts the html into blocks. Files of file blocks and local files of links are taken
+// from the disk or extracted from the source, links to files become file blocks
+func ConvertToBlocks(content []byte, filesSource source.Source, path string, tempDirProvider core.TempDirProvider) ([]*model.Block, error) {
+	blocks, _, err := anymark.HTMLToBlocks(content, "")
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", common.ErrWrongHTMLFormat, err.Error())
 	}
 	for _, block := range blocks {
 		if block.GetFile() != nil {
-			if newFileName, _, err := common.ProvideFileName(block.GetFile().GetName(), filesSource, path, h.tempDirProvider); err == nil {
+			if newFileName, _, err := common.ProvideFileName(block.GetFile().GetName(), filesSource, path, tempDirProvider); err == nil {
 				block.GetFile().Name = newFileName
 			} else {
 				log.Errorf("failed to update file block with new file name: %v", anyerror.CleanupError(err))
 			}
 		}
 		if block.GetText() != nil && block.GetText().Marks != nil && len(block.GetText().Marks.Marks) > 0 {
-			h.updateFilesInLinks(block, filesSource, path)
+			updateFilesInLinks(block, filesSource, path, tempDirProvider)
 		}
 	}
 	return blocks, nil
 }
 
-func (h *HTML) updateFilesInLinks(block *model.Block, filesSource source.Source, path string) {
+func updateFilesInLinks(block *model.Block, filesSource source.Source, path string, tempDirProvider core.TempDirProvider) {
 	marks := block.GetText().GetMarks().GetMarks()
 	for _, mark := range marks {
 		if mark.Type == model.BlockContentTextMark_Link {
@@ -187,7 +193,7 @@ func (h *HTML) updateFilesInLinks(block *model.Block, filesSource source.Source,
 				newFileName     string
 				createFileBlock bool
 			)
-			if newFileName, createFileBlock, err = common.ProvideFileName(mark.Param, filesSource, path, h.tempDirProvider); err == nil {
+			if newFileName, createFileBlock, err = common.ProvideFileName(mark.Param, filesSource, path, tempDirProvider); err == nil {
 				mark.Param = newFileName
 				if createFileBlock {
 					block.Content = anymark.ConvertTextToFile(mark.Param)
@@ -23,9 +23,12 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/common/syncer"
 	"github.com/anyproto/anytype-heart/core/block/import/common/workerpool"
 	"github.com/anyproto/anytype-heart/core/block/import/csv"
+	"github.com/anyproto/anytype-heart/core/block/import/enex"
 	"github.com/anyproto/anytype-heart/core/block/import/html"
 	"github.com/anyproto/anytype-heart/core/block/import/markdown"
 	"github.com/anyproto/anytype-heart/core/block/import/notion"
+	"github.com/anyproto/anytype-heart/core/block/import/opml"
+	"github.com/anyproto/anytype-heart/core/block/import/outliner"
 	pbc "github.com/anyproto/anytype-heart/core/block/import/pb"
 	"github.com/anyproto/anytype-heart/core/block/import/txt"
 	"github.com/anyproto/anytype-heart/core/block/import/web"
@@ -86,12 +89,17 @@ func (i *Import) Init(a *app.App) (err error) {
 	i.tempDirProvider = app.MustComponent[core.TempDirProvider](a)
 	converters := []common.Converter{
 		markdown.New(i.tempDirProvider, col),
+		markdown.NewObsidian(i.tempDirProvider, col),
 		notion.New(col),
 		pbc.New(col, accountService, i.tempDirProvider),
 		web.NewConverter(),
 		html.New(col, i.tempDirProvider),
 		txt.New(col),
 		csv.New(col),
+		enex.New(col, i.tempDirProvider),
+		outliner.NewLogseq(col, i.tempDirProvider),
+		outliner.NewRoam(col, i.tempDirProvider),
+		opml.New(col),
 	}
 	for _, c := range converters {
 		i.converters[c.Name()] = c
@@ -28,21 +28,25 @@ type FileInfo struct {
 	Title                 string
 	ParsedBlocks          []*model.Block
 	CollectionsObjectsIds []string
+	RelationLinks         []*model.RelationLink
+	// Properties and Tags are filled from the front matter and the text of obsidian notes
+	Properties map[string]any
+	Tags       []string
 }
 
 func newMDConverter(tempDirProvider core.TempDirProvider) *mdConverter {
 	return &mdConverter{tempDirProvider: tempDirProvider}
 }
 
-func (m *mdConverter) markdownToBlocks(importPath string, importSource source.Source, allErrors *common.ConvertError) map[string]*FileInfo {
-	files := m.processFiles(importPath, allErrors, importSource)
+func (m *mdConverter) markdownToBlocks(importPath string, importSource source.Source, obsidian bool, allErrors *common.ConvertError) map[string]*FileInfo {
+	files := m.processFiles(importPath, allErrors, importSource, obsidian)
 
 	log.Debug("2. DirWithMarkdownToBlocks: MarkdownToBlocks completed")
 
 	return files
 }
 
-func (m *mdConverter) processFiles(importPath string, allErrors *common.ConvertError, importSource source.Source) map[string]*FileInfo {
+func (m *mdConverter) processFiles(importPath string, allErrors *common.ConvertError, importSource source.Source, obsidian bool) map[string]*FileInfo {
 	err := importSource.Initialize(importPath)
 	if err != nil {
 		allErrors.Add(err)
@@ -54,7 +58,14 @@ func (m *mdConverter) processFiles(importPath string, allErrors *common.ConvertE
 		allErrors.Add(common.ErrorBySourceType(importSource))
 		return nil
 	}
-	fileInfo := m.getFileInfo(importSource, allErrors)
+	var vault *obsidianVault
+	if obsidian {
+		if vault, err = newObsidianVault(importSource); err != nil {
+			allErrors.Add(err)
+			return nil
+		}
+	}
+	fileInfo := m.getFileInfo(importSource, vault, allErrors)
 	for name, file := range fileInfo {
 		m.processBlocks(name, file, fileInfo, importSource)
 		for _, b := range file.ParsedBlocks {
@@ -64,10 +75,13 @@ func (m *mdConverter) processFiles(importPath string, allErrors *common.ConvertE
 	return fileInfo
 }
 
-func (m *mdConverter) getFileInfo(importSource source.Source, allErrors *common.ConvertError) map[string]*FileInfo {
+func (m *mdConverter) getFileInfo(importSource source.Source, vault *obsidianVault, allErrors *common.ConvertError) map[string]*FileInfo {
 	fileInfo := make(map[string]*FileInfo, 0)
 	if iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
-		if err := m.fillFilesInfo(importSource, fileInfo, fileName, fileReader); err != nil {
+		if vault != nil && isObsidianServiceFile(fileName) {
+			return true
+		}
+		if err := m.fillFilesInfo(importSource, fileInfo, fileName, fileReader, vault); err != nil {
 			allErrors.Add(err)
 			if allErrors.ShouldAbortImport(0, model.Import_Markdown) {
 				return false
@@ -80,9 +94,9 @@ func (m *mdConverter) getFileInfo(importSource source.Source, allErrors *common.
 	return fileInfo
 }
 
-func (m *mdConverter) fillFilesInfo(importSource source.Source, fileInfo map[string]*FileInfo, path string, rc io.ReadCloser) error {
+func (m *mdConverter) fillFilesInfo(importSource source.Source, fileInfo map[string]*FileInfo, path string, rc io.ReadCloser, vault *obsidianVault) error {
 	fileInfo[path] = &FileInfo{}
-	if err := m.createBlocksFromFile(importSource, path, rc, fileInfo); err != nil {
+	if err := m.createBlocksFromFile(importSource, path, rc, fileInfo, vault); err != nil {
 		log.Errorf("failed to create blocks from file: %s", err)
 		return err
 	}
@@ -263,7 +277,7 @@ func (m *mdConverter) convertTextToPageMention(block *model.Block) {
 	}
 }
 
-func (m *mdConverter) createBlocksFromFile(importSource source.Source, filePath string, f io.ReadCloser, files map[string]*FileInfo) error {
+func (m *mdConverter) createBlocksFromFile(importSource source.Source, filePath string, f io.ReadCloser, files map[string]*FileInfo, vault *obsidianVault) error {
 	if importSource.IsRootFile(filePath) {
 		files[filePath].IsRootFile = true
 	}
@@ -272,10 +286,17 @@ func (m *mdConverter) createBlocksFromFile(importSource source.Source, filePath
 		if err != nil {
 			return err
 		}
+		if vault != nil {
+			b, files[filePath].Properties = splitFrontMatter(b)
+			b = vault.convertWikilinks(filePath, b)
+		}
 		files[filePath].ParsedBlocks, _, err = anymark.MarkdownToBlocks(b, filepath.Dir(filePath), nil)
 		if err != nil {
 			log.Errorf("failed to read blocks: %s", err)
 		}
+		if vault != nil {
+			files[filePath].Tags = collectTags(files[filePath].Properties, files[filePath].ParsedBlocks)
+		}
 	}
 	return nil
 }
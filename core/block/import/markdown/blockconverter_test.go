@@ -39,7 +39,7 @@ func Test_processFiles(t *testing.T) {
 		source := source.GetSource(absolutePath)
 
 		// when
-		files := converter.processFiles(absolutePath, common.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source)
+		files := converter.processFiles(absolutePath, common.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source, false)
 
 		// then
 		assert.Len(t, files, 9)
@@ -71,7 +71,7 @@ func Test_processFiles(t *testing.T) {
 		absolutePath := filepath.Join(workingDir, "testdata")
 
 		// when
-		files := converter.processFiles(absolutePath, common.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source)
+		files := converter.processFiles(absolutePath, common.NewError(pb.RpcObjectImportRequest_IGNORE_ERRORS), source, false)
 
 		// then
 		assert.Len(t, files, 7)
@@ -33,22 +33,39 @@ const numberOfStages = 9 // 8 cycles to get snapshots and 1 cycle to create obje
 type Markdown struct {
 	blockConverter *mdConverter
 	service        *collection.Service
+	obsidian       bool
 }
 
 const (
-	Name               = "Markdown"
-	rootCollectionName = "Markdown Import"
+	Name                       = "Markdown"
+	ObsidianName               = "Obsidian"
+	rootCollectionName         = "Markdown Import"
+	obsidianRootCollectionName = "Obsidian Import"
 )
 
 func New(tempDirProvider core.TempDirProvider, service *collection.Service) common.Converter {
 	return &Markdown{blockConverter: newMDConverter(tempDirProvider), service: service}
 }
 
+// NewObsidian creates the converter of Obsidian vaults, which are markdown files with wikilinks, tags and front matter
+func NewObsidian(tempDirProvider core.TempDirProvider, service *collection.Service) common.Converter {
+	return &Markdown{blockConverter: newMDConverter(tempDirProvider), service: service, obsidian: true}
+}
+
 func (m *Markdown) Name() string {
+	if m.obsidian {
+		return ObsidianName
+	}
 	return Name
 }
 
 func (m *Markdown) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if m.obsidian {
+		if p := req.GetObsidianParams(); p != nil {
+			return p.Path
+		}
+		return nil
+	}
 	if p := req.GetMarkdownParams(); p != nil {
 		return p.Path
 	}
@@ -102,7 +119,11 @@ func (m *Markdown) processFiles(req *pb.RpcObjectImportRequest, progress process
 
 func (m *Markdown) createRootCollection(allSnapshots []*common.Snapshot, allRootObjectsIds []string) ([]*common.Snapshot, string, error) {
 	rootCollection := common.NewImportCollection(m.service)
-	settings := common.MakeImportCollectionSetting(rootCollectionName, allRootObjectsIds, "", nil, true, true, true)
+	collectionName := rootCollectionName
+	if m.obsidian {
+		collectionName = obsidianRootCollectionName
+	}
+	settings := common.MakeImportCollectionSetting(collectionName, allRootObjectsIds, "", nil, true, true, true)
 	rootCol, err := rootCollection.MakeImportCollection(settings)
 	if err != nil {
 		return nil, "", err
@@ -127,8 +148,8 @@ func (m *Markdown) getSnapshotsAndRootObjectsIds(
 		return nil, nil
 	}
 	defer importSource.Close()
-	files := m.blockConverter.markdownToBlocks(path, importSource, allErrors)
-	pathsCount := len(req.GetMarkdownParams().Path)
+	files := m.blockConverter.markdownToBlocks(path, importSource, m.obsidian, allErrors)
+	pathsCount := len(m.GetParams(req))
 	if allErrors.ShouldAbortImport(pathsCount, req.Type) {
 		return nil, nil
 	}
@@ -146,7 +167,12 @@ func (m *Markdown) getSnapshotsAndRootObjectsIds(
 		return nil, nil
 	}
 
-	return m.createSnapshots(pathsCount, files, progress, details, allErrors), m.retrieveRootObjectsIds(files)
+	var propertySnapshots []*common.Snapshot
+	if m.obsidian {
+		propertySnapshots = m.setProperties(files, details)
+	}
+	snapshots := m.createSnapshots(pathsCount, files, progress, details, allErrors)
+	return append(snapshots, propertySnapshots...), m.retrieveRootObjectsIds(files)
 }
 
 func (m *Markdown) processImportStep(pathCount int,
@@ -412,9 +438,10 @@ func (m *Markdown) createSnapshots(
 			Snapshot: &common.SnapshotModel{
 				SbType: smartblock.SmartBlockTypePage,
 				Data: &common.StateSnapshot{
-					Blocks:      file.ParsedBlocks,
-					Details:     details[name],
-					ObjectTypes: []string{bundle.TypeKeyPage.String()},
+					Blocks:        file.ParsedBlocks,
+					Details:       details[name],
+					RelationLinks: file.RelationLinks,
+					ObjectTypes:   []string{bundle.TypeKeyPage.String()},
 				}},
 		})
 	}
@@ -568,7 +595,8 @@ func (m *Markdown) setNewID(files map[string]*FileInfo, progress process.Progres
 
 func (m *Markdown) setDetails(file *FileInfo, fileName string, details map[string]*domain.Details) {
 	var title, emoji string
-	if len(file.ParsedBlocks) > 0 {
+	// obsidian notes are titled by their file names, so the first header stays in the content
+	if len(file.ParsedBlocks) > 0 && !m.obsidian {
 		title, emoji = m.extractTitleAndEmojiFromBlock(file)
 	}
 	details[fileName] = common.GetCommonDetails(fileName, title, emoji, model.ObjectType_basic)
@@ -0,0 +1,456 @@
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/mail"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var (
+	wikilinkRegexp     = regexp.MustCompile(`(!?)\[\[([^\[\]\n]+)\]\]`)
+	wholeWikilinkRegex = regexp.MustCompile(`^\[\[([^\[\]\n]+)\]\]$`)
+	tagRegexp          = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_/-]+)`)
+	markdownEscaper    = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`, `*`, `\*`, `_`, `\_`, "`", "\\`")
+)
+
+var (
+	obsidianServiceDirs = []string{".obsidian", ".trash"}
+	imageExtensions     = []string{".jpg", ".jpeg", ".png", ".gif", ".webp"}
+	dateLayouts         = []string{"2006-01-02", "2006-01-02T15:04", "2006-01-02T15:04:05", "2006-01-02 15:04", time.RFC3339}
+)
+
+func isObsidianServiceFile(path string) bool {
+	for _, part := range strings.Split(filepath.ToSlash(path), "/") {
+		if lo.Contains(obsidianServiceDirs, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// obsidianVault resolves wikilinks, which reference notes and attachments by name instead of a relative path
+type obsidianVault struct {
+	paths []string
+}
+
+func newObsidianVault(importSource source.Source) (*obsidianVault, error) {
+	vault := &obsidianVault{}
+	err := importSource.Iterate(func(fileName string, _ io.ReadCloser) bool {
+		if !isObsidianServiceFile(fileName) {
+			vault.paths = append(vault.paths, fileName)
+		}
+		return true
+	})
+	return vault, err
+}
+
+// resolve returns the path of the file the link points to or an empty string if there is no such file
+func (v *obsidianVault) resolve(notePath, target string) string {
+	target, _, _ = strings.Cut(target, "#")
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return ""
+	}
+	target = filepath.FromSlash(target)
+	if path := v.find(filepath.Dir(notePath), target); path != "" {
+		return path
+	}
+	if strings.EqualFold(filepath.Ext(target), ".md") {
+		return ""
+	}
+	return v.find(filepath.Dir(notePath), target+".md")
+}
+
+// find looks for the file next to the note first, and then for the shortest path ending with the name,
+// as obsidian writes only as much of the path as needed to make the link unique
+func (v *obsidianVault) find(noteDir, name string) string {
+	relative := filepath.Join(noteDir, name)
+	suffix := strings.ToLower(string(filepath.Separator) + name)
+	var found string
+	for _, path := range v.paths {
+		if path == relative {
+			return path
+		}
+		lowerPath := strings.ToLower(path)
+		if lowerPath != strings.ToLower(name) && !strings.HasSuffix(lowerPath, suffix) {
+			continue
+		}
+		if found == "" || len(path) < len(found) || (len(path) == len(found) && path < found) {
+			found = path
+		}
+	}
+	return found
+}
+
+// convertWikilinks replaces wikilinks and embeds with markdown links, so they are handled like the links of plain markdown files
+func (v *obsidianVault) convertWikilinks(notePath string, data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	var inCode bool
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCode = !inCode
+			continue
+		}
+		if !inCode {
+			lines[i] = v.convertLine(notePath, line)
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+func (v *obsidianVault) convertLine(notePath, line string) string {
+	matches := wikilinkRegexp.FindAllStringSubmatchIndex(line, -1)
+	if len(matches) == 0 {
+		return line
+	}
+	var (
+		result strings.Builder
+		last   int
+	)
+	for _, match := range matches {
+		result.WriteString(line[last:match[0]])
+		last = match[1]
+		// links inside inline code stay as they are
+		if strings.Count(line[:match[0]], "`")%2 == 1 {
+			result.WriteString(line[match[0]:match[1]])
+			continue
+		}
+		isEmbed := match[3] > match[2]
+		result.WriteString(v.wikilinkToMarkdown(notePath, line[match[4]:match[5]], isEmbed))
+	}
+	result.WriteString(line[last:])
+	return result.String()
+}
+
+func (v *obsidianVault) wikilinkToMarkdown(notePath, link string, isEmbed bool) string {
+	target, label, _ := strings.Cut(link, "|")
+	target = strings.TrimSpace(target)
+	label = strings.TrimSpace(label)
+	if label == "" {
+		label = strings.TrimLeft(target, "#^")
+	}
+	path := v.resolve(notePath, target)
+	if path == "" {
+		return markdownEscaper.Replace(label)
+	}
+	destination, err := filepath.Rel(filepath.Dir(notePath), path)
+	if err != nil {
+		destination = path
+	}
+	destination = "<" + strings.NewReplacer("<", `\<`, ">", `\>`).Replace(destination) + ">"
+	if isEmbed && lo.Contains(imageExtensions, strings.ToLower(filepath.Ext(path))) {
+		return "![" + markdownEscaper.Replace(label) + "](" + destination + ")"
+	}
+	return "[" + markdownEscaper.Replace(label) + "](" + destination + ")"
+}
+
+// splitFrontMatter cuts the yaml front matter off the note and returns its properties
+func splitFrontMatter(data []byte) ([]byte, map[string]any) {
+	if !bytes.HasPrefix(data, []byte("---\n")) && !bytes.HasPrefix(data, []byte("---\r\n")) {
+		return data, nil
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	for i := 1; i < len(lines); i++ {
+		line := bytes.TrimSpace(lines[i])
+		if !bytes.Equal(line, []byte("---")) && !bytes.Equal(line, []byte("...")) {
+			continue
+		}
+		properties := make(map[string]any)
+		if err := yaml.Unmarshal(bytes.Join(lines[1:i], nil), &properties); err != nil {
+			log.Warnf("failed to parse front matter: %v", err)
+			return data, nil
+		}
+		return bytes.Join(lines[i+1:], nil), properties
+	}
+	return data, nil
+}
+
+func isTagsProperty(name string) bool {
+	return strings.EqualFold(name, "tags") || strings.EqualFold(name, "tag")
+}
+
+// collectTags returns the tags of the front matter and the #tags of the note text
+func collectTags(properties map[string]any, blocks []*model.Block) []string {
+	var tags []string
+	for name, value := range properties {
+		if !isTagsProperty(name) {
+			continue
+		}
+		for _, tag := range propertyList(value) {
+			for _, part := range strings.FieldsFunc(tag, func(r rune) bool { return r == ',' || r == ' ' }) {
+				if part = strings.TrimPrefix(part, "#"); part != "" {
+					tags = append(tags, part)
+				}
+			}
+		}
+	}
+	for _, b := range blocks {
+		text := b.GetText()
+		if text == nil || text.Style == model.BlockContentText_Code {
+			continue
+		}
+		for _, match := range tagRegexp.FindAllStringSubmatch(text.Text, -1) {
+			// obsidian doesn't treat numbers like #123 as tags
+			if strings.Trim(match[1], "0123456789") != "" {
+				tags = append(tags, match[1])
+			}
+		}
+	}
+	return lo.Uniq(tags)
+}
+
+func propertyList(value any) []string {
+	values, ok := value.([]any)
+	if !ok {
+		if value == nil {
+			return nil
+		}
+		return []string{propertyString(value)}
+	}
+	list := make([]string, 0, len(values))
+	for _, v := range values {
+		if s := propertyString(v); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func propertyString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.DateOnly)
+	case []any:
+		return strings.Join(propertyList(v), ", ")
+	case map[string]any:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return strings.TrimSpace(string(data))
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func parsePropertyDate(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func parseWikilink(value any) (string, bool) {
+	s, ok := value.(string)
+	if !ok {
+		return "", false
+	}
+	match := wholeWikilinkRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return "", false
+	}
+	target, _, _ := strings.Cut(match[1], "|")
+	return target, true
+}
+
+// inferFormat returns the relation format for the front matter value, or false when the value is empty
+func inferFormat(value any) (model.RelationFormat, bool) {
+	switch v := value.(type) {
+	case nil:
+		return 0, false
+	case bool:
+		return model.RelationFormat_checkbox, true
+	case int, int64, uint64, float64:
+		return model.RelationFormat_number, true
+	case time.Time:
+		return model.RelationFormat_date, true
+	case string:
+		if _, ok := parseWikilink(v); ok {
+			return model.RelationFormat_object, true
+		}
+		if _, ok := parsePropertyDate(v); ok {
+			return model.RelationFormat_date, true
+		}
+		if strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://") {
+			return model.RelationFormat_url, true
+		}
+		if address, err := mail.ParseAddress(v); err == nil && address.Address == v {
+			return model.RelationFormat_email, true
+		}
+		return model.RelationFormat_longtext, true
+	case []any:
+		if len(v) == 0 {
+			return 0, false
+		}
+		for _, item := range v {
+			if _, ok := parseWikilink(item); !ok {
+				return model.RelationFormat_tag, true
+			}
+		}
+		return model.RelationFormat_object, true
+	default:
+		return model.RelationFormat_longtext, true
+	}
+}
+
+// mergeFormats chooses the format for the property, which values have different formats in different notes
+func mergeFormats(current, next model.RelationFormat) model.RelationFormat {
+	switch {
+	case current == next:
+		return current
+	case current == model.RelationFormat_tag && next == model.RelationFormat_longtext,
+		current == model.RelationFormat_longtext && next == model.RelationFormat_tag:
+		// a single value is a list with one tag
+		return model.RelationFormat_tag
+	default:
+		return model.RelationFormat_longtext
+	}
+}
+
+type propertiesBuilder struct {
+	*common.RelationsBuilder
+	files map[string]*FileInfo
+	vault *obsidianVault
+}
+
+// setProperties turns front matter of obsidian notes into relations with the formats inferred from the values of all notes,
+// properties named like bundled relations, e.g. description, are put into them. Tags of the notes go into the tag relation
+func (m *Markdown) setProperties(files map[string]*FileInfo, details map[string]*domain.Details) []*common.Snapshot {
+	fileNames := lo.Keys(files)
+	sort.Strings(fileNames)
+	b := &propertiesBuilder{
+		RelationsBuilder: common.NewRelationsBuilder(),
+		files:            files,
+		vault:            &obsidianVault{paths: fileNames},
+	}
+
+	formats := make(map[string]model.RelationFormat)
+	for _, name := range fileNames {
+		for property, value := range files[name].Properties {
+			if isTagsProperty(property) {
+				continue
+			}
+			format, ok := inferFormat(value)
+			if !ok {
+				continue
+			}
+			if current, exists := formats[property]; exists {
+				format = mergeFormats(current, format)
+			}
+			formats[property] = format
+		}
+	}
+	properties := lo.Keys(formats)
+	sort.Strings(properties)
+	relations := make(map[string]*model.RelationLink, len(properties))
+	for _, property := range properties {
+		relations[property] = b.ProvideRelation(property, formats[property])
+	}
+
+	for _, name := range fileNames {
+		file := files[name]
+		if file.PageID == "" || details[name] == nil {
+			continue
+		}
+		noteProperties := lo.Keys(file.Properties)
+		sort.Strings(noteProperties)
+		for _, property := range noteProperties {
+			relation, ok := relations[property]
+			if !ok {
+				continue
+			}
+			propertyValue, ok := b.value(name, relation, file.Properties[property])
+			if !ok {
+				continue
+			}
+			details[name].Set(domain.RelationKey(relation.Key), propertyValue)
+			file.RelationLinks = append(file.RelationLinks, relation)
+		}
+		if len(file.Tags) > 0 {
+			details[name].SetStringList(bundle.RelationKeyTag, b.OptionIds(bundle.RelationKeyTag.String(), file.Tags))
+			file.RelationLinks = append(file.RelationLinks, &model.RelationLink{
+				Key:    bundle.RelationKeyTag.String(),
+				Format: model.RelationFormat_tag,
+			})
+		}
+	}
+	return b.Snapshots()
+}
+
+func (b *propertiesBuilder) value(notePath string, relation *model.RelationLink, value any) (domain.Value, bool) {
+	switch relation.Format {
+	case model.RelationFormat_checkbox:
+		v, ok := value.(bool)
+		return domain.Bool(v), ok
+	case model.RelationFormat_number:
+		switch v := value.(type) {
+		case int:
+			return domain.Int64(v), true
+		case int64:
+			return domain.Int64(v), true
+		case uint64:
+			return domain.Int64(v), true
+		case float64:
+			return domain.Float64(v), true
+		}
+		return domain.Invalid(), false
+	case model.RelationFormat_date:
+		t, ok := parsePropertyDate(value)
+		return domain.Int64(t.Unix()), ok
+	case model.RelationFormat_object:
+		var ids []string
+		for _, item := range toList(value) {
+			target, ok := parseWikilink(item)
+			if !ok {
+				continue
+			}
+			if file := b.files[b.vault.resolve(notePath, target)]; file != nil && file.PageID != "" {
+				ids = append(ids, file.PageID)
+			}
+		}
+		return domain.StringList(ids), len(ids) > 0
+	case model.RelationFormat_tag, model.RelationFormat_status:
+		names := propertyList(value)
+		if relation.Format == model.RelationFormat_status && len(names) > 1 {
+			names = names[:1]
+		}
+		return domain.StringList(b.OptionIds(relation.Key, names)), len(names) > 0
+	default:
+		s := propertyString(value)
+		return domain.String(s), s != ""
+	}
+}
+
+func toList(value any) []any {
+	if list, ok := value.([]any); ok {
+		return list
+	}
+	return []any{value}
+}
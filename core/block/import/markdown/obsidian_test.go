@@ -0,0 +1,150 @@
+package markdown
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func setupObsidianVault(t *testing.T) string {
+	vaultDir := filepath.Join(t.TempDir(), "vault")
+	files := map[string]string{
+		filepath.Join(".obsidian", "app.json"): "{}",
+		"Note.md": "---\n" +
+			"tags: [project, idea]\n" +
+			"status: draft\n" +
+			"description: Plan of the project\n" +
+			"rating: 4\n" +
+			"due: 2024-05-01\n" +
+			"done: false\n" +
+			"related: \"[[Other]]\"\n" +
+			"---\n" +
+			"# Plan\n" +
+			"See [[Other|the other note]] and #inline tags, but not `[[Other]]` and #123\n" +
+			"\n" +
+			"![[image.png]]\n",
+		filepath.Join("folder", "Other.md"):       "Other note",
+		filepath.Join("attachments", "image.png"): "image",
+	}
+	for name, content := range files {
+		path := filepath.Join(vaultDir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+	return vaultDir
+}
+
+func TestObsidian_GetSnapshots(t *testing.T) {
+	// given
+	vaultDir := setupObsidianVault(t)
+	converter := NewObsidian(&MockTempDir{}, nil)
+
+	// when
+	sn, ce := converter.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfObsidianParams{
+			ObsidianParams: &pb.RpcObjectImportRequestObsidianParams{Path: []string{vaultDir}},
+		},
+		Type: model.Import_Obsidian,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, process.NewNoOp())
+
+	// then
+	require.Nil(t, ce)
+	require.NotNil(t, sn)
+	var (
+		note, other *common.Snapshot
+		relations   = map[string]*common.Snapshot{}
+		options     = map[string]*common.Snapshot{}
+	)
+	for _, snapshot := range sn.Snapshots {
+		switch {
+		case snapshot.FileName == filepath.Join(vaultDir, "Note.md"):
+			note = snapshot
+		case snapshot.FileName == filepath.Join(vaultDir, "folder", "Other.md"):
+			other = snapshot
+		case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelation:
+			relations[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)] = snapshot
+		case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelationOption:
+			options[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)] = snapshot
+		case strings.Contains(snapshot.FileName, ".obsidian"):
+			t.Fatalf("obsidian config is imported: %s", snapshot.FileName)
+		}
+	}
+	require.NotNil(t, note)
+	require.NotNil(t, other)
+
+	formats := map[string]model.RelationFormat{
+		"rating":  model.RelationFormat_number,
+		"due":     model.RelationFormat_date,
+		"related": model.RelationFormat_object,
+	}
+	require.Len(t, relations, len(formats))
+	details := note.Snapshot.Data.Details
+	for name, format := range formats {
+		relation := relations[name]
+		require.NotNil(t, relation, name)
+		assert.Equal(t, int64(format), relation.Snapshot.Data.Details.GetInt64(bundle.RelationKeyRelationFormat))
+		assert.True(t, details.Has(domain.RelationKey(relation.Snapshot.Data.Key)), name)
+	}
+	assert.Equal(t, "Plan of the project", details.GetString(bundle.RelationKeyDescription))
+	assert.True(t, details.Has(bundle.RelationKeyDone))
+	assert.False(t, details.GetBool(bundle.RelationKeyDone))
+	assert.Equal(t, float64(4), details.GetFloat64(domain.RelationKey(relations["rating"].Snapshot.Data.Key)))
+	assert.Equal(t, int64(1714521600), details.GetInt64(domain.RelationKey(relations["due"].Snapshot.Data.Key)))
+	assert.Equal(t, []string{other.Id}, details.GetStringList(domain.RelationKey(relations["related"].Snapshot.Data.Key)))
+
+	require.Len(t, options, 4)
+	assert.ElementsMatch(t, []string{options["project"].Id, options["idea"].Id, options["inline"].Id}, details.GetStringList(bundle.RelationKeyTag))
+	assert.Equal(t, bundle.RelationKeyStatus.String(), options["draft"].Snapshot.Data.Details.GetString(bundle.RelationKeyRelationKey))
+	assert.Equal(t, []string{options["draft"].Id}, details.GetStringList(bundle.RelationKeyStatus))
+	assert.Equal(t, "Note", details.GetString(bundle.RelationKeyName))
+
+	blocks := note.Snapshot.Data.Blocks
+	assert.Equal(t, "Plan", blocks[0].GetText().GetText())
+	text := blocks[1].GetText()
+	require.NotNil(t, text)
+	assert.Equal(t, "See the other note and #inline tags, but not [[Other]] and #123", text.Text)
+	require.Len(t, text.Marks.Marks, 2)
+	assert.Equal(t, model.BlockContentTextMark_Mention, text.Marks.Marks[0].Type)
+	assert.Equal(t, other.Id, text.Marks.Marks[0].Param)
+	file := blocks[2].GetFile()
+	require.NotNil(t, file)
+	assert.Equal(t, model.BlockContentFile_Image, file.Type)
+	assert.Equal(t, filepath.Join(vaultDir, "attachments", "image.png"), file.Name)
+}
+
+func TestObsidianVault_resolve(t *testing.T) {
+	vault := &obsidianVault{paths: []string{
+		filepath.Join("vault", "Note.md"),
+		filepath.Join("vault", "a", "Note.md"),
+		filepath.Join("vault", "a", "b", "Deep.md"),
+		filepath.Join("vault", "files", "doc.pdf"),
+	}}
+
+	for _, tc := range []struct {
+		note, target, expected string
+	}{
+		{filepath.Join("vault", "a", "Other.md"), "Note", filepath.Join("vault", "a", "Note.md")},
+		{filepath.Join("vault", "x", "Other.md"), "Note", filepath.Join("vault", "Note.md")},
+		{filepath.Join("vault", "Other.md"), "a/Note#Heading", filepath.Join("vault", "a", "Note.md")},
+		{filepath.Join("vault", "Other.md"), "b/deep", filepath.Join("vault", "a", "b", "Deep.md")},
+		{filepath.Join("vault", "Other.md"), "doc.pdf", filepath.Join("vault", "files", "doc.pdf")},
+		{filepath.Join("vault", "Other.md"), "Missing", ""},
+		{filepath.Join("vault", "Other.md"), "#Heading", ""},
+	} {
+		assert.Equal(t, tc.expected, vault.resolve(tc.note, tc.target), tc.target)
+	}
+}
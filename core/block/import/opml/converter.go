@@ -0,0 +1,272 @@
+package opml
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+	"golang.org/x/net/html/charset"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Opml"
+	rootCollectionName = "OPML Import"
+	opmlExtension      = ".opml"
+)
+
+var dateLayouts = []string{time.RFC1123, time.RFC1123Z, time.RFC822, time.RFC822Z, "Mon, 2 Jan 2006 15:04:05 MST", "Mon, 2 Jan 2006 15:04:05 -0700"}
+
+type document struct {
+	Head struct {
+		Title        string `xml:"title"`
+		DateCreated  string `xml:"dateCreated"`
+		DateModified string `xml:"dateModified"`
+	} `xml:"head"`
+	Body struct {
+		Outlines []*outline `xml:"outline"`
+	} `xml:"body"`
+}
+
+type outline struct {
+	Text     string     `xml:"text,attr"`
+	Title    string     `xml:"title,attr"`
+	Type     string     `xml:"type,attr"`
+	URL      string     `xml:"url,attr"`
+	HTMLURL  string     `xml:"htmlUrl,attr"`
+	Note     string     `xml:"_note,attr"`
+	Complete string     `xml:"_complete,attr"`
+	Outlines []*outline `xml:"outline"`
+}
+
+type OPML struct {
+	service *collection.Service
+}
+
+func New(service *collection.Service) common.Converter {
+	return &OPML{service: service}
+}
+
+func (o *OPML) Name() string {
+	return Name
+}
+
+func (o *OPML) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetOpmlParams(); p != nil {
+		return p.Path
+	}
+
+	return nil
+}
+
+func (o *OPML) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := o.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	asToggles := req.GetOpmlParams().GetAsToggles()
+	snapshots, targetObjects := o.getSnapshots(req, progress, paths, asToggles, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	rootCollection := common.NewImportCollection(o.service)
+	settings := common.MakeImportCollectionSetting(rootCollectionName, targetObjects, "", nil, true, true, true)
+	rootCol, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &common.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &common.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (o *OPML) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	asToggles bool,
+	allErrors *common.ConvertError,
+) ([]*common.Snapshot, []string) {
+	snapshots := make([]*common.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, nil
+		}
+		sn, to := o.handleImportPath(p, len(paths), asToggles, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, nil
+		}
+		snapshots = append(snapshots, sn...)
+		targetObjects = append(targetObjects, to...)
+	}
+	return snapshots, targetObjects
+}
+
+func (o *OPML) handleImportPath(p string, pathsCount int, asToggles bool, allErrors *common.ConvertError) ([]*common.Snapshot, []string) {
+	importSource := source.GetSource(p)
+	defer importSource.Close()
+	err := importSource.Initialize(p)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Opml) {
+			return nil, nil
+		}
+	}
+	var numberOfFiles int
+	if numberOfFiles = importSource.CountFilesWithGivenExtensions([]string{opmlExtension}); numberOfFiles == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil, nil
+	}
+	snapshots := make([]*common.Snapshot, 0, numberOfFiles)
+	targetObjects := make([]string, 0, numberOfFiles)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), opmlExtension) {
+			return true
+		}
+		doc, err := readDocument(fileReader)
+		if err != nil {
+			allErrors.Add(err)
+			if allErrors.ShouldAbortImport(pathsCount, model.Import_Opml) {
+				return false
+			}
+			return true
+		}
+		sn := o.getSnapshot(doc, fileName, asToggles)
+		snapshots = append(snapshots, sn)
+		targetObjects = append(targetObjects, sn.Id)
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	return snapshots, targetObjects
+}
+
+func readDocument(r io.Reader) (*document, error) {
+	doc := &document{}
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charset.NewReaderLabel
+	if err := decoder.Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (o *OPML) getSnapshot(doc *document, fileName string, asToggles bool) *common.Snapshot {
+	details := common.GetCommonDetails(fileName, strings.TrimSpace(doc.Head.Title), "", model.ObjectType_basic)
+	if created := parseDate(doc.Head.DateCreated); created != 0 {
+		details.SetInt64(bundle.RelationKeyCreatedDate, created)
+	}
+	if modified := parseDate(doc.Head.DateModified); modified != 0 {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, modified)
+	}
+	var blocks []*model.Block
+	for _, item := range doc.Body.Outlines {
+		blocks = outlineToBlocks(item, asToggles, blocks)
+	}
+	return &common.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:      blocks,
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}
+}
+
+// outlineToBlocks appends the text block of the outline and blocks of the nested outlines, which become its children.
+// The note of the outline is the first child
+func outlineToBlocks(item *outline, asToggles bool, blocks []*model.Block) []*model.Block {
+	text := item.Text
+	if text == "" {
+		text = item.Title
+	}
+	content := &model.BlockContentText{Text: text, Style: model.BlockContentText_Marked}
+	switch {
+	case item.Complete != "":
+		content.Style = model.BlockContentText_Checkbox
+		content.Checked = item.Complete == "true"
+	case asToggles && len(item.Outlines) > 0:
+		content.Style = model.BlockContentText_Toggle
+	}
+	if url := item.URL; url != "" || item.HTMLURL != "" {
+		if url == "" {
+			url = item.HTMLURL
+		}
+		if content.Text == "" {
+			content.Text = url
+		}
+		content.Marks = &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{{
+			Range: &model.Range{From: 0, To: int32(len([]rune(content.Text)))},
+			Type:  model.BlockContentTextMark_Link,
+			Param: url,
+		}}}
+	}
+	block := &model.Block{
+		Id:      bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfText{Text: content},
+	}
+	blocks = append(blocks, block)
+	if item.Note != "" {
+		note := &model.Block{
+			Id:      bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: item.Note}},
+		}
+		blocks = append(blocks, note)
+		block.ChildrenIds = append(block.ChildrenIds, note.Id)
+	}
+	for _, child := range item.Outlines {
+		childIndex := len(blocks)
+		blocks = outlineToBlocks(child, asToggles, blocks)
+		block.ChildrenIds = append(block.ChildrenIds, blocks[childIndex].Id)
+	}
+	return blocks
+}
+
+// parseDate parses RFC 822 dates of the OPML head, returning 0 if the date is missing or malformed
+func parseDate(value string) int64 {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Unix()
+		}
+	}
+	return 0
+}
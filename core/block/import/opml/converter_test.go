@@ -0,0 +1,116 @@
+package opml
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	opmlconverter "github.com/anyproto/anytype-heart/core/converter/opml"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func getSnapshots(t *testing.T, asToggles bool) (*common.Snapshot, map[string]*model.Block) {
+	resp, ce := New(nil).GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfOpmlParams{
+			OpmlParams: &pb.RpcObjectImportRequestOpmlParams{Path: []string{filepath.Join("testdata", "trip.opml")}, AsToggles: asToggles},
+		},
+		Type: model.Import_Opml,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, process.NewNoOp())
+	require.Nil(t, ce)
+	require.NotNil(t, resp)
+	require.Len(t, resp.Snapshots, 2)
+	var page *common.Snapshot
+	for _, snapshot := range resp.Snapshots {
+		if snapshot.Id != resp.RootCollectionID {
+			page = snapshot
+		}
+	}
+	require.NotNil(t, page)
+	byText := map[string]*model.Block{}
+	for _, block := range page.Snapshot.Data.Blocks {
+		byText[block.GetText().Text] = block
+	}
+	return page, byText
+}
+
+func TestOPML_GetSnapshots(t *testing.T) {
+	t.Run("outlines become nested text blocks", func(t *testing.T) {
+		// when
+		page, blocks := getSnapshots(t, false)
+
+		// then
+		details := page.Snapshot.Data.Details
+		assert.Equal(t, "Trip", details.GetString(bundle.RelationKeyName))
+		assert.Equal(t, int64(1704164645), details.GetInt64(bundle.RelationKeyCreatedDate))
+		require.Len(t, page.Snapshot.Data.Blocks, 6)
+
+		plan := blocks["Plan"]
+		assert.Equal(t, model.BlockContentText_Marked, plan.GetText().Style)
+		assert.Equal(t, []string{blocks["Summer vacation"].Id, blocks["book hotel"].Id, blocks["pack"].Id}, plan.ChildrenIds)
+		assert.Equal(t, model.BlockContentText_Paragraph, blocks["Summer vacation"].GetText().Style)
+		assert.Equal(t, model.BlockContentText_Checkbox, blocks["book hotel"].GetText().Style)
+		assert.True(t, blocks["book hotel"].GetText().Checked)
+		assert.Equal(t, model.BlockContentText_Checkbox, blocks["pack"].GetText().Style)
+		assert.False(t, blocks["pack"].GetText().Checked)
+		assert.Equal(t, []string{blocks["passport"].Id}, blocks["pack"].ChildrenIds)
+		assert.Equal(t, []*model.BlockContentTextMark{{
+			Range: &model.Range{From: 0, To: 5},
+			Type:  model.BlockContentTextMark_Link,
+			Param: "https://example.com/guide",
+		}}, blocks["Guide"].GetText().Marks.Marks)
+	})
+	t.Run("outlines with nested outlines become toggles", func(t *testing.T) {
+		// when
+		_, blocks := getSnapshots(t, true)
+
+		// then
+		assert.Equal(t, model.BlockContentText_Toggle, blocks["Plan"].GetText().Style)
+		assert.Equal(t, model.BlockContentText_Checkbox, blocks["pack"].GetText().Style)
+		assert.Equal(t, model.BlockContentText_Marked, blocks["passport"].GetText().Style)
+	})
+	t.Run("outline is exported back", func(t *testing.T) {
+		// given
+		page, _ := getSnapshots(t, false)
+		blocks := map[string]simple.Block{}
+		root := &model.Block{Id: "root"}
+		children := map[string]bool{}
+		for _, block := range page.Snapshot.Data.Blocks {
+			blocks[block.Id] = simple.New(block)
+			for _, id := range block.ChildrenIds {
+				children[id] = true
+			}
+		}
+		for _, block := range page.Snapshot.Data.Blocks {
+			if !children[block.Id] {
+				root.ChildrenIds = append(root.ChildrenIds, block.Id)
+			}
+		}
+		blocks[root.Id] = simple.New(root)
+		s := state.NewDoc(root.Id, blocks).(*state.State)
+		s.SetDetails(page.Snapshot.Data.Details)
+
+		// when
+		result := opmlconverter.NewConverter(s).Convert(model.SmartBlockType_Page)
+
+		// then
+		assert.Contains(t, string(result), `<title>Trip</title>`)
+		assert.Contains(t, string(result), `    <outline text="Plan">
+      <outline text="Summer vacation"></outline>
+      <outline text="book hotel" _complete="true"></outline>
+      <outline text="pack" _complete="false">
+        <outline text="passport"></outline>
+      </outline>
+    </outline>
+    <outline text="Guide" type="link" url="https://example.com/guide"></outline>`)
+	})
+}
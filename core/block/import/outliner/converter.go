@@ -0,0 +1,195 @@
+package outliner
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	LogseqName              = "Logseq"
+	RoamName                = "Roam"
+	journalDateRelationName = "Journal date"
+)
+
+var log = logging.Logger("import-outliner")
+
+// Outliner imports graphs of outliner applications, where pages are trees of blocks referencing other pages and blocks
+type Outliner struct {
+	name               string
+	importType         model.ImportType
+	rootCollectionName string
+	extensions         []string
+	params             func(req *pb.RpcObjectImportRequest) []string
+	// readFile adds pages of the file to the graph
+	readFile func(importPath, fileName string, r io.Reader, g *graph) error
+
+	collectionService *collection.Service
+	tempDirProvider   core.TempDirProvider
+}
+
+func NewLogseq(collectionService *collection.Service, tempDirProvider core.TempDirProvider) common.Converter {
+	return &Outliner{
+		name:               LogseqName,
+		importType:         model.Import_Logseq,
+		rootCollectionName: "Logseq Import",
+		extensions:         []string{".md"},
+		params: func(req *pb.RpcObjectImportRequest) []string {
+			if p := req.GetLogseqParams(); p != nil {
+				return p.Path
+			}
+			return nil
+		},
+		readFile: func(importPath, fileName string, r io.Reader, g *graph) error {
+			if !isLogseqPage(importPath, fileName) {
+				return nil
+			}
+			content, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			g.add(parseLogseqPage(fileName, string(content)))
+			return nil
+		},
+		collectionService: collectionService,
+		tempDirProvider:   tempDirProvider,
+	}
+}
+
+func NewRoam(collectionService *collection.Service, tempDirProvider core.TempDirProvider) common.Converter {
+	return &Outliner{
+		name:               RoamName,
+		importType:         model.Import_Roam,
+		rootCollectionName: "Roam Import",
+		extensions:         []string{".json", ".edn"},
+		params: func(req *pb.RpcObjectImportRequest) []string {
+			if p := req.GetRoamParams(); p != nil {
+				return p.Path
+			}
+			return nil
+		},
+		readFile: func(_, fileName string, r io.Reader, g *graph) error {
+			if strings.EqualFold(filepath.Ext(fileName), ".edn") {
+				return parseRoamEDNExport(fileName, r, g)
+			}
+			return parseRoamJSONExport(fileName, r, g)
+		},
+		collectionService: collectionService,
+		tempDirProvider:   tempDirProvider,
+	}
+}
+
+func (o *Outliner) Name() string {
+	return o.name
+}
+
+func (o *Outliner) GetParams(req *pb.RpcObjectImportRequest) []string {
+	return o.params(req)
+}
+
+func (o *Outliner) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := o.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	relations := newRelationsBuilder()
+	snapshots := make([]*common.Snapshot, 0)
+	targetObjects := make([]string, 0)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, allErrors
+		}
+		sn := o.handleImportPath(p, len(paths), relations, allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+		for _, snapshot := range sn {
+			targetObjects = append(targetObjects, snapshot.Id)
+		}
+		snapshots = append(snapshots, sn...)
+	}
+	snapshots = append(snapshots, relations.Snapshots()...)
+
+	rootCollection := common.NewImportCollection(o.collectionService)
+	settings := common.MakeImportCollectionSetting(o.rootCollectionName, targetObjects, "", nil, true, true, true)
+	rootCol, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &common.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &common.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+// handleImportPath reads all pages of the graph first, so references between them are resolved when blocks are created
+func (o *Outliner) handleImportPath(p string, pathsCount int, relations *relationsBuilder, allErrors *common.ConvertError) []*common.Snapshot {
+	importSource := source.GetSource(p)
+	defer importSource.Close()
+	err := importSource.Initialize(p)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, o.importType) {
+			return nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions(o.extensions) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil
+	}
+	g := newGraph()
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !o.hasExtension(fileName) {
+			return true
+		}
+		if err = o.readFile(p, fileName, fileReader, g); err != nil {
+			allErrors.Add(err)
+			if allErrors.ShouldAbortImport(pathsCount, o.importType) {
+				return false
+			}
+		}
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	if allErrors.ShouldAbortImport(pathsCount, o.importType) {
+		return nil
+	}
+	return g.snapshots(importSource, o.tempDirProvider, relations)
+}
+
+func (o *Outliner) hasExtension(fileName string) bool {
+	for _, ext := range o.extensions {
+		if strings.EqualFold(filepath.Ext(fileName), ext) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+package outliner
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type mockTempDirProvider struct {
+	dir string
+}
+
+func (p *mockTempDirProvider) TempDir() string {
+	return p.dir
+}
+
+type importedGraph struct {
+	pages     map[string]*common.Snapshot
+	relations map[string]*common.Snapshot
+	options   map[string]*common.Snapshot
+}
+
+func splitSnapshots(t *testing.T, resp *common.Response) importedGraph {
+	result := importedGraph{
+		pages:     map[string]*common.Snapshot{},
+		relations: map[string]*common.Snapshot{},
+		options:   map[string]*common.Snapshot{},
+	}
+	for _, snapshot := range resp.Snapshots {
+		name := snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)
+		switch {
+		case snapshot.Id == resp.RootCollectionID:
+		case snapshot.Snapshot.SbType == smartblock.SmartBlockTypePage:
+			result.pages[name] = snapshot
+		case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelation:
+			result.relations[name] = snapshot
+		case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelationOption:
+			result.options[name] = snapshot
+		default:
+			t.Fatalf("unexpected snapshot %s", snapshot.FileName)
+		}
+	}
+	return result
+}
+
+func relationKey(relation *common.Snapshot) domain.RelationKey {
+	return domain.RelationKey(relation.Snapshot.Data.Key)
+}
+
+// topBlocks returns blocks of the page which are not children of other blocks
+func topBlocks(snapshot *common.Snapshot) []*model.Block {
+	children := map[string]bool{}
+	for _, block := range snapshot.Snapshot.Data.Blocks {
+		for _, id := range block.ChildrenIds {
+			children[id] = true
+		}
+	}
+	var result []*model.Block
+	for _, block := range snapshot.Snapshot.Data.Blocks {
+		if !children[block.Id] {
+			result = append(result, block)
+		}
+	}
+	return result
+}
+
+func childBlocks(snapshot *common.Snapshot, parent *model.Block) []*model.Block {
+	byId := map[string]*model.Block{}
+	for _, block := range snapshot.Snapshot.Data.Blocks {
+		byId[block.Id] = block
+	}
+	result := make([]*model.Block, 0, len(parent.ChildrenIds))
+	for _, id := range parent.ChildrenIds {
+		result = append(result, byId[id])
+	}
+	return result
+}
+
+func TestLogseq_GetSnapshots(t *testing.T) {
+	// given
+	tempDir := t.TempDir()
+	converter := NewLogseq(nil, &mockTempDirProvider{dir: tempDir})
+
+	// when
+	resp, ce := converter.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfLogseqParams{
+			LogseqParams: &pb.RpcObjectImportRequestLogseqParams{Path: []string{filepath.Join("testdata", "graph")}},
+		},
+		Type: model.Import_Logseq,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, process.NewNoOp())
+
+	// then
+	require.Nil(t, ce)
+	require.NotNil(t, resp)
+	imported := splitSnapshots(t, resp)
+	require.Len(t, imported.pages, 3)
+	project, alice, journal := imported.pages["Project Alpha"], imported.pages["Alice"], imported.pages["Jan 2nd, 2024"]
+	require.NotNil(t, project)
+	require.NotNil(t, alice)
+	require.NotNil(t, journal)
+
+	details := project.Snapshot.Data.Details
+	require.Len(t, imported.relations, 4)
+	assert.Equal(t, "active", details.GetString(relationKey(imported.relations["status"])))
+	assert.Equal(t, float64(2), details.GetFloat64(relationKey(imported.relations["priority"])))
+	assert.Equal(t, []string{alice.Id}, details.GetStringList(relationKey(imported.relations["owner"])))
+	assert.Equal(t, []string{imported.options["work"].Id, imported.options["planning"].Id}, details.GetStringList(bundle.RelationKeyTag))
+
+	journalDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local).Unix()
+	assert.Equal(t, journalDate, journal.Snapshot.Data.Details.GetInt64(relationKey(imported.relations[journalDateRelationName])))
+	assert.Equal(t, journalDate, journal.Snapshot.Data.Details.GetInt64(bundle.RelationKeyCreatedDate))
+
+	top := topBlocks(project)
+	require.Len(t, top, 3)
+	overview := top[0].GetText()
+	assert.Equal(t, "Overview with bold and a link to Alice", overview.Text)
+	assert.Equal(t, model.BlockContentText_Marked, overview.Style)
+	assert.Contains(t, overview.Marks.Marks, &model.BlockContentTextMark{
+		Range: &model.Range{From: 33, To: 38},
+		Type:  model.BlockContentTextMark_Mention,
+		Param: alice.Id,
+	})
+
+	tasks := childBlocks(project, top[0])
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "write spec", tasks[0].GetText().Text)
+	assert.Equal(t, model.BlockContentText_Checkbox, tasks[0].GetText().Style)
+	assert.False(t, tasks[0].GetText().Checked)
+	assert.Equal(t, "review", tasks[1].GetText().Text)
+	assert.True(t, tasks[1].GetText().Checked)
+
+	reference := top[1].GetText()
+	assert.Equal(t, "See review on Jan 2nd, 2024", reference.Text)
+	require.Len(t, reference.Marks.Marks, 2)
+	assert.Equal(t, model.BlockContentTextMark_Object, reference.Marks.Marks[0].Type)
+	assert.Equal(t, project.Id, reference.Marks.Marks[0].Param)
+	assert.Equal(t, model.BlockContentTextMark_Mention, reference.Marks.Marks[1].Type)
+	assert.Equal(t, journal.Id, reference.Marks.Marks[1].Param)
+
+	file := top[2].GetFile()
+	require.NotNil(t, file)
+	assert.Equal(t, filepath.Join("testdata", "graph", "assets", "diagram.png"), file.Name)
+
+	journalText := journal.Snapshot.Data.Blocks[0].GetText()
+	assert.Equal(t, "Met with Project Alpha #unknown", journalText.Text)
+	assert.Equal(t, project.Id, journalText.Marks.Marks[0].Param)
+}
+
+func TestRoam_GetSnapshots(t *testing.T) {
+	for _, export := range []string{"roam.json", "roam.edn"} {
+		t.Run(export, func(t *testing.T) {
+			// given
+			converter := NewRoam(nil, &mockTempDirProvider{dir: t.TempDir()})
+
+			// when
+			resp, ce := converter.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+				Params: &pb.RpcObjectImportRequestParamsOfRoamParams{
+					RoamParams: &pb.RpcObjectImportRequestRoamParams{Path: []string{filepath.Join("testdata", export)}},
+				},
+				Type: model.Import_Roam,
+				Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+			}, process.NewNoOp())
+
+			// then
+			require.Nil(t, ce)
+			require.NotNil(t, resp)
+			imported := splitSnapshots(t, resp)
+			require.Len(t, imported.pages, 2)
+			project, journal := imported.pages["Project"], imported.pages["January 2nd, 2024"]
+			require.NotNil(t, project)
+			require.NotNil(t, journal)
+
+			details := project.Snapshot.Data.Details
+			assert.Equal(t, int64(1704164645), details.GetInt64(bundle.RelationKeyCreatedDate))
+			assert.Equal(t, int64(1706933106), details.GetInt64(bundle.RelationKeyLastModifiedDate))
+			assert.Equal(t, "active", details.GetString(relationKey(imported.relations["Status"])))
+			assert.Equal(t, []string{imported.options["work"].Id, imported.options["idea"].Id}, details.GetStringList(bundle.RelationKeyTag))
+			assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local).Unix(),
+				journal.Snapshot.Data.Details.GetInt64(relationKey(imported.relations[journalDateRelationName])))
+
+			top := topBlocks(project)
+			require.Len(t, top, 2)
+			assert.Equal(t, "Overview", top[0].GetText().Text)
+			assert.Equal(t, model.BlockContentText_Header1, top[0].GetText().Style)
+			tasks := childBlocks(project, top[0])
+			require.Len(t, tasks, 2)
+			assert.Equal(t, "write spec", tasks[0].GetText().Text)
+			assert.Equal(t, model.BlockContentTextMark_Italic, tasks[0].GetText().Marks.Marks[0].Type)
+			assert.False(t, tasks[0].GetText().Checked)
+			assert.Equal(t, "review now", tasks[1].GetText().Text)
+			assert.True(t, tasks[1].GetText().Checked)
+
+			reference := top[1].GetText()
+			assert.Equal(t, "See review now on January 2nd, 2024", reference.Text)
+			require.Len(t, reference.Marks.Marks, 2)
+			assert.Equal(t, model.BlockContentTextMark_Object, reference.Marks.Marks[0].Type)
+			assert.Equal(t, project.Id, reference.Marks.Marks[0].Param)
+			assert.Equal(t, journal.Id, reference.Marks.Marks[1].Param)
+		})
+	}
+}
+
+func TestParseJournalTitle(t *testing.T) {
+	expected := time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local)
+	for _, title := range []string{"January 2nd, 2024", "Jan 2nd, 2024", "2024-01-02", "2024_01_02"} {
+		date, ok := parseJournalTitle(title)
+		assert.True(t, ok, title)
+		assert.Equal(t, expected, date, title)
+	}
+	_, ok := parseJournalTitle("Project")
+	assert.False(t, ok)
+	assert.Equal(t, "Jan 21st, 2024", journalTitle(time.Date(2024, 1, 21, 0, 0, 0, 0, time.Local)))
+}
+
+func TestParseEDN(t *testing.T) {
+	value, err := parseEDN([]byte(`#db {:a [1 -2.5 "x\"y\n" \z nil true], ; comment
+		:b #{sym :k} #_ :skipped :c ({[1] 2})}`))
+	require.NoError(t, err)
+
+	db, ok := value.(ednTagged)
+	require.True(t, ok)
+	assert.Equal(t, "db", db.tag)
+	m := db.value.(ednMap)
+	a, _ := m.get("a")
+	assert.Equal(t, []any{int64(1), -2.5, "x\"y\n", "z", nil, true}, a)
+	b, _ := m.get("b")
+	assert.Equal(t, []any{ednSymbol("sym"), ednKeyword("k")}, b)
+	c, _ := m.get("c")
+	assert.Equal(t, []any{ednMap{{key: []any{int64(1)}, value: int64(2)}}}, c)
+
+	_, err = parseEDN([]byte(`[1 2`))
+	assert.ErrorIs(t, err, errEDNUnexpectedEnd)
+}
@@ -0,0 +1,285 @@
+package outliner
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var errEDNUnexpectedEnd = errors.New("edn: unexpected end of input")
+
+// ednKeyword is the keyword without the leading colon, e.g. block/string
+type ednKeyword string
+
+type ednSymbol string
+
+type ednTagged struct {
+	tag   string
+	value any
+}
+
+type ednPair struct {
+	key   any
+	value any
+}
+
+// ednMap keeps pairs in the order of the input, keys of edn maps can be collections, so they are not comparable
+type ednMap []ednPair
+
+func (m ednMap) get(key ednKeyword) (any, bool) {
+	for _, pair := range m {
+		if k, ok := pair.key.(ednKeyword); ok && k == key {
+			return pair.value, true
+		}
+	}
+	return nil, false
+}
+
+// parseEDN reads the single value of the edn document. Lists, vectors and sets become []any, integers
+// become int64 and characters become strings
+func parseEDN(data []byte) (any, error) {
+	r := &ednReader{data: data}
+	value, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	r.skipWhitespace()
+	if r.pos < len(r.data) {
+		return nil, fmt.Errorf("edn: unexpected data at %d", r.pos)
+	}
+	return value, nil
+}
+
+type ednReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *ednReader) skipWhitespace() {
+	for r.pos < len(r.data) {
+		switch c := r.data[r.pos]; {
+		case c == ';':
+			for r.pos < len(r.data) && r.data[r.pos] != '\n' {
+				r.pos++
+			}
+		case c == ',' || c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			r.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (r *ednReader) read() (any, error) {
+	r.skipWhitespace()
+	if r.pos >= len(r.data) {
+		return nil, errEDNUnexpectedEnd
+	}
+	switch c := r.data[r.pos]; c {
+	case '"':
+		return r.readString()
+	case '\\':
+		return r.readChar()
+	case ':':
+		r.pos++
+		return ednKeyword(r.readToken()), nil
+	case '(':
+		r.pos++
+		return r.readCollection(')')
+	case '[':
+		r.pos++
+		return r.readCollection(']')
+	case '{':
+		r.pos++
+		return r.readMap()
+	case '#':
+		return r.readDispatch()
+	case ')', ']', '}':
+		return nil, fmt.Errorf("edn: unexpected %q at %d", c, r.pos)
+	default:
+		return r.readAtom()
+	}
+}
+
+func (r *ednReader) readDispatch() (any, error) {
+	r.pos++
+	if r.pos >= len(r.data) {
+		return nil, errEDNUnexpectedEnd
+	}
+	switch r.data[r.pos] {
+	case '{':
+		r.pos++
+		return r.readCollection('}')
+	case '_':
+		r.pos++
+		if _, err := r.read(); err != nil {
+			return nil, err
+		}
+		return r.read()
+	}
+	tag := r.readToken()
+	if tag == "" {
+		return nil, fmt.Errorf("edn: invalid dispatch at %d", r.pos)
+	}
+	value, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	return ednTagged{tag: tag, value: value}, nil
+}
+
+func (r *ednReader) readCollection(end byte) ([]any, error) {
+	var values []any
+	for {
+		r.skipWhitespace()
+		if r.pos >= len(r.data) {
+			return nil, errEDNUnexpectedEnd
+		}
+		if r.data[r.pos] == end {
+			r.pos++
+			return values, nil
+		}
+		value, err := r.read()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+}
+
+func (r *ednReader) readMap() (ednMap, error) {
+	values, err := r.readCollection('}')
+	if err != nil {
+		return nil, err
+	}
+	if len(values)%2 != 0 {
+		return nil, fmt.Errorf("edn: map with odd number of forms at %d", r.pos)
+	}
+	m := make(ednMap, 0, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		m = append(m, ednPair{key: values[i], value: values[i+1]})
+	}
+	return m, nil
+}
+
+func (r *ednReader) readString() (string, error) {
+	r.pos++
+	var sb strings.Builder
+	for r.pos < len(r.data) {
+		c := r.data[r.pos]
+		r.pos++
+		switch c {
+		case '"':
+			return sb.String(), nil
+		case '\\':
+			if r.pos >= len(r.data) {
+				return "", errEDNUnexpectedEnd
+			}
+			escaped := r.data[r.pos]
+			r.pos++
+			switch escaped {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case 'u':
+				if r.pos+4 > len(r.data) {
+					return "", errEDNUnexpectedEnd
+				}
+				code, err := strconv.ParseUint(string(r.data[r.pos:r.pos+4]), 16, 32)
+				if err != nil {
+					return "", fmt.Errorf("edn: invalid unicode escape at %d", r.pos)
+				}
+				sb.WriteRune(rune(code))
+				r.pos += 4
+			default:
+				sb.WriteByte(escaped)
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", errEDNUnexpectedEnd
+}
+
+var ednCharacters = map[string]string{
+	"newline": "\n",
+	"return":  "\r",
+	"space":   " ",
+	"tab":     "\t",
+}
+
+func (r *ednReader) readChar() (string, error) {
+	r.pos++
+	if r.pos >= len(r.data) {
+		return "", errEDNUnexpectedEnd
+	}
+	token := r.readToken()
+	if token == "" {
+		// delimiters like \( are characters too
+		_, size := utf8.DecodeRune(r.data[r.pos:])
+		token = string(r.data[r.pos : r.pos+size])
+		r.pos += size
+	}
+	if c, ok := ednCharacters[token]; ok {
+		return c, nil
+	}
+	if strings.HasPrefix(token, "u") && len(token) == 5 {
+		if code, err := strconv.ParseUint(token[1:], 16, 32); err == nil {
+			return string(rune(code)), nil
+		}
+	}
+	return token, nil
+}
+
+func (r *ednReader) readAtom() (any, error) {
+	start := r.pos
+	token := r.readToken()
+	switch token {
+	case "":
+		return nil, fmt.Errorf("edn: unexpected %q at %d", r.data[start], start)
+	case "nil":
+		return nil, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	if c := token[0]; c >= '0' && c <= '9' || (c == '-' || c == '+') && len(token) > 1 && token[1] >= '0' && token[1] <= '9' {
+		return parseEDNNumber(token)
+	}
+	return ednSymbol(token), nil
+}
+
+func parseEDNNumber(token string) (any, error) {
+	if strings.HasSuffix(token, "N") {
+		token = strings.TrimSuffix(token, "N")
+	} else if strings.HasSuffix(token, "M") {
+		token = strings.TrimSuffix(token, "M")
+		return strconv.ParseFloat(token, 64)
+	}
+	if i, err := strconv.ParseInt(token, 10, 64); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return nil, fmt.Errorf("edn: invalid number %s", token)
+	}
+	return f, nil
+}
+
+func (r *ednReader) readToken() string {
+	start := r.pos
+	for r.pos < len(r.data) {
+		switch r.data[r.pos] {
+		case ' ', '\t', '\n', '\r', '\f', ',', '"', ';', '(', ')', '[', ']', '{', '}', '\\':
+			return string(r.data[start:r.pos])
+		}
+		r.pos++
+	}
+	return string(r.data[start:r.pos])
+}
@@ -0,0 +1,442 @@
+package outliner
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/anyerror"
+)
+
+var (
+	propertyRegexp = regexp.MustCompile(`^([\p{L}\p{N}][\p{L}\p{N} _.-]*)::\s*(.*)$`)
+	embedRegexp    = regexp.MustCompile(`\{\{\s*embed:?\s*(\(\([\w-]+\)\)|\[\[[^\[\]]+\]\])\s*\}\}`)
+	blockRefRegexp = regexp.MustCompile(`\(\(([\w-]+)\)\)`)
+	pageRefRegexp  = regexp.MustCompile(`#?\[\[([^\[\]\n]+)\]\]|(^|\s)#([\p{L}\p{N}_/-]+)`)
+	ordinalRegexp  = regexp.MustCompile(`(\d)(st|nd|rd|th),`)
+
+	markdownEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`, `*`, `\*`, `_`, `\_`, "`", "\\`", `<`, `\<`)
+
+	journalTitleLayouts = []string{"January 2, 2006", "Jan 2, 2006", "2006-01-02", "2006_01_02"}
+)
+
+// page is a page of the outliner graph, independently of the application it was exported from
+type page struct {
+	id         string
+	title      string
+	fileName   string
+	sourcePath string
+	journal    time.Time
+	created    int64
+	updated    int64
+	properties []property
+	nodes      []*node
+}
+
+type property struct {
+	key   string
+	value string
+}
+
+// node is a bullet of the outline with its own text and nested bullets
+type node struct {
+	uid      string
+	text     string
+	heading  int
+	children []*node
+}
+
+type blockRef struct {
+	page *page
+	node *node
+}
+
+// graph indexes pages and blocks of one export, so references can be resolved after all pages are read
+type graph struct {
+	pages   []*page
+	titles  map[string]*page
+	dates   map[string]*page
+	blocks  map[string]blockRef
+	pageIds map[string]bool
+}
+
+func newGraph() *graph {
+	return &graph{
+		titles:  make(map[string]*page),
+		dates:   make(map[string]*page),
+		blocks:  make(map[string]blockRef),
+		pageIds: make(map[string]bool),
+	}
+}
+
+func (g *graph) add(p *page) {
+	p.id = uuid.New().String()
+	g.pages = append(g.pages, p)
+	g.pageIds[p.id] = true
+	g.titles[strings.ToLower(p.title)] = p
+	if !p.journal.IsZero() {
+		g.dates[p.journal.Format(time.DateOnly)] = p
+	}
+	var addNodes func(nodes []*node)
+	addNodes = func(nodes []*node) {
+		for _, n := range nodes {
+			if n.uid != "" {
+				g.blocks[n.uid] = blockRef{page: p, node: n}
+			}
+			addNodes(n.children)
+		}
+	}
+	addNodes(p.nodes)
+}
+
+// resolve finds the page by title, journal pages are also found by dates written in other formats
+func (g *graph) resolve(title string) *page {
+	title = strings.TrimSpace(title)
+	if p := g.titles[strings.ToLower(title)]; p != nil {
+		return p
+	}
+	if date, ok := parseJournalTitle(title); ok {
+		return g.dates[date.Format(time.DateOnly)]
+	}
+	return nil
+}
+
+// parseJournalTitle parses default titles of journal pages, like "January 2nd, 2024" in Roam or "Jan 2nd, 2024" in Logseq
+func parseJournalTitle(title string) (time.Time, bool) {
+	title = ordinalRegexp.ReplaceAllString(strings.TrimSpace(title), "$1,")
+	for _, layout := range journalTitleLayouts {
+		if t, err := time.ParseInLocation(layout, title, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func journalTitle(t time.Time) string {
+	suffix := "th"
+	switch day := t.Day(); {
+	case day == 1 || day == 21 || day == 31:
+		suffix = "st"
+	case day == 2 || day == 22:
+		suffix = "nd"
+	case day == 3 || day == 23:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%s %d%s, %d", t.Format("Jan"), t.Day(), suffix, t.Year())
+}
+
+func parseProperty(line string) (property, bool) {
+	match := propertyRegexp.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return property{}, false
+	}
+	return property{key: strings.TrimSpace(match[1]), value: strings.TrimSpace(match[2])}, true
+}
+
+// pageRefs returns titles of the pages referenced in the text as [[page]], #[[page]] or #page
+func pageRefs(text string) []string {
+	var titles []string
+	for _, match := range pageRefRegexp.FindAllStringSubmatch(text, -1) {
+		titles = append(titles, refTitle(match))
+	}
+	return titles
+}
+
+func refTitle(match []string) string {
+	if match[1] != "" {
+		return match[1]
+	}
+	return match[3]
+}
+
+// plainText removes the reference syntax from the text
+func plainText(text string) string {
+	text = embedRegexp.ReplaceAllString(text, "$1")
+	text = blockRefRegexp.ReplaceAllString(text, "")
+	text = pageRefRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		match := pageRefRegexp.FindStringSubmatch(s)
+		return match[2] + refTitle(match)
+	})
+	return strings.TrimSpace(text)
+}
+
+// toMarkdown turns references into markdown links, which destinations are ids of the pages for page references
+// and ids of the pages with the uids of the blocks for block references
+func (g *graph) toMarkdown(text string) string {
+	text = embedRegexp.ReplaceAllString(text, "$1")
+	text = blockRefRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		uid := blockRefRegexp.FindStringSubmatch(s)[1]
+		ref, ok := g.blocks[uid]
+		if !ok {
+			return s
+		}
+		label := plainText(stripMarker(ref.node.text))
+		if label == "" {
+			label = ref.page.title
+		}
+		return fmt.Sprintf("[%s](<%s#%s>)", markdownEscaper.Replace(label), ref.page.id, uid)
+	})
+	return pageRefRegexp.ReplaceAllStringFunc(text, func(s string) string {
+		match := pageRefRegexp.FindStringSubmatch(s)
+		title := refTitle(match)
+		target := g.resolve(title)
+		if target == nil {
+			if match[1] != "" {
+				return title
+			}
+			return s
+		}
+		return fmt.Sprintf("%s[%s](<%s>)", match[2], markdownEscaper.Replace(target.title), target.id)
+	})
+}
+
+func stripMarker(text string) string {
+	text, _, _ = parseMarker(text)
+	return text
+}
+
+// parseMarker removes task markers of Logseq and Roam from the text and reports whether the task is done
+func parseMarker(text string) (rest string, isTask, checked bool) {
+	for _, marker := range []struct {
+		prefix  string
+		checked bool
+	}{
+		{"{{[[TODO]]}}", false}, {"{{TODO}}", false}, {"{{[[DONE]]}}", true}, {"{{DONE}}", true},
+		{"TODO ", false}, {"DOING ", false}, {"NOW ", false}, {"LATER ", false}, {"WAITING ", false}, {"DONE ", true},
+	} {
+		if strings.HasPrefix(text, marker.prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(text, marker.prefix)), true, marker.checked
+		}
+	}
+	return text, false, false
+}
+
+// blocksBuilder converts nodes of the graph into nested blocks, files referenced from the nodes are taken from the import source
+type blocksBuilder struct {
+	graph           *graph
+	page            *page
+	importSource    source.Source
+	tempDirProvider core.TempDirProvider
+	blocks          []*model.Block
+}
+
+func (b *blocksBuilder) build(nodes []*node) []*model.Block {
+	for _, n := range nodes {
+		b.addNode(n)
+	}
+	return b.blocks
+}
+
+// addNode adds blocks of the node and returns the id of the first one, which is the parent of the nested nodes
+func (b *blocksBuilder) addNode(n *node) string {
+	text, isTask, checked := parseMarker(n.text)
+	blocks, _, err := anymark.MarkdownToBlocks([]byte(b.graph.toMarkdown(text)), "", nil)
+	if err != nil {
+		log.Warnf("failed to convert block of %s: %v", b.page.title, err)
+		blocks = nil
+	}
+	if len(blocks) == 0 {
+		blocks = []*model.Block{{
+			Id:      bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{}},
+		}}
+	}
+	for _, block := range blocks {
+		b.updateBlock(block)
+	}
+	b.blocks = append(b.blocks, blocks...)
+
+	nested := make(map[string]bool)
+	for _, block := range blocks {
+		for _, id := range block.ChildrenIds {
+			nested[id] = true
+		}
+	}
+	var parent *model.Block
+	for _, block := range blocks {
+		if nested[block.Id] {
+			continue
+		}
+		if parent == nil {
+			parent = block
+			continue
+		}
+		parent.ChildrenIds = append(parent.ChildrenIds, block.Id)
+	}
+	if parentText := parent.GetText(); parentText != nil {
+		switch {
+		case isTask:
+			parentText.Style = model.BlockContentText_Checkbox
+			parentText.Checked = checked
+		case n.heading > 0 && n.heading <= 3:
+			parentText.Style = model.BlockContentText_Header1 + model.BlockContentTextStyle(n.heading-1)
+		case parentText.Style == model.BlockContentText_Paragraph:
+			parentText.Style = model.BlockContentText_Marked
+		}
+	}
+	for _, child := range n.children {
+		parent.ChildrenIds = append(parent.ChildrenIds, b.addNode(child))
+	}
+	return parent.Id
+}
+
+// updateBlock turns links to pages and blocks of the graph into mentions and links to objects, and links to
+// local files into file blocks
+func (b *blocksBuilder) updateBlock(block *model.Block) {
+	if file := block.GetFile(); file != nil {
+		if name, _, ok := b.provideFile(file.Name); ok {
+			file.Name = name
+		}
+		return
+	}
+	for _, mark := range block.GetText().GetMarks().GetMarks() {
+		if mark.Type != model.BlockContentTextMark_Link {
+			continue
+		}
+		if b.graph.pageIds[mark.Param] {
+			mark.Type = model.BlockContentTextMark_Mention
+			continue
+		}
+		if pageId, _, found := strings.Cut(mark.Param, "#"); found && b.graph.pageIds[pageId] {
+			mark.Type = model.BlockContentTextMark_Object
+			mark.Param = pageId
+			continue
+		}
+		if name, createFileBlock, ok := b.provideFile(mark.Param); ok {
+			mark.Param = name
+			if createFileBlock {
+				block.Content = anymark.ConvertTextToFile(name)
+				return
+			}
+		}
+	}
+}
+
+// provideFile finds the file by the path relative to the page file
+func (b *blocksBuilder) provideFile(name string) (string, bool, bool) {
+	if name == "" || strings.Contains(name, "://") {
+		return name, false, false
+	}
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(filepath.Dir(b.page.fileName), name)
+	}
+	newName, createFileBlock, err := common.ProvideFileName(name, b.importSource, "", b.tempDirProvider)
+	if err != nil {
+		log.Errorf("failed to provide file %s: %v", name, anyerror.CleanupError(err))
+		return "", false, false
+	}
+	return newName, createFileBlock, true
+}
+
+// snapshots converts pages of the graph into page objects with properties as relations
+func (g *graph) snapshots(importSource source.Source, tempDirProvider core.TempDirProvider, relations *relationsBuilder) []*common.Snapshot {
+	formats := g.propertyFormats()
+	snapshots := make([]*common.Snapshot, 0, len(g.pages))
+	for _, p := range g.pages {
+		builder := &blocksBuilder{graph: g, page: p, importSource: importSource, tempDirProvider: tempDirProvider}
+		blocks := builder.build(p.nodes)
+
+		details := common.GetCommonDetails(p.fileName, p.title, "", model.ObjectType_basic)
+		if p.sourcePath != "" {
+			details.SetString(bundle.RelationKeySourceFilePath, p.sourcePath)
+		}
+		if p.created == 0 && !p.journal.IsZero() {
+			p.created = p.journal.Unix()
+		}
+		if p.created != 0 {
+			details.SetInt64(bundle.RelationKeyCreatedDate, p.created)
+		}
+		if p.updated != 0 {
+			details.SetInt64(bundle.RelationKeyLastModifiedDate, p.updated)
+		}
+
+		var relationLinks []*model.RelationLink
+		if !p.journal.IsZero() {
+			relation := relations.relation(journalDateRelationName, model.RelationFormat_date)
+			details.SetInt64(domainKey(relation), p.journal.Unix())
+			relationLinks = append(relationLinks, relation)
+		}
+		for _, prop := range p.properties {
+			if isTagsProperty(prop.key) {
+				tags := propertyValues(prop.value)
+				if len(tags) == 0 {
+					continue
+				}
+				details.SetStringList(bundle.RelationKeyTag, relations.OptionIds(bundle.RelationKeyTag.String(), tags))
+				relationLinks = append(relationLinks, &model.RelationLink{
+					Key:    bundle.RelationKeyTag.String(),
+					Format: model.RelationFormat_tag,
+				})
+				continue
+			}
+			format, ok := formats[strings.ToLower(prop.key)]
+			if !ok {
+				continue
+			}
+			relation := relations.relation(format.name, format.format)
+			if value, ok := g.propertyValue(format.format, prop.value); ok {
+				details.Set(domainKey(relation), value)
+				relationLinks = append(relationLinks, relation)
+			}
+		}
+
+		snapshots = append(snapshots, &common.Snapshot{
+			Id:       p.id,
+			FileName: p.fileName,
+			Snapshot: &common.SnapshotModel{
+				SbType: smartblock.SmartBlockTypePage,
+				Data: &common.StateSnapshot{
+					Blocks:        blocks,
+					Details:       details,
+					RelationLinks: relationLinks,
+					ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+				},
+			},
+		})
+	}
+	return snapshots
+}
+
+func isTagsProperty(key string) bool {
+	key = strings.ToLower(key)
+	return key == "tags" || key == "tag"
+}
+
+// propertyValues splits the value of the property by commas, items with references are split into the referenced titles
+func propertyValues(value string) []string {
+	var values []string
+	for _, item := range strings.Split(value, ",") {
+		if refs := pageRefs(item); len(refs) > 0 {
+			values = append(values, refs...)
+		} else if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+func inferFormat(value string) model.RelationFormat {
+	if refs := pageRefs(value); len(refs) > 0 && strings.Trim(pageRefRegexp.ReplaceAllString(value, ""), " ,") == "" {
+		return model.RelationFormat_object
+	}
+	if value == "true" || value == "false" {
+		return model.RelationFormat_checkbox
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return model.RelationFormat_number
+	}
+	return model.RelationFormat_longtext
+}
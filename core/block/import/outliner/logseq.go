@@ -0,0 +1,162 @@
+package outliner
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	logseqConfigDir   = "logseq"
+	logseqJournalsDir = "journals"
+)
+
+var logseqJournalFileLayouts = []string{"2006_01_02", "2006-01-02"}
+
+// isLogseqPage checks that the file is a page of the graph and not a backup or other file of the Logseq config directory
+func isLogseqPage(importPath, fileName string) bool {
+	if !strings.EqualFold(filepath.Ext(fileName), ".md") {
+		return false
+	}
+	relativePath := strings.TrimPrefix(fileName, importPath)
+	for _, dir := range strings.Split(filepath.Dir(relativePath), string(filepath.Separator)) {
+		if dir == logseqConfigDir {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLogseqPage reads the page of the Logseq graph. Pages are outlines of "- " bullets nested by indentation,
+// "key:: value" lines before the first bullet or in the first bullet without text are page properties
+func parseLogseqPage(fileName string, content string) *page {
+	p := &page{fileName: fileName, title: logseqTitle(fileName)}
+	if filepath.Base(filepath.Dir(fileName)) == logseqJournalsDir {
+		name := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+		for _, layout := range logseqJournalFileLayouts {
+			if t, err := time.ParseInLocation(layout, name, time.Local); err == nil {
+				p.journal = t
+				p.title = journalTitle(t)
+				break
+			}
+		}
+	}
+
+	type level struct {
+		indent int
+		node   *node
+	}
+	var (
+		stack         []level
+		current       *node
+		contentIndent int
+		inCode        bool
+	)
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := indentWidth(line[:len(line)-len(trimmed)])
+		if inCode {
+			current.text += "\n" + trimIndent(line, contentIndent)
+			inCode = !strings.HasPrefix(trimmed, "```")
+			continue
+		}
+		if trimmed == "-" || strings.HasPrefix(trimmed, "- ") {
+			current = &node{text: strings.TrimSpace(strings.TrimPrefix(trimmed, "-"))}
+			contentIndent = indent + 2
+			for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 {
+				p.nodes = append(p.nodes, current)
+			} else {
+				parent := stack[len(stack)-1].node
+				parent.children = append(parent.children, current)
+			}
+			stack = append(stack, level{indent: indent, node: current})
+			if prop, ok := parseProperty(current.text); ok {
+				current.text = ""
+				addLogseqProperty(p, current, prop)
+			}
+			inCode = isCodeStart(current.text)
+			continue
+		}
+		if trimmed == "" {
+			continue
+		}
+		if prop, ok := parseProperty(trimmed); ok {
+			addLogseqProperty(p, current, prop)
+			continue
+		}
+		if current == nil {
+			// pages without bullets are plain markdown, every line of them is a block
+			p.nodes = append(p.nodes, &node{text: trimmed})
+			continue
+		}
+		if current.text == "" {
+			current.text = trimIndent(line, contentIndent)
+		} else {
+			current.text += "\n" + trimIndent(line, contentIndent)
+		}
+		inCode = isCodeStart(trimmed)
+	}
+
+	// the first bullet with properties only is a container of page properties
+	if len(p.nodes) > 0 && p.nodes[0].text == "" && len(p.nodes[0].children) == 0 && len(p.properties) > 0 {
+		p.nodes = p.nodes[1:]
+	}
+	for i, prop := range p.properties {
+		if strings.EqualFold(prop.key, "title") {
+			p.title = prop.value
+			p.properties = append(p.properties[:i], p.properties[i+1:]...)
+			break
+		}
+	}
+	return p
+}
+
+func addLogseqProperty(p *page, current *node, prop property) {
+	if current == nil || current == p.nodes[0] && current.text == "" {
+		p.properties = append(p.properties, prop)
+		return
+	}
+	// block properties are only used to find blocks referenced by their ids
+	if strings.EqualFold(prop.key, "id") {
+		current.uid = prop.value
+	}
+}
+
+// logseqTitle restores the page title from the file name, where namespace separators are written as "___"
+// and characters not allowed in file names are url encoded
+func logseqTitle(fileName string) string {
+	title := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	title = strings.ReplaceAll(title, "___", "/")
+	if unescaped, err := url.PathUnescape(title); err == nil {
+		title = unescaped
+	}
+	return title
+}
+
+func isCodeStart(text string) bool {
+	return strings.HasPrefix(text, "```") && strings.Count(text, "```") == 1
+}
+
+func indentWidth(indent string) int {
+	return len(strings.ReplaceAll(indent, "\t", "  "))
+}
+
+// trimIndent removes the indentation of the block content, keeping the indentation inside the content
+func trimIndent(line string, width int) string {
+	for width > 0 && line != "" {
+		switch line[0] {
+		case ' ':
+			width--
+		case '\t':
+			width -= 2
+		default:
+			return line
+		}
+		line = line[1:]
+	}
+	return line
+}
@@ -0,0 +1,86 @@
+package outliner
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type propertyFormat struct {
+	name   string
+	format model.RelationFormat
+}
+
+// propertyFormats infers formats of page properties from the values of all pages of the graph.
+// Properties are matched case-insensitively and named as they are written first
+func (g *graph) propertyFormats() map[string]propertyFormat {
+	formats := make(map[string]propertyFormat)
+	for _, p := range g.pages {
+		for _, prop := range p.properties {
+			if isTagsProperty(prop.key) || prop.value == "" {
+				continue
+			}
+			key := strings.ToLower(prop.key)
+			format := inferFormat(prop.value)
+			if current, ok := formats[key]; ok {
+				if current.format != format {
+					current.format = model.RelationFormat_longtext
+					formats[key] = current
+				}
+				continue
+			}
+			formats[key] = propertyFormat{name: prop.key, format: format}
+		}
+	}
+	return formats
+}
+
+func (g *graph) propertyValue(format model.RelationFormat, value string) (domain.Value, bool) {
+	switch format {
+	case model.RelationFormat_object:
+		var ids []string
+		for _, title := range pageRefs(value) {
+			if target := g.resolve(title); target != nil {
+				ids = append(ids, target.id)
+			}
+		}
+		return domain.StringList(ids), len(ids) > 0
+	case model.RelationFormat_checkbox:
+		return domain.Bool(value == "true"), true
+	case model.RelationFormat_number:
+		number, err := strconv.ParseFloat(value, 64)
+		return domain.Float64(number), err == nil
+	default:
+		text := plainText(value)
+		return domain.String(text), text != ""
+	}
+}
+
+func domainKey(relation *model.RelationLink) domain.RelationKey {
+	return domain.RelationKey(relation.Key)
+}
+
+// relationsBuilder creates relations and relation options once per import, so pages of different graphs share them
+type relationsBuilder struct {
+	*common.RelationsBuilder
+	relations map[propertyFormat]*model.RelationLink
+}
+
+func newRelationsBuilder() *relationsBuilder {
+	return &relationsBuilder{
+		RelationsBuilder: common.NewRelationsBuilder(),
+		relations:        make(map[propertyFormat]*model.RelationLink),
+	}
+}
+
+func (b *relationsBuilder) relation(name string, format model.RelationFormat) *model.RelationLink {
+	if link, ok := b.relations[propertyFormat{name: name, format: format}]; ok {
+		return link
+	}
+	link := b.AddRelation(name, format)
+	b.relations[propertyFormat{name: name, format: format}] = link
+	return link
+}
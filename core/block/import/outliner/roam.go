@@ -0,0 +1,91 @@
+package outliner
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var (
+	roamItalicRegexp    = regexp.MustCompile(`__(.+?)__`)
+	roamHighlightRegexp = regexp.MustCompile(`\^\^(.+?)\^\^`)
+)
+
+type roamPage struct {
+	Title      string      `json:"title"`
+	Children   []roamBlock `json:"children"`
+	CreateTime int64       `json:"create-time"`
+	EditTime   int64       `json:"edit-time"`
+}
+
+type roamBlock struct {
+	String   string      `json:"string"`
+	UID      string      `json:"uid"`
+	Heading  int         `json:"heading"`
+	Children []roamBlock `json:"children"`
+}
+
+// parseRoamJSONExport reads pages of the Roam json export
+func parseRoamJSONExport(fileName string, r io.Reader, g *graph) error {
+	var pages []roamPage
+	if err := json.NewDecoder(r).Decode(&pages); err != nil {
+		return err
+	}
+	addRoamPages(fileName, pages, g)
+	return nil
+}
+
+// addRoamPages adds pages to the graph. Top level "Attribute:: value" blocks of the page are page properties,
+// values written as nested blocks are joined with commas
+func addRoamPages(fileName string, pages []roamPage, g *graph) {
+	for _, rp := range pages {
+		if strings.TrimSpace(rp.Title) == "" {
+			continue
+		}
+		p := &page{
+			title:      rp.Title,
+			fileName:   fileName,
+			sourcePath: fmt.Sprintf("%s#%s", fileName, rp.Title),
+			created:    rp.CreateTime / 1000,
+			updated:    rp.EditTime / 1000,
+		}
+		if t, ok := parseJournalTitle(rp.Title); ok {
+			p.journal = t
+		}
+		for _, child := range rp.Children {
+			if prop, ok := parseProperty(child.String); ok && !strings.Contains(child.String, "\n") {
+				if prop.value == "" {
+					values := make([]string, 0, len(child.Children))
+					for _, valueBlock := range child.Children {
+						values = append(values, strings.TrimSpace(valueBlock.String))
+					}
+					prop.value = strings.Join(values, ", ")
+				}
+				p.properties = append(p.properties, prop)
+				continue
+			}
+			p.nodes = append(p.nodes, roamNode(child))
+		}
+		g.add(p)
+	}
+}
+
+func roamNode(block roamBlock) *node {
+	n := &node{
+		uid:     block.UID,
+		text:    roamToMarkdown(block.String),
+		heading: block.Heading,
+	}
+	for _, child := range block.Children {
+		n.children = append(n.children, roamNode(child))
+	}
+	return n
+}
+
+// roamToMarkdown replaces formatting of Roam, which differs from markdown: __italic__ and ^^highlight^^
+func roamToMarkdown(text string) string {
+	text = roamItalicRegexp.ReplaceAllString(text, "*$1*")
+	return roamHighlightRegexp.ReplaceAllString(text, "$1")
+}
@@ -0,0 +1,144 @@
+package outliner
+
+import (
+	"errors"
+	"io"
+	"sort"
+)
+
+var errRoamEDNFormat = errors.New("edn file is not a Roam database export")
+
+// roamEntity is the page or the block of the Roam database assembled from its datoms
+type roamEntity struct {
+	title    string
+	uid      string
+	text     string
+	heading  int
+	order    int64
+	children []int64
+	created  int64
+	updated  int64
+}
+
+// parseRoamEDNExport reads the Roam edn export, which is the dump of datoms of the Roam database:
+// #datascript/DB {:schema {...} :datoms [[entity attribute value transaction] ...]}.
+// Datoms are gathered into entities, and pages are rebuilt from them like they are in the json export
+func parseRoamEDNExport(fileName string, r io.Reader, g *graph) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	value, err := parseEDN(content)
+	if err != nil {
+		return err
+	}
+	if tagged, ok := value.(ednTagged); ok {
+		value = tagged.value
+	}
+	db, ok := value.(ednMap)
+	if !ok {
+		return errRoamEDNFormat
+	}
+	datomsValue, ok := db.get("datoms")
+	if !ok {
+		return errRoamEDNFormat
+	}
+	datoms, ok := datomsValue.([]any)
+	if !ok {
+		return errRoamEDNFormat
+	}
+	entities := make(map[int64]*roamEntity)
+	for _, d := range datoms {
+		datom, ok := d.([]any)
+		if !ok || len(datom) < 3 {
+			continue
+		}
+		id, ok := datom[0].(int64)
+		if !ok {
+			continue
+		}
+		attribute, ok := datom[1].(ednKeyword)
+		if !ok {
+			continue
+		}
+		e := entities[id]
+		if e == nil {
+			e = &roamEntity{}
+			entities[id] = e
+		}
+		e.set(attribute, datom[2])
+	}
+	addRoamPages(fileName, roamPagesFromEntities(entities), g)
+	return nil
+}
+
+func (e *roamEntity) set(attribute ednKeyword, value any) {
+	switch attribute {
+	case "node/title":
+		e.title, _ = value.(string)
+	case "block/uid":
+		e.uid, _ = value.(string)
+	case "block/string":
+		e.text, _ = value.(string)
+	case "block/heading":
+		if heading, ok := value.(int64); ok {
+			e.heading = int(heading)
+		}
+	case "block/order":
+		e.order, _ = value.(int64)
+	case "block/children":
+		if child, ok := value.(int64); ok {
+			e.children = append(e.children, child)
+		}
+	case "create/time":
+		e.created, _ = value.(int64)
+	case "edit/time":
+		e.updated, _ = value.(int64)
+	}
+}
+
+// roamPagesFromEntities converts entities with titles into pages in the order of their creation in the database
+func roamPagesFromEntities(entities map[int64]*roamEntity) []roamPage {
+	ids := make([]int64, 0, len(entities))
+	for id, e := range entities {
+		if e.title != "" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	pages := make([]roamPage, 0, len(ids))
+	for _, id := range ids {
+		e := entities[id]
+		pages = append(pages, roamPage{
+			Title:      e.title,
+			Children:   roamChildren(e, entities, map[int64]bool{id: true}),
+			CreateTime: e.created,
+			EditTime:   e.updated,
+		})
+	}
+	return pages
+}
+
+// roamChildren returns children of the entity sorted by their order, visited entities are skipped
+// to not loop on broken exports
+func roamChildren(parent *roamEntity, entities map[int64]*roamEntity, visited map[int64]bool) []roamBlock {
+	children := make([]int64, 0, len(parent.children))
+	for _, id := range parent.children {
+		if entities[id] != nil && !visited[id] {
+			children = append(children, id)
+		}
+	}
+	sort.SliceStable(children, func(i, j int) bool { return entities[children[i]].order < entities[children[j]].order })
+	blocks := make([]roamBlock, 0, len(children))
+	for _, id := range children {
+		visited[id] = true
+		e := entities[id]
+		blocks = append(blocks, roamBlock{
+			String:   e.text,
+			UID:      e.uid,
+			Heading:  e.heading,
+			Children: roamChildren(e, entities, visited),
+		})
+	}
+	return blocks
+}
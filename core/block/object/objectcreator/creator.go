@@ -91,6 +91,8 @@ type CreateObjectRequest struct {
 	InternalFlags []*model.InternalFlag
 	TemplateId    string
 	ObjectTypeKey domain.TypeKey
+	// UniqueKey derives the id of the object from the key, so the object is created once even by several devices
+	UniqueKey domain.UniqueKey
 }
 
 // CreateObject is high-level method for creating new objects
@@ -165,7 +167,11 @@ func (s *service) createObjectInSpace(
 		return buildDateObject(space, details)
 	}
 
-	return s.createObjectFromTemplate(ctx, space, []domain.TypeKey{req.ObjectTypeKey}, details, req.TemplateId)
+	var opts []CreateOption
+	if req.UniqueKey != nil {
+		opts = append(opts, WithUniqueKey(req.UniqueKey))
+	}
+	return s.createObjectFromTemplate(ctx, space, []domain.TypeKey{req.ObjectTypeKey}, details, req.TemplateId, opts...)
 }
 
 func (s *service) createObjectFromTemplate(
@@ -174,12 +180,13 @@ func (s *service) createObjectFromTemplate(
 	objectTypeKeys []domain.TypeKey,
 	details *domain.Details,
 	templateId string,
+	opts ...CreateOption,
 ) (id string, newDetails *domain.Details, err error) {
 	createState, err := s.templateService.CreateTemplateStateWithDetails(templateId, details)
 	if err != nil {
 		return
 	}
-	return s.CreateSmartBlockFromStateInSpace(ctx, space, objectTypeKeys, createState)
+	return s.CreateSmartBlockFromStateInSpaceWithOptions(ctx, space, objectTypeKeys, createState, opts...)
 }
 
 // buildDateObject does not create real date object. It just builds date object details
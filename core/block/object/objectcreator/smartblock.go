@@ -22,7 +22,8 @@ type eventKey int
 const eventCreate eventKey = 0
 
 type CreateOptions struct {
-	payload *treestorage.TreeStorageCreatePayload
+	payload   *treestorage.TreeStorageCreatePayload
+	uniqueKey domain.UniqueKey
 }
 
 type CreateOption func(opts *CreateOptions)
@@ -33,6 +34,13 @@ func WithPayload(payload *treestorage.TreeStorageCreatePayload) CreateOption {
 	}
 }
 
+// WithUniqueKey derives the object from the key, treestorage.ErrTreeExists is returned if it is already created
+func WithUniqueKey(uniqueKey domain.UniqueKey) CreateOption {
+	return func(opts *CreateOptions) {
+		opts.uniqueKey = uniqueKey
+	}
+}
+
 // CreateSmartBlockFromState create new object from the provided `createState` and `details`.
 // If you pass `details` into the function, it will automatically add missing relationLinks and override the details from the `createState`
 // It will return error if some of the relation keys in `details` not installed in the workspace.
@@ -155,6 +163,12 @@ func createSmartBlock(
 	for _, opt := range opts {
 		opt(createOpts)
 	}
+	if createOpts.uniqueKey != nil {
+		return spc.DeriveTreeObject(ctx, objectcache.TreeDerivationParams{
+			Key:      createOpts.uniqueKey,
+			InitFunc: initFunc,
+		})
+	}
 	if createOpts.payload != nil {
 		return spc.CreateTreeObjectWithPayload(ctx, *createOpts.payload, initFunc)
 	}
@@ -3,6 +3,8 @@ package undo
 import (
 	"errors"
 
+	"github.com/gogo/protobuf/types"
+
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -32,6 +34,10 @@ type ObjectType struct {
 	Before, After []domain.TypeKey
 }
 
+type Store struct {
+	Before, After *types.Struct
+}
+
 type CarriageInfo struct {
 	Before, After CarriageState
 }
@@ -53,11 +59,12 @@ type Action struct {
 	RelationLinks *RelationLinks
 	Group         string
 	ObjectTypes   *ObjectType
+	Store         *Store
 	CarriageInfo  CarriageInfo
 }
 
 func (a Action) IsEmpty() bool {
-	return len(a.Add)+len(a.Change)+len(a.Remove) == 0 && a.Details == nil && a.ObjectTypes == nil && a.RelationLinks == nil
+	return len(a.Add)+len(a.Change)+len(a.Remove) == 0 && a.Details == nil && a.ObjectTypes == nil && a.RelationLinks == nil && a.Store == nil
 }
 
 func (a Action) Merge(b Action) (result Action) {
@@ -43,6 +43,26 @@ const (
 	wrapExportEnd = `</div>
 			</body>
 		</html>`
+	wrapPageStart = `<!DOCTYPE html>
+<html>
+	<head>
+		<meta charset="utf-8">
+		<title>%s</title>
+		<style type="text/css">
+			body { max-width: 704px; margin: 0 auto; padding: 32px 16px; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; color: #252525; }
+			nav { margin-bottom: 24px; font-size: 14px; }
+			.row > * { display: flex; }
+			.callout-image { width: 20px; height: 20px; font-size: 16px; line-height: 20px; margin-right: 6px; display: inline-block; }
+			img, video { max-width: 100%%; }
+			a { color: inherit; }
+			kbd {` + styleKbd + `}
+		</style>
+	</head>
+	<body>
+`
+	wrapPageEnd = `
+	</body>
+</html>`
 
 	styleParagraph = "font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;"
 	styleHeader1   = "padding: 23px 0px 1px 0px; font-size: 28px; line-height: 32px; letter-spacing: -0.36px; font-weight: 600;"
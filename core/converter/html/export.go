@@ -0,0 +1,180 @@
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// IndexFileName is the name of the page listing all exported objects
+const IndexFileName = "index.html"
+
+type FileNamer interface {
+	Get(path, hash, title, ext string) (name string)
+}
+
+// NewExportConverter creates a converter that renders an object to a standalone page.
+// Files are referenced by their names in the files directory instead of being embedded,
+// and links to other exported objects point to their pages
+func NewExportConverter(s *state.State, fn FileNamer) converter.Converter {
+	return &exportConverter{h: &HTML{s: s, fn: fn}}
+}
+
+type exportConverter struct {
+	h *HTML
+}
+
+func (c *exportConverter) Convert(sbType model.SmartBlockType) (result []byte) {
+	h := c.h
+	root := h.s.Pick(h.s.RootId())
+	if root == nil {
+		return
+	}
+	h.buf = bytes.NewBuffer(nil)
+	fmt.Fprintf(h.buf, wrapPageStart, html.EscapeString(PageTitle(h.s.Details(), h.s.RootId())))
+	fmt.Fprintf(h.buf, `<nav><a href="%s">Index</a></nav>`, IndexFileName)
+	h.buf.WriteString(`<div class="anytype-container">`)
+	h.renderChildren(root.Model())
+	h.buf.WriteString(`</div>`)
+	h.buf.WriteString(wrapPageEnd)
+	result = h.buf.Bytes()
+	h.buf = nil
+	return
+}
+
+func (c *exportConverter) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	c.h.knownDocs = docs
+	return c
+}
+
+func (c *exportConverter) FileHashes() []string {
+	return c.h.fileHashes
+}
+
+func (c *exportConverter) ImageHashes() []string {
+	return c.h.imageHashes
+}
+
+func (c *exportConverter) Ext() string {
+	return ".html"
+}
+
+// IndexEntry is a link to an exported page
+type IndexEntry struct {
+	Title    string
+	FileName string
+}
+
+// RenderIndex renders the page linking all exported objects, ordered by title
+func RenderIndex(entries []IndexEntry) []byte {
+	entries = append([]IndexEntry(nil), entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		left, right := strings.ToLower(entries[i].Title), strings.ToLower(entries[j].Title)
+		if left == right {
+			return entries[i].FileName < entries[j].FileName
+		}
+		return left < right
+	})
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, wrapPageStart, "Index")
+	buf.WriteString(`<h1 style="` + styleHeader1 + `">Index</h1><ul style="font-size:15px;">`)
+	for _, entry := range entries {
+		fmt.Fprintf(buf, `<li><a href="%s">%s</a></li>`, hrefPath(entry.FileName), html.EscapeString(entry.Title))
+	}
+	buf.WriteString(`</ul>`)
+	buf.WriteString(wrapPageEnd)
+	return buf.Bytes()
+}
+
+// hrefPath escapes the relative path of the exported file to be used as the link, names of the files
+// may contain characters like # or ? which are parts of urls
+func hrefPath(path string) string {
+	segments := strings.Split(filepath.ToSlash(path), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return html.EscapeString(strings.Join(segments, "/"))
+}
+
+// PageTitle returns the title of the page rendered for the object
+func PageTitle(details *domain.Details, id string) string {
+	title := details.GetString(bundle.RelationKeyName)
+	if title == "" {
+		title = details.GetString(bundle.RelationKeySnippet)
+	}
+	if title == "" {
+		title = id
+	}
+	return title
+}
+
+func (h *HTML) renderExportFile(b *model.Block) {
+	file := b.GetFile()
+	title, filename, ok := h.getLinkInfo(file.TargetObjectId)
+	if !ok {
+		filename = filepath.ToSlash(h.fn.Get("files", file.TargetObjectId, filepath.Base(file.Name), filepath.Ext(file.Name)))
+		title = filepath.Base(file.Name)
+	}
+	title, filename = html.EscapeString(title), hrefPath(filename)
+	switch file.Type {
+	case model.BlockContentFile_Image:
+		h.imageHashes = append(h.imageHashes, file.TargetObjectId)
+		fmt.Fprintf(h.buf, `<div class="image"><img alt="%s" src="%s" />`, title, filename)
+	case model.BlockContentFile_Video:
+		h.fileHashes = append(h.fileHashes, file.TargetObjectId)
+		fmt.Fprintf(h.buf, `<div class="video"><video controls src="%s"></video>`, filename)
+	case model.BlockContentFile_Audio:
+		h.fileHashes = append(h.fileHashes, file.TargetObjectId)
+		fmt.Fprintf(h.buf, `<div class="audio"><audio controls src="%s"></audio>`, filename)
+	default:
+		h.fileHashes = append(h.fileHashes, file.TargetObjectId)
+		fmt.Fprintf(h.buf, `<div class="file"><a href="%s">%s</a>`, filename, title)
+	}
+	h.renderChildren(b)
+	h.buf.WriteString("</div>")
+}
+
+func (h *HTML) renderExportLink(b *model.Block) {
+	h.buf.WriteString(`<div class="link">`)
+	if l := b.GetLink(); l != nil && l.TargetBlockId != "" {
+		if title, filename, ok := h.getLinkInfo(l.TargetBlockId); ok {
+			fmt.Fprintf(h.buf, `<a href="%s">%s</a>`, hrefPath(filename), html.EscapeString(title))
+		}
+	}
+	h.renderChildren(b)
+	h.buf.WriteString("</div>")
+}
+
+func (h *HTML) getLinkInfo(docId string) (title, filename string, ok bool) {
+	info, ok := h.knownDocs[docId]
+	if !ok {
+		return
+	}
+	title = info.GetString(bundle.RelationKeyName)
+	layout := info.GetInt64(bundle.RelationKeyLayout)
+	if layout == int64(model.ObjectType_file) || layout == int64(model.ObjectType_image) || layout == int64(model.ObjectType_audio) || layout == int64(model.ObjectType_video) || layout == int64(model.ObjectType_pdf) {
+		ext := info.GetString(bundle.RelationKeyFileExt)
+		if ext != "" {
+			ext = "." + ext
+		}
+		title = strings.TrimSuffix(title, ext)
+		if title == "" {
+			title = docId
+		}
+		filename = filepath.ToSlash(h.fn.Get("files", docId, title, ext))
+		return
+	}
+	title = PageTitle(info, docId)
+	filename = filepath.ToSlash(h.fn.Get("", docId, title, ".html"))
+	return
+}
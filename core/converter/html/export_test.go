@@ -0,0 +1,103 @@
+package html
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type testNamer struct{}
+
+func (testNamer) Get(path, hash, title, ext string) string {
+	return filepath.Join(path, title+ext)
+}
+
+func TestExportConverter_Convert(t *testing.T) {
+	newState := func(bs ...*model.Block) *state.State {
+		blocks := map[string]simple.Block{}
+		var ids []string
+		for _, b := range bs {
+			blocks[b.Id] = simple.New(b)
+			ids = append(ids, b.Id)
+		}
+		blocks["root"] = simple.New(&model.Block{Id: "root", ChildrenIds: ids})
+		s := state.NewDoc("root", blocks).(*state.State)
+		s.SetDetail(bundle.RelationKeyName, domain.String("Trip <plan>"))
+		return s
+	}
+	knownDocs := map[string]*domain.Details{
+		"page2": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Packing list"),
+		}),
+		"image1": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:    domain.String("beach.png"),
+			bundle.RelationKeyFileExt: domain.String("png"),
+			bundle.RelationKeyLayout:  domain.Int64(model.ObjectType_image),
+		}),
+	}
+
+	t.Run("page with links and images", func(t *testing.T) {
+		// given
+		s := newState(
+			&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text: "see list",
+				Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+					{Range: &model.Range{From: 4, To: 8}, Type: model.BlockContentTextMark_Mention, Param: "page2"},
+				}},
+			}}},
+			&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page2"}}},
+			&model.Block{Id: "file", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+				TargetObjectId: "image1",
+				Name:           "beach.png",
+				Type:           model.BlockContentFile_Image,
+				State:          model.BlockContentFile_Done,
+			}}},
+		)
+		c := NewExportConverter(s, testNamer{}).SetKnownDocs(knownDocs)
+
+		// when
+		result := string(c.Convert(model.SmartBlockType_Page))
+
+		// then
+		assert.Contains(t, result, `<title>Trip &lt;plan&gt;</title>`)
+		assert.Contains(t, result, `<nav><a href="index.html">Index</a></nav>`)
+		assert.Contains(t, result, `see <a href="Packing%20list.html">list</a>`)
+		assert.Contains(t, result, `<div class="link"><a href="Packing%20list.html">Packing list</a></div>`)
+		assert.Contains(t, result, `<img alt="beach" src="files/beach.png" />`)
+		assert.NotContains(t, result, "base64")
+		assert.Equal(t, []string{"image1"}, c.ImageHashes())
+		assert.Equal(t, ".html", c.Ext())
+	})
+
+	t.Run("links to objects outside of the export are not rendered", func(t *testing.T) {
+		// given
+		s := newState(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "unknown"}}})
+		c := NewExportConverter(s, testNamer{})
+
+		// when
+		result := string(c.Convert(model.SmartBlockType_Page))
+
+		// then
+		assert.Contains(t, result, `<div class="link"></div>`)
+	})
+}
+
+func TestRenderIndex(t *testing.T) {
+	// when
+	result := string(RenderIndex([]IndexEntry{
+		{Title: "zebra", FileName: "zebra.html"},
+		{Title: "Apple & pear", FileName: "apple-pear.html"},
+		{Title: "Q&A", FileName: filepath.Join("notes", "Q&A #1?.html")},
+	}))
+
+	// then
+	assert.Contains(t, result, `<li><a href="apple-pear.html">Apple &amp; pear</a></li>`+
+		`<li><a href="notes/Q&amp;A%20%231%3F.html">Q&amp;A</a></li><li><a href="zebra.html">zebra</a></li>`)
+}
@@ -38,6 +38,12 @@ type HTML struct {
 	buf               *bytes.Buffer
 	fileService       files.Service
 	fileObjectService fileobject.Service
+
+	// set only for the export, see NewExportConverter
+	fn          FileNamer
+	knownDocs   map[string]*domain.Details
+	fileHashes  []string
+	imageHashes []string
 }
 
 func (h *HTML) Convert() (result string) {
@@ -166,6 +172,10 @@ func (h *HTML) renderFile(b *model.Block) {
 	if file.State != model.BlockContentFile_Done {
 		return
 	}
+	if h.fn != nil {
+		h.renderExportFile(b)
+		return
+	}
 	goToAnytypeMsg := `<div class="message">
 		<div class="header">This content is available in Anytype.</div>
 		Follow <a href="https://anytype.io">link</a> to ask a permission to get the content
@@ -269,6 +279,10 @@ func (h *HTML) renderLayout(b *model.Block) {
 }
 
 func (h *HTML) renderLink(b *model.Block) {
+	if h.fn != nil {
+		h.renderExportLink(b)
+		return
+	}
 	if len(b.ChildrenIds) > 0 {
 		h.buf.WriteString("<div>")
 	}
@@ -405,6 +419,17 @@ func (h *HTML) writeTag(m *model.BlockContentTextMark, start bool) {
 		} else {
 			h.buf.WriteString("</u>")
 		}
+	case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
+		if h.fn == nil {
+			return
+		}
+		if _, filename, ok := h.getLinkInfo(m.Param); ok {
+			if start {
+				fmt.Fprintf(h.buf, `<a href="%s">`, hrefPath(filename))
+			} else {
+				h.buf.WriteString("</a>")
+			}
+		}
 	}
 }
 
@@ -0,0 +1,172 @@
+package md
+
+import (
+	"bytes"
+	"math"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// ObjectResolver provides relations and details of the objects referenced from the front matter
+type ObjectResolver interface {
+	GetRelationByKey(key string) (*model.Relation, error)
+	GetDetails(id string) (*domain.Details, error)
+}
+
+// system relations that are still meaningful for the reader of the exported file
+var frontMatterSystemRelations = map[domain.RelationKey]struct{}{
+	bundle.RelationKeyName:             {},
+	bundle.RelationKeyDescription:      {},
+	bundle.RelationKeyCreatedDate:      {},
+	bundle.RelationKeyCreator:          {},
+	bundle.RelationKeyLastModifiedDate: {},
+	bundle.RelationKeyLastModifiedBy:   {},
+	bundle.RelationKeyDone:             {},
+	bundle.RelationKeySource:           {},
+}
+
+func (h *MD) renderFrontMatter(buf writer) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	usedNames := map[string]struct{}{}
+	add := func(relation *model.Relation, value interface{}) {
+		name := relation.Name
+		if _, used := usedNames[name]; used || name == "" {
+			name = relation.Key
+		}
+		usedNames[name] = struct{}{}
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(value); err != nil {
+			log.Warnf("failed to encode front matter value of %s: %v", relation.Key, err)
+			return
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, valueNode)
+	}
+
+	details := h.s.CombinedDetails()
+	if typeRelation := h.getRelation(bundle.RelationKeyType.String()); typeRelation != nil {
+		if typeName := h.getObjectName(details.GetString(bundle.RelationKeyType)); typeName != "" {
+			add(typeRelation, typeName)
+		}
+	}
+	for _, link := range h.s.GetRelationLinks() {
+		key := domain.RelationKey(link.Key)
+		if key == bundle.RelationKeyType || !details.Has(key) {
+			continue
+		}
+		_, isMeaningful := frontMatterSystemRelations[key]
+		if !isMeaningful && bundle.IsSystemRelation(key) {
+			continue
+		}
+		relation := h.getRelation(link.Key)
+		if relation == nil || (relation.Hidden && !isMeaningful) {
+			continue
+		}
+		if value := h.frontMatterValue(relation, details.Get(key)); value != nil {
+			add(relation, value)
+		}
+	}
+	if len(node.Content) == 0 {
+		return
+	}
+
+	out := bytes.NewBuffer(nil)
+	enc := yaml.NewEncoder(out)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		log.Warnf("failed to export front matter in markdown: %v", err)
+		return
+	}
+	buf.WriteString("---\n")
+	buf.Write(out.Bytes())
+	buf.WriteString("---\n\n")
+}
+
+func (h *MD) frontMatterValue(relation *model.Relation, value domain.Value) interface{} {
+	if value.IsNull() {
+		return nil
+	}
+	switch relation.Format {
+	case model.RelationFormat_checkbox:
+		return value.Bool()
+	case model.RelationFormat_number:
+		number := value.Float64()
+		if number == math.Trunc(number) {
+			return int64(number)
+		}
+		return number
+	case model.RelationFormat_date:
+		timestamp := value.Int64()
+		if timestamp == 0 {
+			return nil
+		}
+		return time.Unix(timestamp, 0).UTC()
+	case model.RelationFormat_status:
+		names := h.getObjectNames(value.WrapToStringList())
+		if len(names) == 0 {
+			return nil
+		}
+		return names[0]
+	case model.RelationFormat_tag, model.RelationFormat_object, model.RelationFormat_file:
+		names := h.getObjectNames(value.WrapToStringList())
+		if len(names) == 0 {
+			return nil
+		}
+		if relation.MaxCount == 1 {
+			return names[0]
+		}
+		return names
+	default:
+		if value.IsEmpty() {
+			return nil
+		}
+		if list, ok := value.TryStringList(); ok {
+			return list
+		}
+		return value.String()
+	}
+}
+
+func (h *MD) getRelation(key string) *model.Relation {
+	if h.resolver != nil {
+		if relation, err := h.resolver.GetRelationByKey(key); err == nil && relation != nil {
+			return relation
+		}
+	}
+	relation, err := bundle.GetRelation(domain.RelationKey(key))
+	if err != nil {
+		return nil
+	}
+	return relation
+}
+
+func (h *MD) getObjectNames(ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name := h.getObjectName(id); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (h *MD) getObjectName(id string) string {
+	if id == "" {
+		return ""
+	}
+	details, ok := h.knownDocs[id]
+	if !ok && h.resolver != nil {
+		var err error
+		if details, err = h.resolver.GetDetails(id); err != nil {
+			log.Warnf("failed to get details of %s for front matter: %v", id, err)
+		}
+	}
+	if details == nil || details.GetBool(bundle.RelationKeyIsDeleted) {
+		return ""
+	}
+	return details.GetString(bundle.RelationKeyName)
+}
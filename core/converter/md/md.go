@@ -32,6 +32,13 @@ func NewMDConverter(s *state.State, fn FileNamer) converter.Converter {
 	return &MD{s: s, fn: fn}
 }
 
+// NewMDConverterWithFrontMatter creates a converter that prepends the object type and relation values
+// as a YAML front matter header. Names of types, options and linked objects are taken from the known docs
+// or, when the object is not exported, from the resolver
+func NewMDConverterWithFrontMatter(s *state.State, fn FileNamer, resolver ObjectResolver) converter.Converter {
+	return &MD{s: s, fn: fn, resolver: resolver, frontMatter: true}
+}
+
 type MD struct {
 	s *state.State
 
@@ -42,16 +49,22 @@ type MD struct {
 
 	mw *marksWriter
 	fn FileNamer
+
+	resolver    ObjectResolver
+	frontMatter bool
 }
 
 func (h *MD) Convert(sbType model.SmartBlockType) (result []byte) {
 	if h.s.Pick(h.s.RootId()) == nil {
 		return
 	}
+	buf := bytes.NewBuffer(nil)
+	if h.frontMatter {
+		h.renderFrontMatter(buf)
+	}
 	if len(h.s.Pick(h.s.RootId()).Model().ChildrenIds) == 0 {
-		return
+		return buf.Bytes()
 	}
-	buf := bytes.NewBuffer(nil)
 	in := new(renderState)
 	h.renderChildren(buf, in, h.s.Pick(h.s.RootId()).Model())
 	result = buf.Bytes()
@@ -1,12 +1,16 @@
 package md
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
@@ -171,3 +175,103 @@ func TestMD_Convert(t *testing.T) {
 		assert.Equal(t, exp, string(res))
 	})
 }
+
+type testResolver struct {
+	relations map[string]*model.Relation
+	objects   map[string]*domain.Details
+}
+
+func (r *testResolver) GetRelationByKey(key string) (*model.Relation, error) {
+	if relation, ok := r.relations[key]; ok {
+		return relation, nil
+	}
+	return nil, fmt.Errorf("relation %s not found", key)
+}
+
+func (r *testResolver) GetDetails(id string) (*domain.Details, error) {
+	if details, ok := r.objects[id]; ok {
+		return details, nil
+	}
+	return domain.NewDetails(), nil
+}
+
+func TestMD_FrontMatter(t *testing.T) {
+	newState := func() *state.State {
+		blocks := map[string]simple.Block{
+			"root": simple.New(&model.Block{Id: "root", ChildrenIds: []string{"text"}}),
+			"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Body"}}}),
+		}
+		return state.NewDoc("root", blocks).(*state.State)
+	}
+	resolver := &testResolver{
+		relations: map[string]*model.Relation{
+			"estimate": {Key: "estimate", Name: "Estimate", Format: model.RelationFormat_number},
+			"due":      {Key: "due", Name: "Due date", Format: model.RelationFormat_date},
+			"state":    {Key: "state", Name: "Status", Format: model.RelationFormat_status},
+			"assignee": {Key: "assignee", Name: "Assignee", Format: model.RelationFormat_object, MaxCount: 1},
+		},
+		objects: map[string]*domain.Details{
+			"type1":  domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("Task")}),
+			"tag1":   domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("urgent")}),
+			"tag2":   domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("home: kitchen")}),
+			"status": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("In progress")}),
+		},
+	}
+
+	t.Run("front matter with resolved names", func(t *testing.T) {
+		// given
+		s := newState()
+		s.SetDetail(bundle.RelationKeyType, domain.String("type1"))
+		s.SetDetail(bundle.RelationKeyName, domain.String("Fix the sink"))
+		s.SetDetail(bundle.RelationKeyLayout, domain.Int64(model.ObjectType_basic))
+		s.SetDetail(bundle.RelationKeyTag, domain.StringList([]string{"tag1", "tag2"}))
+		s.SetDetail("state", domain.String("status"))
+		s.SetDetail("estimate", domain.Int64(3))
+		s.SetDetail("due", domain.Int64(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).Unix()))
+		s.SetDetail("assignee", domain.StringList([]string{"person"}))
+		s.AddRelationLinks(
+			&model.RelationLink{Key: bundle.RelationKeyType.String(), Format: model.RelationFormat_object},
+			&model.RelationLink{Key: bundle.RelationKeyName.String(), Format: model.RelationFormat_shorttext},
+			&model.RelationLink{Key: bundle.RelationKeyLayout.String(), Format: model.RelationFormat_number},
+			&model.RelationLink{Key: bundle.RelationKeyTag.String(), Format: model.RelationFormat_tag},
+			&model.RelationLink{Key: "state", Format: model.RelationFormat_status},
+			&model.RelationLink{Key: "estimate", Format: model.RelationFormat_number},
+			&model.RelationLink{Key: "due", Format: model.RelationFormat_date},
+			&model.RelationLink{Key: "assignee", Format: model.RelationFormat_object},
+		)
+		c := NewMDConverterWithFrontMatter(s, nil, resolver)
+		c.SetKnownDocs(map[string]*domain.Details{
+			"person": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("John")}),
+		})
+
+		// when
+		res := c.Convert(model.SmartBlockType_Page)
+
+		// then
+		exp := "---\n" +
+			"Object type: Task\n" +
+			"Name: Fix the sink\n" +
+			"Tag:\n" +
+			"  - urgent\n" +
+			"  - 'home: kitchen'\n" +
+			"Status: In progress\n" +
+			"Estimate: 3\n" +
+			"Due date: 2024-05-01T00:00:00Z\n" +
+			"Assignee: John\n" +
+			"---\n\n" +
+			"Body   \n"
+		assert.Equal(t, exp, string(res))
+	})
+
+	t.Run("no front matter without relations", func(t *testing.T) {
+		// given
+		s := newState()
+		c := NewMDConverterWithFrontMatter(s, nil, resolver)
+
+		// when
+		res := c.Convert(model.SmartBlockType_Page)
+
+		// then
+		assert.Equal(t, "Body   \n", string(res))
+	})
+}
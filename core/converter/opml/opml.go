@@ -0,0 +1,173 @@
+package opml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var log = logging.Logger("opml-export")
+
+type document struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    head     `xml:"head"`
+	Body    body     `xml:"body"`
+}
+
+type head struct {
+	Title        string `xml:"title"`
+	DateCreated  string `xml:"dateCreated,omitempty"`
+	DateModified string `xml:"dateModified,omitempty"`
+}
+
+type body struct {
+	Outlines []*outline `xml:"outline"`
+}
+
+type outline struct {
+	Text     string     `xml:"text,attr"`
+	Type     string     `xml:"type,attr,omitempty"`
+	URL      string     `xml:"url,attr,omitempty"`
+	Complete string     `xml:"_complete,attr,omitempty"`
+	Outlines []*outline `xml:"outline"`
+}
+
+// NewConverter creates a converter that writes the nested structure of the object blocks as an OPML outline.
+// Text of blocks becomes outlines, blocks without text of their own, like layout blocks and tables, don't add a level
+func NewConverter(s *state.State) converter.Converter {
+	return &opml{s: s}
+}
+
+type opml struct {
+	s         *state.State
+	knownDocs map[string]*domain.Details
+}
+
+func (o *opml) Convert(sbType model.SmartBlockType) []byte {
+	root := o.s.Pick(o.s.RootId())
+	if root == nil {
+		return nil
+	}
+	details := o.s.CombinedDetails()
+	doc := document{
+		Version: "2.0",
+		Head: head{
+			Title:        details.GetString(bundle.RelationKeyName),
+			DateCreated:  formatDate(details.GetInt64(bundle.RelationKeyCreatedDate)),
+			DateModified: formatDate(details.GetInt64(bundle.RelationKeyLastModifiedDate)),
+		},
+		Body: body{Outlines: o.outlines(root.Model())},
+	}
+	buf := bytes.NewBufferString(xml.Header)
+	encoder := xml.NewEncoder(buf)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		log.Errorf("failed to encode outline: %v", err)
+		return nil
+	}
+	buf.WriteString("\n")
+	return buf.Bytes()
+}
+
+func (o *opml) outlines(parent *model.Block) []*outline {
+	var result []*outline
+	for _, id := range parent.ChildrenIds {
+		if id == state.HeaderLayoutID {
+			continue
+		}
+		b := o.s.Pick(id)
+		if b == nil {
+			continue
+		}
+		result = append(result, o.blockOutlines(b.Model())...)
+	}
+	return result
+}
+
+func (o *opml) blockOutlines(b *model.Block) []*outline {
+	item := &outline{}
+	switch content := b.Content.(type) {
+	case *model.BlockContentOfText:
+		item.Text = content.Text.Text
+		if content.Text.Style == model.BlockContentText_Checkbox {
+			item.Complete = strconv.FormatBool(content.Text.Checked)
+		}
+		if url := linkURL(content.Text); url != "" {
+			item.Type, item.URL = "link", url
+		}
+	case *model.BlockContentOfLink:
+		item.Text = o.title(content.Link.TargetBlockId)
+	case *model.BlockContentOfBookmark:
+		item.Text = content.Bookmark.Title
+		if item.Text == "" {
+			item.Text = content.Bookmark.Url
+		}
+		item.Type, item.URL = "link", content.Bookmark.Url
+	case *model.BlockContentOfFile:
+		item.Text = filepath.Base(content.File.Name)
+	default:
+		return o.outlines(b)
+	}
+	item.Outlines = o.outlines(b)
+	if item.Text == "" && len(item.Outlines) == 0 {
+		return nil
+	}
+	return []*outline{item}
+}
+
+// linkURL returns the url of the link mark which covers the whole text
+func linkURL(text *model.BlockContentText) string {
+	length := int32(len([]rune(text.Text)))
+	for _, mark := range text.GetMarks().GetMarks() {
+		if mark.Type == model.BlockContentTextMark_Link && mark.Range.GetFrom() == 0 && mark.Range.GetTo() >= length && length > 0 {
+			return mark.Param
+		}
+	}
+	return ""
+}
+
+func (o *opml) title(id string) string {
+	details, ok := o.knownDocs[id]
+	if !ok {
+		return ""
+	}
+	if name := details.GetString(bundle.RelationKeyName); name != "" {
+		return name
+	}
+	return details.GetString(bundle.RelationKeySnippet)
+}
+
+// formatDate formats the date as RFC 822, as OPML requires
+func formatDate(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC1123)
+}
+
+func (o *opml) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	o.knownDocs = docs
+	return o
+}
+
+func (o *opml) FileHashes() []string {
+	return nil
+}
+
+func (o *opml) ImageHashes() []string {
+	return nil
+}
+
+func (o *opml) Ext() string {
+	return ".opml"
+}
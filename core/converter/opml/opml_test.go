@@ -0,0 +1,68 @@
+package opml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestOpml_Convert(t *testing.T) {
+	// given
+	textBlock := func(id, text string, style model.BlockContentTextStyle, checked bool, children ...string) *model.Block {
+		return &model.Block{Id: id, ChildrenIds: children, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: text, Style: style, Checked: checked,
+		}}}
+	}
+	blocks := map[string]simple.Block{}
+	for _, b := range []*model.Block{
+		{Id: "root", ChildrenIds: []string{state.HeaderLayoutID, "intro", "row", "empty"}},
+		{Id: state.HeaderLayoutID, ChildrenIds: []string{state.TitleBlockID}},
+		textBlock(state.TitleBlockID, "Plan", model.BlockContentText_Title, false),
+		textBlock("intro", "Trip & <plans>", model.BlockContentText_Marked, false, "task1", "task2"),
+		textBlock("task1", "book hotel", model.BlockContentText_Checkbox, true),
+		textBlock("task2", "pack", model.BlockContentText_Checkbox, false, "link"),
+		{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page2"}}},
+		{Id: "row", ChildrenIds: []string{"bookmark"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_Row}}},
+		{Id: "bookmark", Content: &model.BlockContentOfBookmark{Bookmark: &model.BlockContentBookmark{Url: "https://example.com/?a=1&b=2"}}},
+		textBlock("empty", "", model.BlockContentText_Paragraph, false),
+	} {
+		blocks[b.Id] = simple.New(b)
+	}
+	s := state.NewDoc("root", blocks).(*state.State)
+	s.SetDetail(bundle.RelationKeyName, domain.String("Plan"))
+	s.SetDetail(bundle.RelationKeyCreatedDate, domain.Int64(1704164645))
+	conv := NewConverter(s).SetKnownDocs(map[string]*domain.Details{
+		"page2": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Packing list"),
+		}),
+	})
+
+	// when
+	result := conv.Convert(model.SmartBlockType_Page)
+
+	// then
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head>
+    <title>Plan</title>
+    <dateCreated>Tue, 02 Jan 2024 03:04:05 UTC</dateCreated>
+  </head>
+  <body>
+    <outline text="Trip &amp; &lt;plans&gt;">
+      <outline text="book hotel" _complete="true"></outline>
+      <outline text="pack" _complete="false">
+        <outline text="Packing list"></outline>
+      </outline>
+    </outline>
+    <outline text="https://example.com/?a=1&amp;b=2" type="link" url="https://example.com/?a=1&amp;b=2"></outline>
+  </body>
+</opml>
+`, string(result))
+	assert.Equal(t, ".opml", conv.Ext())
+}
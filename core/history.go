@@ -121,6 +121,138 @@ func (mw *Middleware) HistorySetVersion(cctx context.Context, req *pb.RpcHistory
 	}))
 }
 
+func (mw *Middleware) HistorySetVersionName(cctx context.Context, req *pb.RpcHistorySetVersionNameRequest) *pb.RpcHistorySetVersionNameResponse {
+	response := func(err error) (res *pb.RpcHistorySetVersionNameResponse) {
+		res = &pb.RpcHistorySetVersionNameResponse{
+			Error: &pb.RpcHistorySetVersionNameResponseError{
+				Code: pb.RpcHistorySetVersionNameResponseError_NULL,
+			},
+		}
+		if err != nil {
+			res.Error.Code = pb.RpcHistorySetVersionNameResponseError_UNKNOWN_ERROR
+			res.Error.Description = getErrorDescription(err)
+			return
+		}
+		return
+	}
+	return response(mw.doBlockService(func(bs *block.Service) (err error) {
+		hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+		res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+		spaceID, err := res.ResolveSpaceID(req.ObjectId)
+		if err != nil {
+			return fmt.Errorf("resolve spaceID: %w", err)
+		}
+		return hs.SetVersionName(domain.FullID{
+			SpaceID:  spaceID,
+			ObjectID: req.ObjectId,
+		}, req.VersionId, req.Name)
+	}))
+}
+
+func (mw *Middleware) HistoryGetNamedVersions(cctx context.Context, req *pb.RpcHistoryGetNamedVersionsRequest) *pb.RpcHistoryGetNamedVersionsResponse {
+	response := func(vers []*pb.RpcHistoryVersion, err error) (res *pb.RpcHistoryGetNamedVersionsResponse) {
+		res = &pb.RpcHistoryGetNamedVersionsResponse{
+			Error: &pb.RpcHistoryGetNamedVersionsResponseError{
+				Code: pb.RpcHistoryGetNamedVersionsResponseError_NULL,
+			},
+		}
+		if err != nil {
+			res.Error.Code = pb.RpcHistoryGetNamedVersionsResponseError_UNKNOWN_ERROR
+			res.Error.Description = getErrorDescription(err)
+			return
+		} else {
+			res.Versions = vers
+		}
+		return res
+	}
+	var (
+		vers []*pb.RpcHistoryVersion
+		err  error
+	)
+	if err = mw.doBlockService(func(bs *block.Service) (err error) {
+		hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+		res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+		spaceID, err := res.ResolveSpaceID(req.ObjectId)
+		if err != nil {
+			return fmt.Errorf("resolve spaceID: %w", err)
+		}
+		vers, err = hs.NamedVersions(domain.FullID{
+			SpaceID:  spaceID,
+			ObjectID: req.ObjectId,
+		})
+		return
+	}); err != nil {
+		return response(nil, err)
+	}
+	return response(vers, nil)
+}
+
+func (mw *Middleware) HistoryRestoreVersion(cctx context.Context, req *pb.RpcHistoryRestoreVersionRequest) *pb.RpcHistoryRestoreVersionResponse {
+	response := func(err error) (res *pb.RpcHistoryRestoreVersionResponse) {
+		res = &pb.RpcHistoryRestoreVersionResponse{
+			Error: &pb.RpcHistoryRestoreVersionResponseError{
+				Code: pb.RpcHistoryRestoreVersionResponseError_NULL,
+			},
+		}
+		if err != nil {
+			res.Error.Code = pb.RpcHistoryRestoreVersionResponseError_UNKNOWN_ERROR
+			res.Error.Description = getErrorDescription(err)
+			return
+		}
+		return
+	}
+	return response(mw.doBlockService(func(bs *block.Service) (err error) {
+		hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+		res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+		spaceID, err := res.ResolveSpaceID(req.ObjectId)
+		if err != nil {
+			return fmt.Errorf("resolve spaceID: %w", err)
+		}
+		return hs.RestoreVersion(domain.FullID{
+			SpaceID:  spaceID,
+			ObjectID: req.ObjectId,
+		}, req.VersionId)
+	}))
+}
+
+func (mw *Middleware) HistoryGetBlockChanges(cctx context.Context, req *pb.RpcHistoryGetBlockChangesRequest) *pb.RpcHistoryGetBlockChangesResponse {
+	response := func(changes []*pb.RpcHistoryBlockChange, err error) (res *pb.RpcHistoryGetBlockChangesResponse) {
+		res = &pb.RpcHistoryGetBlockChangesResponse{
+			Error: &pb.RpcHistoryGetBlockChangesResponseError{
+				Code: pb.RpcHistoryGetBlockChangesResponseError_NULL,
+			},
+		}
+		if err != nil {
+			res.Error.Code = pb.RpcHistoryGetBlockChangesResponseError_UNKNOWN_ERROR
+			res.Error.Description = getErrorDescription(err)
+			return
+		} else {
+			res.Changes = changes
+		}
+		return res
+	}
+	var (
+		changes []*pb.RpcHistoryBlockChange
+		err     error
+	)
+	if err = mw.doBlockService(func(bs *block.Service) (err error) {
+		hs := mw.applicationService.GetApp().MustComponent(history.CName).(history.History)
+		res := mw.applicationService.GetApp().MustComponent(idresolver.CName).(idresolver.Resolver)
+		spaceID, err := res.ResolveSpaceID(req.ObjectId)
+		if err != nil {
+			return fmt.Errorf("resolve spaceID: %w", err)
+		}
+		changes, err = hs.BlockChanges(domain.FullID{
+			SpaceID:  spaceID,
+			ObjectID: req.ObjectId,
+		}, req.BlockId, req.LastVersionId, int(req.Limit))
+		return
+	}); err != nil {
+		return response(nil, err)
+	}
+	return response(changes, nil)
+}
+
 func (mw *Middleware) HistoryDiffVersions(cctx context.Context, req *pb.RpcHistoryDiffVersionsRequest) *pb.RpcHistoryDiffVersionsResponse {
 	response := func(historyEvents []*pb.EventMessage, objectView *model.ObjectView, err error) (res *pb.RpcHistoryDiffVersionsResponse) {
 		res = &pb.RpcHistoryDiffVersionsResponse{
@@ -14,6 +14,7 @@ import (
 	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
 	"github.com/anyproto/any-sync/commonspace/objecttreebuilder"
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	"github.com/samber/lo"
 	"github.com/zeebo/blake3"
 
@@ -57,8 +58,12 @@ type History interface {
 	Show(id domain.FullID, versionId string) (bs *model.ObjectView, ver *pb.RpcHistoryVersion, err error)
 	Versions(id domain.FullID, lastVersionId string, limit int, notIncludeVersion bool) (resp []*pb.RpcHistoryVersion, err error)
 	SetVersion(id domain.FullID, versionId string) (err error)
+	SetVersionName(id domain.FullID, versionId string, name string) (err error)
+	NamedVersions(id domain.FullID) (resp []*pb.RpcHistoryVersion, err error)
+	RestoreVersion(id domain.FullID, versionId string) (err error)
 	DiffVersions(req *pb.RpcHistoryDiffVersionsRequest) ([]*pb.EventMessage, *model.ObjectView, error)
 	GetBlocksParticipants(id domain.FullID, versionId string, blocks []*model.Block) ([]*model.ObjectViewBlockParticipant, error)
+	BlockChanges(id domain.FullID, blockId string, lastVersionId string, limit int) (resp []*pb.RpcHistoryBlockChange, err error)
 	app.Component
 }
 
@@ -70,6 +75,37 @@ type history struct {
 	heads        map[string]string
 }
 
+// versionNamesStoreKey is the key of the object store keeping named versions by version id, so they are synced with the object
+const versionNamesStoreKey = "versionNames"
+
+type namedVersion struct {
+	Id   string
+	Name string
+	// Heads are kept for versions with several heads, as their ids are hashes of heads
+	Heads    []string
+	AuthorId string
+	Time     int64
+}
+
+func (v *namedVersion) toValue() *types.Value {
+	return pbtypes.Struct(&types.Struct{Fields: map[string]*types.Value{
+		"name":     pbtypes.String(v.Name),
+		"heads":    pbtypes.StringList(v.Heads),
+		"authorId": pbtypes.String(v.AuthorId),
+		"time":     pbtypes.Int64(v.Time),
+	}})
+}
+
+func namedVersionFromStruct(versionId string, value *types.Struct) *namedVersion {
+	return &namedVersion{
+		Id:       versionId,
+		Name:     pbtypes.GetString(value, "name"),
+		Heads:    pbtypes.GetStringList(value, "heads"),
+		AuthorId: pbtypes.GetString(value, "authorId"),
+		Time:     pbtypes.GetInt64(value, "time"),
+	}
+}
+
 func (h *history) Init(a *app.App) (err error) {
 	h.picker = app.MustComponent[cache.ObjectGetter](a)
 	h.objectStore = a.MustComponent(objectstore.CName).(objectstore.ObjectStore)
@@ -188,13 +224,30 @@ func (h *history) Versions(id domain.FullID, lastVersionId string, limit int, no
 	if len(resp) > limit {
 		resp = resp[:limit]
 	}
+
+	named, err := h.getNamedVersions(id.ObjectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range resp {
+		if i := slices.IndexFunc(named, func(v *namedVersion) bool { return v.Id == version.Id }); i != -1 {
+			version.Name = named[i].Name
+		}
+	}
 	return
 }
 
-func (h *history) retrieveHeads(versionId string) []string {
+func (h *history) retrieveHeads(id domain.FullID, versionId string) []string {
 	if heads, ok := h.heads[versionId]; ok {
 		return strings.Split(heads, " ")
 	}
+	// versions with several heads are shown by the hash of heads, named ones keep their heads
+	named, err := h.getNamedVersions(id.ObjectID)
+	if err == nil {
+		if i := slices.IndexFunc(named, func(v *namedVersion) bool { return v.Id == versionId }); i != -1 && len(named[i].Heads) > 0 {
+			return named[i].Heads
+		}
+	}
 	return []string{versionId}
 }
 
@@ -516,18 +569,212 @@ func (h *history) handleSimpleBlockEvents(event *pb.EventMessage, blockId []stri
 	return blockId
 }
 
+// BlockChanges returns changes of the block text or marks with the text before and after each change, latest first
+func (h *history) BlockChanges(id domain.FullID, blockId string, lastVersionId string, limit int) (resp []*pb.RpcHistoryBlockChange, err error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	var includeLastId = true
+
+	for len(resp) < limit {
+		tree, _, e := h.treeWithId(id, lastVersionId, includeLastId)
+		if e != nil {
+			return nil, e
+		}
+		var (
+			data []*pb.RpcHistoryBlockChange
+			st   *state.State
+			root *objecttree.Change
+		)
+		e = tree.IterateFrom(tree.Root().Id, source.UnmarshalChange, func(c *objecttree.Change) (isContinue bool) {
+			if root == nil {
+				root = c
+			}
+			if c.Id == tree.Id() {
+				st = state.NewDoc(tree.Id(), nil).(*state.State)
+				return true
+			}
+			changeModel, ok := c.Model.(*pb.Change)
+			if !ok {
+				return true
+			}
+			if st == nil {
+				if changeModel.Snapshot != nil {
+					// changes before the snapshot are read from the previous tree
+					st = state.NewDocFromSnapshot(tree.Id(), changeModel.Snapshot, state.WithChangeId(c.Id)).(*state.State)
+					return true
+				}
+				st = state.NewDoc(tree.Id(), nil).(*state.State)
+			}
+			if !slices.Contains(h.getChangedBlockIds(changeModel.Content), blockId) {
+				st.ApplyChangeIgnoreErr(changeModel.Content...)
+				return true
+			}
+			before := pickText(st, blockId)
+			st.ApplyChangeIgnoreErr(changeModel.Content...)
+			after := pickText(st, blockId)
+			if (before == nil) != (after == nil) || before.GetText() != after.GetText() ||
+				!slices.EqualFunc(before.GetMarks().GetMarks(), after.GetMarks().GetMarks(), func(a, b *model.BlockContentTextMark) bool {
+					return proto.Equal(a, b)
+				}) {
+				data = append(data, &pb.RpcHistoryBlockChange{
+					ChangeId:    c.Id,
+					AuthorId:    domain.NewParticipantId(id.SpaceID, c.Identity.Account()),
+					Time:        c.Timestamp,
+					TextBefore:  before.GetText(),
+					TextAfter:   after.GetText(),
+					MarksBefore: before.GetMarks(),
+					MarksAfter:  after.GetMarks(),
+				})
+			}
+			return true
+		})
+		if e != nil {
+			return nil, e
+		}
+		resp = append(data, resp...)
+		if root == nil || len(root.PreviousIds) == 0 {
+			break
+		}
+		lastVersionId = root.Id
+		includeLastId = false
+	}
+
+	slices.Reverse(resp)
+	if len(resp) > limit {
+		resp = resp[:limit]
+	}
+	return
+}
+
+// pickText returns the copy of the block text content, so it is not changed by the changes applied later
+func pickText(st *state.State, blockId string) *model.BlockContentText {
+	b := st.Pick(blockId)
+	if b == nil {
+		return nil
+	}
+	text := pbtypes.CopyBlock(b.Model()).GetText()
+	if text == nil {
+		return &model.BlockContentText{}
+	}
+	return text
+}
+
 func (h *history) SetVersion(id domain.FullID, versionId string) (err error) {
 	s, _, _, err := h.buildState(id, versionId)
 	if err != nil {
 		return
 	}
 	return cache.Do(h.picker, id.ObjectID, func(sb smartblock2.SmartBlock) error {
+		keepVersionNames(sb, s)
 		return history2.ResetToVersion(sb, s)
 	})
 }
 
+// RestoreVersion restores the object to the version with the new change, unlike SetVersion the restore can be undone
+func (h *history) RestoreVersion(id domain.FullID, versionId string) (err error) {
+	s, _, _, err := h.buildState(id, versionId)
+	if err != nil {
+		return
+	}
+	return cache.Do(h.picker, id.ObjectID, func(sb smartblock2.SmartBlock) error {
+		keepVersionNames(sb, s)
+		return history2.RestoreVersion(sb, s)
+	})
+}
+
+// SetVersionName names the version or removes the name when it is empty
+func (h *history) SetVersionName(id domain.FullID, versionId string, name string) (err error) {
+	var version *namedVersion
+	if name = strings.TrimSpace(name); name != "" {
+		if version, err = h.namedVersion(id, versionId); err != nil {
+			return err
+		}
+		version.Name = name
+	}
+	return cache.Do(h.picker, id.ObjectID, func(sb smartblock2.SmartBlock) error {
+		st := sb.NewState()
+		path := []string{versionNamesStoreKey, versionId}
+		if version != nil {
+			st.SetInStore(path, version.toValue())
+		} else if !st.RemoveFromStore(path) {
+			return nil
+		}
+		return sb.Apply(st, smartblock2.NoHistory)
+	})
+}
+
+// namedVersion collects the version data from the tree, the time and the author are taken from the latest head
+func (h *history) namedVersion(id domain.FullID, versionId string) (*namedVersion, error) {
+	heads := h.retrieveHeads(id, versionId)
+	tree, _, err := h.treeWithId(id, versionId, true)
+	if err != nil {
+		return nil, fmt.Errorf("get version: %w", err)
+	}
+	version := &namedVersion{Id: versionId}
+	if len(heads) > 1 {
+		version.Heads = heads
+	}
+	for _, head := range heads {
+		change, err := tree.GetChange(head)
+		if err != nil {
+			return nil, fmt.Errorf("get change %s: %w", head, err)
+		}
+		if change.Timestamp >= version.Time {
+			version.Time = change.Timestamp
+			version.AuthorId = domain.NewParticipantId(id.SpaceID, change.Identity.Account())
+		}
+	}
+	return version, nil
+}
+
+// NamedVersions returns named versions of the object, the latest first
+func (h *history) NamedVersions(id domain.FullID) (resp []*pb.RpcHistoryVersion, err error) {
+	named, err := h.getNamedVersions(id.ObjectID)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(named, func(a, b *namedVersion) int {
+		return int(b.Time - a.Time)
+	})
+	for _, version := range named {
+		resp = append(resp, &pb.RpcHistoryVersion{
+			Id:       version.Id,
+			AuthorId: version.AuthorId,
+			Time:     version.Time,
+			Name:     version.Name,
+		})
+	}
+	return resp, nil
+}
+
+// getNamedVersions reads named versions from the object store
+func (h *history) getNamedVersions(objectId string) (named []*namedVersion, err error) {
+	err = cache.Do(h.picker, objectId, func(sb smartblock2.SmartBlock) error {
+		versions := pbtypes.GetStruct(sb.NewState().Store(), versionNamesStoreKey)
+		for versionId, value := range versions.GetFields() {
+			named = append(named, namedVersionFromStruct(versionId, value.GetStructValue()))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get named versions: %w", err)
+	}
+	return named, nil
+}
+
+// keepVersionNames replaces named versions of the restored state with the current ones, so the names given later are not lost
+func keepVersionNames(sb smartblock2.SmartBlock, s *state.State) {
+	path := []string{versionNamesStoreKey}
+	if names := pbtypes.GetStruct(sb.NewState().Store(), versionNamesStoreKey); names != nil {
+		s.SetInStore(path, pbtypes.Struct(names))
+	} else {
+		s.RemoveFromStore(path)
+	}
+}
+
 func (h *history) treeWithId(id domain.FullID, versionId string, includeBeforeId bool) (ht objecttree.HistoryTree, sbt smartblock.SmartBlockType, err error) {
-	heads := h.retrieveHeads(versionId)
+	heads := h.retrieveHeads(id, versionId)
 	spc, err := h.spaceService.Get(context.Background(), id.SpaceID)
 	if err != nil {
 		return
@@ -15,9 +15,17 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver/mock_idresolver"
+	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -1099,10 +1107,247 @@ func TestHistory_Versions(t *testing.T) {
 	})
 }
 
+func TestHistory_SetVersionName(t *testing.T) {
+	objectId := "objectId"
+	spaceID := "spaceID"
+	versionId := "versionId"
+	accountKeys, _ := accountdata.NewRandom()
+	account := accountKeys.SignKey.GetPublic()
+	changes := []*objecttree.Change{
+		provideBlockEmptyChange(objectId, account),
+		{Id: versionId, Identity: account, Timestamp: 100, PreviousIds: []string{objectId}, Model: &pb.Change{}},
+	}
+
+	t.Run("named version is listed and marked in versions", func(t *testing.T) {
+		// given
+		history := newFixture(t, changes, objectId, spaceID, versionId)
+		id := domain.FullID{ObjectID: objectId, SpaceID: spaceID}
+
+		// when
+		err := history.SetVersionName(id, versionId, " Sent to client ")
+		require.NoError(t, err)
+		named, err := history.NamedVersions(id)
+		require.NoError(t, err)
+		configureTreeBuilder(history.treeBuilder, objectId, versionId, spaceID, changes, history.space, history.spaceService.(*mock_space.MockService))
+		versions, err := history.Versions(id, versionId, 0, false)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, []*pb.RpcHistoryVersion{{
+			Id:       versionId,
+			AuthorId: domain.NewParticipantId(spaceID, account.Account()),
+			Time:     100,
+			Name:     "Sent to client",
+		}}, named)
+		require.Len(t, versions, 1)
+		assert.Equal(t, "Sent to client", versions[0].Name)
+		stored := pbtypes.GetStruct(history.object.NewState().Store(), versionNamesStoreKey)
+		assert.Equal(t, "Sent to client", pbtypes.GetString(pbtypes.GetStruct(stored, versionId), "name"))
+	})
+	t.Run("empty name removes the version from named ones", func(t *testing.T) {
+		// given
+		history := newFixture(t, changes, objectId, spaceID, versionId)
+		id := domain.FullID{ObjectID: objectId, SpaceID: spaceID}
+		require.NoError(t, history.SetVersionName(id, versionId, "draft"))
+
+		// when
+		err := history.SetVersionName(id, versionId, "")
+
+		// then
+		require.NoError(t, err)
+		named, err := history.NamedVersions(id)
+		require.NoError(t, err)
+		assert.Empty(t, named)
+	})
+}
+
+func TestHistory_BlockChanges(t *testing.T) {
+	objectId := "objectId"
+	spaceID := "spaceID"
+	versionId := "versionId"
+	blockId := "blockId"
+	id := domain.FullID{ObjectID: objectId, SpaceID: spaceID}
+	creatorKeys, _ := accountdata.NewRandom()
+	creator := creatorKeys.SignKey.GetPublic()
+	editorKeys, _ := accountdata.NewRandom()
+	editor := editorKeys.SignKey.GetPublic()
+	mark := &model.BlockContentTextMark{Type: model.BlockContentTextMark_Bold, Range: &model.Range{To: 3}}
+
+	newChanges := func() []*objecttree.Change {
+		bl := &model.Block{Id: blockId, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  "old",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mark}},
+		}}}
+		other := &model.Block{Id: "otherBlockId", Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}}
+		changes := []*objecttree.Change{
+			provideBlockEmptyChange(objectId, creator),
+			provideBlockCreateChange(bl, creator),
+			provideBlockCreateChange(other, editor),
+			provideBlockSetTextChange(bl, editor),
+			provideBlockSetTextChange(other, editor),
+		}
+		for i, change := range changes[1:] {
+			change.Id = fmt.Sprintf("change%d", i+1)
+			change.Timestamp = int64(i + 1)
+		}
+		changes[len(changes)-1].Id = versionId
+		return changes
+	}
+
+	t.Run("changes of the block text are returned latest first", func(t *testing.T) {
+		// given
+		history := newFixture(t, newChanges(), objectId, spaceID, versionId)
+
+		// when
+		changes, err := history.BlockChanges(id, blockId, versionId, 0)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*pb.RpcHistoryBlockChange{
+			{
+				ChangeId:    "change3",
+				AuthorId:    domain.NewParticipantId(spaceID, editor.Account()),
+				Time:        3,
+				TextBefore:  "old",
+				TextAfter:   "new text",
+				MarksBefore: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mark}},
+				MarksAfter:  &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mark}},
+			},
+			{
+				ChangeId:   "change1",
+				AuthorId:   domain.NewParticipantId(spaceID, creator.Account()),
+				Time:       1,
+				TextAfter:  "old",
+				MarksAfter: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mark}},
+			},
+		}, changes)
+	})
+	t.Run("limit keeps the latest changes", func(t *testing.T) {
+		// given
+		history := newFixture(t, newChanges(), objectId, spaceID, versionId)
+
+		// when
+		changes, err := history.BlockChanges(id, blockId, versionId, 1)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "change3", changes[0].ChangeId)
+	})
+}
+
+func TestHistory_RestoreVersion(t *testing.T) {
+	t.Run("restore blocks and keep version names", func(t *testing.T) {
+		objectId := "objectId"
+		spaceID := "spaceID"
+		versionId := "versionId"
+		accountKeys, _ := accountdata.NewRandom()
+		account := accountKeys.SignKey.GetPublic()
+		versionChange := provideBlockCreateChange(&model.Block{
+			Id:      "version",
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "version"}},
+		}, account)
+		versionChange.Id = versionId
+		changes := []*objecttree.Change{
+			provideBlockEmptyChange(objectId, account),
+			provideBlockCreateChange(&model.Block{Id: objectId, ChildrenIds: []string{"version"}}, account),
+			versionChange,
+		}
+
+		// given
+		history := newFixture(t, changes, objectId, spaceID, versionId)
+		id := domain.FullID{ObjectID: objectId, SpaceID: spaceID}
+		history.object.AddBlock(simple.New(&model.Block{Id: objectId, ChildrenIds: []string{"current"}}))
+		history.object.AddBlock(simple.New(&model.Block{Id: "current", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "current"}}}))
+		named := history.object.NewState()
+		named.SetInStore([]string{versionNamesStoreKey, "later"}, (&namedVersion{Name: "named later"}).toValue())
+		require.NoError(t, history.object.Apply(named, smartblock.NoHistory))
+
+		// when
+		err := history.RestoreVersion(id, versionId)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, history.object.Pick("current"))
+		assert.Equal(t, "version", history.object.Pick("version").Model().GetText().GetText())
+		versions, err := history.NamedVersions(id)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, "named later", versions[0].Name)
+
+		// when
+		_, err = basic.NewHistory(history.object).Undo(nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, history.object.Pick("version"))
+		assert.Equal(t, "current", history.object.Pick("current").Model().GetText().GetText())
+	})
+
+	t.Run("restore store of collection", func(t *testing.T) {
+		objectId := "objectId"
+		spaceID := "spaceID"
+		versionId := "versionId"
+		accountKeys, _ := accountdata.NewRandom()
+		account := accountKeys.SignKey.GetPublic()
+		versionChange := provideStoreKeySetChange(account, template.CollectionStoreKey, pbtypes.StringList([]string{"object1", "object2"}))
+		versionChange.Id = versionId
+		changes := []*objecttree.Change{
+			provideBlockEmptyChange(objectId, account),
+			provideBlockCreateChange(&model.Block{Id: objectId}, account),
+			versionChange,
+		}
+
+		// given
+		history := newFixture(t, changes, objectId, spaceID, versionId)
+		id := domain.FullID{ObjectID: objectId, SpaceID: spaceID}
+		history.object.AddBlock(simple.New(&model.Block{Id: objectId}))
+		current := history.object.NewState()
+		current.UpdateStoreSlice(template.CollectionStoreKey, []string{"object3"})
+		current.SetInStore([]string{"removed"}, pbtypes.Bool(true))
+		require.NoError(t, history.object.Apply(current, smartblock.NoHistory))
+		var pushed []*pb.ChangeContent
+		history.object.AddHook(func(info smartblock.ApplyInfo) error {
+			pushed = info.Changes
+			return nil
+		})
+
+		// when
+		err := history.RestoreVersion(id, versionId)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"object1", "object2"}, history.object.NewState().GetStoreSlice(template.CollectionStoreKey))
+		assert.Contains(t, pushed, &pb.ChangeContent{Value: &pb.ChangeContentValueOfStoreKeySet{
+			StoreKeySet: &pb.ChangeStoreKeySet{Path: []string{template.CollectionStoreKey}, Value: pbtypes.StringList([]string{"object1", "object2"})},
+		}})
+		assert.Contains(t, pushed, &pb.ChangeContent{Value: &pb.ChangeContentValueOfStoreKeyUnset{
+			StoreKeyUnset: &pb.ChangeStoreKeyUnset{Path: []string{"removed"}},
+		}})
+
+		// when
+		_, err = basic.NewHistory(history.object).Undo(nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"object3"}, history.object.NewState().GetStoreSlice(template.CollectionStoreKey))
+		assert.True(t, pbtypes.GetBool(history.object.NewState().Store(), "removed"))
+	})
+}
+
 type historyFixture struct {
 	*history
 	space       *mock_clientspace.MockSpace
 	treeBuilder *mock_objecttreebuilder.MockTreeBuilder
+	object      *smarttest.SmartTest
+}
+
+// newObjectGetter returns the getter of the opened object, which keeps named versions in its store
+func newObjectGetter(t *testing.T, objectId string) (cache.ObjectGetter, *smarttest.SmartTest) {
+	object := smarttest.New(objectId)
+	objectGetter := mock_cache.NewMockObjectGetter(t)
+	objectGetter.EXPECT().GetObject(mock.Anything, objectId).Return(object, nil).Maybe()
+	return objectGetter, object
 }
 
 func newFixture(t *testing.T, expectedChanges []*objecttree.Change, objectId, spaceID, versionId string) *historyFixture {
@@ -1114,7 +1359,9 @@ func newFixture(t *testing.T, expectedChanges []*objecttree.Change, objectId, sp
 	if len(expectedChanges) > 0 {
 		configureTreeBuilder(treeBuilder, objectId, versionId, spaceID, expectedChanges, space, spaceService)
 	}
+	picker, object := newObjectGetter(t, objectId)
 	history := &history{
+		picker:       picker,
 		objectStore:  objectstore.NewStoreFixture(t),
 		spaceService: spaceService,
 		heads:        map[string]string{},
@@ -1123,6 +1370,7 @@ func newFixture(t *testing.T, expectedChanges []*objecttree.Change, objectId, sp
 		history:     history,
 		space:       space,
 		treeBuilder: treeBuilder,
+		object:      object,
 	}
 }
 
@@ -1142,7 +1390,9 @@ func newFixtureDiffVersions(t *testing.T,
 	if len(prevChanges) > 0 {
 		configureTreeBuilder(treeBuilder, objectId, prevVersionId, spaceID, prevChanges, space, spaceService)
 	}
+	picker, object := newObjectGetter(t, objectId)
 	history := &history{
+		picker:       picker,
 		objectStore:  objectstore.NewStoreFixture(t),
 		spaceService: spaceService,
 		heads:        map[string]string{},
@@ -1152,6 +1402,7 @@ func newFixtureDiffVersions(t *testing.T,
 		history:     history,
 		space:       space,
 		treeBuilder: treeBuilder,
+		object:      object,
 	}
 }
 
@@ -1173,6 +1424,21 @@ func configureTreeBuilder(treeBuilder *mock_objecttreebuilder.MockTreeBuilder,
 	spaceService.EXPECT().Get(context.Background(), spaceID).Return(space, nil)
 }
 
+func provideStoreKeySetChange(account crypto.PubKey, key string, value *types.Value) *objecttree.Change {
+	return &objecttree.Change{
+		Identity: account,
+		Model: &pb.Change{
+			Content: []*pb.ChangeContent{
+				{
+					Value: &pb.ChangeContentValueOfStoreKeySet{
+						StoreKeySet: &pb.ChangeStoreKeySet{Path: []string{key}, Value: value},
+					},
+				},
+			},
+		},
+	}
+}
+
 func provideBlockCreateChange(block *model.Block, account crypto.PubKey) *objecttree.Change {
 	return &objecttree.Change{
 		Identity: account,
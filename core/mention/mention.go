@@ -0,0 +1,543 @@
+package mention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/anyproto/any-sync/util/periodicsync"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/chats"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/source"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const CName = "core.mention"
+
+const (
+	checkIntervalSecs = 10
+	stateKey          = "state"
+	// chatMentionTTL is the time chat mentions are kept for, older messages are not notified about
+	chatMentionTTL = 30 * 24 * time.Hour
+)
+
+var log = logging.Logger("anytype-mw-mention")
+
+// Service notifies about mentions of the current user made by other participants in objects and chat messages.
+// Every mention is notified once, the notification is marked read after the object or the chat is opened
+type Service interface {
+	app.ComponentRunnable
+}
+
+type accountService interface {
+	AccountID() string
+}
+
+type mention struct {
+	SpaceId  string `json:"spaceId"`
+	ObjectId string `json:"objectId"`
+	// NotificationId is empty for mentions found without the notification, e.g. made before the first start
+	NotificationId string `json:"notificationId,omitempty"`
+	CreatedAt      int64  `json:"createdAt"`
+	Read           bool   `json:"read"`
+	// Chat is set for mentions in chat messages, they are forgotten after they are read or expired
+	Chat bool `json:"chat,omitempty"`
+}
+
+type storedState struct {
+	// Objects keeps lastModifiedDate of objects with mentions, objects are read again only after they change
+	Objects map[string]int64 `json:"objects"`
+	// Mentions are the found mentions by objectId/blockId or chatId/messageId
+	Mentions map[string]*mention `json:"mentions"`
+	// Since is the time of the first start, earlier mentions are not notified about
+	Since int64 `json:"since"`
+	// Heads keeps heads of objects with mentions at the last check, authors of new mentions are looked up in later changes
+	Heads map[string][]string `json:"heads,omitempty"`
+}
+
+type service struct {
+	objectStore         objectstore.ObjectStore
+	objectGetter        cache.ObjectGetter
+	accountService      accountService
+	notificationService notifications.Notifications
+	chatService         chats.Service
+	store               keyvaluestore.Store[*storedState]
+	periodicSync        periodicsync.PeriodicSync
+	unsubscribeChats    func()
+
+	mu    sync.Mutex
+	state *storedState
+	now   func() time.Time
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.accountService = app.MustComponent[accountService](a)
+	s.notificationService = app.MustComponent[notifications.Notifications](a)
+	s.chatService = app.MustComponent[chats.Service](a)
+	db, err := app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get badger: %w", err)
+	}
+	s.store = keyvaluestore.NewJson[*storedState](db, []byte("mention/"))
+	s.periodicSync = periodicsync.NewPeriodicSync(checkIntervalSecs, 0, s.check, logger.CtxLogger{Logger: log.Desugar()})
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) error {
+	state, err := s.store.Get(stateKey)
+	if err != nil && !errors.Is(err, keyvaluestore.ErrNotFound) {
+		return fmt.Errorf("load mentions: %w", err)
+	}
+	if state == nil {
+		state = &storedState{Since: s.now().Unix()}
+	}
+	if state.Objects == nil {
+		state.Objects = map[string]int64{}
+	}
+	if state.Mentions == nil {
+		state.Mentions = map[string]*mention{}
+	}
+	if state.Heads == nil {
+		state.Heads = map[string][]string{}
+	}
+	s.mu.Lock()
+	s.state = state
+	s.mu.Unlock()
+	s.unsubscribeChats = s.chatService.SubscribeMessages(s.ChatMessageAdded)
+	s.periodicSync.Run()
+	return nil
+}
+
+func (s *service) Close(ctx context.Context) error {
+	if s.unsubscribeChats != nil {
+		s.unsubscribeChats()
+	}
+	if s.periodicSync != nil {
+		s.periodicSync.Close()
+	}
+	return nil
+}
+
+func mentionKey(objectId, blockOrMessageId string) string {
+	return objectId + "/" + blockOrMessageId
+}
+
+// check finds new mentions in changed objects and marks notifications of opened objects read
+func (s *service) check(ctx context.Context) error {
+	var (
+		created []*model.Notification
+		read    []string
+	)
+	err := s.objectStore.IterateSpaceIndex(func(store spaceindex.Store) error {
+		spaceCreated, err := s.checkSpace(store)
+		if err != nil {
+			return fmt.Errorf("check space %s: %w", store.SpaceId(), err)
+		}
+		created = append(created, spaceCreated...)
+		read = append(read, s.markOpened(store)...)
+		return nil
+	})
+	s.pruneChatMentions()
+	if err != nil {
+		return err
+	}
+	for _, notification := range created {
+		if err = s.notificationService.CreateAndSend(notification); err != nil {
+			log.Errorf("failed to send mention: %v", err)
+		}
+	}
+	if len(read) > 0 {
+		if err = s.notificationService.Reply(read, model.Notification_CLOSE); err != nil {
+			log.Errorf("failed to mark mentions read: %v", err)
+		}
+	}
+	return s.saveState()
+}
+
+func (s *service) saveState() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.store.Set(stateKey, s.state)
+}
+
+func (s *service) checkSpace(store spaceindex.Store) ([]*model.Notification, error) {
+	spaceId := store.SpaceId()
+	participantId := domain.NewParticipantId(spaceId, s.accountService.AccountID())
+	records, err := store.Query(database.Query{
+		Filters: []database.FilterRequest{{
+			RelationKey: bundle.RelationKeyMentions,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.StringList([]string{participantId}),
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query objects with mentions: %w", err)
+	}
+
+	found := map[string]bool{}
+	var created []*model.Notification
+	for _, record := range records {
+		objectId := record.Details.GetString(bundle.RelationKeyId)
+		modified := record.Details.GetInt64(bundle.RelationKeyLastModifiedDate)
+		found[objectId] = true
+		s.mu.Lock()
+		if seen, ok := s.state.Objects[objectId]; ok && seen == modified {
+			s.mu.Unlock()
+			continue
+		}
+		processedHeads := s.state.Heads[objectId]
+		// authors are looked up only for mentions which are not known yet
+		known := map[string]bool{}
+		for key := range s.state.Mentions {
+			if blockId, ok := strings.CutPrefix(key, objectId+"/"); ok {
+				known[blockId] = true
+			}
+		}
+		s.mu.Unlock()
+		// blocks are read without the lock, so chat messages are not blocked by the object
+		blocks, authors, heads, err := s.mentionBlocks(spaceId, objectId, participantId, known, processedHeads)
+		if err != nil {
+			log.With("objectId", objectId).Errorf("failed to read mentions: %v", err)
+			continue
+		}
+		s.mu.Lock()
+		blockKeys := map[string]bool{}
+		for _, block := range blocks {
+			key := mentionKey(objectId, block.Id)
+			blockKeys[key] = true
+			if _, ok := s.state.Mentions[key]; ok {
+				continue
+			}
+			// mentions are credited to the author of the change adding them, not to the last editor of the object
+			author, ok := authors[block.Id]
+			notify := ok && author.timestamp >= s.state.Since && author.participantId != participantId
+			m := &mention{SpaceId: spaceId, ObjectId: objectId, CreatedAt: s.now().Unix(), Read: !notify}
+			if notify {
+				m.NotificationId = uuid.New().String()
+				created = append(created, s.newNotification(m, &model.NotificationMention{
+					ObjectName: record.Details.GetString(bundle.RelationKeyName),
+					BlockId:    block.Id,
+					AuthorId:   author.participantId,
+					Text:       block.GetText().GetText(),
+				}))
+			}
+			s.state.Mentions[key] = m
+		}
+		s.forgetMentions(objectId, blockKeys)
+		s.state.Objects[objectId] = modified
+		s.state.Heads[objectId] = heads
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for objectId := range s.state.Objects {
+		if !found[objectId] && s.isInSpace(store, objectId) {
+			delete(s.state.Objects, objectId)
+			delete(s.state.Heads, objectId)
+			s.forgetMentions(objectId, nil)
+		}
+	}
+	return created, nil
+}
+
+// forgetMentions removes mentions of the object except the kept ones, so they are notified about when they are added again
+func (s *service) forgetMentions(objectId string, keep map[string]bool) {
+	for key := range s.state.Mentions {
+		if strings.HasPrefix(key, objectId+"/") && !keep[key] {
+			delete(s.state.Mentions, key)
+		}
+	}
+}
+
+func (s *service) isInSpace(store spaceindex.Store, objectId string) bool {
+	details, err := store.GetDetails(objectId)
+	return err == nil && details.GetString(bundle.RelationKeyId) != ""
+}
+
+// mentionBlocks returns text blocks with mention marks of the participant, the authors of the mentions in blocks
+// which are not known yet and the current heads of the object
+func (s *service) mentionBlocks(spaceId, objectId, participantId string, known map[string]bool, processedHeads []string) (result []*model.Block, authors map[string]mentionAuthor, heads []string, err error) {
+	err = cache.Do(s.objectGetter, objectId, func(sb smartblock.SmartBlock) error {
+		var blockIds []string
+		for _, block := range sb.Blocks() {
+			if hasMention(block.GetText().GetMarks().GetMarks(), participantId) {
+				result = append(result, block)
+				if !known[block.Id] {
+					blockIds = append(blockIds, block.Id)
+				}
+			}
+		}
+		tree := sb.Tree()
+		if tree != nil {
+			heads = slices.Clone(tree.Heads())
+		}
+		if len(blockIds) == 0 {
+			return nil
+		}
+		authors, err = addedMentionAuthors(spaceId, tree, participantId, blockIds, processedHeads)
+		if err != nil || len(authors) == len(blockIds) {
+			return err
+		}
+		// the mention may come from the change ordered before the processed heads, e.g. from the branch made offline
+		authors, err = mentionAuthors(spaceId, tree, participantId, blockIds)
+		return err
+	})
+	return result, authors, heads, err
+}
+
+type mentionAuthor struct {
+	participantId string
+	timestamp     int64
+}
+
+// mentionAuthors replays the whole object tree and finds the changes adding the mention of the participant to the blocks
+func mentionAuthors(spaceId string, tree objecttree.ObjectTree, participantId string, blockIds []string) (map[string]mentionAuthor, error) {
+	authors := make(map[string]mentionAuthor, len(blockIds))
+	if tree == nil {
+		return authors, nil
+	}
+	var st *state.State
+	err := tree.IterateRoot(source.UnmarshalChange, func(c *objecttree.Change) (isContinue bool) {
+		if c.Id == tree.Id() {
+			return true
+		}
+		changeModel, ok := c.Model.(*pb.Change)
+		if !ok {
+			return true
+		}
+		author := mentionAuthor{
+			participantId: domain.NewParticipantId(spaceId, c.Identity.Account()),
+			timestamp:     c.Timestamp,
+		}
+		if st == nil && changeModel.Snapshot != nil {
+			// the tree starts from the snapshot, so its mentions are credited to the author of the snapshot
+			st = state.NewDocFromSnapshot(tree.Id(), changeModel.Snapshot, state.WithChangeId(c.Id)).(*state.State)
+			for _, blockId := range blockIds {
+				if blockHasMention(st, blockId, participantId) {
+					authors[blockId] = author
+				}
+			}
+			return true
+		}
+		if st == nil {
+			st = state.NewDoc(tree.Id(), nil).(*state.State)
+		}
+		before := make(map[string]bool, len(blockIds))
+		for _, blockId := range blockIds {
+			before[blockId] = blockHasMention(st, blockId, participantId)
+		}
+		st.ApplyChangeIgnoreErr(changeModel.Content...)
+		for _, blockId := range blockIds {
+			if !before[blockId] && blockHasMention(st, blockId, participantId) {
+				authors[blockId] = author
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate changes: %w", err)
+	}
+	return authors, nil
+}
+
+// addedMentionAuthors walks only the changes after the heads processed at the last check and finds the changes
+// setting the mention of the participant to the blocks. The blocks had no mention at the processed heads,
+// otherwise their mentions would be known already
+func addedMentionAuthors(spaceId string, tree objecttree.ObjectTree, participantId string, blockIds []string, processedHeads []string) (map[string]mentionAuthor, error) {
+	authors := make(map[string]mentionAuthor, len(blockIds))
+	if tree == nil || len(processedHeads) == 0 || !tree.HasChanges(processedHeads...) {
+		return authors, nil
+	}
+	mentioned := make(map[string]bool, len(blockIds))
+	for _, blockId := range blockIds {
+		mentioned[blockId] = false
+	}
+	err := tree.IterateFrom(processedHeads[0], source.UnmarshalChange, func(c *objecttree.Change) (isContinue bool) {
+		changeModel, ok := c.Model.(*pb.Change)
+		if !ok || slices.Contains(processedHeads, c.Id) {
+			return true
+		}
+		for blockId, hasMention := range changedMentions(changeModel, participantId) {
+			wasMentioned, ok := mentioned[blockId]
+			if !ok {
+				continue
+			}
+			if hasMention && !wasMentioned {
+				authors[blockId] = mentionAuthor{
+					participantId: domain.NewParticipantId(spaceId, c.Identity.Account()),
+					timestamp:     c.Timestamp,
+				}
+			}
+			mentioned[blockId] = hasMention
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate changes: %w", err)
+	}
+	return authors, nil
+}
+
+// changedMentions returns whether blocks created, updated or removed by the change have the mention of the participant
+func changedMentions(change *pb.Change, participantId string) map[string]bool {
+	mentions := map[string]bool{}
+	for _, block := range change.GetSnapshot().GetData().GetBlocks() {
+		mentions[block.Id] = hasMention(block.GetText().GetMarks().GetMarks(), participantId)
+	}
+	for _, content := range change.Content {
+		for _, block := range content.GetBlockCreate().GetBlocks() {
+			mentions[block.Id] = hasMention(block.GetText().GetMarks().GetMarks(), participantId)
+		}
+		for _, message := range content.GetBlockUpdate().GetEvents() {
+			if setText := message.GetBlockSetText(); setText != nil && setText.Marks != nil {
+				mentions[setText.Id] = hasMention(setText.Marks.GetValue().GetMarks(), participantId)
+			}
+		}
+		for _, blockId := range content.GetBlockRemove().GetIds() {
+			mentions[blockId] = false
+		}
+	}
+	return mentions
+}
+
+func blockHasMention(st *state.State, blockId, participantId string) bool {
+	block := st.Pick(blockId)
+	return block != nil && hasMention(block.Model().GetText().GetMarks().GetMarks(), participantId)
+}
+
+func hasMention(marks []*model.BlockContentTextMark, participantId string) bool {
+	for _, mark := range marks {
+		if mark.Type == model.BlockContentTextMark_Mention && mark.Param == participantId {
+			return true
+		}
+	}
+	return false
+}
+
+// markOpened marks mentions read when their objects were opened after the notification, returning the notification ids
+func (s *service) markOpened(store spaceindex.Store) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var read []string
+	for _, m := range s.state.Mentions {
+		if m.Read || m.SpaceId != store.SpaceId() {
+			continue
+		}
+		details, err := store.GetDetails(m.ObjectId)
+		if err != nil || details.GetInt64(bundle.RelationKeyLastOpenedDate) < m.CreatedAt {
+			continue
+		}
+		m.Read = true
+		read = append(read, m.NotificationId)
+	}
+	return read
+}
+
+// pruneChatMentions forgets read and expired chat mentions, added messages are notified about only once
+func (s *service) pruneChatMentions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiredAt := s.now().Add(-chatMentionTTL).Unix()
+	for key, m := range s.state.Mentions {
+		if m.Chat && (m.Read || m.CreatedAt < expiredAt) {
+			delete(s.state.Mentions, key)
+		}
+	}
+}
+
+// ChatMessageAdded notifies about the message of other participant mentioning the current user
+func (s *service) ChatMessageAdded(spaceId string, chatObjectId string, message *model.ChatMessage) {
+	identity := s.accountService.AccountID()
+	participantId := domain.NewParticipantId(spaceId, identity)
+	if message.Creator == identity || !hasMention(message.GetMessage().GetMarks(), participantId) {
+		return
+	}
+
+	s.mu.Lock()
+	// messages read before the start, created before the first start or expired are skipped
+	if s.state == nil || message.CreatedAt < s.state.Since || message.CreatedAt < s.now().Add(-chatMentionTTL).Unix() {
+		s.mu.Unlock()
+		return
+	}
+	key := mentionKey(chatObjectId, message.Id)
+	if _, ok := s.state.Mentions[key]; ok {
+		s.mu.Unlock()
+		return
+	}
+	m := &mention{
+		SpaceId:        spaceId,
+		ObjectId:       chatObjectId,
+		NotificationId: uuid.New().String(),
+		CreatedAt:      s.now().Unix(),
+		Chat:           true,
+	}
+	s.state.Mentions[key] = m
+	notification := s.newNotification(m, &model.NotificationMention{
+		MessageId: message.Id,
+		AuthorId:  domain.NewParticipantId(spaceId, message.Creator),
+		Text:      message.GetMessage().GetText(),
+	})
+	s.mu.Unlock()
+
+	if err := s.notificationService.CreateAndSend(notification); err != nil {
+		log.Errorf("failed to send mention: %v", err)
+	}
+	if err := s.saveState(); err != nil {
+		log.Errorf("failed to save mentions: %v", err)
+	}
+}
+
+// newNotification fills the payload with the space, the object and the author names
+func (s *service) newNotification(m *mention, payload *model.NotificationMention) *model.Notification {
+	payload.SpaceId = m.SpaceId
+	payload.SpaceName = s.objectStore.GetSpaceName(m.SpaceId)
+	payload.ObjectId = m.ObjectId
+	store := s.objectStore.SpaceIndex(m.SpaceId)
+	if payload.ObjectName == "" {
+		if details, err := store.GetDetails(m.ObjectId); err == nil {
+			payload.ObjectName = details.GetString(bundle.RelationKeyName)
+		}
+	}
+	if details, err := store.GetDetails(payload.AuthorId); err == nil {
+		payload.AuthorName = details.GetString(bundle.RelationKeyName)
+	}
+	return &model.Notification{
+		Id:      m.NotificationId,
+		Status:  model.Notification_Created,
+		IsLocal: true,
+		Space:   m.SpaceId,
+		Payload: &model.NotificationPayloadOfMention{Mention: payload},
+	}
+}
@@ -0,0 +1,401 @@
+package mention
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/objecttree"
+	"github.com/anyproto/any-sync/util/crypto"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/globalsign/mgo/bson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/notifications/mock_notifications"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const (
+	spaceId  = "space1"
+	identity = "me"
+	author   = "alice"
+)
+
+type testAccountService struct{}
+
+// testKey is the identity of the change author with the account equal to the test identity
+type testKey struct {
+	crypto.PubKey
+	account string
+}
+
+func (k testKey) Account() string {
+	return k.account
+}
+
+// testTree iterates over the given changes of the object, the last change is the head
+type testTree struct {
+	objecttree.ObjectTree
+	id      string
+	changes []*objecttree.Change
+	// rootWalks counts iterations over the whole tree
+	rootWalks int
+}
+
+func (t *testTree) Id() string {
+	return t.id
+}
+
+func (t *testTree) Heads() []string {
+	return []string{t.changes[len(t.changes)-1].Id}
+}
+
+func (t *testTree) HasChanges(ids ...string) bool {
+	for _, id := range ids {
+		if !slices.ContainsFunc(t.changes, func(c *objecttree.Change) bool { return c.Id == id }) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *testTree) IterateRoot(convert objecttree.ChangeConvertFunc, iterate objecttree.ChangeIterateFunc) error {
+	t.rootWalks++
+	return t.IterateFrom(t.id, convert, iterate)
+}
+
+func (t *testTree) IterateFrom(id string, _ objecttree.ChangeConvertFunc, iterate objecttree.ChangeIterateFunc) error {
+	start := slices.IndexFunc(t.changes, func(c *objecttree.Change) bool { return c.Id == id })
+	for _, change := range t.changes[start:] {
+		if !iterate(change) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (testAccountService) AccountID() string {
+	return identity
+}
+
+type fixture struct {
+	*service
+	objectStore *objectstore.StoreFixture
+	objects     map[string]smartblock.SmartBlock
+	sent        []*model.Notification
+	read        []string
+	now         time.Time
+}
+
+func newFixture(t *testing.T) *fixture {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	fx := &fixture{
+		objectStore: objectstore.NewStoreFixture(t),
+		objects:     map[string]smartblock.SmartBlock{},
+		now:         time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC),
+	}
+	objectGetter := mock_cache.NewMockObjectGetter(t)
+	objectGetter.EXPECT().GetObject(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, id string) (smartblock.SmartBlock, error) {
+		return fx.objects[id], nil
+	}).Maybe()
+	notificationService := mock_notifications.NewMockNotifications(t)
+	notificationService.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(notification *model.Notification) error {
+		fx.sent = append(fx.sent, notification)
+		return nil
+	}).Maybe()
+	notificationService.EXPECT().Reply(mock.Anything, model.Notification_CLOSE).RunAndReturn(func(ids []string, _ model.NotificationActionType) error {
+		fx.read = append(fx.read, ids...)
+		return nil
+	}).Maybe()
+	fx.service = &service{
+		objectStore:         fx.objectStore,
+		objectGetter:        objectGetter,
+		accountService:      testAccountService{},
+		notificationService: notificationService,
+		store:               keyvaluestore.NewJson[*storedState](db, []byte("mention/")),
+		state: &storedState{
+			Objects:  map[string]int64{},
+			Mentions: map[string]*mention{},
+			Since:    fx.now.Unix(),
+			Heads:    map[string][]string{},
+		},
+		now: func() time.Time { return fx.now },
+	}
+	fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:   domain.String(domain.NewParticipantId(spaceId, author)),
+		bundle.RelationKeyName: domain.String("Alice"),
+	}})
+	return fx
+}
+
+func mentionMark(identity string) *model.BlockContentTextMark {
+	return &model.BlockContentTextMark{
+		Type:  model.BlockContentTextMark_Mention,
+		Param: domain.NewParticipantId(spaceId, identity),
+	}
+}
+
+func textChange(by string, timestamp time.Time, mentioned string) *objecttree.Change {
+	return &objecttree.Change{
+		Id:        bson.NewObjectId().Hex(),
+		Identity:  testKey{account: by},
+		Timestamp: timestamp.Unix(),
+		Model: &pb.Change{Content: []*pb.ChangeContent{{Value: &pb.ChangeContentValueOfBlockUpdate{
+			BlockUpdate: &pb.ChangeBlockUpdate{Events: []*pb.EventMessage{
+				event.NewMessage("", &pb.EventMessageValueOfBlockSetText{BlockSetText: &pb.EventBlockSetText{
+					Id:    "text1",
+					Text:  &pb.EventBlockSetTextText{Value: "ask @me"},
+					Marks: &pb.EventBlockSetTextMarks{Value: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mentionMark(mentioned)}}},
+				}}),
+			}},
+		}}}},
+	}
+}
+
+// editPage replaces the page with the text block mentioning the participant, the mention is added by the first change
+func (fx *fixture) editPage(t *testing.T, by string, modified time.Time, mentioned string, changes ...*objecttree.Change) *testTree {
+	if len(changes) == 0 {
+		changes = []*objecttree.Change{textChange(by, modified, mentioned)}
+	}
+	changes = append([]*objecttree.Change{{Id: "page1"}, {
+		Id:        "create",
+		Identity:  testKey{account: by},
+		Timestamp: modified.Unix(),
+		Model: &pb.Change{Content: []*pb.ChangeContent{{Value: &pb.ChangeContentValueOfBlockCreate{
+			BlockCreate: &pb.ChangeBlockCreate{Blocks: []*model.Block{{Id: "text1", Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}}}},
+		}}}},
+	}}, changes...)
+	tree := &testTree{id: "page1", changes: changes}
+	sb := smarttest.NewWithTree("page1", tree)
+	sb.AddBlock(simple.New(&model.Block{Id: "page1", ChildrenIds: []string{"text1"}}))
+	sb.AddBlock(simple.New(&model.Block{Id: "text1", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:  "ask @me",
+		Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mentionMark(mentioned)}},
+	}}}))
+	fx.objects["page1"] = sb
+	fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:               domain.String("page1"),
+		bundle.RelationKeyName:             domain.String("Plans"),
+		bundle.RelationKeyMentions:         domain.StringList([]string{domain.NewParticipantId(spaceId, mentioned)}),
+		bundle.RelationKeyLastModifiedDate: domain.Int64(modified.Unix()),
+		bundle.RelationKeyLastModifiedBy:   domain.String(domain.NewParticipantId(spaceId, by)),
+	}})
+	return tree
+}
+
+func (fx *fixture) chatMessage(id, creator string, createdAt time.Time, mentioned string) *model.ChatMessage {
+	return &model.ChatMessage{
+		Id:        id,
+		Creator:   creator,
+		CreatedAt: createdAt.Unix(),
+		Message: &model.ChatMessageMessageContent{
+			Text:  "hi @me",
+			Marks: []*model.BlockContentTextMark{mentionMark(mentioned)},
+		},
+	}
+}
+
+func TestService_Check(t *testing.T) {
+	t.Run("mention by other participant is notified once", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.editPage(t, author, fx.now.Add(time.Minute), identity)
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+		fx.editPage(t, author, fx.now.Add(2*time.Minute), identity)
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.sent, 1)
+		assert.True(t, fx.sent[0].IsLocal)
+		assert.Equal(t, &model.NotificationMention{
+			SpaceId:    spaceId,
+			SpaceName:  fx.objectStore.GetSpaceName(spaceId),
+			ObjectId:   "page1",
+			ObjectName: "Plans",
+			BlockId:    "text1",
+			AuthorId:   domain.NewParticipantId(spaceId, author),
+			AuthorName: "Alice",
+			Text:       "ask @me",
+		}, fx.sent[0].GetMention())
+	})
+	t.Run("mentions made before the first start, by myself or of others are not notified", func(t *testing.T) {
+		for _, tc := range []struct {
+			name      string
+			by        string
+			modified  time.Duration
+			mentioned string
+		}{
+			{"before the first start", author, -time.Minute, identity},
+			{"by myself", identity, time.Minute, identity},
+			{"of others", author, time.Minute, "bob"},
+		} {
+			// given
+			fx := newFixture(t)
+			fx.editPage(t, tc.by, fx.now.Add(tc.modified), tc.mentioned)
+
+			// when
+			require.NoError(t, fx.check(context.Background()))
+			fx.editPage(t, author, fx.now.Add(2*time.Minute), tc.mentioned)
+			require.NoError(t, fx.check(context.Background()))
+
+			// then
+			assert.Empty(t, fx.sent, tc.name)
+		}
+	})
+	t.Run("mention is credited to the author of the change adding it", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.editPage(t, identity, fx.now.Add(2*time.Minute), identity,
+			textChange(author, fx.now.Add(time.Minute), identity),
+			textChange(identity, fx.now.Add(2*time.Minute), identity),
+		)
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.sent, 1)
+		assert.Equal(t, domain.NewParticipantId(spaceId, author), fx.sent[0].GetMention().AuthorId)
+	})
+	t.Run("new mention is looked up only in changes after the last check", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		tree := fx.editPage(t, author, fx.now.Add(time.Minute), identity)
+		require.NoError(t, fx.check(context.Background()))
+		require.Len(t, fx.sent, 1)
+
+		// when
+		modified := fx.now.Add(2 * time.Minute)
+		mentionBlock := &model.Block{Id: "text2", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  "and @me",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mentionMark(identity)}},
+		}}}
+		tree.changes = append(tree.changes, &objecttree.Change{
+			Id:        "create2",
+			Identity:  testKey{account: "bob"},
+			Timestamp: modified.Unix(),
+			Model: &pb.Change{Content: []*pb.ChangeContent{{Value: &pb.ChangeContentValueOfBlockCreate{
+				BlockCreate: &pb.ChangeBlockCreate{Blocks: []*model.Block{mentionBlock}},
+			}}}},
+		})
+		doc := fx.objects["page1"].(*smarttest.SmartTest).Doc.(*state.State)
+		doc.Set(simple.New(&model.Block{Id: "page1", ChildrenIds: []string{"text1", "text2"}}))
+		doc.Add(simple.New(mentionBlock))
+		fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:               domain.String("page1"),
+			bundle.RelationKeyName:             domain.String("Plans"),
+			bundle.RelationKeyMentions:         domain.StringList([]string{domain.NewParticipantId(spaceId, identity)}),
+			bundle.RelationKeyLastModifiedDate: domain.Int64(modified.Unix()),
+		}})
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.sent, 2)
+		assert.Equal(t, "text2", fx.sent[1].GetMention().BlockId)
+		assert.Equal(t, domain.NewParticipantId(spaceId, "bob"), fx.sent[1].GetMention().AuthorId)
+		assert.Equal(t, 1, tree.rootWalks)
+	})
+	t.Run("notification is marked read after the object is opened", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.editPage(t, author, fx.now.Add(time.Minute), identity)
+		require.NoError(t, fx.check(context.Background()))
+		require.Len(t, fx.sent, 1)
+
+		// when
+		fx.now = fx.now.Add(time.Minute)
+		fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:               domain.String("page1"),
+			bundle.RelationKeyMentions:         domain.StringList([]string{domain.NewParticipantId(spaceId, identity)}),
+			bundle.RelationKeyLastModifiedDate: domain.Int64(fx.now.Unix()),
+			bundle.RelationKeyLastOpenedDate:   domain.Int64(fx.now.Unix()),
+		}})
+		require.NoError(t, fx.check(context.Background()))
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		assert.Equal(t, []string{fx.sent[0].Id}, fx.read)
+	})
+}
+
+func TestService_ChatMessageAdded(t *testing.T) {
+	t.Run("mention in the message is notified once", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		message := fx.chatMessage("message1", author, fx.now, identity)
+
+		// when
+		fx.ChatMessageAdded(spaceId, "chat1", message)
+		fx.ChatMessageAdded(spaceId, "chat1", message)
+
+		// then
+		require.Len(t, fx.sent, 1)
+		assert.Equal(t, &model.NotificationMention{
+			SpaceId:    spaceId,
+			SpaceName:  fx.objectStore.GetSpaceName(spaceId),
+			ObjectId:   "chat1",
+			MessageId:  "message1",
+			AuthorId:   domain.NewParticipantId(spaceId, author),
+			AuthorName: "Alice",
+			Text:       "hi @me",
+		}, fx.sent[0].GetMention())
+	})
+	t.Run("own, old and not mentioning messages are skipped", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+
+		// when
+		fx.ChatMessageAdded(spaceId, "chat1", fx.chatMessage("message1", identity, fx.now, identity))
+		fx.ChatMessageAdded(spaceId, "chat1", fx.chatMessage("message2", author, fx.now.Add(-time.Hour), identity))
+		fx.ChatMessageAdded(spaceId, "chat1", fx.chatMessage("message3", author, fx.now, "bob"))
+
+		// then
+		assert.Empty(t, fx.sent)
+	})
+	t.Run("read and expired chat mentions are forgotten", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.ChatMessageAdded(spaceId, "chat1", fx.chatMessage("message1", author, fx.now, identity))
+		fx.ChatMessageAdded(spaceId, "chat2", fx.chatMessage("message2", author, fx.now, identity))
+		fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:             domain.String("chat1"),
+			bundle.RelationKeyLastOpenedDate: domain.Int64(fx.now.Unix()),
+		}})
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		assert.Equal(t, []string{fx.sent[0].Id}, fx.read)
+		assert.NotContains(t, fx.state.Mentions, mentionKey("chat1", "message1"))
+		assert.Contains(t, fx.state.Mentions, mentionKey("chat2", "message2"))
+
+		// when
+		fx.now = fx.now.Add(chatMentionTTL + time.Minute)
+		require.NoError(t, fx.check(context.Background()))
+		fx.ChatMessageAdded(spaceId, "chat2", fx.chatMessage("message2", author, fx.now.Add(-chatMentionTTL-time.Minute), identity))
+
+		// then
+		assert.Empty(t, fx.state.Mentions)
+		assert.Len(t, fx.sent, 2)
+	})
+}
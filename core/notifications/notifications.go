@@ -39,6 +39,12 @@ type Notifications interface {
 	List(limit int64, includeRead bool) ([]*model.Notification, error)
 }
 
+// ReplyHandler is implemented by components which create notifications and react to replies to them
+type ReplyHandler interface {
+	// HandleReply is called after the status of the notification is updated
+	HandleReply(notification *model.Notification, notificationAction model.NotificationActionType) error
+}
+
 type notificationService struct {
 	notificationId     string
 	notificationCancel context.CancelFunc
@@ -49,6 +55,7 @@ type notificationService struct {
 	mu                 sync.Mutex
 	loadTimeout        time.Duration
 	loadFinish         chan struct{}
+	replyHandlers      []ReplyHandler
 
 	sync.RWMutex
 	lastNotificationIdToAcl map[string]string
@@ -72,6 +79,11 @@ func (n *notificationService) Init(a *app.App) (err error) {
 	n.eventSender = app.MustComponent[event.Sender](a)
 	n.spaceService = app.MustComponent[space.Service](a)
 	n.picker = app.MustComponent[cache.ObjectGetter](a)
+	a.IterateComponents(func(c app.Component) {
+		if handler, ok := c.(ReplyHandler); ok {
+			n.replyHandlers = append(n.replyHandlers, handler)
+		}
+	})
 	return nil
 }
 
@@ -212,6 +224,11 @@ func (n *notificationService) Reply(notificationIds []string, notificationAction
 				return fmt.Errorf("failed to update notification object: %w", err)
 			}
 		}
+		for _, handler := range n.replyHandlers {
+			if err = handler.HandleReply(notification, notificationAction); err != nil {
+				return fmt.Errorf("failed to handle reply: %w", err)
+			}
+		}
 	}
 	return nil
 }
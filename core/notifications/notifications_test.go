@@ -19,9 +19,6 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-// TODO: Right now we use only CLOSE action type in protocol, so tests should be improved when we have more types
-const notCloseActionType = 1
-
 func TestNotificationService_List(t *testing.T) {
 	t.Run("no notification in store - empty result", func(t *testing.T) {
 		// given
@@ -167,7 +164,7 @@ func TestNotificationService_Reply(t *testing.T) {
 		}
 
 		// when
-		err = notifications.Reply([]string{"id"}, notCloseActionType)
+		err = notifications.Reply([]string{"id"}, model.Notification_SNOOZE)
 		assert.Nil(t, err)
 		notification, err := storeFixture.GetNotificationById("id")
 		assert.Nil(t, err)
@@ -194,7 +191,7 @@ func TestNotificationService_Reply(t *testing.T) {
 		}
 
 		// when
-		err = notifications.Reply([]string{"id", "id1"}, notCloseActionType)
+		err = notifications.Reply([]string{"id", "id1"}, model.Notification_SNOOZE)
 		assert.Nil(t, err)
 
 		// then
@@ -206,6 +203,44 @@ func TestNotificationService_Reply(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, model.Notification_Replied, notification.Status)
 	})
+	t.Run("reply is passed to handlers", func(t *testing.T) {
+		// given
+		storeFixture := NewTestStore(t)
+		err := storeFixture.SaveNotification(&model.Notification{Id: "id", Status: model.Notification_Created, IsLocal: true})
+		assert.Nil(t, err)
+
+		sender := mock_event.NewMockSender(t)
+		sender.EXPECT().Broadcast(mock.Anything).Return().Times(1)
+		handler := &testReplyHandler{}
+
+		notifications := notificationService{
+			eventSender:       sender,
+			notificationStore: storeFixture,
+			loadTimeout:       10 * time.Millisecond,
+			replyHandlers:     []ReplyHandler{handler},
+		}
+
+		// when
+		err = notifications.Reply([]string{"id"}, model.Notification_SNOOZE)
+		assert.Nil(t, err)
+
+		// then
+		require.Len(t, handler.replies, 1)
+		assert.Equal(t, "id", handler.replies[0].Id)
+		assert.Equal(t, model.Notification_Replied, handler.replies[0].Status)
+		assert.Equal(t, []model.NotificationActionType{model.Notification_SNOOZE}, handler.actions)
+	})
+}
+
+type testReplyHandler struct {
+	replies []*model.Notification
+	actions []model.NotificationActionType
+}
+
+func (h *testReplyHandler) HandleReply(notification *model.Notification, notificationAction model.NotificationActionType) error {
+	h.replies = append(h.replies, notification)
+	h.actions = append(h.actions, notificationAction)
+	return nil
 }
 
 func TestNotificationService_CreateAndSend(t *testing.T) {
@@ -37,6 +37,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/inviteservice"
 	"github.com/anyproto/anytype-heart/core/inviteservice/mock_inviteservice"
 	"github.com/anyproto/anytype-heart/core/notifications/mock_notifications"
+	"github.com/anyproto/anytype-heart/core/subscription/mock_subscription"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
@@ -745,6 +746,7 @@ func prepareExporter(t *testing.T, objectTypeId string, spaceService *mock_space
 	a.Register(testutil.PrepareMock(context.Background(), a, mock_files.NewMockService(t)))
 	a.Register(testutil.PrepareMock(context.Background(), a, mock_account.NewMockService(t)))
 	a.Register(testutil.PrepareMock(context.Background(), a, mock_notifications.NewMockNotifications(t)))
+	a.Register(testutil.PrepareMock(context.Background(), a, mock_subscription.NewMockService(t)))
 
 	exp := export.New()
 	err = exp.Init(a)
@@ -856,6 +858,7 @@ func prepareExporterWithFile(t *testing.T, objectTypeId string, spaceService *mo
 	a.Register(testutil.PrepareMock(context.Background(), a, mock_typeprovider.NewMockSmartBlockTypeProvider(t)))
 	a.Register(testutil.PrepareMock(context.Background(), a, mock_account.NewMockService(t)))
 	a.Register(testutil.PrepareMock(context.Background(), a, mock_notifications.NewMockNotifications(t)))
+	a.Register(testutil.PrepareMock(context.Background(), a, mock_subscription.NewMockService(t)))
 	a.Register(testutil.PrepareMock(context.Background(), a, fileService))
 
 	exp := export.New()
@@ -0,0 +1,256 @@
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/anyproto/any-sync/util/periodicsync"
+
+	"github.com/anyproto/anytype-heart/core/block/detailservice"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const CName = "core.recurrence"
+
+const (
+	checkIntervalSecs = 60
+	// overdueAfter is the time after the due date when the occurrence is considered passed,
+	// so tasks due today can still be done today
+	overdueAfter = 24 * time.Hour
+)
+
+var log = logging.Logger("anytype-mw-recurrence")
+
+// copiedSystemRelations are the system relations copied to the next occurrence.
+// Other relations are copied unless they are system or bundled readonly ones
+var copiedSystemRelations = []domain.RelationKey{
+	bundle.RelationKeyName,
+	bundle.RelationKeyDescription,
+	bundle.RelationKeyIconEmoji,
+	bundle.RelationKeyIconImage,
+	bundle.RelationKeyTag,
+}
+
+// notCopiedRelations are set for the next occurrence separately
+var notCopiedRelations = []domain.RelationKey{
+	bundle.RelationKeyDueDate,
+	bundle.RelationKeyDone,
+	bundle.RelationKeyRecurrenceRule,
+	bundle.RelationKeyRecurrenceSeries,
+}
+
+// Service creates the next occurrence of objects with recurrenceRule when they are done or their due date passes.
+// The rule moves to the next occurrence, previous occurrences link to the first object of the series
+type Service interface {
+	app.ComponentRunnable
+}
+
+type service struct {
+	objectStore   objectstore.ObjectStore
+	objectCreator objectcreator.Service
+	detailService detailservice.Service
+	periodicSync  periodicsync.PeriodicSync
+
+	now func() time.Time
+}
+
+type occurrence struct {
+	spaceId  string
+	details  *domain.Details
+	seriesId string
+	rule     string
+	date     time.Time
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.objectCreator = app.MustComponent[objectcreator.Service](a)
+	s.detailService = app.MustComponent[detailservice.Service](a)
+	s.periodicSync = periodicsync.NewPeriodicSync(checkIntervalSecs, 0, s.check, logger.CtxLogger{Logger: log.Desugar()})
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) error {
+	s.periodicSync.Run()
+	return nil
+}
+
+func (s *service) Close(ctx context.Context) error {
+	if s.periodicSync != nil {
+		s.periodicSync.Close()
+	}
+	return nil
+}
+
+func (s *service) check(ctx context.Context) error {
+	return s.objectStore.IterateSpaceIndex(func(store spaceindex.Store) error {
+		records, err := store.Query(database.Query{
+			Filters: []database.FilterRequest{
+				{
+					RelationKey: bundle.RelationKeyRecurrenceRule,
+					Condition:   model.BlockContentDataviewFilter_NotEmpty,
+				},
+				{
+					RelationKey: bundle.RelationKeyDueDate,
+					Condition:   model.BlockContentDataviewFilter_NotEmpty,
+				},
+				{
+					RelationKey: bundle.RelationKeyTargetObjectType,
+					Condition:   model.BlockContentDataviewFilter_Empty,
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("query recurring objects in space %s: %w", store.SpaceId(), err)
+		}
+		now := s.now()
+		for _, record := range records {
+			id := record.Details.GetString(bundle.RelationKeyId)
+			due := time.Unix(record.Details.GetInt64(bundle.RelationKeyDueDate), 0).UTC()
+			if !record.Details.GetBool(bundle.RelationKeyDone) && now.Before(due.Add(overdueAfter)) {
+				continue
+			}
+			if err = s.createNext(ctx, store, record.Details, due, now); err != nil {
+				log.With("objectId", id).Errorf("failed to create next occurrence: %v", err)
+			}
+		}
+		return nil
+	})
+}
+
+// createNext creates the next occurrence unless the series is over, then removes the rule from the current one.
+// The rule is kept until the next occurrence is created, so the failed creation is retried on the next check.
+// The id of the next occurrence is derived from the series and the date, so retries and other devices don't create duplicates
+func (s *service) createNext(ctx context.Context, store spaceindex.Store, details *domain.Details, due, now time.Time) error {
+	id := details.GetString(bundle.RelationKeyId)
+	ruleValue := details.GetString(bundle.RelationKeyRecurrenceRule)
+	rule, err := ParseRule(ruleValue)
+	if err != nil {
+		return fmt.Errorf("parse rule: %w", err)
+	}
+	seriesId := details.GetString(bundle.RelationKeyRecurrenceSeries)
+	if seriesId == "" {
+		seriesId = id
+	}
+
+	after := due
+	if now.After(after) {
+		after = now
+	}
+	next, ok := rule.Next(due, after)
+	if ok && rule.Count > 0 {
+		count, err := s.countOccurrences(store, seriesId)
+		if err != nil {
+			return err
+		}
+		ok = count < rule.Count
+	}
+	if ok {
+		nextOccurrence := occurrence{spaceId: store.SpaceId(), details: details, seriesId: seriesId, rule: ruleValue, date: next}
+		if err = s.createOccurrence(ctx, nextOccurrence); err != nil {
+			return err
+		}
+	}
+	err = s.detailService.SetDetails(nil, id, []domain.Detail{
+		{Key: bundle.RelationKeyRecurrenceRule, Value: domain.Null()},
+		{Key: bundle.RelationKeyRecurrenceSeries, Value: domain.String(seriesId)},
+	})
+	if err != nil {
+		return fmt.Errorf("remove rule: %w", err)
+	}
+	return nil
+}
+
+// countOccurrences counts the objects of the series, the first object links to the series after it gets the next occurrence
+func (s *service) countOccurrences(store spaceindex.Store, seriesId string) (int, error) {
+	ids, _, err := store.QueryObjectIds(database.Query{
+		Filters: []database.FilterRequest{{
+			RelationKey: bundle.RelationKeyRecurrenceSeries,
+			Condition:   model.BlockContentDataviewFilter_Equal,
+			Value:       domain.String(seriesId),
+		}},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("query series: %w", err)
+	}
+	if len(ids) == 0 {
+		return 1, nil
+	}
+	return len(ids), nil
+}
+
+// createOccurrence creates the object of the same type using the default template of the type.
+// The occurrence already created by other device or by the previous attempt is skipped
+func (s *service) createOccurrence(ctx context.Context, next occurrence) error {
+	store := s.objectStore.SpaceIndex(next.spaceId)
+	objectType, err := store.GetDetails(next.details.GetString(bundle.RelationKeyType))
+	if err != nil {
+		return fmt.Errorf("get object type: %w", err)
+	}
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypePage, occurrenceKey(next.seriesId, next.date))
+	if err != nil {
+		return err
+	}
+	_, _, err = s.objectCreator.CreateObjectUsingObjectUniqueTypeKey(ctx, next.spaceId, objectType.GetString(bundle.RelationKeyUniqueKey), objectcreator.CreateObjectRequest{
+		Details:    nextDetails(next.details, next.seriesId, next.rule, next.date),
+		TemplateId: objectType.GetString(bundle.RelationKeyDefaultTemplateId),
+		UniqueKey:  uniqueKey,
+	})
+	if err != nil && !errors.Is(err, treestorage.ErrTreeExists) {
+		return fmt.Errorf("create object: %w", err)
+	}
+	return nil
+}
+
+func occurrenceKey(seriesId string, date time.Time) string {
+	return fmt.Sprintf("recurrence.%s.%d", seriesId, date.Unix())
+}
+
+func nextDetails(details *domain.Details, seriesId, rule string, date time.Time) *domain.Details {
+	next := domain.NewDetails()
+	for key, value := range details.Iterate() {
+		if isCopied(key) {
+			next.Set(key, value)
+		}
+	}
+	next.SetInt64(bundle.RelationKeyDueDate, date.Unix())
+	next.SetString(bundle.RelationKeyRecurrenceRule, rule)
+	next.SetString(bundle.RelationKeyRecurrenceSeries, seriesId)
+	return next
+}
+
+func isCopied(key domain.RelationKey) bool {
+	if slices.Contains(notCopiedRelations, key) {
+		return false
+	}
+	if slices.Contains(copiedSystemRelations, key) {
+		return true
+	}
+	if bundle.IsSystemRelation(key) {
+		return false
+	}
+	relation, err := bundle.GetRelation(key)
+	return err != nil || !relation.ReadOnly
+}
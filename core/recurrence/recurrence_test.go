@@ -0,0 +1,217 @@
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/detailservice/mock_detailservice"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator/mock_objectcreator"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const spaceId = "space1"
+
+type fixture struct {
+	*service
+	objectStore   *objectstore.StoreFixture
+	objectCreator *mock_objectcreator.MockService
+	detailService *mock_detailservice.MockService
+	created       []objectcreator.CreateObjectRequest
+	createdKeys   map[string]struct{}
+	updated       map[string][]domain.Detail
+	updateErr     error
+	createErr     error
+	now           time.Time
+}
+
+func newFixture(t *testing.T) *fixture {
+	fx := &fixture{
+		objectStore:   objectstore.NewStoreFixture(t),
+		objectCreator: mock_objectcreator.NewMockService(t),
+		detailService: mock_detailservice.NewMockService(t),
+		createdKeys:   map[string]struct{}{},
+		updated:       map[string][]domain.Detail{},
+		now:           time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC),
+	}
+	fx.objectCreator.EXPECT().CreateObjectUsingObjectUniqueTypeKey(mock.Anything, spaceId, "ot-task", mock.Anything).RunAndReturn(
+		func(_ context.Context, _ string, _ string, req objectcreator.CreateObjectRequest) (string, *domain.Details, error) {
+			if fx.createErr != nil {
+				return "", nil, fx.createErr
+			}
+			if _, ok := fx.createdKeys[req.UniqueKey.Marshal()]; ok {
+				return "", nil, fmt.Errorf("put tree: %w", treestorage.ErrTreeExists)
+			}
+			fx.createdKeys[req.UniqueKey.Marshal()] = struct{}{}
+			fx.created = append(fx.created, req)
+			return "next", req.Details, nil
+		}).Maybe()
+	fx.detailService.EXPECT().SetDetails(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(_ session.Context, objectId string, details []domain.Detail) error {
+			if fx.updateErr != nil {
+				return fx.updateErr
+			}
+			fx.updated[objectId] = details
+			return nil
+		}).Maybe()
+	fx.service = &service{
+		objectStore:   fx.objectStore,
+		objectCreator: fx.objectCreator,
+		detailService: fx.detailService,
+		now:           func() time.Time { return fx.now },
+	}
+	fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:                domain.String("task-type"),
+		bundle.RelationKeyUniqueKey:         domain.String("ot-task"),
+		bundle.RelationKeyLayout:            domain.Int64(int64(model.ObjectType_objectType)),
+		bundle.RelationKeyDefaultTemplateId: domain.String("template1"),
+	}})
+	return fx
+}
+
+func (fx *fixture) addTask(t *testing.T, id string, due time.Time, done bool, rule string) {
+	fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:             domain.String(id),
+		bundle.RelationKeyName:           domain.String("Weekly review"),
+		bundle.RelationKeyType:           domain.String("task-type"),
+		bundle.RelationKeyCreatedDate:    domain.Int64(due.Add(-time.Hour).Unix()),
+		bundle.RelationKeyDueDate:        domain.Int64(due.Unix()),
+		bundle.RelationKeyDone:           domain.Bool(done),
+		bundle.RelationKeyRecurrenceRule: domain.String(rule),
+		"effort":                         domain.Int64(3),
+	}})
+}
+
+func TestService_Check(t *testing.T) {
+	t.Run("done task gets the next occurrence", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		due := time.Date(2024, 1, 4, 9, 0, 0, 0, time.UTC)
+		fx.addTask(t, "task1", due, true, "FREQ=WEEKLY")
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.created, 1)
+		assert.Equal(t, "template1", fx.created[0].TemplateId)
+		assert.Equal(t, occurrenceKey("task1", due.AddDate(0, 0, 7)), fx.created[0].UniqueKey.InternalKey())
+		details := fx.created[0].Details
+		assert.Equal(t, "Weekly review", details.GetString(bundle.RelationKeyName))
+		assert.Equal(t, int64(3), details.GetInt64("effort"))
+		assert.Equal(t, due.AddDate(0, 0, 7).Unix(), details.GetInt64(bundle.RelationKeyDueDate))
+		assert.Equal(t, "FREQ=WEEKLY", details.GetString(bundle.RelationKeyRecurrenceRule))
+		assert.Equal(t, "task1", details.GetString(bundle.RelationKeyRecurrenceSeries))
+		assert.False(t, details.Has(bundle.RelationKeyDone))
+		assert.False(t, details.Has(bundle.RelationKeyCreatedDate))
+		assert.Equal(t, []domain.Detail{
+			{Key: bundle.RelationKeyRecurrenceRule, Value: domain.Null()},
+			{Key: bundle.RelationKeyRecurrenceSeries, Value: domain.String("task1")},
+		}, fx.updated["task1"])
+	})
+	t.Run("rule is kept until the next occurrence is created", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.addTask(t, "task1", time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), true, "FREQ=DAILY")
+		fx.createErr = errors.New("space is not available")
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		assert.Empty(t, fx.created)
+		assert.Empty(t, fx.updated)
+
+		// when
+		fx.createErr = nil
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.created, 1)
+		assert.Contains(t, fx.updated, "task1")
+	})
+	t.Run("next occurrence is not duplicated when the rule can't be removed", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.addTask(t, "task1", time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), true, "FREQ=DAILY")
+		fx.updateErr = errors.New("object is not available")
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+		fx.updateErr = nil
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.created, 1)
+		assert.Contains(t, fx.updated, "task1")
+	})
+	t.Run("task which is not done waits until its date passes", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.addTask(t, "task1", time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC), false, "FREQ=DAILY")
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+		require.Empty(t, fx.created)
+		fx.now = fx.now.Add(24 * time.Hour)
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.created, 1)
+		assert.Equal(t, time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC).Unix(), fx.created[0].Details.GetInt64(bundle.RelationKeyDueDate))
+	})
+	t.Run("date-only value keeps its day in zones west of UTC", func(t *testing.T) {
+		// given
+		location, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		local := time.Local
+		time.Local = location
+		t.Cleanup(func() { time.Local = local })
+		fx := newFixture(t)
+		// Thursday, stored as UTC midnight
+		fx.addTask(t, "task1", time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), true, "FREQ=WEEKLY;BYDAY=TH")
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		require.Len(t, fx.created, 1)
+		assert.Equal(t, time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC).Unix(), fx.created[0].Details.GetInt64(bundle.RelationKeyDueDate))
+	})
+	t.Run("series ends after count occurrences", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:               domain.String("task1"),
+			bundle.RelationKeyRecurrenceSeries: domain.String("task1"),
+		}})
+		fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:               domain.String("task2"),
+			bundle.RelationKeyType:             domain.String("task-type"),
+			bundle.RelationKeyDueDate:          domain.Int64(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC).Unix()),
+			bundle.RelationKeyDone:             domain.Bool(true),
+			bundle.RelationKeyRecurrenceRule:   domain.String("FREQ=DAILY;COUNT=2"),
+			bundle.RelationKeyRecurrenceSeries: domain.String("task1"),
+		}})
+
+		// when
+		require.NoError(t, fx.check(context.Background()))
+
+		// then
+		assert.Empty(t, fx.created)
+		assert.Equal(t, domain.Null(), fx.updated["task2"][0].Value)
+	})
+}
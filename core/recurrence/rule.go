@@ -0,0 +1,181 @@
+package recurrence
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// maxSteps limits the search of the next occurrence, so rules which never match don't hang the service
+const maxSteps = 10000
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is the subset of RFC 5545 recurrence rules: FREQ, INTERVAL, COUNT, UNTIL and BYDAY for weekly rules
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []time.Weekday
+	// Count limits the number of occurrences in the series, 0 means no limit
+	Count int
+	// Until is the last possible date of the occurrence, zero means no limit
+	Until time.Time
+}
+
+// ParseRule parses rules like FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH, the RRULE: prefix is optional
+func ParseRule(value string) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	rule := &Rule{Interval: 1, Freq: -1}
+	for _, part := range strings.Split(value, ";") {
+		if part == "" {
+			continue
+		}
+		name, partValue, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			rule.Freq, err = parseFrequency(partValue)
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(partValue)
+		case "COUNT":
+			rule.Count, err = parsePositive(partValue)
+		case "UNTIL":
+			rule.Until, err = parseUntil(partValue)
+		case "BYDAY":
+			rule.ByDay, err = parseWeekdays(partValue)
+		case "WKST":
+			if strings.ToUpper(partValue) != "MO" {
+				err = fmt.Errorf("only weeks starting on monday are supported")
+			}
+		default:
+			err = fmt.Errorf("unsupported rule part %s", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if rule.Freq < 0 {
+		return nil, fmt.Errorf("rule has no frequency")
+	}
+	if len(rule.ByDay) > 0 && rule.Freq != Weekly {
+		return nil, fmt.Errorf("BYDAY is supported only for weekly rules")
+	}
+	return rule, nil
+}
+
+func parseFrequency(value string) (Frequency, error) {
+	switch strings.ToUpper(value) {
+	case "DAILY":
+		return Daily, nil
+	case "WEEKLY":
+		return Weekly, nil
+	case "MONTHLY":
+		return Monthly, nil
+	case "YEARLY":
+		return Yearly, nil
+	}
+	return 0, fmt.Errorf("unsupported frequency %s", value)
+}
+
+func parsePositive(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid number %s", value)
+	}
+	return n, nil
+}
+
+// parseUntil reads floating dates in UTC, as dates are stored
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102T150405", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid until date %s", value)
+	}
+	// the whole day is included
+	return t.AddDate(0, 0, 1).Add(-time.Second), nil
+}
+
+func parseWeekdays(value string) ([]time.Weekday, error) {
+	var result []time.Weekday
+	for _, day := range strings.Split(value, ",") {
+		weekday, ok := weekdays[strings.ToUpper(day)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported weekday %s", day)
+		}
+		result = append(result, weekday)
+	}
+	return result, nil
+}
+
+// Next returns the first occurrence after the given time, counting intervals from the current occurrence.
+// Occurrences keep the time of day of the current one. Days are counted in UTC, as date-only values are stored at UTC midnight.
+// False is returned when there is no such occurrence before Until
+func (r *Rule) Next(current, after time.Time) (time.Time, bool) {
+	current = current.UTC()
+	for step := 1; step <= maxSteps; step++ {
+		candidate, ok := r.occurrence(current, step)
+		if !r.Until.IsZero() && candidate.After(r.Until) {
+			return time.Time{}, false
+		}
+		if ok && candidate.After(after) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// occurrence returns the candidate of the step, false is returned for candidates which don't match the rule
+func (r *Rule) occurrence(current time.Time, step int) (time.Time, bool) {
+	switch r.Freq {
+	case Daily:
+		return current.AddDate(0, 0, step*r.Interval), true
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return current.AddDate(0, 0, 7*step*r.Interval), true
+		}
+		// candidates are days, only the days of every interval week are taken
+		candidate := current.AddDate(0, 0, step)
+		weeks := int(weekStart(candidate).Sub(weekStart(current)).Hours()+12) / (24 * 7)
+		return candidate, weeks%r.Interval == 0 && slices.Contains(r.ByDay, candidate.Weekday())
+	case Monthly:
+		// months without the day of the current occurrence are skipped, as RFC 5545 requires
+		candidate := current.AddDate(0, step*r.Interval, 0)
+		return candidate, candidate.Day() == current.Day()
+	default:
+		candidate := current.AddDate(step*r.Interval, 0, 0)
+		return candidate, candidate.Day() == current.Day()
+	}
+}
+
+func weekStart(t time.Time) time.Time {
+	daysFromMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysFromMonday, 0, 0, 0, 0, t.Location())
+}
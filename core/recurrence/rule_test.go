@@ -0,0 +1,85 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRule(t *testing.T) {
+	t.Run("full rule", func(t *testing.T) {
+		// when
+		rule, err := ParseRule("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,th;COUNT=5;UNTIL=20240131T090000Z")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &Rule{
+			Freq:     Weekly,
+			Interval: 2,
+			ByDay:    []time.Weekday{time.Monday, time.Thursday},
+			Count:    5,
+			Until:    time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+		}, rule)
+	})
+	t.Run("invalid rules", func(t *testing.T) {
+		for _, value := range []string{
+			"",
+			"INTERVAL=2",
+			"FREQ=HOURLY",
+			"FREQ=DAILY;INTERVAL=0",
+			"FREQ=MONTHLY;BYDAY=MO",
+			"FREQ=WEEKLY;BYDAY=XX",
+			"FREQ=WEEKLY;BYSETPOS=1",
+			"FREQ=DAILY;UNTIL=tomorrow",
+		} {
+			_, err := ParseRule(value)
+			assert.Error(t, err, value)
+		}
+	})
+}
+
+func TestRule_Next(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+	}
+	// 2024-01-01 is monday
+	for _, tc := range []struct {
+		rule     string
+		current  time.Time
+		after    time.Time
+		expected time.Time
+	}{
+		{"FREQ=DAILY", date(2024, 1, 1), date(2024, 1, 1), date(2024, 1, 2)},
+		{"FREQ=DAILY;INTERVAL=3", date(2024, 1, 1), date(2024, 1, 5), date(2024, 1, 7)},
+		{"FREQ=WEEKLY", date(2024, 1, 1), date(2024, 1, 1), date(2024, 1, 8)},
+		{"FREQ=WEEKLY;BYDAY=MO,TH", date(2024, 1, 1), date(2024, 1, 1), date(2024, 1, 4)},
+		{"FREQ=WEEKLY;BYDAY=MO,TH", date(2024, 1, 4), date(2024, 1, 4), date(2024, 1, 8)},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", date(2024, 1, 4), date(2024, 1, 4), date(2024, 1, 15)},
+		{"FREQ=MONTHLY", date(2024, 1, 31), date(2024, 1, 31), date(2024, 3, 31)},
+		{"FREQ=YEARLY", date(2024, 2, 29), date(2024, 2, 29), date(2028, 2, 29)},
+	} {
+		// given
+		rule, err := ParseRule(tc.rule)
+		require.NoError(t, err)
+
+		// when
+		next, ok := rule.Next(tc.current, tc.after)
+
+		// then
+		assert.True(t, ok, tc.rule)
+		assert.Equal(t, tc.expected, next, tc.rule)
+	}
+	t.Run("no occurrences after until", func(t *testing.T) {
+		// given
+		rule, err := ParseRule("FREQ=DAILY;UNTIL=20240102")
+		require.NoError(t, err)
+
+		// when
+		_, ok := rule.Next(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC))
+
+		// then
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,252 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/app/logger"
+	"github.com/anyproto/any-sync/util/periodicsync"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const CName = "core.reminder"
+
+const (
+	checkIntervalSecs = 30
+	snoozeDuration    = 10 * time.Minute
+	stateKey          = "state"
+)
+
+var log = logging.Logger("anytype-mw-reminder")
+
+// Service sends notifications when values of date relations flagged with relationRemind come.
+// Reminders are kept in the local storage, so the ones which came while the app was closed are sent after the start
+type Service interface {
+	app.ComponentRunnable
+	notifications.ReplyHandler
+}
+
+type reminder struct {
+	SpaceId     string `json:"spaceId"`
+	ObjectId    string `json:"objectId"`
+	RelationKey string `json:"relationKey"`
+	// Date is the value of the relation
+	Date int64 `json:"date"`
+	// RemindAt is the date or the end of the snooze
+	RemindAt       int64  `json:"remindAt"`
+	Sent           bool   `json:"sent"`
+	NotificationId string `json:"notificationId,omitempty"`
+}
+
+type storedState struct {
+	Reminders map[string]*reminder `json:"reminders"`
+	// LastCheck is the time of the last check, dates before it are not reminded about when they are set
+	LastCheck int64 `json:"lastCheck"`
+}
+
+type service struct {
+	objectStore         objectstore.ObjectStore
+	notificationService notifications.Notifications
+	store               keyvaluestore.Store[*storedState]
+	periodicSync        periodicsync.PeriodicSync
+
+	mu    sync.Mutex
+	state *storedState
+	now   func() time.Time
+}
+
+func New() Service {
+	return &service{now: time.Now}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.notificationService = app.MustComponent[notifications.Notifications](a)
+	db, err := app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get badger: %w", err)
+	}
+	s.store = keyvaluestore.NewJson[*storedState](db, []byte("reminder/"))
+	s.periodicSync = periodicsync.NewPeriodicSync(checkIntervalSecs, 0, s.check, logger.CtxLogger{Logger: log.Desugar()})
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(ctx context.Context) error {
+	state, err := s.store.Get(stateKey)
+	if err != nil && !errors.Is(err, keyvaluestore.ErrNotFound) {
+		return fmt.Errorf("load reminders: %w", err)
+	}
+	if state == nil {
+		state = &storedState{LastCheck: s.now().Unix()}
+	}
+	if state.Reminders == nil {
+		state.Reminders = map[string]*reminder{}
+	}
+	s.mu.Lock()
+	s.state = state
+	s.mu.Unlock()
+	s.periodicSync.Run()
+	return nil
+}
+
+func (s *service) Close(ctx context.Context) error {
+	if s.periodicSync != nil {
+		s.periodicSync.Close()
+	}
+	return nil
+}
+
+func reminderKey(spaceId, objectId, relationKey string) string {
+	return spaceId + "/" + objectId + "/" + relationKey
+}
+
+// check updates reminders from the current values of flagged relations and sends the due ones
+func (s *service) check(ctx context.Context) error {
+	var due []*model.Notification
+	err := s.objectStore.IterateSpaceIndex(func(store spaceindex.Store) error {
+		spaceDue, err := s.checkSpace(store)
+		if err != nil {
+			return fmt.Errorf("check space %s: %w", store.SpaceId(), err)
+		}
+		due = append(due, spaceDue...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, notification := range due {
+		if err = s.notificationService.CreateAndSend(notification); err != nil {
+			log.Errorf("failed to send reminder: %v", err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.LastCheck = s.now().Unix()
+	return s.store.Set(stateKey, s.state)
+}
+
+func (s *service) checkSpace(store spaceindex.Store) ([]*model.Notification, error) {
+	relations, err := store.Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(int64(model.ObjectType_relation)),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationFormat,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(int64(model.RelationFormat_date)),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationRemind,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query relations: %w", err)
+	}
+
+	spaceId := store.SpaceId()
+	now := s.now().Unix()
+	found := map[string]bool{}
+	var due []*model.Notification
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, relation := range relations {
+		relationKey := relation.Details.GetString(bundle.RelationKeyRelationKey)
+		records, err := store.Query(database.Query{
+			Filters: []database.FilterRequest{{
+				RelationKey: domain.RelationKey(relationKey),
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("query objects with %s: %w", relationKey, err)
+		}
+		for _, record := range records {
+			objectId := record.Details.GetString(bundle.RelationKeyId)
+			date := record.Details.GetInt64(domain.RelationKey(relationKey))
+			key := reminderKey(spaceId, objectId, relationKey)
+			found[key] = true
+			r := s.state.Reminders[key]
+			if r == nil || r.Date != date {
+				r = &reminder{
+					SpaceId:     spaceId,
+					ObjectId:    objectId,
+					RelationKey: relationKey,
+					Date:        date,
+					RemindAt:    date,
+					Sent:        date <= s.state.LastCheck,
+				}
+				s.state.Reminders[key] = r
+			}
+			if r.Sent || r.RemindAt > now {
+				continue
+			}
+			r.Sent = true
+			r.NotificationId = uuid.New().String()
+			due = append(due, &model.Notification{
+				Id:      r.NotificationId,
+				Status:  model.Notification_Created,
+				IsLocal: true,
+				Space:   spaceId,
+				Payload: &model.NotificationPayloadOfReminder{Reminder: &model.NotificationReminder{
+					SpaceId:      spaceId,
+					SpaceName:    s.objectStore.GetSpaceName(spaceId),
+					ObjectId:     objectId,
+					ObjectName:   record.Details.GetString(bundle.RelationKeyName),
+					RelationKey:  relationKey,
+					RelationName: relation.Details.GetString(bundle.RelationKeyName),
+					Date:         date,
+				}},
+			})
+		}
+	}
+	for key, r := range s.state.Reminders {
+		if r.SpaceId == spaceId && !found[key] {
+			delete(s.state.Reminders, key)
+		}
+	}
+	return due, nil
+}
+
+// HandleReply snoozes the reminder, closing the notification leaves the reminder sent
+func (s *service) HandleReply(notification *model.Notification, notificationAction model.NotificationActionType) error {
+	payload := notification.GetReminder()
+	if payload == nil || notificationAction != model.Notification_SNOOZE {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.state.Reminders[reminderKey(payload.SpaceId, payload.ObjectId, payload.RelationKey)]
+	if r == nil || r.NotificationId != notification.Id {
+		return nil
+	}
+	r.RemindAt = s.now().Add(snoozeDuration).Unix()
+	r.Sent = false
+	return s.store.Set(stateKey, s.state)
+}
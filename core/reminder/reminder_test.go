@@ -0,0 +1,189 @@
+package reminder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications/mock_notifications"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/keyvaluestore"
+)
+
+const (
+	spaceId     = "space1"
+	relationKey = "dueDate"
+)
+
+type fixture struct {
+	*service
+	objectStore *objectstore.StoreFixture
+	db          *badger.DB
+	sent        []*model.Notification
+	now         time.Time
+}
+
+func newFixture(t *testing.T) *fixture {
+	db, err := badger.Open(badger.DefaultOptions("").WithInMemory(true).WithLoggingLevel(badger.ERROR))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	fx := &fixture{
+		objectStore: objectstore.NewStoreFixture(t),
+		db:          db,
+		now:         time.Date(2024, 5, 10, 9, 0, 0, 0, time.UTC),
+	}
+	fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:             domain.String("rel-dueDate"),
+		bundle.RelationKeyName:           domain.String("Due date"),
+		bundle.RelationKeyLayout:         domain.Int64(int64(model.ObjectType_relation)),
+		bundle.RelationKeyRelationKey:    domain.String(relationKey),
+		bundle.RelationKeyRelationFormat: domain.Int64(int64(model.RelationFormat_date)),
+		bundle.RelationKeyRelationRemind: domain.Bool(true),
+	}})
+	fx.start(t)
+	return fx
+}
+
+// start creates the service over the same storage, like the app does after the restart
+func (fx *fixture) start(t *testing.T) {
+	notificationService := mock_notifications.NewMockNotifications(t)
+	notificationService.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(notification *model.Notification) error {
+		fx.sent = append(fx.sent, notification)
+		return nil
+	}).Maybe()
+	fx.service = &service{
+		objectStore:         fx.objectStore,
+		notificationService: notificationService,
+		store:               keyvaluestore.NewJson[*storedState](fx.db, []byte("reminder/")),
+		now:                 func() time.Time { return fx.now },
+	}
+	state, err := fx.store.Get(stateKey)
+	if err != nil {
+		require.ErrorIs(t, err, keyvaluestore.ErrNotFound)
+		state = &storedState{LastCheck: fx.now.Unix(), Reminders: map[string]*reminder{}}
+	}
+	fx.state = state
+}
+
+func (fx *fixture) setDueDate(t *testing.T, objectId string, date time.Time) {
+	fx.objectStore.AddObjects(t, spaceId, []objectstore.TestObject{{
+		bundle.RelationKeyId:            domain.String(objectId),
+		bundle.RelationKeyName:          domain.String("Task " + objectId),
+		domain.RelationKey(relationKey): domain.Int64(date.Unix()),
+	}})
+}
+
+func (fx *fixture) advance(t *testing.T, d time.Duration) {
+	fx.now = fx.now.Add(d)
+	require.NoError(t, fx.check(context.Background()))
+}
+
+func TestService_Check(t *testing.T) {
+	t.Run("reminder is sent once when the date comes", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.setDueDate(t, "task1", fx.now.Add(time.Hour))
+
+		// when
+		fx.advance(t, 30*time.Minute)
+		require.Empty(t, fx.sent)
+		fx.advance(t, 30*time.Minute)
+		fx.advance(t, 30*time.Minute)
+
+		// then
+		require.Len(t, fx.sent, 1)
+		assert.True(t, fx.sent[0].IsLocal)
+		assert.Equal(t, &model.NotificationReminder{
+			SpaceId:      spaceId,
+			SpaceName:    fx.objectStore.GetSpaceName(spaceId),
+			ObjectId:     "task1",
+			ObjectName:   "Task task1",
+			RelationKey:  relationKey,
+			RelationName: "Due date",
+			Date:         fx.now.Add(-30 * time.Minute).Unix(),
+		}, fx.sent[0].GetReminder())
+	})
+	t.Run("dates in the past are not reminded about", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.setDueDate(t, "task1", fx.now.Add(-time.Hour))
+
+		// when
+		fx.advance(t, time.Minute)
+
+		// then
+		assert.Empty(t, fx.sent)
+	})
+	t.Run("changed date is reminded about again", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.setDueDate(t, "task1", fx.now.Add(time.Minute))
+		fx.advance(t, time.Minute)
+
+		// when
+		fx.setDueDate(t, "task1", fx.now.Add(time.Hour))
+		fx.advance(t, time.Hour)
+
+		// then
+		assert.Len(t, fx.sent, 2)
+	})
+	t.Run("reminder which came while the app was closed is sent after the start", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.setDueDate(t, "task1", fx.now.Add(time.Hour))
+		fx.advance(t, time.Minute)
+
+		// when
+		fx.now = fx.now.Add(24 * time.Hour)
+		fx.start(t)
+		fx.advance(t, time.Minute)
+
+		// then
+		require.Len(t, fx.sent, 1)
+		assert.Equal(t, "task1", fx.sent[0].GetReminder().ObjectId)
+	})
+}
+
+func TestService_HandleReply(t *testing.T) {
+	t.Run("snoozed reminder is sent again", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.setDueDate(t, "task1", fx.now.Add(time.Minute))
+		fx.advance(t, time.Minute)
+		require.Len(t, fx.sent, 1)
+
+		// when
+		require.NoError(t, fx.HandleReply(fx.sent[0], model.Notification_SNOOZE))
+		fx.advance(t, snoozeDuration/2)
+		require.Len(t, fx.sent, 1)
+		fx.advance(t, snoozeDuration/2)
+
+		// then
+		require.Len(t, fx.sent, 2)
+		assert.NotEqual(t, fx.sent[0].Id, fx.sent[1].Id)
+		assert.Equal(t, "task1", fx.sent[1].GetReminder().ObjectId)
+	})
+	t.Run("closed reminder is not sent again", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		fx.setDueDate(t, "task1", fx.now.Add(time.Minute))
+		fx.advance(t, time.Minute)
+		require.Len(t, fx.sent, 1)
+
+		// when
+		require.NoError(t, fx.HandleReply(fx.sent[0], model.Notification_CLOSE))
+		fx.advance(t, time.Hour)
+
+		// then
+		assert.Len(t, fx.sent, 1)
+	})
+}
@@ -27,7 +27,13 @@ var (
 	currentChallengesRequests   = atomic.NewInt32(0)
 )
 
-func (s *service) StartNewChallenge(scope model.AccountAuthLocalApiScope, info *pb.EventAccountLinkChallengeClientInfo) (challengeId string, challengeValue string, err error) {
+// AppPermissions narrows down what the app linked via the challenge is allowed to do within its scope
+type AppPermissions struct {
+	ReadOnly bool
+	SpaceIds []string // empty means all spaces
+}
+
+func (s *service) StartNewChallenge(scope model.AccountAuthLocalApiScope, info *pb.EventAccountLinkChallengeClientInfo, permissions AppPermissions) (challengeId string, challengeValue string, err error) {
 	if currentChallengesRequests.Load() >= maxChallengesRequests {
 		// todo: add limits per process?
 		return "", "", ErrTooManyChallengeRequests
@@ -46,35 +52,36 @@ func (s *service) StartNewChallenge(scope model.AccountAuthLocalApiScope, info *
 	value := fmt.Sprintf("%0*d", challengeDigits, rand.Intn(int(math.Pow10(challengeDigits))))
 
 	s.challenges[id] = challenge{
-		tries:      0,
-		value:      value,
-		clientInfo: info,
-		scope:      scope,
+		tries:       0,
+		value:       value,
+		clientInfo:  info,
+		scope:       scope,
+		permissions: permissions,
 	}
 
 	currentChallengesRequests.Inc()
 	return id, value, nil
 }
 
-func (s *service) SolveChallenge(challengeId string, challengeSolution string, signingKey []byte) (clientInfo *pb.EventAccountLinkChallengeClientInfo, token string, scope model.AccountAuthLocalApiScope, err error) {
+func (s *service) SolveChallenge(challengeId string, challengeSolution string, signingKey []byte) (clientInfo *pb.EventAccountLinkChallengeClientInfo, token string, scope model.AccountAuthLocalApiScope, permissions AppPermissions, err error) {
 	s.lock.Lock()
 	challenge, ok := s.challenges[challengeId]
 	if !ok {
 		s.lock.Unlock()
 
-		return nil, "", 0, ErrChallengeIdNotFound
+		return nil, "", 0, AppPermissions{}, ErrChallengeIdNotFound
 	}
 	if challenge.tries >= challengeMaxTries {
 		s.lock.Unlock()
 
-		return clientInfo, "", 0, ErrChallengeTriesExceeded
+		return clientInfo, "", 0, AppPermissions{}, ErrChallengeTriesExceeded
 	}
 
 	if challenge.value != challengeSolution {
 		s.lock.Unlock()
 
 		challenge.tries++
-		return clientInfo, "", 0, ErrChallengeSolutionWrong
+		return clientInfo, "", 0, AppPermissions{}, ErrChallengeSolutionWrong
 	}
 
 	delete(s.challenges, challengeId)
@@ -82,14 +89,15 @@ func (s *service) SolveChallenge(challengeId string, challengeSolution string, s
 
 	sessionToken, err := s.StartSession(signingKey, scope)
 	if err != nil {
-		return nil, "", 0, err
+		return nil, "", 0, AppPermissions{}, err
 	}
-	return challenge.clientInfo, sessionToken, challenge.scope, nil
+	return challenge.clientInfo, sessionToken, challenge.scope, challenge.permissions, nil
 }
 
 type challenge struct {
-	tries      int
-	value      string
-	clientInfo *pb.EventAccountLinkChallengeClientInfo
-	scope      model.AccountAuthLocalApiScope
+	tries       int
+	value       string
+	clientInfo  *pb.EventAccountLinkChallengeClientInfo
+	scope       model.AccountAuthLocalApiScope
+	permissions AppPermissions
 }
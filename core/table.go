@@ -314,3 +314,22 @@ func (mw *Middleware) BlockTableRowSetHeader(cctx context.Context, req *pb.RpcBl
 	}
 	return response(pb.RpcBlockTableRowSetHeaderResponseError_NULL, id, nil)
 }
+
+func (mw *Middleware) BlockTableToCsv(cctx context.Context, req *pb.RpcBlockTableToCsvRequest) *pb.RpcBlockTableToCsvResponse {
+	response := func(code pb.RpcBlockTableToCsvResponseErrorCode, id string, err error) *pb.RpcBlockTableToCsvResponse {
+		m := &pb.RpcBlockTableToCsvResponse{Error: &pb.RpcBlockTableToCsvResponseError{Code: code}, ObjectId: id}
+		if err != nil {
+			m.Error.Description = getErrorDescription(err)
+		}
+		return m
+	}
+	var id string
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		id, err = bs.TableToCsv(cctx, *req)
+		return
+	})
+	if err != nil {
+		return response(pb.RpcBlockTableToCsvResponseError_UNKNOWN_ERROR, "", err)
+	}
+	return response(pb.RpcBlockTableToCsvResponseError_NULL, id, nil)
+}
@@ -52,7 +52,7 @@ func (mw *Middleware) WalletConvert(cctx context.Context, req *pb.RpcWalletConve
 }
 
 func (mw *Middleware) WalletCreateSession(cctx context.Context, req *pb.RpcWalletCreateSessionRequest) *pb.RpcWalletCreateSessionResponse {
-	token, accountId, err := mw.applicationService.CreateSession(req)
+	token, accountId, appInfo, err := mw.applicationService.CreateSession(req)
 	code := mapErrorCode(err,
 		errToCode(application.ErrBadInput, pb.RpcWalletCreateSessionResponseError_BAD_INPUT),
 		errToCode(wallet.ErrAppLinkNotFound, pb.RpcWalletCreateSessionResponseError_APP_TOKEN_NOT_FOUND_IN_THE_CURRENT_ACCOUNT),
@@ -61,6 +61,7 @@ func (mw *Middleware) WalletCreateSession(cctx context.Context, req *pb.RpcWalle
 	return &pb.RpcWalletCreateSessionResponse{
 		Token:     token,
 		AccountId: accountId,
+		AppInfo:   convertAppLinkInfo(appInfo),
 		Error: &pb.RpcWalletCreateSessionResponseError{
 			Code:        code,
 			Description: getErrorDescription(err),
@@ -5,11 +5,13 @@ import (
 	"crypto/ed25519"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	json "encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/anyproto/any-sync/util/crypto"
 
@@ -22,17 +24,31 @@ const appLinkKeysDirectory = "auth"
 var ErrAppLinkNotFound = fmt.Errorf("app link file not found in the account directory")
 
 type AppLinkPayload struct {
-	AccountPrivateKey []byte `json:"key"` // stored only for the main client app
-	AppName           string `json:"app_name"`
-	AppPath           string `json:"app_path"`   // for now, it is not verified
-	CreatedAt         int64  `json:"created_at"` // unix timestamp
-	ExpireAt          int64  `json:"expire_at"`  // unix timestamp
-	Scope             int    `json:"scope"`
+	AccountPrivateKey []byte   `json:"key"` // stored only for the main client app
+	AppName           string   `json:"app_name"`
+	AppPath           string   `json:"app_path"`   // for now, it is not verified
+	CreatedAt         int64    `json:"created_at"` // unix timestamp
+	ExpireAt          int64    `json:"expire_at"`  // unix timestamp
+	Scope             int      `json:"scope"`
+	ReadOnly          bool     `json:"read_only"`
+	SpaceIds          []string `json:"space_ids"` // empty means all spaces
+}
+
+// AppLinkInfo is the public part of the app link. It is stored unencrypted so the linked apps can be listed without their keys
+type AppLinkInfo struct {
+	AppHash   string   `json:"-"`
+	AppName   string   `json:"app_name"`
+	CreatedAt int64    `json:"created_at"`
+	ExpireAt  int64    `json:"expire_at"`
+	Scope     int      `json:"scope"`
+	ReadOnly  bool     `json:"read_only"`
+	SpaceIds  []string `json:"space_ids"`
 }
 
 type appLinkFileEncrypted struct {
-	Payload   []byte `json:"payload"`
-	Signature []byte `json:"signature"` // account signature to check the integrity of the payload
+	Payload   []byte       `json:"payload"`
+	Signature []byte       `json:"signature"`      // account signature to check the integrity of the payload
+	Info      *AppLinkInfo `json:"info,omitempty"` // for listing only, access checks must rely on the payload
 }
 
 func writeAppLinkFile(accountPk crypto.PrivKey, dir string, payload *AppLinkPayload) (appKey string, err error) {
@@ -65,13 +81,21 @@ func writeAppLinkFile(accountPk crypto.PrivKey, dir string, payload *AppLinkPayl
 	encryptedPayload := appLinkFileEncrypted{
 		Payload:   encPayload,
 		Signature: signature,
+		Info: &AppLinkInfo{
+			AppName:   payload.AppName,
+			CreatedAt: payload.CreatedAt,
+			ExpireAt:  payload.ExpireAt,
+			Scope:     payload.Scope,
+			ReadOnly:  payload.ReadOnly,
+			SpaceIds:  payload.SpaceIds,
+		},
 	}
 
-	hash := sha256.Sum256(key.Bytes())
-	f, err := os.Create(filepath.Join(dir, fmt.Sprintf("%x", hash)+".json"))
+	f, err := os.Create(filepath.Join(dir, appLinkHash(key.Bytes())+".json"))
 	if err != nil {
 		return "", fmt.Errorf("failed to create app key file in the account: %w", err)
 	}
+	defer f.Close()
 	// todo: change perms?
 
 	w := json.NewEncoder(f)
@@ -88,8 +112,7 @@ func readAppLinkFile(pubKey crypto.PubKey, dir string, appKey string) (*AppLinkP
 	if err != nil {
 		return nil, err
 	}
-	hash := sha256.Sum256(symKeyBytes)
-	f, err := os.Open(filepath.Join(dir, fmt.Sprintf("%x", hash)+".json"))
+	f, err := os.Open(filepath.Join(dir, appLinkHash(symKeyBytes)+".json"))
 	if err != nil {
 		// in case it is not found, we return a special error
 		if os.IsNotExist(err) {
@@ -132,6 +155,75 @@ func readAppLinkFile(pubKey crypto.PubKey, dir string, appKey string) (*AppLinkP
 	return &payload, nil
 }
 
+func listAppLinkFiles(pubKey crypto.PubKey, dir string) ([]*AppLinkInfo, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	infos := make([]*AppLinkInfo, 0, len(entries))
+	for _, entry := range entries {
+		appHash, ok := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !ok || !isAppLinkHash(appHash) {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		appKeyFile := appLinkFileEncrypted{}
+		if err = json.Unmarshal(b, &appKeyFile); err != nil {
+			continue
+		}
+		if err = signatureVerify(pubKey, &appKeyFile); err != nil {
+			continue
+		}
+		info := appKeyFile.Info
+		if info == nil {
+			// app links created before the info was introduced can only be identified by the hash
+			info = &AppLinkInfo{}
+		}
+		info.AppHash = appHash
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
+func removeAppLinkFile(dir string, appHash string) error {
+	if !isAppLinkHash(appHash) {
+		return ErrAppLinkNotFound
+	}
+	err := os.Remove(filepath.Join(dir, appHash+".json"))
+	if os.IsNotExist(err) {
+		return ErrAppLinkNotFound
+	}
+	return err
+}
+
+// AppLinkHash returns the identifier of the app link that can be shared without revealing the app key
+func AppLinkHash(appKey string) (string, error) {
+	symKeyBytes, err := base64.StdEncoding.DecodeString(appKey)
+	if err != nil {
+		return "", err
+	}
+	return appLinkHash(symKeyBytes), nil
+}
+
+func appLinkHash(symKeyBytes []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(symKeyBytes))
+}
+
+func isAppLinkHash(appHash string) bool {
+	if len(appHash) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(appHash)
+	return err == nil
+}
+
 func signatureVerify(key crypto.PubKey, encrypted *appLinkFileEncrypted) error {
 	v, err := key.Verify(encrypted.Payload, encrypted.Signature)
 	if err != nil {
@@ -160,6 +252,17 @@ func (r *wallet) PersistAppLink(payload *AppLinkPayload) (appKey string, err err
 	return writeAppLinkFile(r.accountKey, filepath.Join(r.repoPath, appLinkKeysDirectory), payload)
 }
 
+func (r *wallet) ListAppLinks() ([]*AppLinkInfo, error) {
+	if r.accountKey == nil {
+		return nil, fmt.Errorf("account is not set")
+	}
+	return listAppLinkFiles(r.accountKey.GetPublic(), filepath.Join(r.repoPath, appLinkKeysDirectory))
+}
+
+func (r *wallet) RevokeAppLink(appHash string) error {
+	return removeAppLinkFile(filepath.Join(r.repoPath, appLinkKeysDirectory), appHash)
+}
+
 func DeriveForAccount(seed []byte) (res crypto.DerivationResult, err error) {
 	res.Identity = crypto.NewEd25519PrivKey(ed25519.NewKeyFromSeed(seed))
 	return
@@ -44,6 +44,43 @@ func Test_writeAppLinkFileAndRead(t *testing.T) {
 	require.Equal(t, payload, gotPayload)
 }
 
+func Test_listAndRemoveAppLinkFiles(t *testing.T) {
+	priv, pub, err := crypto.GenerateRandomEd25519KeyPair()
+	require.NoError(t, err)
+
+	tempDir := t.TempDir()
+	payload := &AppLinkPayload{
+		AppName:   "test",
+		AppPath:   "path",
+		CreatedAt: time.Now().Unix(),
+		Scope:     1,
+		ReadOnly:  true,
+		SpaceIds:  []string{"space1"},
+	}
+	gotAppKey, err := writeAppLinkFile(priv, tempDir, payload)
+	require.NoError(t, err)
+	appHash, err := AppLinkHash(gotAppKey)
+	require.NoError(t, err)
+
+	infos, err := listAppLinkFiles(pub, tempDir)
+	require.NoError(t, err)
+	require.Equal(t, []*AppLinkInfo{{
+		AppHash:   appHash,
+		AppName:   payload.AppName,
+		CreatedAt: payload.CreatedAt,
+		Scope:     payload.Scope,
+		ReadOnly:  true,
+		SpaceIds:  []string{"space1"},
+	}}, infos)
+
+	require.ErrorIs(t, removeAppLinkFile(tempDir, "../"+appHash), ErrAppLinkNotFound)
+	require.NoError(t, removeAppLinkFile(tempDir, appHash))
+	require.ErrorIs(t, removeAppLinkFile(tempDir, appHash), ErrAppLinkNotFound)
+
+	_, err = readAppLinkFile(pub, tempDir, gotAppKey)
+	require.ErrorIs(t, err, ErrAppLinkNotFound)
+}
+
 func Test_signatureVerify(t *testing.T) {
 	priv, pub, err := crypto.GenerateRandomEd25519KeyPair()
 	require.NoError(t, err)
@@ -401,6 +401,63 @@ func (_c *MockWallet_Init_Call) RunAndReturn(run func(*app.App) error) *MockWall
 	return _c
 }
 
+// ListAppLinks provides a mock function with given fields:
+func (_m *MockWallet) ListAppLinks() ([]*wallet.AppLinkInfo, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAppLinks")
+	}
+
+	var r0 []*wallet.AppLinkInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*wallet.AppLinkInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*wallet.AppLinkInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*wallet.AppLinkInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWallet_ListAppLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAppLinks'
+type MockWallet_ListAppLinks_Call struct {
+	*mock.Call
+}
+
+// ListAppLinks is a helper method to define mock.On call
+func (_e *MockWallet_Expecter) ListAppLinks() *MockWallet_ListAppLinks_Call {
+	return &MockWallet_ListAppLinks_Call{Call: _e.mock.On("ListAppLinks")}
+}
+
+func (_c *MockWallet_ListAppLinks_Call) Run(run func()) *MockWallet_ListAppLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWallet_ListAppLinks_Call) Return(_a0 []*wallet.AppLinkInfo, _a1 error) *MockWallet_ListAppLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWallet_ListAppLinks_Call) RunAndReturn(run func() ([]*wallet.AppLinkInfo, error)) *MockWallet_ListAppLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *MockWallet) Name() string {
 	ret := _m.Called()
@@ -605,6 +662,52 @@ func (_c *MockWallet_RepoPath_Call) RunAndReturn(run func() string) *MockWallet_
 	return _c
 }
 
+// RevokeAppLink provides a mock function with given fields: appHash
+func (_m *MockWallet) RevokeAppLink(appHash string) error {
+	ret := _m.Called(appHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAppLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(appHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWallet_RevokeAppLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAppLink'
+type MockWallet_RevokeAppLink_Call struct {
+	*mock.Call
+}
+
+// RevokeAppLink is a helper method to define mock.On call
+//   - appHash string
+func (_e *MockWallet_Expecter) RevokeAppLink(appHash interface{}) *MockWallet_RevokeAppLink_Call {
+	return &MockWallet_RevokeAppLink_Call{Call: _e.mock.On("RevokeAppLink", appHash)}
+}
+
+func (_c *MockWallet_RevokeAppLink_Call) Run(run func(appHash string)) *MockWallet_RevokeAppLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockWallet_RevokeAppLink_Call) Return(_a0 error) *MockWallet_RevokeAppLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWallet_RevokeAppLink_Call) RunAndReturn(run func(string) error) *MockWallet_RevokeAppLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootPath provides a mock function with given fields:
 func (_m *MockWallet) RootPath() string {
 	ret := _m.Called()
@@ -170,6 +170,8 @@ type Wallet interface {
 
 	ReadAppLink(appKey string) (*AppLinkPayload, error)
 	PersistAppLink(payload *AppLinkPayload) (appKey string, err error)
+	ListAppLinks() ([]*AppLinkInfo, error)
+	RevokeAppLink(appHash string) error
 
 	accountservice.Service
 	app.Component
@@ -1897,6 +1897,9 @@ type EventAccountLinkChallenge struct {
 	Challenge  string                               `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
 	ClientInfo *EventAccountLinkChallengeClientInfo `protobuf:"bytes,2,opt,name=clientInfo,proto3" json:"clientInfo,omitempty"`
 	Scope      model.AccountAuthLocalApiScope       `protobuf:"varint,3,opt,name=scope,proto3,enum=anytype.model.AccountAuthLocalApiScope" json:"scope,omitempty"`
+	// permissions requested by the app, shown to the user before approving the challenge
+	ReadOnly bool     `protobuf:"varint,4,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	SpaceIds []string `protobuf:"bytes,5,rep,name=spaceIds,proto3" json:"spaceIds,omitempty"`
 }
 
 func (m *EventAccountLinkChallenge) Reset()         { *m = EventAccountLinkChallenge{} }
@@ -1953,6 +1956,20 @@ func (m *EventAccountLinkChallenge) GetScope() model.AccountAuthLocalApiScope {
 	return model.AccountAuth_Limited
 }
 
+func (m *EventAccountLinkChallenge) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
+func (m *EventAccountLinkChallenge) GetSpaceIds() []string {
+	if m != nil {
+		return m.SpaceIds
+	}
+	return nil
+}
+
 type EventAccountLinkChallengeClientInfo struct {
 	ProcessName       string `protobuf:"bytes,1,opt,name=processName,proto3" json:"processName,omitempty"`
 	ProcessPath       string `protobuf:"bytes,2,opt,name=processPath,proto3" json:"processPath,omitempty"`
@@ -12334,395 +12351,395 @@ func init() {
 func init() { proto.RegisterFile("pb/protos/events.proto", fileDescriptor_a966342d378ae5f5) }
 
 var fileDescriptor_a966342d378ae5f5 = []byte{
-	// 6206 bytes of a gzipped FileDescriptorProto
+	// 6207 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4b, 0x8c, 0x1c, 0xc7,
-	0x79, 0xff, 0xbc, 0x1f, 0xdf, 0x92, 0xcb, 0x61, 0x91, 0xa2, 0x5a, 0x2d, 0x8a, 0xa2, 0x56, 0x24,
-	0x45, 0x4b, 0xd4, 0x50, 0x22, 0x29, 0x52, 0xa6, 0xc5, 0xc7, 0xbe, 0xa8, 0x19, 0x3e, 0x96, 0xeb,
-	0x5a, 0x92, 0x96, 0x65, 0xe3, 0x0f, 0xf7, 0x4e, 0xd7, 0xce, 0xb6, 0x39, 0xdb, 0x3d, 0xee, 0xee,
-	0x5d, 0x72, 0x6d, 0xff, 0x1d, 0xc7, 0x76, 0x10, 0x04, 0x48, 0x90, 0x1c, 0x82, 0x24, 0xf0, 0x25,
-	0x40, 0x10, 0x03, 0x39, 0x04, 0x41, 0x80, 0x5c, 0x92, 0x8b, 0x63, 0x20, 0x08, 0x12, 0xe7, 0x01,
-	0xd8, 0xb7, 0x5c, 0x02, 0x1b, 0xf2, 0x25, 0x87, 0xe4, 0xe0, 0x04, 0x08, 0x72, 0x0c, 0xea, 0xd1,
-	0xd5, 0x55, 0xfd, 0x98, 0x9e, 0xb1, 0xe4, 0x3c, 0x10, 0x9d, 0x76, 0xaa, 0xfa, 0xfb, 0x7e, 0x5f,
-	0x3d, 0xbe, 0xef, 0xab, 0xaa, 0xaf, 0x1e, 0x0b, 0xc7, 0xc6, 0x9b, 0xe7, 0xc7, 0xbe, 0x17, 0x7a,
-	0xc1, 0x79, 0xb2, 0x47, 0xdc, 0x30, 0xe8, 0xb2, 0x14, 0x6a, 0x5a, 0xee, 0x7e, 0xb8, 0x3f, 0x26,
-	0xe6, 0xa9, 0xf1, 0xe3, 0xe1, 0xf9, 0x91, 0xb3, 0x79, 0x7e, 0xbc, 0x79, 0x7e, 0xc7, 0xb3, 0xc9,
-	0x28, 0x22, 0x67, 0x09, 0x41, 0x6e, 0x1e, 0x1f, 0x7a, 0xde, 0x70, 0x44, 0xf8, 0xb7, 0xcd, 0xdd,
-	0xad, 0xf3, 0x41, 0xe8, 0xef, 0x0e, 0x42, 0xfe, 0x75, 0xe1, 0xdb, 0xdf, 0x2b, 0x43, 0x7d, 0x95,
-	0xc2, 0xa3, 0x0b, 0xd0, 0xda, 0x21, 0x41, 0x60, 0x0d, 0x49, 0x60, 0x94, 0x4f, 0x56, 0xcf, 0xce,
-	0x5d, 0x38, 0xd6, 0x15, 0xa2, 0xba, 0x8c, 0xa2, 0x7b, 0x8f, 0x7f, 0xc6, 0x92, 0x0e, 0x1d, 0x87,
-	0xf6, 0xc0, 0x73, 0x43, 0xf2, 0x34, 0xec, 0xdb, 0x46, 0xe5, 0x64, 0xf9, 0x6c, 0x1b, 0xc7, 0x19,
-	0xe8, 0x12, 0xb4, 0x1d, 0xd7, 0x09, 0x1d, 0x2b, 0xf4, 0x7c, 0xa3, 0x7a, 0xb2, 0xac, 0x41, 0xb2,
-	0x42, 0x76, 0x17, 0x07, 0x03, 0x6f, 0xd7, 0x0d, 0x71, 0x4c, 0x88, 0x0c, 0x68, 0x86, 0xbe, 0x35,
-	0x20, 0x7d, 0xdb, 0xa8, 0x31, 0xc4, 0x28, 0x69, 0x7e, 0xff, 0x4d, 0x68, 0x8a, 0x32, 0xa0, 0xe7,
-	0xa0, 0x19, 0x8c, 0x39, 0xd5, 0xb7, 0xca, 0x9c, 0x4c, 0xa4, 0xd1, 0x0d, 0x98, 0xb3, 0x38, 0xec,
-	0xc6, 0xb6, 0xf7, 0xc4, 0x28, 0x33, 0xc1, 0xcf, 0x27, 0xea, 0x22, 0x04, 0x77, 0x29, 0x49, 0xaf,
-	0x84, 0x55, 0x0e, 0xd4, 0x87, 0x79, 0x91, 0x5c, 0x21, 0xa1, 0xe5, 0x8c, 0x02, 0xe3, 0xfb, 0x1c,
-	0xe4, 0x44, 0x0e, 0x88, 0x20, 0xeb, 0x95, 0x70, 0x82, 0x11, 0x7d, 0x16, 0x8e, 0x88, 0x9c, 0x65,
-	0xcf, 0xdd, 0x72, 0x86, 0x0f, 0xc7, 0xb6, 0x15, 0x12, 0xe3, 0x6f, 0x38, 0xde, 0xa9, 0x1c, 0x3c,
-	0x4e, 0xdb, 0xe5, 0xc4, 0xbd, 0x12, 0xce, 0xc2, 0x40, 0xb7, 0xe0, 0xa0, 0xc8, 0x16, 0xa0, 0x7f,
-	0xcb, 0x41, 0x5f, 0xc8, 0x01, 0x95, 0x68, 0x3a, 0x1b, 0xfa, 0x1c, 0x1c, 0x15, 0x19, 0x77, 0x1d,
-	0xf7, 0xf1, 0xf2, 0xb6, 0x35, 0x1a, 0x11, 0x77, 0x48, 0x8c, 0xbf, 0x9b, 0x5c, 0x46, 0x8d, 0xb8,
-	0x57, 0xc2, 0x99, 0x20, 0x68, 0x08, 0x46, 0x56, 0x7e, 0xcf, 0xb1, 0x89, 0xf1, 0xf7, 0x5c, 0xc0,
-	0xd9, 0xa9, 0x04, 0x38, 0x36, 0x15, 0x92, 0x0b, 0x86, 0xee, 0x43, 0xc7, 0xdb, 0xfc, 0x22, 0x19,
-	0x44, 0x2d, 0xbf, 0x41, 0x42, 0xa3, 0xc3, 0xf0, 0x5f, 0x4a, 0xe0, 0xdf, 0x67, 0x64, 0x51, 0x9f,
-	0x75, 0x37, 0x48, 0xd8, 0x2b, 0xe1, 0x14, 0x33, 0x7a, 0x08, 0x48, 0xcb, 0x5b, 0xdc, 0x21, 0xae,
-	0x6d, 0x5c, 0x60, 0x90, 0x2f, 0x4f, 0x86, 0x64, 0xa4, 0xbd, 0x12, 0xce, 0x00, 0x48, 0xc1, 0x3e,
-	0x74, 0x03, 0x12, 0x1a, 0x17, 0xa7, 0x81, 0x65, 0xa4, 0x29, 0x58, 0x96, 0x4b, 0x3b, 0x91, 0xe7,
-	0x62, 0x32, 0xb2, 0x42, 0xc7, 0x73, 0x45, 0x79, 0x2f, 0x31, 0xe0, 0xd3, 0xd9, 0xc0, 0x92, 0x56,
-	0x96, 0x38, 0x13, 0x04, 0xfd, 0x3f, 0x78, 0x26, 0x91, 0x8f, 0xc9, 0x8e, 0xb7, 0x47, 0x8c, 0xb7,
-	0x18, 0xfa, 0x99, 0x22, 0x74, 0x4e, 0xdd, 0x2b, 0xe1, 0x6c, 0x18, 0xb4, 0x04, 0x07, 0xa2, 0x0f,
-	0x0c, 0xf6, 0x32, 0x83, 0x3d, 0x9e, 0x07, 0x2b, 0xc0, 0x34, 0x1e, 0x6a, 0xf4, 0x3c, 0xbd, 0x3c,
-	0xf2, 0x02, 0x62, 0x2c, 0x66, 0x1a, 0xbd, 0x80, 0x60, 0x24, 0xd4, 0xe8, 0x15, 0x0e, 0xb5, 0x92,
-	0x41, 0xe8, 0x3b, 0x03, 0x56, 0x40, 0xaa, 0x45, 0x57, 0x26, 0x57, 0x32, 0x26, 0x16, 0xaa, 0x94,
-	0x0d, 0x83, 0x30, 0x1c, 0x0a, 0x76, 0x37, 0x83, 0x81, 0xef, 0x8c, 0x69, 0xde, 0xa2, 0x6d, 0x1b,
-	0xef, 0x4c, 0x42, 0xde, 0x50, 0x88, 0xbb, 0x8b, 0x36, 0xed, 0x9d, 0x24, 0x00, 0xfa, 0x1c, 0x20,
-	0x35, 0x4b, 0x34, 0xdf, 0x35, 0x06, 0xfb, 0x89, 0x29, 0x60, 0x65, 0x5b, 0x66, 0xc0, 0x20, 0x0b,
-	0x8e, 0xaa, 0xb9, 0xeb, 0x5e, 0xe0, 0xd0, 0xbf, 0xc6, 0x75, 0x06, 0xff, 0xda, 0x14, 0xf0, 0x11,
-	0x0b, 0x55, 0xac, 0x2c, 0xa8, 0xa4, 0x88, 0x65, 0x6a, 0xda, 0xc4, 0x0f, 0x8c, 0x1b, 0x53, 0x8b,
-	0x88, 0x58, 0x92, 0x22, 0xa2, 0xfc, 0x64, 0x13, 0xbd, 0xeb, 0x7b, 0xbb, 0xe3, 0xc0, 0xb8, 0x39,
-	0x75, 0x13, 0x71, 0x86, 0x64, 0x13, 0xf1, 0x5c, 0x74, 0x19, 0x5a, 0x9b, 0x23, 0x6f, 0xf0, 0x98,
-	0x76, 0x66, 0x85, 0x41, 0x1a, 0x09, 0xc8, 0x25, 0xfa, 0x59, 0x74, 0x9f, 0xa4, 0xa5, 0xca, 0xca,
-	0x7e, 0xaf, 0x90, 0x11, 0x09, 0x89, 0x18, 0x1a, 0x9f, 0xcf, 0x64, 0xe5, 0x24, 0x54, 0x59, 0x15,
-	0x0e, 0xb4, 0x02, 0x73, 0x5b, 0xce, 0x88, 0x04, 0x0f, 0xc7, 0x23, 0xcf, 0xe2, 0xe3, 0xe4, 0xdc,
-	0x85, 0x93, 0x99, 0x00, 0xb7, 0x62, 0x3a, 0x8a, 0xa2, 0xb0, 0xa1, 0xeb, 0xd0, 0xde, 0xb1, 0xfc,
-	0xc7, 0x41, 0xdf, 0xdd, 0xf2, 0x8c, 0x7a, 0xe6, 0x08, 0xc7, 0x31, 0xee, 0x45, 0x54, 0xbd, 0x12,
-	0x8e, 0x59, 0xe8, 0x38, 0xc9, 0x0a, 0xb5, 0x41, 0xc2, 0x5b, 0x0e, 0x19, 0xd9, 0x81, 0xd1, 0x60,
-	0x20, 0x2f, 0x66, 0x82, 0x6c, 0x90, 0xb0, 0xcb, 0xc9, 0xe8, 0x38, 0xa9, 0x33, 0xa2, 0xf7, 0xe0,
-	0x48, 0x94, 0xb3, 0xbc, 0xed, 0x8c, 0x6c, 0x9f, 0xb8, 0x7d, 0x3b, 0x30, 0x9a, 0x99, 0x43, 0x50,
-	0x8c, 0xa7, 0xd0, 0xd2, 0x61, 0x32, 0x03, 0x82, 0x7a, 0xc6, 0x28, 0x5b, 0x35, 0x49, 0xa3, 0x95,
-	0xe9, 0x19, 0x63, 0x68, 0x95, 0x98, 0x6a, 0x57, 0x16, 0x08, 0xb2, 0xe1, 0xd9, 0x28, 0x7f, 0xc9,
-	0x1a, 0x3c, 0x1e, 0xfa, 0xde, 0xae, 0x6b, 0x2f, 0x7b, 0x23, 0xcf, 0x37, 0xda, 0x99, 0x83, 0x5b,
-	0x8c, 0x9f, 0xa0, 0xef, 0x95, 0x70, 0x1e, 0x14, 0x5a, 0x86, 0x03, 0xd1, 0xa7, 0x07, 0xe4, 0x69,
-	0x68, 0x40, 0xe6, 0x38, 0x1f, 0x43, 0x53, 0x22, 0xea, 0x20, 0x55, 0x26, 0x15, 0x84, 0xaa, 0x84,
-	0x31, 0x57, 0x00, 0x42, 0x89, 0x54, 0x10, 0x9a, 0x56, 0x41, 0xe8, 0x10, 0x6c, 0x1c, 0x2c, 0x00,
-	0xa1, 0x44, 0x2a, 0x08, 0x4d, 0xd3, 0xa1, 0x5a, 0xd6, 0xd4, 0xf3, 0x1e, 0x53, 0x7d, 0x32, 0xe6,
-	0x33, 0x87, 0x6a, 0xa5, 0xb5, 0x04, 0x21, 0x1d, 0xaa, 0x93, 0xcc, 0x74, 0x26, 0x14, 0xe5, 0x2d,
-	0x8e, 0x9c, 0xa1, 0x6b, 0x1c, 0x9a, 0xa0, 0xcb, 0x14, 0x8d, 0x51, 0xd1, 0x99, 0x90, 0xc6, 0x86,
-	0x6e, 0x0a, 0xb3, 0xdc, 0x20, 0xe1, 0x8a, 0xb3, 0x67, 0x1c, 0xce, 0x1c, 0x86, 0x62, 0x94, 0x15,
-	0x67, 0x4f, 0xda, 0x25, 0x67, 0x51, 0xab, 0x16, 0x0d, 0x72, 0xc6, 0x33, 0x05, 0x55, 0x8b, 0x08,
-	0xd5, 0xaa, 0x45, 0x79, 0x6a, 0xd5, 0xee, 0x5a, 0x21, 0x79, 0x6a, 0x3c, 0x57, 0x50, 0x35, 0x46,
-	0xa5, 0x56, 0x8d, 0x65, 0xd0, 0xd1, 0x2d, 0xca, 0x78, 0x44, 0xfc, 0xd0, 0x19, 0x58, 0x23, 0xde,
-	0x54, 0xa7, 0x32, 0xc7, 0xa0, 0x18, 0x4f, 0xa3, 0xa6, 0xa3, 0x5b, 0x26, 0x8c, 0x5a, 0xf1, 0x07,
-	0xd6, 0xe6, 0x88, 0x60, 0xef, 0x89, 0x71, 0xba, 0xa0, 0xe2, 0x11, 0xa1, 0x5a, 0xf1, 0x28, 0x4f,
-	0xf5, 0x2d, 0x9f, 0x71, 0xec, 0x21, 0x09, 0x8d, 0xb3, 0x05, 0xbe, 0x85, 0x93, 0xa9, 0xbe, 0x85,
-	0xe7, 0x48, 0x0f, 0xb0, 0x62, 0x85, 0xd6, 0x9e, 0x43, 0x9e, 0x3c, 0x72, 0xc8, 0x13, 0x3a, 0xb0,
-	0x1f, 0x99, 0xe0, 0x01, 0x22, 0xda, 0xae, 0x20, 0x96, 0x1e, 0x20, 0x01, 0x22, 0x3d, 0x80, 0x9a,
-	0x2f, 0xdc, 0xfa, 0xd1, 0x09, 0x1e, 0x40, 0xc3, 0x97, 0x3e, 0x3e, 0x0f, 0x0a, 0x59, 0x70, 0x2c,
-	0xf5, 0xe9, 0xbe, 0x6f, 0x13, 0xdf, 0x78, 0x81, 0x09, 0x79, 0xa5, 0x58, 0x08, 0x23, 0xef, 0x95,
-	0x70, 0x0e, 0x50, 0x4a, 0xc4, 0x86, 0xb7, 0xeb, 0x0f, 0x08, 0x6d, 0xa7, 0x97, 0xa7, 0x11, 0x21,
-	0xc9, 0x53, 0x22, 0xe4, 0x17, 0xb4, 0x07, 0x2f, 0xc8, 0x2f, 0x54, 0x30, 0x1b, 0x45, 0x99, 0x74,
-	0xb1, 0x82, 0x39, 0xc3, 0x24, 0x75, 0x27, 0x4b, 0x4a, 0x72, 0xf5, 0x4a, 0x78, 0x32, 0x2c, 0xda,
-	0x87, 0x13, 0x1a, 0x01, 0x1f, 0xe7, 0x55, 0xc1, 0xaf, 0x30, 0xc1, 0xe7, 0x27, 0x0b, 0x4e, 0xb1,
-	0xf5, 0x4a, 0xb8, 0x00, 0x18, 0x8d, 0xe1, 0x79, 0xad, 0x31, 0x22, 0xc3, 0x16, 0x2a, 0xf2, 0x55,
-	0x26, 0xf7, 0xdc, 0x64, 0xb9, 0x3a, 0x4f, 0xaf, 0x84, 0x27, 0x41, 0xd2, 0x15, 0x57, 0xe6, 0x67,
-	0xda, 0x93, 0x5f, 0xc9, 0x9c, 0xf6, 0xe4, 0x88, 0xe3, 0x7d, 0x99, 0x0b, 0x96, 0xa9, 0xf9, 0xa2,
-	0x39, 0xff, 0xff, 0xb4, 0x9a, 0x2f, 0xdb, 0x31, 0x0f, 0x4a, 0xeb, 0x3b, 0xfa, 0xe9, 0x81, 0xe5,
-	0x0f, 0x49, 0xc8, 0x1b, 0xba, 0x6f, 0xd3, 0x4a, 0x7d, 0x6d, 0x9a, 0xbe, 0x4b, 0xb1, 0x69, 0x7d,
-	0x97, 0x09, 0x8c, 0x02, 0x38, 0xae, 0x51, 0xf4, 0x83, 0x65, 0x6f, 0x34, 0x22, 0x83, 0xa8, 0x35,
-	0x7f, 0x81, 0x09, 0x7e, 0x7d, 0xb2, 0xe0, 0x04, 0x53, 0xaf, 0x84, 0x27, 0x82, 0xa6, 0xea, 0x7b,
-	0x7f, 0x64, 0x27, 0x74, 0xc6, 0x98, 0x4a, 0x57, 0x93, 0x6c, 0xa9, 0xfa, 0xa6, 0x28, 0x52, 0xba,
-	0xaa, 0x50, 0xd0, 0xea, 0x3e, 0x3b, 0x8d, 0xae, 0xea, 0x3c, 0x29, 0x5d, 0xd5, 0x3f, 0xd3, 0xd1,
-	0x6d, 0x37, 0x20, 0x3e, 0xc3, 0xb8, 0xed, 0x39, 0xae, 0xf1, 0x62, 0xe6, 0xe8, 0xf6, 0x30, 0x20,
-	0xbe, 0x10, 0x44, 0xa9, 0xe8, 0xe8, 0xa6, 0xb1, 0x69, 0x38, 0x77, 0xc9, 0x56, 0x68, 0x9c, 0x2c,
-	0xc2, 0xa1, 0x54, 0x1a, 0x0e, 0xcd, 0xa0, 0x23, 0x85, 0xcc, 0xd8, 0x20, 0xb4, 0x57, 0xb0, 0xe5,
-	0x0e, 0x89, 0xf1, 0x52, 0xe6, 0x48, 0xa1, 0xc0, 0x29, 0xc4, 0x74, 0xa4, 0xc8, 0x02, 0xa1, 0x2b,
-	0x7f, 0x99, 0x4f, 0x67, 0x64, 0x1c, 0x7a, 0x21, 0x73, 0xe5, 0xaf, 0x40, 0x4b, 0x52, 0xba, 0x06,
-	0x49, 0x03, 0xa0, 0x4f, 0x40, 0x6d, 0xec, 0xb8, 0x43, 0xc3, 0x66, 0x40, 0x47, 0x12, 0x40, 0xeb,
-	0x8e, 0x3b, 0xec, 0x95, 0x30, 0x23, 0x41, 0xef, 0x00, 0x8c, 0x7d, 0x6f, 0x40, 0x82, 0x60, 0x8d,
-	0x3c, 0x31, 0x08, 0x63, 0x30, 0x93, 0x0c, 0x9c, 0xa0, 0xbb, 0x46, 0xe8, 0xb8, 0xac, 0xd0, 0xa3,
-	0x55, 0x38, 0x28, 0x52, 0xc2, 0xca, 0xb7, 0x32, 0x27, 0x7f, 0x11, 0x40, 0x1c, 0x6e, 0xd2, 0xb8,
-	0xe8, 0xda, 0x47, 0x64, 0xac, 0x78, 0x2e, 0x31, 0x86, 0x99, 0x6b, 0x9f, 0x08, 0x84, 0x92, 0xd0,
-	0x39, 0x96, 0xc2, 0x81, 0x96, 0xe0, 0x40, 0xb8, 0xed, 0x13, 0xcb, 0xde, 0x08, 0xad, 0x70, 0x37,
-	0x30, 0xdc, 0xcc, 0x69, 0x1a, 0xff, 0xd8, 0x7d, 0xc0, 0x28, 0xe9, 0x14, 0x54, 0xe5, 0x41, 0x6b,
-	0xd0, 0xa1, 0x0b, 0xa1, 0xbb, 0xce, 0x8e, 0x13, 0x62, 0x62, 0x0d, 0xb6, 0x89, 0x6d, 0x78, 0x99,
-	0x8b, 0x28, 0x3a, 0xed, 0xed, 0xaa, 0x74, 0x74, 0xb6, 0x92, 0xe4, 0x45, 0x3d, 0x98, 0xa7, 0x79,
-	0x1b, 0x63, 0x6b, 0x40, 0x1e, 0x06, 0xd6, 0x90, 0x18, 0xe3, 0x4c, 0x0d, 0x64, 0x68, 0x31, 0x15,
-	0x9d, 0xac, 0xe8, 0x7c, 0x11, 0xd2, 0x5d, 0x6f, 0x60, 0x8d, 0x38, 0xd2, 0x97, 0xf2, 0x91, 0x62,
-	0xaa, 0x08, 0x29, 0xce, 0xd1, 0xea, 0xc8, 0xdb, 0xde, 0x36, 0xf6, 0x0a, 0xea, 0x28, 0xe8, 0xb4,
-	0x3a, 0x8a, 0x3c, 0x8a, 0xe7, 0x7a, 0xa1, 0xb3, 0xe5, 0x0c, 0x84, 0xfd, 0xba, 0xb6, 0xe1, 0x67,
-	0xe2, 0xad, 0x29, 0x64, 0xdd, 0x0d, 0x1e, 0x59, 0x4a, 0xf1, 0xa2, 0x07, 0x80, 0xd4, 0x3c, 0xa1,
-	0x54, 0x01, 0x43, 0x5c, 0x98, 0x84, 0x28, 0x35, 0x2b, 0x83, 0x9f, 0x96, 0x72, 0x6c, 0xed, 0xd3,
-	0xe5, 0xed, 0x92, 0xef, 0x59, 0xf6, 0xc0, 0x0a, 0x42, 0x23, 0xcc, 0x2c, 0xe5, 0x3a, 0x27, 0xeb,
-	0x4a, 0x3a, 0x5a, 0xca, 0x24, 0x2f, 0xc5, 0xdb, 0x21, 0x3b, 0x9b, 0xc4, 0x0f, 0xb6, 0x9d, 0xb1,
-	0x28, 0xe3, 0x6e, 0x26, 0xde, 0x3d, 0x49, 0x16, 0x97, 0x30, 0xc5, 0x4b, 0x27, 0xe2, 0x2c, 0x4e,
-	0xbd, 0xb1, 0xef, 0x0e, 0xb8, 0x32, 0x0a, 0xd0, 0x27, 0x99, 0x13, 0x71, 0xa6, 0x19, 0xdd, 0x98,
-	0x38, 0x86, 0xce, 0x86, 0x41, 0x77, 0xe0, 0xd0, 0xf8, 0xc2, 0x58, 0x43, 0x7e, 0x9a, 0x39, 0x71,
-	0x5e, 0xbf, 0xb0, 0x9e, 0x84, 0x4c, 0x72, 0x52, 0x53, 0x73, 0x76, 0xc6, 0x9e, 0x1f, 0xde, 0x72,
-	0x5c, 0x27, 0xd8, 0x36, 0xf6, 0x33, 0x4d, 0xad, 0xcf, 0x48, 0xba, 0x9c, 0x86, 0x9a, 0x9a, 0xca,
-	0x83, 0x2e, 0x41, 0x73, 0xb0, 0x6d, 0x85, 0x8b, 0xb6, 0x6d, 0x7c, 0x9d, 0x07, 0x7c, 0x9f, 0x4d,
-	0xf0, 0x2f, 0x6f, 0x5b, 0xa1, 0x08, 0x91, 0x44, 0xa4, 0xe8, 0x1a, 0x00, 0xfd, 0x29, 0x6a, 0xf0,
-	0x8b, 0xe5, 0x4c, 0x5f, 0xc5, 0x18, 0x65, 0xe9, 0x15, 0x06, 0xf4, 0x1e, 0x1c, 0x89, 0x53, 0xd4,
-	0x48, 0xf9, 0x9a, 0xff, 0x1b, 0xe5, 0x4c, 0x6f, 0xab, 0xe0, 0x48, 0xda, 0x5e, 0x09, 0x67, 0x41,
-	0x44, 0x05, 0x13, 0x63, 0xf1, 0x37, 0x27, 0x14, 0x4c, 0x8e, 0xbb, 0x0a, 0xc3, 0x52, 0x13, 0xea,
-	0x7b, 0xd6, 0x68, 0x97, 0x98, 0xdf, 0xad, 0x40, 0x8d, 0x92, 0x99, 0x04, 0xaa, 0xb4, 0xc2, 0xf3,
-	0x50, 0x71, 0x6c, 0x83, 0xef, 0x64, 0x54, 0x1c, 0x1b, 0x19, 0xd0, 0xf4, 0xe8, 0x3c, 0x52, 0xee,
-	0xab, 0x44, 0x49, 0xda, 0xa0, 0x62, 0xff, 0x45, 0x04, 0x8e, 0xcc, 0xc4, 0x9e, 0x0a, 0x85, 0x8d,
-	0xb6, 0x6a, 0x22, 0x52, 0xd3, 0x80, 0x86, 0x18, 0xe6, 0x13, 0x92, 0xcc, 0x35, 0x68, 0x88, 0x56,
-	0x4b, 0x96, 0x41, 0x91, 0x54, 0x99, 0x5e, 0x12, 0x81, 0x43, 0xc9, 0x46, 0x4b, 0x02, 0x2f, 0x41,
-	0xdb, 0x97, 0x9d, 0x52, 0x49, 0xc4, 0x78, 0x52, 0xd0, 0x5d, 0x09, 0x84, 0x63, 0x36, 0xf3, 0x3b,
-	0x0d, 0x68, 0x8a, 0xad, 0x02, 0x73, 0x0d, 0x6a, 0x6c, 0xe3, 0xe6, 0x28, 0xd4, 0x1d, 0xd7, 0x26,
-	0x4f, 0x99, 0xa8, 0x3a, 0xe6, 0x09, 0xf4, 0x06, 0x34, 0xc5, 0xb6, 0x81, 0x90, 0x95, 0xb7, 0x09,
-	0x15, 0x91, 0x99, 0xef, 0x43, 0x33, 0xda, 0xc0, 0x39, 0x0e, 0xed, 0xb1, 0xef, 0x51, 0x67, 0xd8,
-	0x8f, 0x6a, 0x10, 0x67, 0xa0, 0x37, 0xa1, 0x69, 0x8b, 0x2d, 0xa2, 0x8a, 0xd0, 0x6d, 0xbe, 0xdd,
-	0xd6, 0x8d, 0xb6, 0xdb, 0xba, 0x1b, 0x6c, 0xbb, 0x0d, 0x47, 0x74, 0xe6, 0xd7, 0xcb, 0xd0, 0xe0,
-	0xfb, 0x38, 0xe6, 0x9e, 0x6c, 0xf9, 0xb7, 0xa0, 0x31, 0x60, 0x79, 0x46, 0x72, 0x0f, 0x47, 0x2b,
-	0xa1, 0xd8, 0x18, 0xc2, 0x82, 0x98, 0xb2, 0x05, 0x7c, 0x10, 0xac, 0x4c, 0x64, 0xe3, 0x46, 0x8d,
-	0x05, 0xf1, 0x7f, 0x9b, 0xdc, 0x3f, 0xaf, 0xc0, 0x41, 0x7d, 0x7b, 0xe8, 0x38, 0xb4, 0x07, 0x72,
-	0xc3, 0x49, 0xb4, 0xae, 0xcc, 0x40, 0xf7, 0x01, 0x06, 0x23, 0x87, 0xb8, 0x21, 0x0b, 0x50, 0x56,
-	0x32, 0xe7, 0xbd, 0x99, 0xbb, 0x45, 0xdd, 0x65, 0xc9, 0x86, 0x15, 0x08, 0x74, 0x03, 0xea, 0xc1,
-	0xc0, 0x1b, 0x73, 0xc3, 0x99, 0x57, 0x16, 0x42, 0x7a, 0xb1, 0x17, 0x77, 0xc3, 0x6d, 0x3e, 0xb6,
-	0x2e, 0x8e, 0x9d, 0x0d, 0xca, 0x80, 0x39, 0x9f, 0xf9, 0x35, 0x80, 0x18, 0x1a, 0x9d, 0x94, 0x53,
-	0x99, 0x35, 0x6b, 0x27, 0x2a, 0xbf, 0x9a, 0xa5, 0x50, 0xac, 0x5b, 0xe1, 0xb6, 0xb0, 0x64, 0x35,
-	0x0b, 0x9d, 0x83, 0xc3, 0x81, 0x33, 0x74, 0xad, 0x70, 0xd7, 0x27, 0x8f, 0x88, 0xef, 0x6c, 0x39,
-	0xc4, 0x66, 0xc5, 0x6b, 0xe1, 0xf4, 0x07, 0xf3, 0x4d, 0x38, 0x9c, 0xde, 0xfa, 0x9a, 0xd8, 0x88,
-	0xe6, 0xaf, 0xb4, 0xa1, 0xc1, 0x57, 0x35, 0xe6, 0xbf, 0x57, 0xa4, 0x5e, 0x9b, 0x7f, 0x51, 0x86,
-	0x3a, 0xdf, 0xdd, 0x49, 0x1a, 0xe7, 0x2d, 0x55, 0xa7, 0xab, 0x19, 0x53, 0xfe, 0xac, 0xdd, 0xae,
-	0xee, 0x1d, 0xb2, 0xff, 0x88, 0x3a, 0x36, 0xa9, 0xe8, 0xe8, 0x18, 0x34, 0x82, 0xdd, 0xcd, 0xbe,
-	0x1d, 0x18, 0xd5, 0x93, 0xd5, 0xb3, 0x6d, 0x2c, 0x52, 0xe6, 0x6d, 0x68, 0x45, 0xc4, 0xa8, 0x03,
-	0xd5, 0xc7, 0x64, 0x5f, 0x08, 0xa7, 0x3f, 0xd1, 0x39, 0xe1, 0x20, 0xa5, 0xa9, 0x26, 0xed, 0x89,
-	0x4b, 0x11, 0x5e, 0xf4, 0x0b, 0x50, 0xa5, 0xeb, 0x88, 0x64, 0x15, 0x66, 0x37, 0xcb, 0xdc, 0xd2,
-	0x2e, 0x43, 0x9d, 0xef, 0xb0, 0x25, 0x65, 0x20, 0xa8, 0x3d, 0x26, 0xfb, 0xbc, 0x8d, 0xda, 0x98,
-	0xfd, 0xce, 0x05, 0xf9, 0x5e, 0x15, 0x0e, 0xa8, 0xbb, 0x0a, 0xe6, 0x6a, 0xae, 0xd3, 0xb7, 0xb6,
-	0x42, 0xd5, 0xe9, 0x8b, 0x24, 0xf5, 0x6c, 0x0c, 0x8b, 0xa9, 0x46, 0x1b, 0xf3, 0x84, 0xd9, 0x85,
-	0x86, 0xd8, 0xac, 0x49, 0x22, 0x49, 0xfa, 0x8a, 0x4a, 0x7f, 0x1b, 0x5a, 0x72, 0xef, 0xe5, 0xc3,
-	0xca, 0xf6, 0xa1, 0x25, 0x37, 0x59, 0x8e, 0x42, 0x3d, 0xf4, 0x42, 0x6b, 0xc4, 0xe0, 0xaa, 0x98,
-	0x27, 0xa8, 0x5e, 0xba, 0xe4, 0x69, 0xb8, 0x2c, 0x3d, 0x6f, 0x15, 0xc7, 0x19, 0xdc, 0xb1, 0x92,
-	0x3d, 0xfe, 0xb5, 0xca, 0xbf, 0xca, 0x8c, 0x58, 0x66, 0x4d, 0x95, 0xb9, 0x0f, 0x0d, 0xb1, 0xf3,
-	0x22, 0xbf, 0x97, 0x95, 0xef, 0x68, 0x11, 0xea, 0x43, 0xfa, 0x5d, 0xf4, 0xfa, 0x6b, 0x09, 0xfb,
-	0xe6, 0x0b, 0xaa, 0x65, 0xcf, 0x0d, 0xa9, 0x1a, 0xeb, 0x01, 0x25, 0xcc, 0x39, 0x69, 0x17, 0xfa,
-	0x7c, 0x1b, 0x8d, 0x1b, 0xa1, 0x48, 0x99, 0xdf, 0x29, 0x43, 0x5b, 0xee, 0x5b, 0x9a, 0xef, 0xe7,
-	0x19, 0xcf, 0x22, 0x1c, 0xf4, 0x05, 0x15, 0x35, 0xd4, 0xc8, 0x84, 0x9e, 0x4f, 0x94, 0x04, 0x2b,
-	0x34, 0x58, 0xe7, 0x30, 0xdf, 0xc9, 0xed, 0xd4, 0x05, 0x38, 0x10, 0x91, 0xde, 0x89, 0x55, 0x4f,
-	0xcb, 0x33, 0x4d, 0xc9, 0xdd, 0x81, 0xaa, 0x63, 0xf3, 0xa3, 0x1c, 0x6d, 0x4c, 0x7f, 0x9a, 0x5b,
-	0x70, 0x40, 0xdd, 0xbd, 0x30, 0x1f, 0x65, 0x5b, 0xcf, 0x0d, 0x2a, 0x46, 0xd9, 0x29, 0xa9, 0x24,
-	0x96, 0x68, 0x51, 0x15, 0x62, 0x12, 0xac, 0x31, 0x98, 0xcf, 0x42, 0x9d, 0xef, 0xa9, 0x26, 0xa7,
-	0x1a, 0xdf, 0x1e, 0x40, 0x9d, 0x75, 0x82, 0x79, 0x91, 0x1b, 0xc0, 0x39, 0x68, 0xb0, 0xf8, 0x40,
-	0x74, 0xe2, 0xe4, 0x68, 0x56, 0x8f, 0x61, 0x41, 0x63, 0x2e, 0xc3, 0x9c, 0xb2, 0x9b, 0x45, 0x35,
-	0x96, 0x7d, 0x90, 0x5a, 0x10, 0x25, 0x91, 0x09, 0x2d, 0x3a, 0x40, 0x0b, 0x9f, 0x4b, 0xeb, 0x2f,
-	0xd3, 0xe6, 0x29, 0x39, 0x11, 0x32, 0xc5, 0xee, 0x5d, 0x5f, 0xb6, 0x92, 0x4c, 0x9b, 0x9f, 0x87,
-	0xb6, 0xdc, 0xf4, 0x42, 0xf7, 0xe1, 0x80, 0xd8, 0xf4, 0xe2, 0x6b, 0x76, 0x4a, 0x3c, 0x5f, 0xa0,
-	0x5d, 0x74, 0x81, 0xce, 0xf6, 0xcd, 0xba, 0x0f, 0xf6, 0xc7, 0x04, 0x6b, 0x00, 0xe6, 0x2f, 0x9d,
-	0x65, 0x2d, 0x6f, 0x8e, 0xa1, 0x25, 0x23, 0xfd, 0xc9, 0x5e, 0xb8, 0xc2, 0x5d, 0x63, 0xa5, 0x70,
-	0x9b, 0x8a, 0xf3, 0x53, 0x07, 0xcc, 0x3c, 0xa8, 0xf9, 0x3c, 0x54, 0xef, 0x90, 0x7d, 0x6a, 0x21,
-	0xdc, 0x91, 0x0a, 0x0b, 0xe1, 0x0e, 0xb3, 0x0f, 0x0d, 0xb1, 0xe3, 0x96, 0x94, 0x77, 0x1e, 0x1a,
-	0x5b, 0x7c, 0x13, 0xaf, 0xc0, 0x65, 0x0a, 0x32, 0xf3, 0x06, 0xcc, 0xa9, 0xfb, 0x6c, 0x49, 0xbc,
-	0x93, 0x30, 0x37, 0x50, 0x76, 0xf2, 0x78, 0x37, 0xa8, 0x59, 0x26, 0xd1, 0xd5, 0x31, 0x85, 0xb0,
-	0x9a, 0xa9, 0x87, 0x2f, 0x65, 0x36, 0xfb, 0x04, 0x6d, 0xbc, 0x03, 0x87, 0x92, 0x1b, 0x6a, 0x49,
-	0x49, 0x67, 0xe1, 0xd0, 0x66, 0x62, 0xfb, 0x8e, 0xfb, 0xc0, 0x64, 0xb6, 0xd9, 0x87, 0x3a, 0xdf,
-	0xf0, 0x48, 0x42, 0xbc, 0x01, 0x75, 0x8b, 0x6d, 0xa8, 0x54, 0xd8, 0xd4, 0xc2, 0xcc, 0x2c, 0x25,
-	0x63, 0xc5, 0x9c, 0xd0, 0x74, 0xe0, 0xa0, 0xbe, 0x87, 0x92, 0x84, 0xec, 0xc1, 0xc1, 0x3d, 0x6d,
-	0xaf, 0x86, 0x43, 0x2f, 0x64, 0x42, 0x6b, 0x50, 0x58, 0x67, 0x34, 0xbf, 0xd1, 0x80, 0x1a, 0xdb,
-	0x04, 0x4c, 0x8a, 0xb8, 0x0c, 0xb5, 0x90, 0x3c, 0x8d, 0xe6, 0xc5, 0x0b, 0x13, 0x77, 0x14, 0x79,
-	0x24, 0x8a, 0xd1, 0xa3, 0x4f, 0x42, 0x3d, 0x08, 0xf7, 0x47, 0xd1, 0x0a, 0xe4, 0xe5, 0xc9, 0x8c,
-	0x1b, 0x94, 0x14, 0x73, 0x0e, 0xca, 0xca, 0x6c, 0x41, 0x6c, 0x5a, 0x17, 0xb0, 0x32, 0x23, 0xc4,
-	0x9c, 0x03, 0xdd, 0xa0, 0x4b, 0x49, 0x32, 0x78, 0x4c, 0x6c, 0xb1, 0x5b, 0x7d, 0x7a, 0x32, 0xf3,
-	0x32, 0x27, 0xc6, 0x11, 0x17, 0x95, 0x3d, 0x60, 0xbd, 0xdb, 0x98, 0x46, 0x36, 0xeb, 0x71, 0xcc,
-	0x39, 0xd0, 0x2a, 0xb4, 0x9d, 0x81, 0xe7, 0xae, 0xee, 0x78, 0x5f, 0x74, 0xc4, 0xb6, 0xf4, 0x2b,
-	0x93, 0xd9, 0xfb, 0x11, 0x39, 0x8e, 0x39, 0x23, 0x98, 0xfe, 0x0e, 0x5d, 0x54, 0xb5, 0xa6, 0x85,
-	0x61, 0xe4, 0x38, 0xe6, 0x34, 0x8f, 0x8b, 0xfe, 0xcc, 0x36, 0xf2, 0x5b, 0x50, 0x67, 0x4d, 0x8e,
-	0xae, 0xa9, 0x9f, 0xe7, 0x15, 0x49, 0xb9, 0x1e, 0x4b, 0x74, 0x95, 0xc4, 0x61, 0xed, 0xaf, 0xe3,
-	0xcc, 0x4d, 0x83, 0x23, 0xfa, 0x8d, 0xe3, 0xbc, 0x08, 0x4d, 0xd1, 0x15, 0x7a, 0x81, 0x5b, 0x11,
-	0xc1, 0x0b, 0x50, 0xe7, 0x86, 0x99, 0x5d, 0x9f, 0x97, 0xa0, 0x2d, 0x1b, 0x73, 0x32, 0x09, 0x6b,
-	0x9d, 0x1c, 0x92, 0x5f, 0xae, 0x40, 0x9d, 0x6f, 0x86, 0xa6, 0x5d, 0xad, 0x6a, 0x05, 0x2f, 0x4f,
-	0xde, 0x5b, 0x55, 0xcd, 0xe0, 0x16, 0x5b, 0x1c, 0xd2, 0xb9, 0xbc, 0x3c, 0xe0, 0x78, 0xb6, 0x80,
-	0x7b, 0x3d, 0xa2, 0xc7, 0x31, 0x6b, 0x41, 0x77, 0xde, 0x87, 0xb6, 0xe4, 0x42, 0x4b, 0x7a, 0x97,
-	0x9e, 0x9b, 0xd8, 0x15, 0x49, 0x91, 0x02, 0xf0, 0xb7, 0xca, 0x50, 0x5d, 0x71, 0xf6, 0x52, 0xed,
-	0xf0, 0x76, 0x64, 0xd5, 0x45, 0xee, 0x60, 0xc5, 0xd9, 0xd3, 0x8c, 0xda, 0x5c, 0x8d, 0x34, 0xee,
-	0x1d, 0xbd, 0x78, 0x67, 0x26, 0xcf, 0xc0, 0x62, 0x18, 0x5e, 0xb0, 0x5f, 0x6f, 0x42, 0x8d, 0x9d,
-	0x33, 0xc8, 0xf2, 0x53, 0xfb, 0xe3, 0xe2, 0x82, 0xb1, 0x48, 0x26, 0x1b, 0x70, 0x19, 0x3d, 0xf7,
-	0x53, 0x56, 0x58, 0xec, 0xa7, 0x78, 0x60, 0x96, 0x92, 0x62, 0xce, 0x41, 0x45, 0xee, 0x38, 0x3b,
-	0x44, 0xb8, 0xa9, 0x02, 0x91, 0xf7, 0x9c, 0x1d, 0x82, 0x19, 0x3d, 0xe5, 0xdb, 0xb6, 0x82, 0x6d,
-	0xe1, 0xa1, 0x0a, 0xf8, 0x7a, 0x56, 0xb0, 0x8d, 0x19, 0x3d, 0xe5, 0x73, 0xe9, 0x2a, 0xb2, 0x31,
-	0x0d, 0x1f, 0x5d, 0x5c, 0x62, 0x46, 0x4f, 0xf9, 0x02, 0xe7, 0xcb, 0x44, 0xf8, 0xa4, 0x02, 0xbe,
-	0x0d, 0xe7, 0xcb, 0x04, 0x33, 0xfa, 0xd8, 0x85, 0xb7, 0xa6, 0x6b, 0x1a, 0xc5, 0x85, 0x3f, 0x80,
-	0xf9, 0x50, 0xdb, 0x2d, 0x13, 0x87, 0x5d, 0xce, 0x15, 0xf4, 0x8b, 0xc6, 0x83, 0x13, 0x18, 0xd4,
-	0x08, 0xd8, 0x9a, 0x39, 0xdb, 0x08, 0x5e, 0x80, 0xfa, 0x67, 0x1c, 0x3b, 0xdc, 0xd6, 0x3f, 0xd7,
-	0x35, 0x97, 0x47, 0xbb, 0x6d, 0x26, 0x97, 0xa7, 0xf6, 0x3a, 0xc7, 0x59, 0x81, 0x1a, 0x55, 0x9f,
-	0xd9, 0xf4, 0x38, 0xd6, 0xba, 0x0f, 0xe5, 0x80, 0xd5, 0x86, 0xe6, 0x38, 0xc7, 0xa1, 0x46, 0x35,
-	0x24, 0xa7, 0x49, 0x8e, 0x43, 0x8d, 0xea, 0x5d, 0xfe, 0x57, 0xda, 0xdb, 0xfa, 0xd7, 0x6a, 0xf4,
-	0xf5, 0x0c, 0xcc, 0xeb, 0xdd, 0x91, 0x83, 0xf2, 0xdd, 0x26, 0xd4, 0xd8, 0xa1, 0x9d, 0xa4, 0x45,
-	0x7e, 0x1a, 0x0e, 0xf2, 0xfe, 0x5b, 0x12, 0x53, 0xf0, 0x4a, 0xe6, 0x99, 0x3d, 0xfd, 0x28, 0x90,
-	0x50, 0x01, 0xc1, 0x82, 0x75, 0x84, 0xe9, 0x27, 0x15, 0x0c, 0x4a, 0xd3, 0xc8, 0x77, 0xe4, 0xe4,
-	0xb5, 0x56, 0x70, 0x62, 0x8c, 0xf1, 0xf2, 0x29, 0x70, 0x34, 0x93, 0x45, 0x4b, 0xd0, 0xa2, 0x43,
-	0x2b, 0x6d, 0x2e, 0x61, 0xb6, 0x67, 0x26, 0xf3, 0xf7, 0x05, 0x35, 0x96, 0x7c, 0x74, 0x60, 0x1f,
-	0x58, 0xbe, 0xcd, 0x4a, 0x25, 0x6c, 0xf8, 0x95, 0xc9, 0x20, 0xcb, 0x11, 0x39, 0x8e, 0x39, 0xd1,
-	0x1d, 0x98, 0xb3, 0x89, 0x8c, 0x13, 0x08, 0xa3, 0xfe, 0xc4, 0x64, 0xa0, 0x95, 0x98, 0x01, 0xab,
-	0xdc, 0xb4, 0x4c, 0xd1, 0xda, 0x30, 0x28, 0x9c, 0x6c, 0x30, 0xa8, 0xf8, 0x64, 0x6e, 0xcc, 0x69,
-	0x9e, 0x86, 0x83, 0x5a, 0xbf, 0x7d, 0xa4, 0xb3, 0x0e, 0xb5, 0x2f, 0x39, 0xce, 0x15, 0xb9, 0x44,
-	0x79, 0x5d, 0x9f, 0x76, 0xe4, 0xae, 0x48, 0x04, 0xe3, 0x5d, 0x68, 0x45, 0x1d, 0x83, 0x6e, 0xea,
-	0x65, 0x78, 0xb5, 0xb8, 0x0c, 0xb2, 0x4f, 0x05, 0xda, 0x1a, 0xb4, 0x65, 0x0f, 0xa1, 0x45, 0x1d,
-	0xee, 0xb5, 0x62, 0xb8, 0xb8, 0x77, 0x05, 0x1e, 0x86, 0x39, 0xa5, 0xa3, 0xd0, 0xb2, 0x8e, 0xf8,
-	0x7a, 0x31, 0xa2, 0xda, 0xcd, 0xf1, 0xac, 0x47, 0xf6, 0x98, 0xda, 0x2b, 0xd5, 0xb8, 0x57, 0xfe,
-	0xb8, 0x09, 0x2d, 0x79, 0x50, 0x2e, 0x63, 0x8d, 0xb9, 0xeb, 0x8f, 0x0a, 0xd7, 0x98, 0x11, 0x7f,
-	0xf7, 0xa1, 0x3f, 0xc2, 0x94, 0x83, 0x76, 0x71, 0xe8, 0x84, 0xd2, 0x54, 0x5f, 0x29, 0x66, 0x7d,
-	0x40, 0xc9, 0x31, 0xe7, 0x42, 0xf7, 0x75, 0x2d, 0xaf, 0x4d, 0x38, 0x48, 0xa1, 0x81, 0xe4, 0x6a,
-	0x7a, 0x1f, 0xda, 0x0e, 0x9d, 0xfa, 0xf5, 0xe2, 0x91, 0xf7, 0xb5, 0x62, 0xb8, 0x7e, 0xc4, 0x82,
-	0x63, 0x6e, 0x5a, 0xb6, 0x2d, 0x6b, 0x8f, 0xda, 0x35, 0x03, 0x6b, 0x4c, 0x5b, 0xb6, 0x5b, 0x31,
-	0x13, 0x56, 0x11, 0xd0, 0x55, 0x31, 0x77, 0x69, 0x16, 0x78, 0x96, 0xb8, 0xa9, 0xe2, 0xf9, 0xcb,
-	0x7b, 0xa9, 0x91, 0x96, 0x9b, 0xf1, 0x1b, 0x53, 0xa0, 0x4c, 0x1c, 0x6d, 0x69, 0x0f, 0xf2, 0x99,
-	0x51, 0x7b, 0xda, 0x1e, 0x54, 0x67, 0x47, 0xe6, 0xf3, 0x50, 0x7d, 0xe8, 0x8f, 0xf2, 0xc7, 0x6a,
-	0xd6, 0xdd, 0x39, 0x9f, 0x5f, 0xd6, 0x2d, 0x21, 0x7f, 0x42, 0x2f, 0xfb, 0x24, 0x17, 0x47, 0x69,
-	0xf4, 0x1c, 0xa2, 0x6b, 0x62, 0x40, 0x7f, 0x4b, 0xb7, 0xb7, 0x17, 0x13, 0xf6, 0x46, 0x2d, 0x6c,
-	0xdd, 0x27, 0xfc, 0xac, 0x90, 0x32, 0x92, 0x4f, 0x3b, 0x4e, 0xde, 0x8e, 0xe6, 0x1f, 0x33, 0x79,
-	0x8a, 0x64, 0xdb, 0x72, 0xac, 0x6f, 0x95, 0xa1, 0x25, 0xcf, 0x41, 0xa6, 0xa3, 0xf3, 0x2d, 0x27,
-	0xe8, 0x11, 0xcb, 0x26, 0xbe, 0xb0, 0xdb, 0x57, 0x0b, 0x0f, 0x58, 0x76, 0xfb, 0x82, 0x03, 0x4b,
-	0x5e, 0xf3, 0x24, 0xb4, 0xa2, 0xdc, 0x9c, 0x45, 0xd9, 0x8f, 0x2b, 0xd0, 0x10, 0x27, 0x28, 0x93,
-	0x85, 0xb8, 0x0e, 0x8d, 0x91, 0xb5, 0xef, 0xed, 0x46, 0x4b, 0xa6, 0x33, 0x05, 0x87, 0x32, 0xbb,
-	0x77, 0x19, 0x35, 0x16, 0x5c, 0xe8, 0x53, 0x50, 0x1f, 0x39, 0x3b, 0x4e, 0x28, 0xdc, 0xc7, 0xe9,
-	0x42, 0x76, 0x76, 0xd6, 0x82, 0xf3, 0x50, 0xe1, 0xec, 0xe0, 0x54, 0x74, 0xec, 0xbd, 0x50, 0xf8,
-	0x23, 0x46, 0x8d, 0x05, 0x97, 0x79, 0x1b, 0x1a, 0xbc, 0x38, 0xb3, 0x0d, 0x12, 0x7a, 0x4d, 0x62,
-	0x4d, 0x67, 0x65, 0xcb, 0x99, 0x95, 0x9e, 0x80, 0x06, 0x17, 0x9e, 0xa3, 0x35, 0x3f, 0x7a, 0x8e,
-	0xad, 0x77, 0x46, 0xe6, 0xdd, 0x78, 0xc3, 0xf1, 0xc3, 0xef, 0x65, 0x98, 0x0f, 0xe0, 0xd0, 0x8a,
-	0x15, 0x5a, 0x9b, 0x56, 0x40, 0x30, 0x19, 0x78, 0xbe, 0x9d, 0x89, 0xea, 0xf3, 0x4f, 0x22, 0x42,
-	0x9d, 0x8f, 0x2a, 0xe8, 0x3e, 0x0e, 0x1d, 0xfe, 0xcf, 0x09, 0x1d, 0xfe, 0x49, 0x2d, 0x27, 0x9e,
-	0x37, 0x4d, 0x24, 0x83, 0x2a, 0x5c, 0x2a, 0xa0, 0x77, 0x55, 0x9f, 0x7b, 0x9f, 0x2a, 0xe0, 0xd4,
-	0x26, 0xdf, 0x57, 0xf5, 0x88, 0x5e, 0x11, 0xaf, 0x16, 0xd2, 0xbb, 0x99, 0x0c, 0xe9, 0x9d, 0x29,
-	0xe0, 0x4e, 0xc5, 0xf4, 0xae, 0xea, 0x31, 0xbd, 0x22, 0xe9, 0x6a, 0x50, 0xef, 0xff, 0x58, 0x18,
-	0xed, 0xb7, 0x73, 0xc2, 0x3e, 0x9f, 0xd4, 0xc3, 0x3e, 0x13, 0xb4, 0xe6, 0xe7, 0x15, 0xf7, 0xf9,
-	0x9d, 0x46, 0x4e, 0xdc, 0xe7, 0x8a, 0x16, 0xf7, 0x99, 0x50, 0xb2, 0x64, 0xe0, 0xe7, 0xaa, 0x1e,
-	0xf8, 0x39, 0x55, 0xc0, 0xa9, 0x45, 0x7e, 0xae, 0x68, 0x91, 0x9f, 0x22, 0xa1, 0x4a, 0xe8, 0xe7,
-	0x8a, 0x16, 0xfa, 0x29, 0x62, 0x54, 0x62, 0x3f, 0x57, 0xb4, 0xd8, 0x4f, 0x11, 0xa3, 0x12, 0xfc,
-	0xb9, 0xa2, 0x05, 0x7f, 0x8a, 0x18, 0x95, 0xe8, 0xcf, 0x55, 0x3d, 0xfa, 0x53, 0xdc, 0x3e, 0x4a,
-	0xa7, 0x7f, 0x1c, 0xa8, 0xf9, 0x2f, 0x0c, 0xd4, 0xfc, 0x5a, 0x35, 0x27, 0x00, 0x83, 0xb3, 0x03,
-	0x30, 0xe7, 0xf2, 0x7b, 0xb2, 0x38, 0x02, 0x33, 0xfd, 0x28, 0x90, 0x0e, 0xc1, 0x5c, 0x4b, 0x84,
-	0x60, 0x4e, 0x17, 0x30, 0xeb, 0x31, 0x98, 0xff, 0x35, 0x41, 0x86, 0x3f, 0x6c, 0x4c, 0x58, 0x4f,
-	0xbf, 0xad, 0xae, 0xa7, 0x27, 0x8c, 0x64, 0xe9, 0x05, 0xf5, 0x75, 0x7d, 0x41, 0x7d, 0x76, 0x0a,
-	0x5e, 0x6d, 0x45, 0xbd, 0x9e, 0xb5, 0xa2, 0xee, 0x4e, 0x81, 0x92, 0xbb, 0xa4, 0xbe, 0x9d, 0x5e,
-	0x52, 0x9f, 0x9b, 0x02, 0x2f, 0x73, 0x4d, 0xbd, 0x9e, 0xb5, 0xa6, 0x9e, 0xa6, 0x74, 0xb9, 0x8b,
-	0xea, 0x4f, 0x69, 0x8b, 0xea, 0x57, 0xa6, 0x69, 0xae, 0x78, 0x70, 0xf8, 0x6c, 0xce, 0xaa, 0xfa,
-	0xcd, 0x69, 0x60, 0x26, 0x07, 0xb1, 0x3f, 0x5e, 0x17, 0xeb, 0x62, 0xfe, 0xe0, 0x45, 0x68, 0x45,
-	0x07, 0x6d, 0xcc, 0x2f, 0x41, 0x33, 0xba, 0x36, 0x97, 0xb4, 0x9c, 0x63, 0x72, 0x51, 0xc7, 0x67,
-	0xcf, 0x22, 0x85, 0xae, 0x43, 0x8d, 0xfe, 0x12, 0x66, 0xf1, 0xea, 0x74, 0x07, 0x7a, 0xa8, 0x10,
-	0xcc, 0xf8, 0xcc, 0x7f, 0x3b, 0x0a, 0xa0, 0xdc, 0x26, 0x9a, 0x56, 0xec, 0xbb, 0xd4, 0x99, 0x8d,
-	0x42, 0xe2, 0xb3, 0x83, 0x5c, 0x85, 0xb7, 0x6d, 0x62, 0x09, 0x54, 0x5b, 0x42, 0xe2, 0x63, 0xc1,
-	0x8e, 0xee, 0x41, 0x2b, 0x0a, 0xa4, 0x1a, 0x35, 0x06, 0xf5, 0xe6, 0xd4, 0x50, 0x51, 0x68, 0x0f,
-	0x4b, 0x08, 0xb4, 0x08, 0xb5, 0xc0, 0xf3, 0x43, 0xa3, 0xce, 0xa0, 0x5e, 0x9f, 0x1a, 0x6a, 0xc3,
-	0xf3, 0x43, 0xcc, 0x58, 0x79, 0xd5, 0x94, 0xcb, 0xda, 0xb3, 0x54, 0x4d, 0xf3, 0xd8, 0xff, 0x5a,
-	0x95, 0x3e, 0x74, 0x59, 0x58, 0x23, 0xd7, 0xa1, 0xf3, 0xd3, 0xf7, 0x92, 0x6a, 0x95, 0x48, 0x4c,
-	0x82, 0x78, 0x4f, 0xf0, 0xf9, 0xcd, 0xab, 0xd0, 0x19, 0x78, 0x7b, 0xc4, 0xc7, 0xf1, 0x11, 0x27,
-	0x71, 0x0a, 0x2d, 0x95, 0x8f, 0x4c, 0x68, 0x6d, 0x3b, 0x36, 0xe9, 0x0f, 0x84, 0xff, 0x6b, 0x61,
-	0x99, 0x46, 0x77, 0xa0, 0xc5, 0x62, 0xec, 0x51, 0x84, 0x7f, 0xb6, 0x42, 0xf2, 0x50, 0x7f, 0x04,
-	0x40, 0x05, 0x31, 0xe1, 0xb7, 0x9c, 0x90, 0xb5, 0x61, 0x0b, 0xcb, 0x34, 0x2d, 0x30, 0x3b, 0x47,
-	0xa6, 0x16, 0xb8, 0xc9, 0x0b, 0x9c, 0xcc, 0x47, 0x97, 0xe0, 0x19, 0x96, 0x97, 0x58, 0x62, 0xf2,
-	0x50, 0x7d, 0x0b, 0x67, 0x7f, 0x64, 0xe7, 0xe6, 0xac, 0x21, 0xbf, 0x9a, 0xc1, 0x82, 0x77, 0x75,
-	0x1c, 0x67, 0xa0, 0x73, 0x70, 0xd8, 0x26, 0x5b, 0xd6, 0xee, 0x28, 0x7c, 0x40, 0x76, 0xc6, 0x23,
-	0x2b, 0x24, 0x7d, 0x9b, 0xdd, 0x17, 0x6f, 0xe3, 0xf4, 0x07, 0xf4, 0x06, 0x1c, 0x11, 0x99, 0xdc,
-	0x8c, 0x69, 0x6f, 0xf4, 0x6d, 0x76, 0x7d, 0xba, 0x8d, 0xb3, 0x3e, 0x99, 0x3f, 0xaa, 0xd1, 0x4e,
-	0x67, 0xaa, 0xfd, 0x2e, 0x54, 0x2d, 0xdb, 0x16, 0xc3, 0xe6, 0xc5, 0x19, 0x0d, 0x44, 0x9c, 0xf7,
-	0xa7, 0x08, 0x68, 0x5d, 0x1e, 0xb9, 0xe3, 0x03, 0xe7, 0xe5, 0x59, 0xb1, 0xe4, 0x33, 0x16, 0x02,
-	0x87, 0x22, 0xee, 0xf2, 0x9b, 0x03, 0xd5, 0x9f, 0x0d, 0x51, 0x5e, 0x2a, 0x10, 0x38, 0xe8, 0x36,
-	0xd4, 0x58, 0x09, 0xf9, 0xc0, 0x7a, 0x69, 0x56, 0xbc, 0x7b, 0xbc, 0x7c, 0x0c, 0xc3, 0x1c, 0xf0,
-	0xb3, 0x6f, 0xca, 0x81, 0xcb, 0xb2, 0x7e, 0xe0, 0x72, 0x09, 0xea, 0x4e, 0x48, 0x76, 0xd2, 0xe7,
-	0x6f, 0x27, 0xaa, 0xaa, 0xf0, 0x3c, 0x9c, 0x75, 0xe2, 0x39, 0xc0, 0xf7, 0x73, 0x4f, 0xfc, 0xdf,
-	0x84, 0x1a, 0x65, 0x4f, 0xcd, 0x25, 0xa7, 0x11, 0xcc, 0x38, 0xcd, 0x0b, 0x50, 0xa3, 0x95, 0x9d,
-	0x50, 0x3b, 0x51, 0x9e, 0x8a, 0x2c, 0xcf, 0xd2, 0x1c, 0xb4, 0xbd, 0x31, 0xf1, 0x99, 0x61, 0x98,
-	0xff, 0x52, 0x53, 0x0e, 0xc5, 0xf5, 0x55, 0x1d, 0x7b, 0x6b, 0x66, 0xcf, 0xa9, 0x6a, 0x19, 0x4e,
-	0x68, 0xd9, 0xdb, 0xb3, 0xa3, 0xa5, 0xf4, 0x0c, 0x27, 0xf4, 0xec, 0x67, 0xc0, 0x4c, 0x69, 0xda,
-	0x5d, 0x4d, 0xd3, 0x2e, 0xcf, 0x8e, 0xa8, 0xe9, 0x1a, 0x29, 0xd2, 0xb5, 0x15, 0x5d, 0xd7, 0xba,
-	0xd3, 0x75, 0xb9, 0x1c, 0x9a, 0xa6, 0xd0, 0xb6, 0xcf, 0xe7, 0x6a, 0xdb, 0x92, 0xa6, 0x6d, 0xb3,
-	0x8a, 0xfe, 0x88, 0xf4, 0xed, 0x87, 0x35, 0xa8, 0xd1, 0xe1, 0x11, 0xad, 0xaa, 0xba, 0xf6, 0xe6,
-	0x4c, 0x43, 0xab, 0xaa, 0x67, 0x6b, 0x09, 0x3d, 0xbb, 0x34, 0x1b, 0x52, 0x4a, 0xc7, 0xd6, 0x12,
-	0x3a, 0x36, 0x23, 0x5e, 0x4a, 0xbf, 0x7a, 0x9a, 0x7e, 0x5d, 0x98, 0x0d, 0x4d, 0xd3, 0x2d, 0xab,
-	0x48, 0xb7, 0x6e, 0xea, 0xba, 0x35, 0xe5, 0xec, 0x8d, 0xcd, 0x55, 0xa6, 0xd0, 0xab, 0xf7, 0x72,
-	0xf5, 0xea, 0xba, 0xa6, 0x57, 0xb3, 0x88, 0xfd, 0x88, 0x74, 0xea, 0x12, 0x9f, 0x74, 0x66, 0x5f,
-	0xb8, 0xca, 0x9b, 0x74, 0x9a, 0x6f, 0x41, 0x3b, 0x7e, 0x8e, 0x21, 0xe3, 0x78, 0x3e, 0x27, 0x8b,
-	0xa4, 0x46, 0x49, 0xf3, 0x22, 0xb4, 0xe3, 0x27, 0x16, 0x32, 0x64, 0x05, 0xec, 0xa3, 0xe0, 0x12,
-	0x29, 0x73, 0x15, 0x0e, 0xa7, 0x2f, 0x80, 0x67, 0xc4, 0xe1, 0x95, 0xb3, 0xe5, 0xd1, 0xed, 0x15,
-	0x25, 0xcb, 0x7c, 0x02, 0xf3, 0x89, 0x2b, 0xdd, 0x33, 0x63, 0xa0, 0x8b, 0xca, 0x14, 0xb9, 0x9a,
-	0xb8, 0x20, 0xa8, 0x9f, 0x96, 0x8f, 0x27, 0xc2, 0xe6, 0x0a, 0xcc, 0x17, 0x14, 0x7e, 0x9a, 0xc3,
-	0xf2, 0x5f, 0x80, 0xb9, 0x49, 0x65, 0xff, 0x08, 0x0e, 0xf3, 0x87, 0xd0, 0x49, 0x3d, 0x47, 0x91,
-	0x14, 0xb3, 0x0e, 0x30, 0x94, 0x34, 0x42, 0x69, 0xdf, 0x98, 0xe1, 0xea, 0x02, 0xe3, 0xc3, 0x0a,
-	0x86, 0xf9, 0xfb, 0x65, 0x38, 0x9c, 0x7e, 0x8b, 0x62, 0xda, 0xc5, 0x8f, 0x01, 0x4d, 0x86, 0x25,
-	0x6f, 0x7c, 0x44, 0x49, 0x74, 0x0f, 0x0e, 0x04, 0x23, 0x67, 0x40, 0x96, 0xb7, 0x2d, 0x77, 0x48,
-	0x02, 0xb1, 0xa2, 0x29, 0x78, 0x4f, 0x62, 0x23, 0xe6, 0xc0, 0x1a, 0xbb, 0xf9, 0x04, 0xe6, 0x94,
-	0x8f, 0xe8, 0x1d, 0xa8, 0x78, 0xe3, 0xd4, 0xb9, 0xc6, 0x7c, 0xcc, 0xfb, 0x91, 0xbd, 0xe1, 0x8a,
-	0x37, 0x4e, 0x9b, 0xa4, 0x6a, 0xbe, 0x55, 0xcd, 0x7c, 0xcd, 0x3b, 0x70, 0x38, 0xfd, 0xdc, 0x43,
-	0xb2, 0x79, 0xce, 0xa4, 0xa2, 0x04, 0xbc, 0x99, 0x92, 0x4b, 0xfe, 0x2b, 0x70, 0x28, 0xf9, 0x88,
-	0x43, 0xc6, 0x6d, 0x9c, 0xf8, 0x52, 0x53, 0x14, 0xae, 0x5f, 0xf8, 0xd5, 0x32, 0xcc, 0xeb, 0x15,
-	0x41, 0xc7, 0x00, 0xe9, 0x39, 0x6b, 0x9e, 0x4b, 0x3a, 0x25, 0xf4, 0x0c, 0x1c, 0xd6, 0xf3, 0x17,
-	0x6d, 0xbb, 0x53, 0x4e, 0x93, 0x53, 0xb7, 0xd5, 0xa9, 0x20, 0x03, 0x8e, 0x26, 0x5a, 0x88, 0x39,
-	0xd1, 0x4e, 0x15, 0x3d, 0x07, 0xcf, 0x24, 0xbf, 0x8c, 0x47, 0xd6, 0x80, 0x74, 0x6a, 0xe6, 0x4f,
-	0x2b, 0x50, 0x7b, 0x18, 0x10, 0xdf, 0xfc, 0xa7, 0x4a, 0x74, 0x4b, 0xe3, 0x6d, 0xa8, 0xb1, 0xf7,
-	0x15, 0x94, 0x1b, 0x94, 0xe5, 0xc4, 0x0d, 0x4a, 0xed, 0x16, 0x5e, 0x7c, 0x83, 0xf2, 0x6d, 0xa8,
-	0xb1, 0x17, 0x15, 0x66, 0xe7, 0xfc, 0x66, 0x19, 0xda, 0xf1, 0xeb, 0x06, 0x33, 0xf3, 0xab, 0xb7,
-	0x42, 0x2a, 0xfa, 0xad, 0x90, 0x57, 0xa1, 0xee, 0xb3, 0xfb, 0x1b, 0xdc, 0xcb, 0x24, 0xef, 0x9a,
-	0x30, 0x81, 0x98, 0x93, 0x98, 0x04, 0xe6, 0xd4, 0xb7, 0x1b, 0x66, 0x2f, 0xc6, 0x29, 0xf1, 0x70,
-	0x53, 0xdf, 0x0e, 0x16, 0x7d, 0xdf, 0xda, 0x17, 0x8a, 0xa9, 0x67, 0x9a, 0xc7, 0xa1, 0xb6, 0xee,
-	0xb8, 0xc3, 0xec, 0x8b, 0xab, 0xe6, 0x9f, 0x96, 0xa1, 0x29, 0x0e, 0xef, 0x9a, 0x57, 0xa0, 0xba,
-	0x46, 0x9e, 0xd0, 0x82, 0x88, 0x63, 0xc3, 0xa9, 0x82, 0xdc, 0x63, 0xb5, 0x10, 0xf4, 0x38, 0x22,
-	0x33, 0xaf, 0xca, 0x61, 0x72, 0x76, 0xde, 0xb7, 0xa1, 0xc6, 0x9e, 0x5c, 0x98, 0x9d, 0xf3, 0xcf,
-	0x5a, 0xd0, 0xe0, 0xb7, 0x3f, 0xcd, 0x3f, 0x6a, 0x41, 0x83, 0x3f, 0xc3, 0x80, 0xae, 0x43, 0x33,
-	0xd8, 0xdd, 0xd9, 0xb1, 0xfc, 0x7d, 0x23, 0xfb, 0x71, 0x51, 0xed, 0xd5, 0x86, 0xee, 0x06, 0xa7,
-	0xc5, 0x11, 0x13, 0x7a, 0x0b, 0x6a, 0x03, 0x6b, 0x8b, 0xa4, 0xb6, 0x73, 0xb3, 0x98, 0x97, 0xad,
-	0x2d, 0x82, 0x19, 0x39, 0xba, 0x09, 0x2d, 0xd1, 0x2d, 0x81, 0x88, 0xe7, 0x4c, 0x96, 0x1b, 0x75,
-	0xa6, 0xe4, 0x32, 0x6f, 0x43, 0x53, 0x14, 0x06, 0xdd, 0x90, 0x77, 0x5f, 0x93, 0x91, 0xe7, 0xcc,
-	0x2a, 0xc8, 0xfb, 0xf9, 0xf2, 0x16, 0xec, 0x5f, 0x56, 0xa0, 0x46, 0x0b, 0xf7, 0xa1, 0x91, 0xd0,
-	0x09, 0x80, 0x91, 0x15, 0x84, 0xeb, 0xbb, 0xa3, 0x11, 0xb1, 0xc5, 0x0d, 0x3b, 0x25, 0x07, 0x9d,
-	0x85, 0x43, 0x3c, 0x15, 0x6c, 0x6f, 0xec, 0x0e, 0x06, 0x44, 0xde, 0x2c, 0x4d, 0x66, 0xa3, 0x45,
-	0xa8, 0xb3, 0x87, 0x01, 0xc5, 0xac, 0xf0, 0xb5, 0xc2, 0x96, 0xed, 0xae, 0x3b, 0xae, 0x28, 0x0d,
-	0xe7, 0x34, 0x3d, 0x68, 0xcb, 0x3c, 0x6a, 0x84, 0x63, 0xc7, 0x75, 0x1d, 0x77, 0x28, 0x34, 0x3a,
-	0x4a, 0xd2, 0x41, 0x87, 0xfe, 0x14, 0xe5, 0xad, 0x63, 0x91, 0xa2, 0xf9, 0x5b, 0x96, 0x33, 0x12,
-	0x45, 0xac, 0x63, 0x91, 0xa2, 0x48, 0xbb, 0xe2, 0xf1, 0x8a, 0x1a, 0xab, 0x60, 0x94, 0x34, 0x3f,
-	0x28, 0xcb, 0x0b, 0xe0, 0x59, 0x97, 0x33, 0x53, 0xb1, 0xa4, 0xe3, 0x6a, 0x40, 0x9b, 0x0f, 0x08,
-	0x4a, 0x88, 0xfa, 0x18, 0x34, 0x3c, 0x77, 0xe4, 0xb8, 0x44, 0xc4, 0x8e, 0x44, 0x2a, 0xd1, 0xc6,
-	0xf5, 0x54, 0x1b, 0x8b, 0xef, 0xab, 0xb6, 0x43, 0x8b, 0xd8, 0x88, 0xbf, 0xf3, 0x1c, 0x74, 0x0d,
-	0x9a, 0x36, 0xd9, 0x73, 0x06, 0x24, 0x30, 0x9a, 0x4c, 0xf5, 0x5e, 0x9e, 0xd8, 0xb6, 0x2b, 0x8c,
-	0x16, 0x47, 0x3c, 0x66, 0x08, 0x0d, 0x9e, 0x25, 0xab, 0x54, 0x56, 0xaa, 0x14, 0x17, 0xba, 0x32,
-	0xa1, 0xd0, 0xd5, 0x82, 0x42, 0xd7, 0x92, 0x85, 0x5e, 0xf8, 0x2a, 0x40, 0xac, 0x6e, 0x68, 0x0e,
-	0x9a, 0x0f, 0xdd, 0xc7, 0xae, 0xf7, 0xc4, 0xed, 0x94, 0x68, 0xe2, 0xfe, 0xd6, 0x16, 0x95, 0xd2,
-	0x29, 0xd3, 0x04, 0xa5, 0x73, 0xdc, 0x61, 0xa7, 0x82, 0x00, 0x1a, 0x34, 0x41, 0xec, 0x4e, 0x95,
-	0xfe, 0xbe, 0xc5, 0xfa, 0xaf, 0x53, 0x43, 0xcf, 0xc2, 0x91, 0xbe, 0x3b, 0xf0, 0x76, 0xc6, 0x56,
-	0xe8, 0x6c, 0x8e, 0xc8, 0x23, 0xe2, 0x07, 0x8e, 0xe7, 0x76, 0xea, 0x74, 0xf4, 0x5a, 0x23, 0xe1,
-	0x13, 0xcf, 0x7f, 0xbc, 0x46, 0x88, 0x2d, 0xde, 0x9c, 0xe8, 0x34, 0xcc, 0xff, 0x28, 0xf3, 0xdd,
-	0x60, 0xf3, 0x26, 0x1c, 0xd0, 0x5e, 0x59, 0x31, 0xe2, 0x37, 0x9f, 0x13, 0x4f, 0x3e, 0x1f, 0x63,
-	0xf1, 0x5a, 0x12, 0x4f, 0x65, 0x78, 0xca, 0xbc, 0x05, 0xa0, 0xbc, 0xad, 0x72, 0x02, 0x60, 0x73,
-	0x3f, 0x24, 0x01, 0x7f, 0x57, 0x85, 0x42, 0xd4, 0xb0, 0x92, 0xa3, 0xe2, 0x57, 0x34, 0x7c, 0xf3,
-	0x32, 0x80, 0xf2, 0xb2, 0x0a, 0xb5, 0x2b, 0x9a, 0x5a, 0x4a, 0x82, 0x25, 0xb3, 0xcd, 0xae, 0xa8,
-	0x41, 0xf4, 0x86, 0x4a, 0x54, 0x02, 0x1e, 0xbd, 0x53, 0x4b, 0xc0, 0x72, 0xcc, 0x55, 0x80, 0xf8,
-	0x19, 0x11, 0xf3, 0x8a, 0x74, 0xdd, 0xaf, 0x43, 0xcd, 0xb6, 0x42, 0x4b, 0x78, 0xcd, 0xe7, 0x12,
-	0x23, 0x57, 0xcc, 0x82, 0x19, 0x99, 0xf9, 0x7b, 0x65, 0x38, 0xa0, 0x3e, 0x99, 0x62, 0xbe, 0x0b,
-	0x35, 0xf6, 0xe6, 0xca, 0x0d, 0x38, 0xa0, 0xbe, 0x99, 0x92, 0x7a, 0x1b, 0x9b, 0xe3, 0xa9, 0xac,
-	0x58, 0x63, 0x30, 0xfb, 0xb2, 0x48, 0x1f, 0x1a, 0xea, 0x0d, 0x68, 0x8a, 0x27, 0x58, 0xcc, 0xd3,
-	0xd0, 0x8e, 0x5f, 0x5c, 0xa1, 0xbe, 0x83, 0xe7, 0x47, 0xbd, 0x2c, 0x92, 0xe6, 0x3f, 0x57, 0xa1,
-	0xce, 0xba, 0xd3, 0xfc, 0x7a, 0x45, 0xd5, 0x50, 0xf3, 0xa7, 0xe5, 0xdc, 0xb5, 0xe0, 0x45, 0xed,
-	0xa9, 0x82, 0xf9, 0xd4, 0x4b, 0x43, 0xe2, 0x81, 0x15, 0xdd, 0xb1, 0x5e, 0x86, 0xa6, 0xcb, 0x35,
-	0x53, 0xbc, 0x14, 0x70, 0x3c, 0x93, 0x4b, 0x68, 0x2f, 0x8e, 0x88, 0xd1, 0x25, 0xa8, 0x13, 0xdf,
-	0xf7, 0x7c, 0x66, 0x52, 0xf3, 0xa9, 0x37, 0x7b, 0xe2, 0xc7, 0x5c, 0x56, 0x29, 0x15, 0xe6, 0xc4,
-	0xe8, 0x12, 0x3c, 0x13, 0x70, 0x2b, 0xe2, 0x73, 0xca, 0x40, 0xdc, 0xab, 0x16, 0xde, 0x26, 0xfb,
-	0xe3, 0xc2, 0xa7, 0xa3, 0x01, 0x56, 0x31, 0xbc, 0x92, 0x6a, 0x91, 0x65, 0xd4, 0x86, 0x3a, 0x13,
-	0xd4, 0xa9, 0xa8, 0x66, 0x5b, 0xcd, 0x31, 0xbc, 0xda, 0xc2, 0x45, 0x68, 0x8a, 0x7c, 0x4a, 0xbf,
-	0xc8, 0xcb, 0xde, 0x29, 0xa1, 0x03, 0xd0, 0xda, 0x20, 0xa3, 0xad, 0x9e, 0x17, 0x84, 0x9d, 0x32,
-	0x3a, 0x08, 0x6d, 0x66, 0x0b, 0xf7, 0xdd, 0xd1, 0x7e, 0xa7, 0xb2, 0xf0, 0x1e, 0xb4, 0x65, 0x8d,
-	0x50, 0x0b, 0x6a, 0x6b, 0xbb, 0xa3, 0x51, 0xa7, 0xc4, 0xa6, 0xa6, 0xa1, 0xe7, 0x47, 0x81, 0xe9,
-	0xd5, 0xa7, 0x74, 0x9c, 0xe9, 0x94, 0xf3, 0xbc, 0x41, 0x05, 0x75, 0xe0, 0x80, 0x10, 0xce, 0xcb,
-	0x5c, 0x35, 0xff, 0xb1, 0x0c, 0x6d, 0xf9, 0x4a, 0x0d, 0x9d, 0x17, 0x46, 0x7d, 0x9c, 0xef, 0x07,
-	0xae, 0x24, 0x7a, 0x3b, 0xff, 0xd1, 0x9b, 0x44, 0x8f, 0x9f, 0x81, 0x79, 0xe1, 0x72, 0xa3, 0xc6,
-	0xe7, 0x5e, 0x33, 0x91, 0xbb, 0x70, 0x5b, 0xb6, 0x7a, 0x87, 0x99, 0xd8, 0xb2, 0xe7, 0xba, 0x64,
-	0x10, 0xb2, 0xb6, 0x3f, 0x04, 0x73, 0x6b, 0x5e, 0xb8, 0xee, 0x05, 0x01, 0xad, 0x19, 0x6f, 0xa9,
-	0xf8, 0x7b, 0x05, 0xcd, 0x03, 0x44, 0x67, 0xcd, 0xa8, 0x93, 0x34, 0x7f, 0xb7, 0x0c, 0x0d, 0xfe,
-	0x76, 0x8e, 0xf9, 0x9b, 0x65, 0x68, 0x88, 0xf7, 0x72, 0x5e, 0x85, 0x8e, 0xef, 0x51, 0xe0, 0x68,
-	0x41, 0xd1, 0x5f, 0x11, 0xb5, 0x4c, 0xe5, 0xd3, 0x35, 0xae, 0xa7, 0x68, 0x85, 0x98, 0x02, 0x68,
-	0x79, 0xe8, 0x2a, 0x00, 0x7f, 0x8f, 0xe7, 0xc1, 0xbe, 0x7c, 0xf8, 0x22, 0x79, 0xc4, 0x4c, 0xbc,
-	0xe0, 0xc3, 0x36, 0x63, 0x14, 0xea, 0x85, 0xaf, 0xc0, 0x41, 0x4c, 0x82, 0xb1, 0xe7, 0x06, 0xe4,
-	0xe7, 0xf5, 0x3f, 0x02, 0x72, 0x5f, 0xfb, 0x5f, 0xf8, 0x61, 0x1d, 0xea, 0x6c, 0x76, 0x69, 0xfe,
-	0x55, 0x5d, 0xce, 0x83, 0x53, 0xf6, 0x7d, 0x41, 0x3d, 0xe8, 0xa3, 0x1a, 0xaa, 0x36, 0x31, 0xd5,
-	0x0f, 0xf8, 0x7c, 0x0a, 0x5a, 0x63, 0xdf, 0x1b, 0xfa, 0x74, 0x3e, 0x5b, 0x4b, 0x3c, 0x8e, 0xa4,
-	0xb3, 0xad, 0x0b, 0x32, 0x2c, 0x19, 0x54, 0xe5, 0xab, 0xeb, 0xca, 0x77, 0x13, 0xda, 0xb6, 0xef,
-	0x8d, 0xd9, 0x15, 0x75, 0xb1, 0xb9, 0x76, 0x32, 0x07, 0x77, 0x25, 0xa2, 0xeb, 0x95, 0x70, 0xcc,
-	0x44, 0xd5, 0x97, 0xb7, 0xbe, 0xd8, 0xd7, 0x7e, 0x21, 0x87, 0x9d, 0xf7, 0x57, 0xaf, 0x84, 0x05,
-	0x39, 0x65, 0x24, 0x4f, 0x19, 0x63, 0x6b, 0x22, 0xe3, 0xea, 0xd3, 0x88, 0x91, 0x93, 0xa3, 0x6b,
-	0xd0, 0x0a, 0xac, 0x3d, 0xc2, 0x5e, 0x04, 0x6e, 0x4f, 0x6c, 0x8a, 0x0d, 0x41, 0xd6, 0x2b, 0x61,
-	0xc9, 0x42, 0xab, 0xbc, 0xe3, 0x0c, 0xf9, 0x4a, 0x52, 0x3c, 0x4b, 0x9c, 0x57, 0xe5, 0x7b, 0x11,
-	0x1d, 0x7b, 0x43, 0x3a, 0x4a, 0xd0, 0x95, 0x0f, 0x77, 0x99, 0x73, 0x7c, 0xdb, 0x98, 0x25, 0xcc,
-	0x39, 0x68, 0xcb, 0x26, 0x32, 0x5b, 0xd2, 0x4c, 0x5a, 0xd0, 0xe0, 0x35, 0x30, 0x01, 0x5a, 0x51,
-	0x81, 0x28, 0xb1, 0x04, 0x37, 0xd7, 0xa0, 0x15, 0x75, 0x5a, 0xce, 0xb3, 0x14, 0x08, 0x6a, 0xb6,
-	0x27, 0xa6, 0x4c, 0x55, 0xcc, 0x7e, 0xd3, 0x4e, 0x55, 0xdf, 0x54, 0x6a, 0xcb, 0xd7, 0x8c, 0x16,
-	0x16, 0xa3, 0xf3, 0x4a, 0xd4, 0xb5, 0xf1, 0xc5, 0xf8, 0x1c, 0x34, 0xf1, 0x2e, 0x9b, 0xcd, 0x76,
-	0xca, 0x34, 0x9b, 0x2e, 0x91, 0x3a, 0x15, 0xea, 0x25, 0x97, 0x2d, 0x77, 0x40, 0x46, 0x6c, 0x06,
-	0x24, 0x7d, 0x6f, 0x6d, 0xa9, 0x2d, 0xc1, 0x97, 0x8e, 0xff, 0xf5, 0x07, 0x27, 0xca, 0x3f, 0xf8,
-	0xe0, 0x44, 0xf9, 0xc7, 0x1f, 0x9c, 0x28, 0xff, 0xc6, 0x4f, 0x4e, 0x94, 0x7e, 0xf0, 0x93, 0x13,
-	0xa5, 0x7f, 0xf8, 0xc9, 0x89, 0xd2, 0xfb, 0x95, 0xf1, 0xe6, 0x66, 0x83, 0x9d, 0x39, 0xb9, 0xf8,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x54, 0x80, 0x8a, 0xfa, 0x63, 0x00, 0x00,
+	0x79, 0xff, 0xce, 0x7b, 0xe6, 0x5b, 0x72, 0x39, 0x2a, 0x51, 0x52, 0xab, 0x45, 0x51, 0xd4, 0x4a,
+	0xa2, 0x28, 0x89, 0x1a, 0x4a, 0x4b, 0x8a, 0x94, 0x69, 0xf1, 0xb1, 0x2f, 0x6a, 0x97, 0x8f, 0xe5,
+	0xba, 0x96, 0x94, 0x65, 0xd9, 0xf8, 0xc3, 0xbd, 0xd3, 0xb5, 0xbb, 0x6d, 0xce, 0x4e, 0x8f, 0xbb,
+	0x7b, 0x97, 0x5c, 0xdb, 0x7f, 0xc7, 0xb1, 0x1d, 0x04, 0x01, 0x12, 0x24, 0x87, 0x20, 0x09, 0x72,
+	0x09, 0x10, 0x24, 0x40, 0x0e, 0x41, 0x10, 0x20, 0x97, 0xe4, 0x62, 0x18, 0x08, 0x82, 0xc4, 0x79,
+	0x00, 0x76, 0x4e, 0xb9, 0x18, 0x36, 0xe4, 0x4b, 0x0e, 0xc9, 0xc1, 0x09, 0x90, 0xe4, 0x18, 0x7c,
+	0x55, 0xd5, 0xd5, 0x55, 0xfd, 0x98, 0x9e, 0xb5, 0xe4, 0x3c, 0x10, 0x5f, 0xc8, 0xa9, 0xea, 0xef,
+	0xf7, 0xab, 0xd7, 0xf7, 0xd5, 0xe3, 0xab, 0xc7, 0xc2, 0x93, 0xa3, 0xcd, 0x73, 0xa3, 0xc0, 0x8f,
+	0xfc, 0xf0, 0x1c, 0xdb, 0x67, 0xc3, 0x28, 0xec, 0xf1, 0x10, 0x69, 0x39, 0xc3, 0x83, 0xe8, 0x60,
+	0xc4, 0xec, 0x17, 0x47, 0x0f, 0xb6, 0xcf, 0x0d, 0xbc, 0xcd, 0x73, 0xa3, 0xcd, 0x73, 0xbb, 0xbe,
+	0xcb, 0x06, 0xb1, 0x38, 0x0f, 0x48, 0x71, 0xfb, 0xc4, 0xb6, 0xef, 0x6f, 0x0f, 0x98, 0xf8, 0xb6,
+	0xb9, 0xb7, 0x75, 0x2e, 0x8c, 0x82, 0xbd, 0x7e, 0x24, 0xbe, 0xce, 0xfe, 0xfd, 0xb7, 0x2b, 0xd0,
+	0x58, 0x46, 0x7a, 0x32, 0x07, 0xed, 0x5d, 0x16, 0x86, 0xce, 0x36, 0x0b, 0xad, 0xca, 0xa9, 0xda,
+	0x99, 0xe9, 0xb9, 0x27, 0x7b, 0x32, 0xa9, 0x1e, 0x97, 0xe8, 0xdd, 0x11, 0x9f, 0xa9, 0x92, 0x23,
+	0x27, 0xa0, 0xd3, 0xf7, 0x87, 0x11, 0x7b, 0x14, 0xad, 0xba, 0x56, 0xf5, 0x54, 0xe5, 0x4c, 0x87,
+	0x26, 0x11, 0xe4, 0x02, 0x74, 0xbc, 0xa1, 0x17, 0x79, 0x4e, 0xe4, 0x07, 0x56, 0xed, 0x54, 0xc5,
+	0xa0, 0xe4, 0x99, 0xec, 0xcd, 0xf7, 0xfb, 0xfe, 0xde, 0x30, 0xa2, 0x89, 0x20, 0xb1, 0xa0, 0x15,
+	0x05, 0x4e, 0x9f, 0xad, 0xba, 0x56, 0x9d, 0x33, 0xc6, 0x41, 0xfb, 0x3b, 0x6f, 0x42, 0x4b, 0xe6,
+	0x81, 0x3c, 0x0d, 0xad, 0x70, 0x24, 0xa4, 0xbe, 0x59, 0x11, 0x62, 0x32, 0x4c, 0xae, 0xc1, 0xb4,
+	0x23, 0x68, 0x37, 0x76, 0xfc, 0x87, 0x56, 0x85, 0x27, 0xfc, 0x4c, 0xaa, 0x2c, 0x32, 0xe1, 0x1e,
+	0x8a, 0xac, 0x4c, 0x51, 0x1d, 0x41, 0x56, 0x61, 0x46, 0x06, 0x97, 0x58, 0xe4, 0x78, 0x83, 0xd0,
+	0xfa, 0x8e, 0x20, 0x39, 0x59, 0x40, 0x22, 0xc5, 0x56, 0xa6, 0x68, 0x0a, 0x48, 0x3e, 0x03, 0x8f,
+	0xcb, 0x98, 0x45, 0x7f, 0xb8, 0xe5, 0x6d, 0xdf, 0x1f, 0xb9, 0x4e, 0xc4, 0xac, 0xbf, 0x16, 0x7c,
+	0x2f, 0x16, 0xf0, 0x09, 0xd9, 0x9e, 0x10, 0x5e, 0x99, 0xa2, 0x79, 0x1c, 0xe4, 0x06, 0x1c, 0x95,
+	0xd1, 0x92, 0xf4, 0x6f, 0x04, 0xe9, 0xb3, 0x05, 0xa4, 0x8a, 0xcd, 0x84, 0x91, 0xcf, 0xc2, 0x71,
+	0x19, 0x71, 0xdb, 0x1b, 0x3e, 0x58, 0xdc, 0x71, 0x06, 0x03, 0x36, 0xdc, 0x66, 0xd6, 0xdf, 0x8e,
+	0xcf, 0xa3, 0x21, 0xbc, 0x32, 0x45, 0x73, 0x49, 0xc8, 0x36, 0x58, 0x79, 0xf1, 0x2b, 0x9e, 0xcb,
+	0xac, 0xbf, 0x13, 0x09, 0x9c, 0x99, 0x28, 0x01, 0xcf, 0xc5, 0x44, 0x0a, 0xc9, 0xc8, 0x5d, 0xe8,
+	0xfa, 0x9b, 0x5f, 0x60, 0xfd, 0xb8, 0xe6, 0x37, 0x58, 0x64, 0x75, 0x39, 0xff, 0xf3, 0x29, 0xfe,
+	0xbb, 0x5c, 0x2c, 0x6e, 0xb3, 0xde, 0x06, 0x8b, 0x56, 0xa6, 0x68, 0x06, 0x4c, 0xee, 0x03, 0x31,
+	0xe2, 0xe6, 0x77, 0xd9, 0xd0, 0xb5, 0xe6, 0x38, 0xe5, 0x0b, 0xe3, 0x29, 0xb9, 0xe8, 0xca, 0x14,
+	0xcd, 0x21, 0xc8, 0xd0, 0xde, 0x1f, 0x86, 0x2c, 0xb2, 0xce, 0x4f, 0x42, 0xcb, 0x45, 0x33, 0xb4,
+	0x3c, 0x16, 0x1b, 0x51, 0xc4, 0x52, 0x36, 0x70, 0x22, 0xcf, 0x1f, 0xca, 0xfc, 0x5e, 0xe0, 0xc4,
+	0x2f, 0xe5, 0x13, 0x2b, 0x59, 0x95, 0xe3, 0x5c, 0x12, 0xf2, 0xff, 0xe0, 0x89, 0x54, 0x3c, 0x65,
+	0xbb, 0xfe, 0x3e, 0xb3, 0xde, 0xe2, 0xec, 0xa7, 0xcb, 0xd8, 0x85, 0xf4, 0xca, 0x14, 0xcd, 0xa7,
+	0x21, 0x0b, 0x70, 0x24, 0xfe, 0xc0, 0x69, 0x2f, 0x72, 0xda, 0x13, 0x45, 0xb4, 0x92, 0xcc, 0xc0,
+	0xa0, 0xd1, 0x8b, 0xf0, 0xe2, 0xc0, 0x0f, 0x99, 0x35, 0x9f, 0x6b, 0xf4, 0x92, 0x82, 0x8b, 0xa0,
+	0xd1, 0x6b, 0x08, 0xbd, 0x90, 0x61, 0x14, 0x78, 0x7d, 0x9e, 0x41, 0xd4, 0xa2, 0x4b, 0xe3, 0x0b,
+	0x99, 0x08, 0x4b, 0x55, 0xca, 0xa7, 0x21, 0x14, 0x8e, 0x85, 0x7b, 0x9b, 0x61, 0x3f, 0xf0, 0x46,
+	0x18, 0x37, 0xef, 0xba, 0xd6, 0x3b, 0xe3, 0x98, 0x37, 0x34, 0xe1, 0xde, 0xbc, 0x8b, 0xad, 0x93,
+	0x26, 0x20, 0x9f, 0x05, 0xa2, 0x47, 0xc9, 0xea, 0xbb, 0xc2, 0x69, 0x5f, 0x99, 0x80, 0x56, 0xd5,
+	0x65, 0x0e, 0x0d, 0x71, 0xe0, 0xb8, 0x1e, 0xbb, 0xee, 0x87, 0x1e, 0xfe, 0x6f, 0x5d, 0xe5, 0xf4,
+	0xaf, 0x4d, 0x40, 0x1f, 0x43, 0x50, 0xb1, 0xf2, 0xa8, 0xd2, 0x49, 0x2c, 0xa2, 0x69, 0xb3, 0x20,
+	0xb4, 0xae, 0x4d, 0x9c, 0x44, 0x0c, 0x49, 0x27, 0x11, 0xc7, 0xa7, 0xab, 0xe8, 0xdd, 0xc0, 0xdf,
+	0x1b, 0x85, 0xd6, 0xf5, 0x89, 0xab, 0x48, 0x00, 0xd2, 0x55, 0x24, 0x62, 0xc9, 0x45, 0x68, 0x6f,
+	0x0e, 0xfc, 0xfe, 0x83, 0x79, 0x57, 0x8c, 0x7e, 0xd3, 0x73, 0x56, 0x8a, 0x72, 0x01, 0x3f, 0xcb,
+	0xe6, 0x53, 0xb2, 0xa8, 0xac, 0xfc, 0xf7, 0x12, 0x1b, 0xb0, 0x88, 0x59, 0xb5, 0x5c, 0x65, 0x15,
+	0x50, 0x21, 0x82, 0xca, 0xaa, 0x21, 0xc8, 0x12, 0x4c, 0x6f, 0x79, 0x03, 0x16, 0xde, 0x1f, 0x0d,
+	0x7c, 0x47, 0x8c, 0x93, 0xd3, 0x73, 0xa7, 0x72, 0x09, 0x6e, 0x24, 0x72, 0xc8, 0xa2, 0xc1, 0xc8,
+	0x55, 0xe8, 0xec, 0x3a, 0xc1, 0x83, 0x70, 0x75, 0xb8, 0xe5, 0x5b, 0x8d, 0xdc, 0x11, 0x4e, 0x70,
+	0xdc, 0x89, 0xa5, 0x56, 0xa6, 0x68, 0x02, 0xc1, 0x71, 0x92, 0x67, 0x6a, 0x83, 0x45, 0x37, 0x3c,
+	0x36, 0x70, 0x43, 0xab, 0xc9, 0x49, 0x9e, 0xcb, 0x25, 0xd9, 0x60, 0x51, 0x4f, 0x88, 0xe1, 0x38,
+	0x69, 0x02, 0xc9, 0xfb, 0xf0, 0x78, 0x1c, 0xb3, 0xb8, 0xe3, 0x0d, 0xdc, 0x80, 0x0d, 0x57, 0xdd,
+	0xd0, 0x6a, 0xe5, 0x0e, 0x41, 0x09, 0x9f, 0x26, 0x8b, 0xc3, 0x64, 0x0e, 0x05, 0xf6, 0x8c, 0x71,
+	0xb4, 0x6e, 0x92, 0x56, 0x3b, 0xb7, 0x67, 0x4c, 0xa8, 0x75, 0x61, 0xd4, 0xae, 0x3c, 0x12, 0xe2,
+	0xc2, 0x53, 0x71, 0xfc, 0x82, 0xd3, 0x7f, 0xb0, 0x1d, 0xf8, 0x7b, 0x43, 0x77, 0xd1, 0x1f, 0xf8,
+	0x81, 0xd5, 0xc9, 0x1d, 0xdc, 0x12, 0xfe, 0x94, 0xfc, 0xca, 0x14, 0x2d, 0xa2, 0x22, 0x8b, 0x70,
+	0x24, 0xfe, 0x74, 0x8f, 0x3d, 0x8a, 0x2c, 0xc8, 0x1d, 0xe7, 0x13, 0x6a, 0x14, 0xc2, 0x0e, 0x52,
+	0x07, 0xe9, 0x24, 0xa8, 0x12, 0xd6, 0x74, 0x09, 0x09, 0x0a, 0xe9, 0x24, 0x18, 0xd6, 0x49, 0x70,
+	0x08, 0xb6, 0x8e, 0x96, 0x90, 0xa0, 0x90, 0x4e, 0x82, 0x61, 0x1c, 0xaa, 0x55, 0x49, 0x7d, 0xff,
+	0x01, 0xea, 0x93, 0x35, 0x93, 0x3b, 0x54, 0x6b, 0xb5, 0x25, 0x05, 0x71, 0xa8, 0x4e, 0x83, 0x71,
+	0x26, 0x14, 0xc7, 0xcd, 0x0f, 0xbc, 0xed, 0xa1, 0x75, 0x6c, 0x8c, 0x2e, 0x23, 0x1b, 0x97, 0xc2,
+	0x99, 0x90, 0x01, 0x23, 0xd7, 0xa5, 0x59, 0x6e, 0xb0, 0x68, 0xc9, 0xdb, 0xb7, 0x1e, 0xcb, 0x1d,
+	0x86, 0x12, 0x96, 0x25, 0x6f, 0x5f, 0xd9, 0xa5, 0x80, 0xe8, 0x45, 0x8b, 0x07, 0x39, 0xeb, 0x89,
+	0x92, 0xa2, 0xc5, 0x82, 0x7a, 0xd1, 0xe2, 0x38, 0xbd, 0x68, 0xb7, 0x9d, 0x88, 0x3d, 0xb2, 0x9e,
+	0x2e, 0x29, 0x1a, 0x97, 0xd2, 0x8b, 0xc6, 0x23, 0x70, 0x74, 0x8b, 0x23, 0xde, 0x63, 0x41, 0xe4,
+	0xf5, 0x9d, 0x81, 0xa8, 0xaa, 0x17, 0x73, 0xc7, 0xa0, 0x84, 0xcf, 0x90, 0xc6, 0xd1, 0x2d, 0x97,
+	0x46, 0x2f, 0xf8, 0x3d, 0x67, 0x73, 0xc0, 0xa8, 0xff, 0xd0, 0x7a, 0xa9, 0xa4, 0xe0, 0xb1, 0xa0,
+	0x5e, 0xf0, 0x38, 0x4e, 0xef, 0x5b, 0x3e, 0xed, 0xb9, 0xdb, 0x2c, 0xb2, 0xce, 0x94, 0xf4, 0x2d,
+	0x42, 0x4c, 0xef, 0x5b, 0x44, 0x8c, 0xea, 0x01, 0x96, 0x9c, 0xc8, 0xd9, 0xf7, 0xd8, 0xc3, 0xf7,
+	0x3c, 0xf6, 0x10, 0x07, 0xf6, 0xc7, 0xc7, 0xf4, 0x00, 0xb1, 0x6c, 0x4f, 0x0a, 0xab, 0x1e, 0x20,
+	0x45, 0xa2, 0x7a, 0x00, 0x3d, 0x5e, 0x76, 0xeb, 0xc7, 0xc7, 0xf4, 0x00, 0x06, 0xbf, 0xea, 0xe3,
+	0x8b, 0xa8, 0x88, 0x03, 0x4f, 0x66, 0x3e, 0xdd, 0x0d, 0x5c, 0x16, 0x58, 0xcf, 0xf2, 0x44, 0x5e,
+	0x2e, 0x4f, 0x84, 0x8b, 0xaf, 0x4c, 0xd1, 0x02, 0xa2, 0x4c, 0x12, 0x1b, 0xfe, 0x5e, 0xd0, 0x67,
+	0x58, 0x4f, 0x2f, 0x4c, 0x92, 0x84, 0x12, 0xcf, 0x24, 0xa1, 0xbe, 0x90, 0x7d, 0x78, 0x56, 0x7d,
+	0xc1, 0x84, 0xf9, 0x28, 0xca, 0x53, 0x97, 0x2b, 0x98, 0xd3, 0x3c, 0xa5, 0xde, 0xf8, 0x94, 0xd2,
+	0xa8, 0x95, 0x29, 0x3a, 0x9e, 0x96, 0x1c, 0xc0, 0x49, 0x43, 0x40, 0x8c, 0xf3, 0x7a, 0xc2, 0x2f,
+	0xf3, 0x84, 0xcf, 0x8d, 0x4f, 0x38, 0x03, 0x5b, 0x99, 0xa2, 0x25, 0xc4, 0x64, 0x04, 0xcf, 0x18,
+	0x95, 0x11, 0x1b, 0xb6, 0x54, 0x91, 0xaf, 0xf0, 0x74, 0xcf, 0x8e, 0x4f, 0xd7, 0xc4, 0xac, 0x4c,
+	0xd1, 0x71, 0x94, 0xb8, 0xe2, 0xca, 0xfd, 0x8c, 0x2d, 0xf9, 0xe5, 0xdc, 0x69, 0x4f, 0x41, 0x72,
+	0xa2, 0x2d, 0x0b, 0xc9, 0x72, 0x35, 0x5f, 0x56, 0xe7, 0xff, 0x9f, 0x54, 0xf3, 0x55, 0x3d, 0x16,
+	0x51, 0x19, 0x6d, 0x87, 0x9f, 0xee, 0x39, 0xc1, 0x36, 0x8b, 0x44, 0x45, 0xaf, 0xba, 0x58, 0xa8,
+	0xaf, 0x4e, 0xd2, 0x76, 0x19, 0x98, 0xd1, 0x76, 0xb9, 0xc4, 0x24, 0x84, 0x13, 0x86, 0xc4, 0x6a,
+	0xb8, 0xe8, 0x0f, 0x06, 0xac, 0x1f, 0xd7, 0xe6, 0xcf, 0xf1, 0x84, 0x5f, 0x1f, 0x9f, 0x70, 0x0a,
+	0xb4, 0x32, 0x45, 0xc7, 0x92, 0x66, 0xca, 0x7b, 0x77, 0xe0, 0xa6, 0x74, 0xc6, 0x9a, 0x48, 0x57,
+	0xd3, 0xb0, 0x4c, 0x79, 0x33, 0x12, 0x19, 0x5d, 0xd5, 0x24, 0xb0, 0xb8, 0x4f, 0x4d, 0xa2, 0xab,
+	0x26, 0x26, 0xa3, 0xab, 0xe6, 0x67, 0x1c, 0xdd, 0xf6, 0x42, 0x16, 0x70, 0x8e, 0x9b, 0xbe, 0x37,
+	0xb4, 0x9e, 0xcb, 0x1d, 0xdd, 0xee, 0x87, 0x2c, 0x90, 0x09, 0xa1, 0x14, 0x8e, 0x6e, 0x06, 0xcc,
+	0xe0, 0xb9, 0xcd, 0xb6, 0x22, 0xeb, 0x54, 0x19, 0x0f, 0x4a, 0x19, 0x3c, 0x18, 0x81, 0x23, 0x85,
+	0x8a, 0xd8, 0x60, 0xd8, 0x2a, 0xd4, 0x41, 0x57, 0xc8, 0xf3, 0xb9, 0x23, 0x85, 0x46, 0xa7, 0x09,
+	0xe3, 0x48, 0x91, 0x47, 0x82, 0x2b, 0x7f, 0x15, 0x8f, 0x33, 0x32, 0x41, 0x3d, 0x9b, 0xbb, 0xf2,
+	0xd7, 0xa8, 0x95, 0x28, 0xae, 0x41, 0xb2, 0x04, 0xe4, 0x15, 0xa8, 0x8f, 0xbc, 0xe1, 0xb6, 0xe5,
+	0x72, 0xa2, 0xc7, 0x53, 0x44, 0xeb, 0xde, 0x70, 0x7b, 0x65, 0x8a, 0x72, 0x11, 0xf2, 0x0e, 0xc0,
+	0x28, 0xf0, 0xfb, 0x2c, 0x0c, 0xd7, 0xd8, 0x43, 0x8b, 0x71, 0x80, 0x9d, 0x06, 0x08, 0x81, 0xde,
+	0x1a, 0xc3, 0x71, 0x59, 0x93, 0x27, 0xcb, 0x70, 0x54, 0x86, 0xa4, 0x95, 0x6f, 0xe5, 0x4e, 0xfe,
+	0x62, 0x82, 0xc4, 0xdd, 0x64, 0xa0, 0x70, 0xed, 0x23, 0x23, 0x96, 0xfc, 0x21, 0xb3, 0xb6, 0x73,
+	0xd7, 0x3e, 0x31, 0x09, 0x8a, 0xe0, 0x1c, 0x4b, 0x43, 0xa0, 0xb7, 0x20, 0xda, 0x09, 0x98, 0xe3,
+	0x6e, 0x44, 0x4e, 0xb4, 0x17, 0x5a, 0xc3, 0xdc, 0x69, 0x9a, 0xf8, 0xd8, 0xbb, 0xc7, 0x25, 0x71,
+	0x0a, 0xaa, 0x63, 0xc8, 0x1a, 0x74, 0x71, 0x21, 0x74, 0xdb, 0xdb, 0xf5, 0x22, 0xca, 0x9c, 0xfe,
+	0x0e, 0x73, 0x2d, 0x3f, 0x77, 0x11, 0x85, 0xd3, 0xde, 0x9e, 0x2e, 0x87, 0xb3, 0x95, 0x34, 0x96,
+	0xac, 0xc0, 0x0c, 0xc6, 0x6d, 0xa0, 0x07, 0xf2, 0x3e, 0xfa, 0x27, 0xad, 0x51, 0xae, 0x06, 0x72,
+	0xb6, 0x44, 0x0a, 0x27, 0x2b, 0x26, 0x2e, 0x66, 0xba, 0xed, 0xf7, 0x9d, 0x81, 0x60, 0xfa, 0x62,
+	0x31, 0x53, 0x22, 0x15, 0x33, 0x25, 0x31, 0x46, 0x19, 0x45, 0xdd, 0xbb, 0xd6, 0x7e, 0x49, 0x19,
+	0xa5, 0x9c, 0x51, 0x46, 0x19, 0x87, 0x7c, 0x43, 0x3f, 0xf2, 0xb6, 0xbc, 0xbe, 0xb4, 0xdf, 0xa1,
+	0x6b, 0x05, 0xb9, 0x7c, 0x6b, 0x9a, 0x58, 0x6f, 0x43, 0x78, 0x96, 0x32, 0x58, 0x72, 0x0f, 0x88,
+	0x1e, 0x27, 0x95, 0x2a, 0xe4, 0x8c, 0xb3, 0xe3, 0x18, 0x95, 0x66, 0xe5, 0xe0, 0x31, 0x97, 0x23,
+	0xe7, 0x00, 0x97, 0xb7, 0x0b, 0x81, 0xef, 0xb8, 0x7d, 0x27, 0x8c, 0xac, 0x28, 0x37, 0x97, 0xeb,
+	0x42, 0xac, 0xa7, 0xe4, 0x30, 0x97, 0x69, 0x2c, 0xf2, 0xed, 0xb2, 0xdd, 0x4d, 0x16, 0x84, 0x3b,
+	0xde, 0x48, 0xe6, 0x71, 0x2f, 0x97, 0xef, 0x8e, 0x12, 0x4b, 0x72, 0x98, 0xc1, 0xe2, 0x44, 0x9c,
+	0xfb, 0xa9, 0x37, 0x0e, 0x86, 0x7d, 0xa1, 0x8c, 0x92, 0xf4, 0x61, 0xee, 0x44, 0x9c, 0x6b, 0x46,
+	0x2f, 0x11, 0x4e, 0xa8, 0xf3, 0x69, 0xc8, 0x2d, 0x38, 0x36, 0x9a, 0x1b, 0x19, 0xcc, 0x8f, 0x72,
+	0x27, 0xce, 0xeb, 0x73, 0xeb, 0x69, 0xca, 0x34, 0x12, 0x4d, 0xcd, 0xdb, 0x1d, 0xf9, 0x41, 0x74,
+	0xc3, 0x1b, 0x7a, 0xe1, 0x8e, 0x75, 0x90, 0x6b, 0x6a, 0xab, 0x5c, 0xa4, 0x27, 0x64, 0xd0, 0xd4,
+	0x74, 0x0c, 0xb9, 0x00, 0xad, 0xfe, 0x8e, 0x13, 0xa1, 0x8b, 0xe4, 0x6b, 0xc2, 0xe1, 0xfb, 0x54,
+	0x0a, 0xbf, 0xb8, 0xe3, 0x44, 0xd2, 0x45, 0x12, 0x8b, 0x92, 0x2b, 0x00, 0xf8, 0x53, 0x96, 0xe0,
+	0xe7, 0x2b, 0xb9, 0x7d, 0x15, 0x07, 0xaa, 0xdc, 0x6b, 0x00, 0x74, 0x27, 0x24, 0x21, 0x34, 0x52,
+	0xb1, 0xe6, 0xff, 0x7a, 0x25, 0xb7, 0xb7, 0xd5, 0x78, 0x94, 0x2c, 0xba, 0x13, 0x72, 0x28, 0xe2,
+	0x8c, 0xc9, 0xb1, 0xf8, 0x1b, 0x63, 0x32, 0xa6, 0xc6, 0x5d, 0x0d, 0xb0, 0xd0, 0x82, 0xc6, 0xbe,
+	0x33, 0xd8, 0x63, 0xf6, 0xb7, 0xaa, 0x50, 0x47, 0x31, 0x9b, 0x41, 0x0d, 0x0b, 0x3c, 0x03, 0x55,
+	0xcf, 0xb5, 0xc4, 0x4e, 0x46, 0xd5, 0x73, 0x71, 0x17, 0xc4, 0xc7, 0x79, 0xa4, 0xda, 0x57, 0x89,
+	0x83, 0x58, 0xa1, 0x72, 0xff, 0xc5, 0xaa, 0xa5, 0x52, 0x17, 0x7b, 0x2a, 0x48, 0x1b, 0x6f, 0xd5,
+	0xc4, 0xa2, 0xb6, 0x05, 0x4d, 0x39, 0xcc, 0xa7, 0x52, 0xb2, 0xd7, 0xa0, 0x29, 0x6b, 0x2d, 0x9d,
+	0x07, 0x2d, 0xa5, 0xea, 0xe4, 0x29, 0x31, 0x38, 0x96, 0xae, 0xb4, 0x34, 0xf1, 0x02, 0x74, 0x02,
+	0xd5, 0x28, 0xd5, 0x94, 0x8f, 0x27, 0x43, 0xdd, 0x53, 0x44, 0x34, 0x81, 0xd9, 0xdf, 0x6f, 0x42,
+	0x4b, 0x6e, 0x15, 0xd8, 0x6b, 0x50, 0xe7, 0x1b, 0x37, 0xc7, 0xa1, 0xe1, 0x0d, 0x5d, 0xf6, 0x88,
+	0x27, 0xd5, 0xa0, 0x22, 0x40, 0xde, 0x80, 0x96, 0xdc, 0x36, 0xb0, 0xaa, 0x63, 0x37, 0xa1, 0x62,
+	0x31, 0xfb, 0x03, 0x68, 0xc5, 0x1b, 0x38, 0x27, 0xa0, 0x33, 0x0a, 0x7c, 0xec, 0x0c, 0x57, 0xe3,
+	0x12, 0x24, 0x11, 0xe4, 0x4d, 0x68, 0xb9, 0x42, 0x50, 0x52, 0x3f, 0xd5, 0x13, 0xdb, 0x6d, 0xbd,
+	0x78, 0xbb, 0xad, 0xb7, 0xc1, 0xb7, 0xdb, 0x68, 0x2c, 0x67, 0x7f, 0xad, 0x02, 0x4d, 0xb1, 0x8f,
+	0x63, 0xef, 0xab, 0x9a, 0x7f, 0x0b, 0x9a, 0x7d, 0x1e, 0x67, 0xa5, 0xf7, 0x70, 0x8c, 0x1c, 0xca,
+	0x8d, 0x21, 0x2a, 0x85, 0x11, 0x16, 0x8a, 0x41, 0xb0, 0x3a, 0x16, 0x26, 0x8c, 0x9a, 0x4a, 0xe1,
+	0xff, 0xb6, 0x74, 0xff, 0xbd, 0x0a, 0x47, 0xcd, 0xed, 0x21, 0xdc, 0x3f, 0x8c, 0x03, 0x71, 0xed,
+	0xaa, 0x08, 0x72, 0x17, 0xa0, 0x3f, 0xf0, 0xd8, 0x30, 0xe2, 0x0e, 0xca, 0x6a, 0xee, 0xbc, 0x37,
+	0x77, 0xb7, 0xa8, 0xb7, 0xa8, 0x60, 0x54, 0xa3, 0x20, 0xd7, 0xa0, 0x11, 0xf6, 0xfd, 0x91, 0x30,
+	0x9c, 0x99, 0xb9, 0x57, 0x0a, 0xb2, 0x3d, 0xbf, 0x17, 0xed, 0x88, 0xb1, 0x75, 0x7e, 0xe4, 0x6d,
+	0x20, 0x80, 0x0a, 0x1c, 0xb1, 0xa1, 0x8d, 0xb3, 0x88, 0xbb, 0xc3, 0xc1, 0x01, 0x77, 0xba, 0xb6,
+	0xa9, 0x0a, 0xe3, 0x37, 0xb9, 0x03, 0x19, 0x5a, 0x8d, 0x53, 0xb5, 0x33, 0x1d, 0xaa, 0xc2, 0xf6,
+	0x57, 0x01, 0x92, 0x2c, 0x91, 0x53, 0x6a, 0x0a, 0xb4, 0xe6, 0xec, 0xc6, 0xe5, 0xd6, 0xa3, 0x34,
+	0x89, 0x75, 0x27, 0xda, 0x91, 0x3d, 0x80, 0x1e, 0x45, 0xce, 0xc2, 0x63, 0xa1, 0xb7, 0x3d, 0x74,
+	0xa2, 0xbd, 0x80, 0xbd, 0xc7, 0x02, 0x6f, 0xcb, 0x63, 0x2e, 0x2f, 0x56, 0x9b, 0x66, 0x3f, 0xd8,
+	0x6f, 0xc2, 0x63, 0xd9, 0x2d, 0xb3, 0xb1, 0x95, 0x6f, 0xff, 0x52, 0x07, 0x9a, 0x62, 0x35, 0x64,
+	0xff, 0x5b, 0x55, 0xd9, 0x83, 0xfd, 0xe7, 0x15, 0x68, 0x88, 0x5d, 0xa1, 0xb4, 0x51, 0xdf, 0xd0,
+	0x6d, 0xa1, 0x96, 0xb3, 0x54, 0xc8, 0xdb, 0x25, 0xeb, 0xdd, 0x62, 0x07, 0xef, 0x61, 0x87, 0xa8,
+	0x0c, 0x84, 0x3c, 0x09, 0xcd, 0x70, 0x6f, 0x13, 0x6b, 0xb1, 0xc6, 0x6b, 0x51, 0x86, 0xec, 0x9b,
+	0xd0, 0x8e, 0x85, 0x49, 0x17, 0x6a, 0x0f, 0xd8, 0x81, 0x4c, 0x1c, 0x7f, 0x92, 0xb3, 0xb2, 0x63,
+	0x55, 0x26, 0x9e, 0xb6, 0x43, 0x91, 0x8a, 0xec, 0x7d, 0x3f, 0x0f, 0x35, 0x5c, 0x7f, 0xa4, 0x8b,
+	0x70, 0x78, 0x73, 0x2e, 0xcc, 0xed, 0x22, 0x34, 0xc4, 0xce, 0x5c, 0x3a, 0x0d, 0x02, 0xf5, 0x07,
+	0xec, 0x40, 0xd4, 0x51, 0x87, 0xf2, 0xdf, 0x85, 0x24, 0xdf, 0xae, 0xc1, 0x11, 0x7d, 0x37, 0xc2,
+	0x5e, 0x2e, 0x1c, 0x2c, 0x9c, 0xad, 0x48, 0x1f, 0x2c, 0x64, 0x10, 0x7b, 0x44, 0xce, 0xc5, 0x55,
+	0xa3, 0x43, 0x45, 0xc0, 0xee, 0x41, 0x53, 0x6e, 0xf2, 0xa4, 0x99, 0x94, 0x7c, 0x55, 0x97, 0xbf,
+	0x09, 0x6d, 0xb5, 0x67, 0xf3, 0x51, 0xd3, 0x0e, 0xa0, 0xad, 0x36, 0x67, 0x8e, 0x43, 0x23, 0xf2,
+	0x23, 0x67, 0xc0, 0xe9, 0x6a, 0x54, 0x04, 0x50, 0x2f, 0x87, 0xec, 0x51, 0xb4, 0xa8, 0x7a, 0xec,
+	0x1a, 0x4d, 0x22, 0x44, 0x87, 0xcc, 0xf6, 0xc5, 0xd7, 0x9a, 0xf8, 0xaa, 0x22, 0x92, 0x34, 0xeb,
+	0x7a, 0x9a, 0x07, 0xd0, 0x94, 0x3b, 0x36, 0xea, 0x7b, 0x45, 0xfb, 0x4e, 0xe6, 0xa1, 0x81, 0xfe,
+	0xf6, 0x91, 0x55, 0x4d, 0x6d, 0x3c, 0x89, 0x7e, 0x41, 0x2c, 0xc4, 0x16, 0xfd, 0x61, 0x84, 0x6a,
+	0x6c, 0x3a, 0xa2, 0xa8, 0x40, 0x62, 0x13, 0x06, 0x62, 0xfb, 0x4d, 0x18, 0xa1, 0x0c, 0xd9, 0xbf,
+	0x5f, 0x81, 0x8e, 0xda, 0xef, 0xb4, 0x3f, 0x28, 0x32, 0x9e, 0x79, 0x38, 0x1a, 0x48, 0x29, 0x34,
+	0xd4, 0xd8, 0x84, 0x9e, 0x49, 0xe5, 0x84, 0x6a, 0x32, 0xd4, 0x44, 0xd8, 0xef, 0x14, 0x36, 0xea,
+	0x2c, 0x1c, 0x89, 0x45, 0x6f, 0x25, 0xaa, 0x67, 0xc4, 0xd9, 0xb6, 0x42, 0x77, 0xa1, 0xe6, 0xb9,
+	0xe2, 0x08, 0x48, 0x87, 0xe2, 0x4f, 0x7b, 0x0b, 0x8e, 0xe8, 0xbb, 0x1e, 0xf6, 0x7b, 0xf9, 0xd6,
+	0x73, 0x0d, 0x93, 0x49, 0xc4, 0x64, 0x65, 0x66, 0x8b, 0x90, 0x88, 0x50, 0x03, 0x60, 0x3f, 0x05,
+	0x0d, 0xb1, 0x17, 0x9b, 0x9e, 0xa2, 0xfc, 0x76, 0x1f, 0x1a, 0xbc, 0x11, 0xec, 0xf3, 0xc2, 0x00,
+	0xce, 0x42, 0x93, 0xfb, 0x15, 0xe2, 0x93, 0x2a, 0xc7, 0xf3, 0x5a, 0x8c, 0x4a, 0x19, 0x7b, 0x11,
+	0xa6, 0xb5, 0x5d, 0x30, 0xd4, 0x58, 0xfe, 0x41, 0x69, 0x41, 0x1c, 0xc4, 0x2e, 0x1c, 0x07, 0x76,
+	0xd9, 0xe7, 0xf2, 0x2e, 0x3c, 0x0e, 0xdb, 0x2f, 0xaa, 0x09, 0x94, 0x2d, 0x77, 0xfd, 0x56, 0x55,
+	0x2d, 0xa9, 0xb0, 0xfd, 0x39, 0xe8, 0xa8, 0xcd, 0x32, 0x72, 0x17, 0x8e, 0xc8, 0xcd, 0x32, 0xb1,
+	0xd6, 0x47, 0xe1, 0x99, 0x12, 0xed, 0xc2, 0x85, 0x3d, 0xdf, 0x6f, 0xeb, 0xdd, 0x3b, 0x18, 0x31,
+	0x6a, 0x10, 0xd8, 0xbf, 0x70, 0x86, 0xd7, 0xbc, 0x3d, 0x82, 0xb6, 0xda, 0x21, 0x48, 0xb7, 0xc2,
+	0x25, 0xd1, 0x35, 0x56, 0x4b, 0xb7, 0xb7, 0x04, 0x1e, 0x3b, 0x60, 0xde, 0x83, 0xda, 0xcf, 0x40,
+	0xed, 0x16, 0x3b, 0x40, 0x0b, 0x11, 0x1d, 0xa9, 0xb4, 0x10, 0x1e, 0xb0, 0x57, 0xa1, 0x29, 0x77,
+	0xea, 0xd2, 0xe9, 0x9d, 0x83, 0xe6, 0x16, 0xff, 0x52, 0xd6, 0x65, 0x4a, 0x31, 0xfb, 0x1a, 0x4c,
+	0xeb, 0xfb, 0x73, 0x69, 0xbe, 0x53, 0x30, 0xdd, 0x4f, 0x3e, 0xcb, 0x66, 0xd0, 0xa3, 0x6c, 0x66,
+	0xaa, 0x63, 0x86, 0x61, 0x39, 0x57, 0x0f, 0x9f, 0xcf, 0xad, 0xf6, 0x31, 0xda, 0x78, 0x0b, 0x8e,
+	0xa5, 0x37, 0xe2, 0xd2, 0x29, 0x9d, 0x81, 0x63, 0x9b, 0xa6, 0x88, 0xec, 0x03, 0xd3, 0xd1, 0xf6,
+	0x2a, 0x34, 0xc4, 0x46, 0x49, 0x9a, 0xe2, 0x0d, 0x68, 0x38, 0xf8, 0x81, 0x03, 0x67, 0xe6, 0xec,
+	0xdc, 0x5c, 0x72, 0x28, 0x15, 0x82, 0xb6, 0x07, 0x47, 0xcd, 0xbd, 0x97, 0x34, 0xe5, 0x0a, 0x1c,
+	0xdd, 0xd7, 0x05, 0x24, 0xf5, 0x6c, 0x2e, 0xb5, 0x41, 0x45, 0x4d, 0xa0, 0xfd, 0xf5, 0x26, 0xd4,
+	0xf9, 0xe6, 0x61, 0x3a, 0x89, 0x8b, 0x50, 0xc7, 0x33, 0x5e, 0xb2, 0x6a, 0x67, 0xc7, 0xee, 0x44,
+	0xf2, 0x7f, 0x28, 0x97, 0x27, 0x9f, 0x80, 0x46, 0x18, 0x1d, 0x0c, 0xe2, 0x95, 0xcb, 0x0b, 0xe3,
+	0x81, 0x1b, 0x28, 0x4a, 0x05, 0x02, 0xa1, 0xdc, 0x16, 0xac, 0xfa, 0x24, 0x50, 0x6e, 0x84, 0x54,
+	0x20, 0xc8, 0x35, 0x5c, 0x82, 0xb2, 0xfe, 0x03, 0xe6, 0x5a, 0x8d, 0x12, 0xb3, 0xe0, 0xe0, 0x45,
+	0x21, 0x4c, 0x63, 0x14, 0xa6, 0xdd, 0xe7, 0xad, 0xdb, 0x9c, 0x24, 0x6d, 0xde, 0xe2, 0x54, 0x20,
+	0xc8, 0x32, 0x74, 0xbc, 0xbe, 0x3f, 0x5c, 0xde, 0xf5, 0xbf, 0xe0, 0x59, 0xad, 0x31, 0x3b, 0x29,
+	0x0a, 0xbe, 0x1a, 0x8b, 0xd3, 0x04, 0x19, 0xd3, 0xac, 0xee, 0xe2, 0x62, 0xac, 0x3d, 0x29, 0x0d,
+	0x17, 0xa7, 0x09, 0xd2, 0x3e, 0x21, 0xdb, 0x33, 0xdf, 0xc8, 0x6f, 0x40, 0x83, 0x57, 0x39, 0xb9,
+	0xa2, 0x7f, 0x9e, 0x99, 0x7b, 0x39, 0x57, 0x73, 0x8c, 0x1e, 0x4b, 0x36, 0x95, 0xe2, 0xe1, 0xf5,
+	0x6f, 0xf2, 0x4c, 0x4f, 0xc2, 0x23, 0xdb, 0x4d, 0xf0, 0x3c, 0x07, 0x2d, 0xd9, 0x14, 0x66, 0x86,
+	0xdb, 0xb1, 0xc0, 0xb3, 0xd0, 0x10, 0x86, 0x99, 0x5f, 0x9e, 0xe7, 0xa1, 0xa3, 0x2a, 0x73, 0xbc,
+	0x08, 0xaf, 0x9d, 0x02, 0x91, 0x5f, 0xac, 0x42, 0x43, 0x6c, 0xa2, 0x66, 0xbb, 0x5a, 0xdd, 0x0a,
+	0x5e, 0x18, 0xbf, 0x27, 0xab, 0x9b, 0xc1, 0x0d, 0xe8, 0xc8, 0xb9, 0xbc, 0x3a, 0x18, 0x79, 0xa6,
+	0x04, 0xbd, 0x1e, 0xcb, 0xd3, 0x04, 0x5a, 0xd2, 0x9c, 0x77, 0xa1, 0xa3, 0x50, 0x64, 0xc1, 0x6c,
+	0xd2, 0xb3, 0x63, 0x9b, 0x22, 0x9d, 0xa4, 0x24, 0xfc, 0x8d, 0x0a, 0xd4, 0x70, 0x97, 0x3b, 0x5d,
+	0x0f, 0x6f, 0xc7, 0x56, 0x5d, 0xd6, 0x1d, 0x2c, 0x79, 0xfb, 0x86, 0x51, 0xdb, 0xcb, 0xb1, 0xc6,
+	0xbd, 0x63, 0x66, 0xef, 0xf4, 0xf8, 0x19, 0x58, 0x42, 0x23, 0x32, 0xf6, 0xab, 0x2d, 0xa8, 0xf3,
+	0xf3, 0x09, 0x79, 0xfd, 0xd4, 0xc1, 0xa8, 0x3c, 0x63, 0x08, 0x16, 0x03, 0x2e, 0x97, 0x17, 0xfd,
+	0x94, 0x13, 0x95, 0xf7, 0x53, 0x1c, 0x88, 0xcb, 0x5c, 0x5e, 0x24, 0x5c, 0x52, 0x5f, 0x84, 0xfa,
+	0xae, 0xb7, 0xcb, 0xac, 0xfa, 0x24, 0x49, 0xde, 0xf1, 0x76, 0x19, 0xe5, 0xf2, 0x88, 0xdb, 0x71,
+	0xc2, 0x1d, 0xab, 0x31, 0x09, 0x6e, 0xc5, 0x09, 0x77, 0x28, 0x97, 0x47, 0xdc, 0x10, 0x57, 0x91,
+	0xcd, 0x49, 0x70, 0xb8, 0xb8, 0xa4, 0x5c, 0x1e, 0x71, 0xa1, 0xf7, 0x25, 0x66, 0xb5, 0x26, 0xc1,
+	0x6d, 0x78, 0x5f, 0x62, 0x94, 0xcb, 0x27, 0x5d, 0x78, 0x7b, 0xb2, 0xaa, 0xd1, 0xba, 0xf0, 0x7b,
+	0x30, 0x13, 0x19, 0xbb, 0x6c, 0xf2, 0x90, 0xcc, 0xd9, 0x92, 0x76, 0x31, 0x30, 0x34, 0xc5, 0x81,
+	0x46, 0xc0, 0xd7, 0xcc, 0xf9, 0x46, 0xf0, 0x2c, 0x34, 0x3e, 0xed, 0xb9, 0xd1, 0x8e, 0xf9, 0xb9,
+	0x61, 0x74, 0x79, 0xd8, 0x6c, 0x87, 0xea, 0xf2, 0xf4, 0x56, 0x17, 0x3c, 0x4b, 0x50, 0x47, 0xf5,
+	0x39, 0x9c, 0x1e, 0x27, 0x5a, 0xf7, 0x91, 0x3a, 0x60, 0xbd, 0xa2, 0x05, 0xcf, 0x09, 0xa8, 0xa3,
+	0x86, 0x14, 0x54, 0xc9, 0x09, 0xa8, 0xa3, 0xde, 0x15, 0x7f, 0xc5, 0xd6, 0x36, 0xbf, 0xd6, 0xe2,
+	0xaf, 0xa7, 0x61, 0xc6, 0x6c, 0x8e, 0x02, 0x96, 0x6f, 0xb5, 0xa0, 0xce, 0x0f, 0xfb, 0xa4, 0x2d,
+	0xf2, 0x53, 0x70, 0x54, 0xb4, 0xdf, 0x82, 0x9c, 0x82, 0x57, 0x73, 0xcf, 0xfa, 0x99, 0x47, 0x88,
+	0xa4, 0x0a, 0x48, 0x08, 0x35, 0x19, 0x26, 0x9f, 0x54, 0x70, 0x2a, 0x43, 0x23, 0xdf, 0x51, 0x93,
+	0xd7, 0x7a, 0xc9, 0x49, 0x33, 0x8e, 0x15, 0x53, 0xe0, 0x78, 0x26, 0x4b, 0x16, 0xa0, 0x8d, 0x43,
+	0x2b, 0x56, 0x97, 0x34, 0xdb, 0xd3, 0xe3, 0xf1, 0xab, 0x52, 0x9a, 0x2a, 0x1c, 0x0e, 0xec, 0x7d,
+	0x27, 0x70, 0x79, 0xae, 0xa4, 0x0d, 0xbf, 0x3c, 0x9e, 0x64, 0x31, 0x16, 0xa7, 0x09, 0x92, 0xdc,
+	0x82, 0x69, 0x97, 0x29, 0x3f, 0x81, 0x34, 0xea, 0x57, 0xc6, 0x13, 0x2d, 0x25, 0x00, 0xaa, 0xa3,
+	0x31, 0x4f, 0xf1, 0xda, 0x30, 0x2c, 0x9d, 0x6c, 0x70, 0xaa, 0xe4, 0x44, 0x6f, 0x82, 0xb4, 0x5f,
+	0x82, 0xa3, 0x46, 0xbb, 0x7d, 0xac, 0xb3, 0x0e, 0xbd, 0x2d, 0x05, 0xcf, 0x25, 0xb5, 0x44, 0x79,
+	0xdd, 0x9c, 0x76, 0x14, 0xae, 0x48, 0x24, 0xf0, 0x36, 0xb4, 0xe3, 0x86, 0x21, 0xd7, 0xcd, 0x3c,
+	0xbc, 0x5a, 0x9e, 0x07, 0xd5, 0xa6, 0x92, 0x6d, 0x0d, 0x3a, 0xaa, 0x85, 0xd0, 0xb1, 0xa0, 0xd3,
+	0xbd, 0x56, 0x4e, 0x97, 0xb4, 0xae, 0xe4, 0xa3, 0x30, 0xad, 0x35, 0x14, 0x59, 0x34, 0x19, 0x5f,
+	0x2f, 0x67, 0xd4, 0x9b, 0x39, 0x99, 0xf5, 0xa8, 0x16, 0xd3, 0x5b, 0xa5, 0x96, 0xb4, 0xca, 0x1f,
+	0xb7, 0xa0, 0xad, 0x0e, 0xd8, 0xe5, 0xac, 0x31, 0xf7, 0x82, 0x41, 0xe9, 0x1a, 0x33, 0xc6, 0xf7,
+	0xee, 0x07, 0x03, 0x8a, 0x08, 0x6c, 0xe2, 0xc8, 0x8b, 0x94, 0xa9, 0xbe, 0x5c, 0x0e, 0xbd, 0x87,
+	0xe2, 0x54, 0xa0, 0xc8, 0x5d, 0x53, 0xcb, 0xeb, 0x63, 0x0e, 0x60, 0x18, 0x24, 0x85, 0x9a, 0xbe,
+	0x0a, 0x1d, 0x0f, 0xa7, 0x7e, 0x2b, 0xc9, 0xc8, 0xfb, 0x5a, 0x39, 0xdd, 0x6a, 0x0c, 0xa1, 0x09,
+	0x1a, 0xf3, 0xb6, 0xe5, 0xec, 0xa3, 0x5d, 0x73, 0xb2, 0xe6, 0xa4, 0x79, 0xbb, 0x91, 0x80, 0xa8,
+	0xce, 0x40, 0x2e, 0xcb, 0xb9, 0x4b, 0xab, 0xa4, 0x67, 0x49, 0xaa, 0x2a, 0x99, 0xbf, 0xbc, 0x9f,
+	0x19, 0x69, 0x85, 0x19, 0xbf, 0x31, 0x01, 0xcb, 0xd8, 0xd1, 0x16, 0x5b, 0x50, 0xcc, 0x8c, 0x3a,
+	0x93, 0xb6, 0xa0, 0x3e, 0x3b, 0x42, 0x27, 0xc3, 0xfd, 0x60, 0x50, 0x3c, 0x56, 0xf3, 0xe6, 0x2e,
+	0xf8, 0xfc, 0x82, 0x69, 0x09, 0xc5, 0x13, 0x7a, 0xd5, 0x26, 0x85, 0x3c, 0x5a, 0xa5, 0x17, 0x08,
+	0x5d, 0x91, 0x03, 0xfa, 0x5b, 0xa6, 0xbd, 0x3d, 0x97, 0xb2, 0x37, 0xb4, 0xb0, 0xf5, 0x80, 0x89,
+	0x33, 0x46, 0xda, 0x48, 0x3e, 0xe9, 0x38, 0x79, 0x33, 0x9e, 0x7f, 0x1c, 0xaa, 0xa7, 0x48, 0xd7,
+	0xad, 0xe0, 0xfa, 0x66, 0x05, 0xda, 0xea, 0xfc, 0x64, 0xd6, 0x3b, 0xdf, 0xf6, 0xc2, 0x15, 0xe6,
+	0xe0, 0x99, 0x41, 0x61, 0xb7, 0xaf, 0x96, 0x1e, 0xcc, 0xec, 0xad, 0x4a, 0x04, 0x55, 0x58, 0xfb,
+	0x14, 0xb4, 0xe3, 0xd8, 0x82, 0x45, 0xd9, 0x0f, 0xab, 0xd0, 0x94, 0x27, 0x2f, 0xd3, 0x99, 0xb8,
+	0x0a, 0xcd, 0x81, 0x73, 0xe0, 0xef, 0xc5, 0x4b, 0xa6, 0xd3, 0x25, 0x87, 0x39, 0x7b, 0xb7, 0xb9,
+	0x34, 0x95, 0x28, 0xf2, 0x49, 0x68, 0x0c, 0xf0, 0x48, 0x82, 0x55, 0x2b, 0xe9, 0x79, 0x62, 0x38,
+	0x0a, 0x53, 0x81, 0xc1, 0xc4, 0xf9, 0x81, 0xab, 0xf8, 0xb8, 0x7c, 0x69, 0xe2, 0xef, 0x71, 0x69,
+	0x2a, 0x51, 0xf6, 0x4d, 0x68, 0x8a, 0xec, 0x1c, 0x6e, 0x90, 0x30, 0x4b, 0x92, 0x68, 0x3a, 0xcf,
+	0x5b, 0xc1, 0xac, 0xf4, 0x24, 0x34, 0x45, 0xe2, 0x05, 0x5a, 0xf3, 0x83, 0xa7, 0xf9, 0x7a, 0x67,
+	0x60, 0xdf, 0x4e, 0x36, 0x2a, 0x3f, 0xfa, 0x5e, 0x86, 0x7d, 0x0f, 0x8e, 0xa1, 0x73, 0x7b, 0xd3,
+	0x09, 0x19, 0x65, 0x7d, 0x3f, 0x70, 0x73, 0x59, 0x03, 0xf1, 0x49, 0x7a, 0xa8, 0x8b, 0x59, 0xa5,
+	0xdc, 0xcf, 0x5c, 0x87, 0xff, 0x73, 0x5c, 0x87, 0x7f, 0x52, 0x2f, 0xf0, 0xe7, 0x4d, 0xe2, 0xc9,
+	0x40, 0x85, 0xcb, 0x38, 0xf4, 0x2e, 0x9b, 0x73, 0xef, 0x17, 0x4b, 0x90, 0xc6, 0xe4, 0xfb, 0xb2,
+	0xe9, 0xd1, 0x2b, 0xc3, 0x1a, 0x2e, 0xbd, 0xeb, 0x69, 0x97, 0xde, 0xe9, 0x12, 0x74, 0xc6, 0xa7,
+	0x77, 0xd9, 0xf4, 0xe9, 0x95, 0xa5, 0xae, 0x3b, 0xf5, 0xfe, 0x8f, 0xb9, 0xd1, 0x7e, 0xb3, 0xc0,
+	0xed, 0xf3, 0x09, 0xd3, 0xed, 0x33, 0x46, 0x6b, 0x7e, 0x5a, 0x7e, 0x9f, 0xdf, 0x6a, 0x16, 0xf8,
+	0x7d, 0x2e, 0x19, 0x7e, 0x9f, 0x31, 0x39, 0x4b, 0x3b, 0x7e, 0x2e, 0x9b, 0x8e, 0x9f, 0x17, 0x4b,
+	0x90, 0x86, 0xe7, 0xe7, 0x92, 0xe1, 0xf9, 0x29, 0x4b, 0x54, 0x73, 0xfd, 0x5c, 0x32, 0x5c, 0x3f,
+	0x65, 0x40, 0xcd, 0xf7, 0x73, 0xc9, 0xf0, 0xfd, 0x94, 0x01, 0x35, 0xe7, 0xcf, 0x25, 0xc3, 0xf9,
+	0x53, 0x06, 0xd4, 0xbc, 0x3f, 0x97, 0x4d, 0xef, 0x4f, 0x79, 0xfd, 0x68, 0x8d, 0xfe, 0x33, 0x47,
+	0xcd, 0x7f, 0xa1, 0xa3, 0xe6, 0x57, 0x6a, 0x05, 0x0e, 0x18, 0x9a, 0xef, 0x80, 0x39, 0x5b, 0xdc,
+	0x92, 0xe5, 0x1e, 0x98, 0xc9, 0x47, 0x81, 0xac, 0x0b, 0xe6, 0x4a, 0xca, 0x05, 0xf3, 0x52, 0x09,
+	0xd8, 0xf4, 0xc1, 0xfc, 0xaf, 0x71, 0x32, 0xfc, 0x61, 0x73, 0xcc, 0x7a, 0xfa, 0x6d, 0x7d, 0x3d,
+	0x3d, 0x66, 0x24, 0xcb, 0x2e, 0xa8, 0xaf, 0x9a, 0x0b, 0xea, 0x33, 0x13, 0x60, 0x8d, 0x15, 0xf5,
+	0x7a, 0xde, 0x8a, 0xba, 0x37, 0x01, 0x4b, 0xe1, 0x92, 0xfa, 0x66, 0x76, 0x49, 0x7d, 0x76, 0x02,
+	0xbe, 0xdc, 0x35, 0xf5, 0x7a, 0xde, 0x9a, 0x7a, 0x92, 0xdc, 0x15, 0x2e, 0xaa, 0x3f, 0x69, 0x2c,
+	0xaa, 0x5f, 0x9e, 0xa4, 0xba, 0x92, 0xc1, 0xe1, 0x33, 0x05, 0xab, 0xea, 0x37, 0x27, 0xa1, 0x19,
+	0xef, 0xc4, 0xfe, 0xd9, 0xba, 0xd8, 0x4c, 0xe6, 0x0f, 0x9e, 0x83, 0x76, 0x7c, 0xd0, 0xc6, 0xfe,
+	0x22, 0xb4, 0xe2, 0xeb, 0x76, 0x69, 0xcb, 0x79, 0x52, 0x2d, 0xea, 0xc4, 0xec, 0x59, 0x86, 0xc8,
+	0x55, 0xa8, 0xe3, 0x2f, 0x69, 0x16, 0xaf, 0x4e, 0x76, 0xa0, 0x07, 0x13, 0xa1, 0x1c, 0x67, 0xff,
+	0xeb, 0x71, 0x00, 0xed, 0x16, 0xd2, 0xa4, 0xc9, 0xbe, 0x8b, 0x9d, 0xd9, 0x20, 0x62, 0x01, 0x3f,
+	0xc8, 0x55, 0x7a, 0x4b, 0x27, 0x49, 0x01, 0xb5, 0x25, 0x62, 0x01, 0x95, 0x70, 0x72, 0x07, 0x0f,
+	0x1a, 0xca, 0x0b, 0xa4, 0xf5, 0x53, 0xb5, 0x42, 0x25, 0xcb, 0xa3, 0x8a, 0x5d, 0x7b, 0x54, 0x51,
+	0x90, 0x79, 0xa8, 0x87, 0x7e, 0x10, 0xf1, 0x73, 0x89, 0xa5, 0x57, 0x96, 0x34, 0xaa, 0x0d, 0x3f,
+	0x88, 0x28, 0x87, 0x8a, 0xa2, 0x69, 0x97, 0xbc, 0x0f, 0x53, 0x34, 0xa3, 0xc7, 0xfe, 0x97, 0x9a,
+	0xea, 0x43, 0x17, 0xa5, 0x35, 0x0a, 0x1d, 0x3a, 0x37, 0x79, 0x2b, 0xe9, 0x56, 0x49, 0xe4, 0x24,
+	0x48, 0xb4, 0x04, 0xff, 0x4d, 0x5e, 0x85, 0x6e, 0xdf, 0xdf, 0x67, 0x01, 0x4d, 0x8e, 0x38, 0xc9,
+	0x53, 0x68, 0x99, 0x78, 0x3c, 0xce, 0xb3, 0xe3, 0xb9, 0x6c, 0xb5, 0x2f, 0xfb, 0xbf, 0x36, 0x55,
+	0x61, 0x72, 0x0b, 0xda, 0xdc, 0xc7, 0x1e, 0x7b, 0xf8, 0x0f, 0x97, 0x49, 0xe1, 0xea, 0x8f, 0x09,
+	0x30, 0x21, 0x9e, 0xf8, 0x0d, 0x2f, 0xe2, 0x75, 0xd8, 0xa6, 0x2a, 0x8c, 0x19, 0xe6, 0xe7, 0xc8,
+	0xf4, 0x0c, 0xb7, 0x44, 0x86, 0xd3, 0xf1, 0xe4, 0x02, 0x3c, 0xc1, 0xe3, 0x52, 0x4b, 0x4c, 0xe1,
+	0xaa, 0x6f, 0xd3, 0xfc, 0x8f, 0xfc, 0xdc, 0x9c, 0xb3, 0x2d, 0xae, 0x74, 0x70, 0xe7, 0x5d, 0x83,
+	0x26, 0x11, 0x78, 0x9c, 0xd4, 0x65, 0x5b, 0xce, 0xde, 0x20, 0xba, 0xc7, 0x76, 0x47, 0x03, 0x27,
+	0xc2, 0xe3, 0xce, 0xc0, 0x33, 0x90, 0xfd, 0x40, 0xde, 0x80, 0xc7, 0x65, 0xa4, 0x30, 0x63, 0x6c,
+	0x8d, 0x55, 0x97, 0x5f, 0xbb, 0xee, 0xd0, 0xbc, 0x4f, 0xf6, 0x0f, 0xea, 0xd8, 0xe8, 0x5c, 0xb5,
+	0xdf, 0x85, 0x9a, 0xe3, 0xba, 0x72, 0xd8, 0x3c, 0x7f, 0x48, 0x03, 0x91, 0xf7, 0x04, 0x90, 0x81,
+	0xac, 0xab, 0x23, 0x77, 0x62, 0xe0, 0xbc, 0x78, 0x58, 0x2e, 0xf5, 0xfc, 0x85, 0xe4, 0x41, 0xc6,
+	0x3d, 0x2e, 0x61, 0xd5, 0x7e, 0x32, 0x46, 0x75, 0x19, 0x41, 0xf2, 0x90, 0x9b, 0x50, 0xe7, 0x39,
+	0x14, 0x03, 0xeb, 0x85, 0xc3, 0xf2, 0xdd, 0x11, 0xf9, 0xe3, 0x1c, 0x76, 0x5f, 0x9c, 0x7d, 0xd3,
+	0x0e, 0x5c, 0x56, 0xcc, 0x03, 0x97, 0x0b, 0xd0, 0xf0, 0x22, 0xb6, 0x9b, 0x3d, 0x7f, 0x3b, 0x56,
+	0x55, 0x65, 0xcf, 0x23, 0xa0, 0x63, 0xcf, 0x01, 0x7e, 0x50, 0x78, 0x53, 0xe0, 0x3a, 0xd4, 0x11,
+	0x9e, 0x99, 0x4b, 0x4e, 0x92, 0x30, 0x47, 0xda, 0x73, 0x50, 0xc7, 0xc2, 0x8e, 0x29, 0x9d, 0xcc,
+	0x4f, 0x55, 0xe5, 0x67, 0x61, 0x1a, 0x3a, 0xfe, 0x88, 0x05, 0xdc, 0x30, 0xec, 0x7f, 0xae, 0x6b,
+	0x87, 0xe2, 0x56, 0x75, 0x1d, 0x7b, 0xeb, 0xd0, 0x3d, 0xa7, 0xae, 0x65, 0x34, 0xa5, 0x65, 0x6f,
+	0x1f, 0x9e, 0x2d, 0xa3, 0x67, 0x34, 0xa5, 0x67, 0x3f, 0x01, 0x67, 0x46, 0xd3, 0x6e, 0x1b, 0x9a,
+	0x76, 0xf1, 0xf0, 0x8c, 0x86, 0xae, 0xb1, 0x32, 0x5d, 0x5b, 0x32, 0x75, 0xad, 0x37, 0x59, 0x93,
+	0xab, 0xa1, 0x69, 0x02, 0x6d, 0xfb, 0x5c, 0xa1, 0xb6, 0x2d, 0x18, 0xda, 0x76, 0xd8, 0xa4, 0x3f,
+	0x26, 0x7d, 0xfb, 0x5e, 0x1d, 0xea, 0x38, 0x3c, 0x92, 0x65, 0x5d, 0xd7, 0xde, 0x3c, 0xd4, 0xd0,
+	0xaa, 0xeb, 0xd9, 0x5a, 0x4a, 0xcf, 0x2e, 0x1c, 0x8e, 0x29, 0xa3, 0x63, 0x6b, 0x29, 0x1d, 0x3b,
+	0x24, 0x5f, 0x46, 0xbf, 0x56, 0x0c, 0xfd, 0x9a, 0x3b, 0x1c, 0x9b, 0xa1, 0x5b, 0x4e, 0x99, 0x6e,
+	0x5d, 0x37, 0x75, 0x6b, 0xc2, 0xd9, 0x1b, 0x26, 0x34, 0x89, 0x5e, 0xbd, 0x5f, 0xa8, 0x57, 0x57,
+	0x0d, 0xbd, 0x3a, 0x4c, 0xb2, 0x1f, 0x93, 0x4e, 0x5d, 0x10, 0x93, 0xce, 0xfc, 0x8b, 0x5a, 0x45,
+	0x93, 0x4e, 0xfb, 0x2d, 0xe8, 0x24, 0xcf, 0x38, 0xe4, 0x1c, 0xcf, 0x17, 0x62, 0x71, 0xaa, 0x71,
+	0xd0, 0x3e, 0x0f, 0x9d, 0xe4, 0x69, 0x86, 0x9c, 0xb4, 0x42, 0xfe, 0x51, 0xa2, 0x64, 0xc8, 0x5e,
+	0x86, 0xc7, 0xb2, 0x17, 0xc7, 0x73, 0xfc, 0xf0, 0xda, 0xd9, 0x72, 0x99, 0x5b, 0x3d, 0xca, 0x7e,
+	0x08, 0x33, 0xa9, 0xab, 0xe0, 0x87, 0xe6, 0x20, 0xe7, 0xb5, 0x29, 0x72, 0x2d, 0x75, 0xb1, 0xd0,
+	0x3c, 0x2d, 0x9f, 0x4c, 0x84, 0xed, 0x25, 0x98, 0x29, 0xc9, 0xfc, 0x24, 0x87, 0xe5, 0x3f, 0x0f,
+	0xd3, 0xe3, 0xf2, 0xfe, 0x31, 0x1c, 0xe6, 0x8f, 0xa0, 0x9b, 0x79, 0xc6, 0x22, 0x9d, 0xcc, 0x3a,
+	0xc0, 0xb6, 0x92, 0xb1, 0xaa, 0xa9, 0x0d, 0xde, 0xf2, 0xab, 0x0b, 0x1c, 0x47, 0x35, 0x0e, 0xfb,
+	0xf7, 0x2a, 0xf0, 0x58, 0xf6, 0x0d, 0x8b, 0x49, 0x17, 0x3f, 0x16, 0xb4, 0x38, 0x97, 0xba, 0xf1,
+	0x11, 0x07, 0xc9, 0x1d, 0x38, 0x12, 0x0e, 0xbc, 0x3e, 0x5b, 0xdc, 0xc1, 0x63, 0xec, 0xa1, 0x5c,
+	0xd1, 0x94, 0xbc, 0x43, 0xb1, 0x91, 0x20, 0xa8, 0x01, 0xb7, 0x1f, 0xc2, 0xb4, 0xf6, 0x91, 0xbc,
+	0x03, 0x55, 0x7f, 0x94, 0x39, 0xd7, 0x58, 0xcc, 0x79, 0x37, 0xb6, 0x37, 0x5a, 0xf5, 0x47, 0x59,
+	0x93, 0xd4, 0xcd, 0xb7, 0x66, 0x98, 0xaf, 0x7d, 0x0b, 0x1e, 0xcb, 0x3e, 0x13, 0x91, 0xae, 0x9e,
+	0xd3, 0x19, 0x2f, 0x81, 0xa8, 0xa6, 0x54, 0xac, 0x7d, 0x09, 0x8e, 0xa5, 0x1f, 0x7f, 0xc8, 0xb9,
+	0x8d, 0x93, 0x5c, 0x6a, 0x8a, 0xdd, 0xf5, 0xb3, 0xbf, 0x5c, 0x81, 0x19, 0xb3, 0x20, 0xe4, 0x49,
+	0x20, 0x66, 0xcc, 0x9a, 0x3f, 0x64, 0xdd, 0x29, 0xf2, 0x04, 0x3c, 0x66, 0xc6, 0xcf, 0xbb, 0x6e,
+	0xb7, 0x92, 0x15, 0xc7, 0x6e, 0xab, 0x5b, 0x25, 0x16, 0x1c, 0x4f, 0xd5, 0x10, 0xef, 0x44, 0xbb,
+	0x35, 0xf2, 0x34, 0x3c, 0x91, 0xfe, 0x32, 0x1a, 0x38, 0x7d, 0xd6, 0xad, 0xdb, 0x3f, 0xae, 0x42,
+	0x1d, 0xdf, 0x2b, 0xb0, 0xff, 0xb1, 0x1a, 0xdf, 0xd2, 0x78, 0x1b, 0xea, 0xfc, 0x5d, 0x06, 0xed,
+	0xe6, 0x65, 0x25, 0x75, 0xf3, 0xd2, 0xb8, 0xbd, 0x97, 0xdc, 0xbc, 0x7c, 0x1b, 0xea, 0xfc, 0x25,
+	0x86, 0xc3, 0x23, 0xbf, 0x51, 0x81, 0x4e, 0xf2, 0x2a, 0xc2, 0xa1, 0xf1, 0xfa, 0xad, 0x90, 0xaa,
+	0x79, 0x2b, 0xe4, 0x55, 0x68, 0x04, 0x48, 0x2a, 0x7b, 0x99, 0xf4, 0x5d, 0x13, 0x9e, 0x20, 0x15,
+	0x22, 0x36, 0x83, 0x69, 0xfd, 0xcd, 0x87, 0xc3, 0x67, 0xe3, 0x45, 0xf9, 0xe0, 0xd3, 0xaa, 0x1b,
+	0xce, 0x07, 0x81, 0x73, 0x20, 0x15, 0xd3, 0x8c, 0x44, 0xdf, 0x2f, 0xbe, 0xec, 0x90, 0x7f, 0xe1,
+	0xd5, 0xfe, 0xd3, 0x0a, 0xb4, 0xe4, 0xe1, 0x5d, 0xfb, 0x12, 0xd4, 0xf0, 0xf1, 0x86, 0x37, 0xa0,
+	0x25, 0x8f, 0x0d, 0x67, 0x32, 0x72, 0x87, 0x97, 0x42, 0xca, 0xd3, 0x58, 0xcc, 0xbe, 0xac, 0x86,
+	0xc9, 0xc3, 0x63, 0xdf, 0x86, 0x3a, 0x7f, 0xaa, 0xe1, 0xf0, 0xc8, 0x3f, 0x6b, 0x43, 0x53, 0xdc,
+	0x1a, 0xb5, 0xff, 0xa8, 0x0d, 0x4d, 0xf1, 0x7c, 0x03, 0xb9, 0x0a, 0xad, 0x70, 0x6f, 0x77, 0xd7,
+	0x09, 0x0e, 0xac, 0xfc, 0x47, 0x49, 0x8d, 0xd7, 0x1e, 0x7a, 0x1b, 0x42, 0x96, 0xc6, 0x20, 0xf2,
+	0x16, 0xd4, 0xfb, 0xce, 0x16, 0xcb, 0x6c, 0xe7, 0xe6, 0x81, 0x17, 0x9d, 0x2d, 0x46, 0xb9, 0x38,
+	0xb9, 0x0e, 0x6d, 0xd9, 0x2c, 0xa1, 0xf4, 0xe7, 0x8c, 0x4f, 0x37, 0x6e, 0x4c, 0x85, 0xb2, 0x6f,
+	0x42, 0x4b, 0x66, 0x86, 0x5c, 0x53, 0x77, 0x66, 0xd3, 0x9e, 0xe7, 0xdc, 0x22, 0xa8, 0x7b, 0xfd,
+	0xea, 0xf6, 0xec, 0x5f, 0xe0, 0x8d, 0x71, 0xcc, 0xd6, 0x47, 0x65, 0x22, 0x27, 0x01, 0x06, 0x4e,
+	0x18, 0xad, 0xef, 0x0d, 0x06, 0xcc, 0x95, 0x37, 0xec, 0xb4, 0x18, 0xdc, 0x9b, 0x16, 0xa1, 0x70,
+	0x67, 0x63, 0xaf, 0xdf, 0x67, 0xea, 0x66, 0x69, 0x3a, 0x1a, 0x4f, 0xad, 0xf0, 0x07, 0x05, 0xe5,
+	0xac, 0xf0, 0xb5, 0xd2, 0x9a, 0xc5, 0x07, 0x49, 0x64, 0x6e, 0x04, 0xd2, 0xf6, 0xa1, 0xa3, 0xe2,
+	0xd0, 0x08, 0x47, 0xde, 0x70, 0x88, 0xef, 0x99, 0x08, 0x8d, 0x8e, 0x83, 0x38, 0xe8, 0xe0, 0x4f,
+	0x99, 0xdf, 0x06, 0x95, 0x21, 0x8c, 0xdf, 0x72, 0xbc, 0x81, 0xcc, 0x62, 0x83, 0xca, 0x10, 0x32,
+	0xed, 0xc9, 0x47, 0x2f, 0xea, 0xbc, 0x80, 0x71, 0xd0, 0xfe, 0xb0, 0xa2, 0x2e, 0x8e, 0xe7, 0x5d,
+	0xce, 0xcc, 0xf8, 0x92, 0x4e, 0xe8, 0x0e, 0x6d, 0x31, 0x20, 0x24, 0x11, 0x98, 0xbe, 0x3f, 0x1c,
+	0x78, 0x43, 0x26, 0x7d, 0x47, 0x32, 0x94, 0xaa, 0xe3, 0x46, 0xa6, 0x8e, 0xe5, 0xf7, 0x65, 0xd7,
+	0xc3, 0x2c, 0x36, 0x93, 0xef, 0x22, 0x86, 0x5c, 0xc1, 0xe3, 0x1b, 0xfb, 0x5e, 0x9f, 0xe1, 0x23,
+	0x88, 0xb5, 0x9c, 0x4d, 0x3a, 0xb3, 0x6e, 0x97, 0xb8, 0x2c, 0x8d, 0x31, 0x76, 0x84, 0xb7, 0xd5,
+	0xf0, 0xa7, 0x2a, 0x52, 0x45, 0x2b, 0x52, 0x92, 0xe9, 0xea, 0x98, 0x4c, 0xd7, 0x4a, 0x32, 0x5d,
+	0x4f, 0x67, 0x7a, 0xf6, 0x2b, 0x00, 0x89, 0xba, 0x91, 0x69, 0x68, 0xdd, 0x1f, 0x3e, 0x18, 0xfa,
+	0x0f, 0x87, 0xdd, 0x29, 0x0c, 0xdc, 0xdd, 0xda, 0xc2, 0x54, 0xba, 0x15, 0x0c, 0xa0, 0x9c, 0x37,
+	0xdc, 0xee, 0x56, 0x09, 0x40, 0x13, 0x03, 0xcc, 0xed, 0xd6, 0xf0, 0xf7, 0x0d, 0xde, 0x7e, 0xdd,
+	0x3a, 0x79, 0x0a, 0x1e, 0x5f, 0x1d, 0xf6, 0xfd, 0xdd, 0x91, 0x13, 0x79, 0x9b, 0x03, 0xbc, 0xcb,
+	0x1c, 0x7a, 0xfe, 0xb0, 0xdb, 0xc0, 0xd1, 0x6b, 0x8d, 0x45, 0x0f, 0xfd, 0xe0, 0xc1, 0x1a, 0x63,
+	0xae, 0x7c, 0xab, 0xa2, 0xdb, 0xb4, 0xff, 0xa3, 0x22, 0x76, 0x83, 0xed, 0xeb, 0x70, 0xc4, 0x78,
+	0x9d, 0xc5, 0x4a, 0xde, 0x8a, 0x4e, 0x3d, 0x15, 0xfd, 0x24, 0xf7, 0xd7, 0xb2, 0x64, 0x2a, 0x23,
+	0x42, 0xf6, 0x0d, 0x00, 0xed, 0x4d, 0x96, 0x93, 0x00, 0x9b, 0x07, 0x11, 0x0b, 0x79, 0x88, 0x53,
+	0xd4, 0xa9, 0x16, 0xa3, 0xf3, 0x57, 0x0d, 0x7e, 0xfb, 0x22, 0x80, 0xf6, 0x22, 0x0b, 0xda, 0x15,
+	0x86, 0x16, 0xd2, 0x64, 0xe9, 0x68, 0xbb, 0x27, 0x4b, 0x10, 0xbf, 0xbd, 0x12, 0xe7, 0x80, 0x47,
+	0x1a, 0x39, 0xe0, 0x31, 0xf6, 0x32, 0x40, 0xf2, 0xfc, 0x08, 0x6e, 0x52, 0xc9, 0xae, 0xfb, 0x75,
+	0xa8, 0xbb, 0x4e, 0xe4, 0xc8, 0x5e, 0xf3, 0xe9, 0xd4, 0xc8, 0x95, 0x40, 0x28, 0x17, 0xb3, 0x7f,
+	0xb7, 0x02, 0x47, 0xf4, 0xa7, 0x56, 0xec, 0x77, 0xa1, 0xce, 0xdf, 0x6a, 0xb9, 0x06, 0x47, 0xf4,
+	0xb7, 0x56, 0x32, 0x6f, 0x6a, 0x0b, 0x3e, 0x1d, 0x4a, 0x0d, 0x80, 0xbd, 0xaa, 0xb2, 0xf4, 0x91,
+	0xa9, 0xde, 0x80, 0x96, 0x7c, 0xba, 0xc5, 0x7e, 0x09, 0x3a, 0xc9, 0x4b, 0x2d, 0xd8, 0x77, 0x88,
+	0xf8, 0xb8, 0x95, 0x65, 0xd0, 0xfe, 0xa7, 0x1a, 0x34, 0x78, 0x73, 0xda, 0x5f, 0xab, 0xea, 0x1a,
+	0x6a, 0xff, 0xb8, 0x52, 0xb8, 0x16, 0x3c, 0x6f, 0x3c, 0x71, 0x30, 0x93, 0x79, 0xa1, 0x48, 0x3e,
+	0xcc, 0x62, 0x76, 0xac, 0x17, 0xa1, 0x35, 0x14, 0x9a, 0x29, 0x5f, 0x18, 0x38, 0x91, 0x8b, 0x92,
+	0xda, 0x4b, 0x63, 0x61, 0x72, 0x01, 0x1a, 0x2c, 0x08, 0xfc, 0x80, 0x9b, 0xd4, 0xcc, 0xdc, 0xc9,
+	0x5c, 0x14, 0xe6, 0x7b, 0x19, 0xa5, 0xa8, 0x10, 0x46, 0x3f, 0x70, 0x28, 0xac, 0x48, 0xcc, 0x29,
+	0x43, 0x79, 0xaf, 0x5a, 0xf6, 0x36, 0xf9, 0x1f, 0x67, 0x3f, 0x15, 0x0f, 0xb0, 0x9a, 0xe1, 0x4d,
+	0xe9, 0x16, 0x59, 0x21, 0x1d, 0x68, 0xf0, 0x84, 0xba, 0x55, 0xdd, 0x6c, 0x6b, 0x05, 0x86, 0x57,
+	0x9f, 0x3d, 0x0f, 0x2d, 0x19, 0x8f, 0xf2, 0xf3, 0x22, 0xef, 0xdd, 0x29, 0x72, 0x04, 0xda, 0x1b,
+	0x6c, 0xb0, 0xb5, 0xe2, 0x87, 0x51, 0xb7, 0x42, 0x8e, 0x42, 0x87, 0xdb, 0x02, 0x3e, 0x96, 0xd0,
+	0xad, 0xce, 0xbe, 0x0f, 0x1d, 0x55, 0x22, 0xd2, 0x86, 0xfa, 0xda, 0xde, 0x60, 0xd0, 0x9d, 0xe2,
+	0x53, 0xd3, 0xc8, 0x0f, 0x62, 0xc7, 0xf4, 0xf2, 0x23, 0x1c, 0x67, 0xba, 0x95, 0xa2, 0xde, 0xa0,
+	0x4a, 0xba, 0x70, 0x44, 0x26, 0x2e, 0xf2, 0x5c, 0xb3, 0xbf, 0x5f, 0x81, 0x8e, 0x7a, 0xdd, 0xc6,
+	0xfe, 0x46, 0xd2, 0xc6, 0xc5, 0xfd, 0xc0, 0xa5, 0x54, 0x6b, 0x17, 0x3f, 0x96, 0x93, 0x6a, 0xf1,
+	0xd3, 0x30, 0x23, 0xbb, 0xdc, 0xb8, 0xf2, 0x45, 0xaf, 0x99, 0x8a, 0x9d, 0xbd, 0xa9, 0x6a, 0xbd,
+	0xcb, 0x4d, 0x6c, 0xd1, 0x1f, 0x0e, 0x59, 0x3f, 0xe2, 0x75, 0x7f, 0x0c, 0xa6, 0xd7, 0xfc, 0x68,
+	0xdd, 0x0f, 0x43, 0x2c, 0x99, 0xa8, 0xa9, 0xe4, 0x7b, 0x95, 0xcc, 0x00, 0xc4, 0x67, 0xcd, 0xb0,
+	0x93, 0xb4, 0x7f, 0xa7, 0x02, 0x4d, 0xf1, 0xe6, 0x8e, 0xfd, 0xeb, 0x15, 0x68, 0xca, 0x77, 0x76,
+	0x5e, 0x85, 0x6e, 0xe0, 0xfb, 0x51, 0xb2, 0xa0, 0x58, 0x5d, 0x92, 0xa5, 0xcc, 0xc4, 0xe3, 0x1a,
+	0xd7, 0xd7, 0xb4, 0x42, 0x4e, 0x01, 0x8c, 0x38, 0x72, 0x19, 0x40, 0xbc, 0xe3, 0x83, 0x1e, 0x7c,
+	0xa9, 0xce, 0xe9, 0x23, 0x66, 0x22, 0x17, 0x62, 0x33, 0x46, 0x93, 0x9e, 0xfd, 0x32, 0x1c, 0xa5,
+	0x2c, 0x1c, 0xf9, 0xc3, 0x90, 0xfd, 0xb4, 0xfe, 0xb6, 0x40, 0xe1, 0x5f, 0x09, 0x98, 0xfd, 0x5e,
+	0x03, 0x1a, 0x7c, 0x76, 0x69, 0xff, 0x65, 0x43, 0xcd, 0x83, 0x33, 0xf6, 0x3d, 0xa7, 0x1f, 0xf4,
+	0xd1, 0x0d, 0xd5, 0x98, 0x98, 0x9a, 0x07, 0x7c, 0x3e, 0x09, 0xed, 0x51, 0xe0, 0x6f, 0x07, 0x38,
+	0x9f, 0xad, 0xa7, 0x1e, 0x55, 0x32, 0x61, 0xeb, 0x52, 0x8c, 0x2a, 0x80, 0xae, 0x7c, 0x0d, 0x53,
+	0xf9, 0xae, 0x43, 0xc7, 0x0d, 0xfc, 0x11, 0xbf, 0xa2, 0x6e, 0x35, 0x53, 0x6f, 0x4b, 0x99, 0xbc,
+	0x4b, 0xb1, 0x1c, 0x3e, 0xc4, 0xac, 0x40, 0xa8, 0xbe, 0xa2, 0xf6, 0xad, 0x56, 0xea, 0x3d, 0x16,
+	0x13, 0x2e, 0xda, 0x0b, 0x9d, 0x7a, 0x42, 0x1c, 0x81, 0xec, 0x11, 0x07, 0xb6, 0xc7, 0x02, 0x97,
+	0x1f, 0xc5, 0x40, 0x21, 0x4e, 0xae, 0x40, 0x3b, 0x74, 0xf6, 0x19, 0x26, 0x6f, 0x75, 0xc6, 0x56,
+	0xc5, 0x86, 0x14, 0xc3, 0x07, 0xb0, 0x63, 0x08, 0x16, 0x79, 0xd7, 0xdb, 0x16, 0x2b, 0x49, 0x0b,
+	0xc6, 0x16, 0xf9, 0x4e, 0x2c, 0x87, 0x45, 0x56, 0x20, 0x5c, 0xf9, 0x88, 0x2e, 0x73, 0x5a, 0x6c,
+	0x1b, 0xf3, 0x80, 0x3d, 0x0d, 0x1d, 0x55, 0x45, 0x76, 0x5b, 0x99, 0x49, 0x1b, 0x9a, 0xa2, 0x04,
+	0x36, 0x40, 0x3b, 0xce, 0x10, 0x0a, 0x2b, 0x72, 0x7b, 0x0d, 0xda, 0x71, 0xa3, 0x15, 0x3c, 0x4b,
+	0x41, 0xa0, 0xee, 0xfa, 0x72, 0xca, 0x54, 0xa3, 0xfc, 0x37, 0x36, 0xaa, 0xfe, 0x16, 0x53, 0x47,
+	0xbd, 0x82, 0x34, 0x3b, 0x1f, 0x9f, 0x57, 0xc2, 0xae, 0x4d, 0x2c, 0xc6, 0xa7, 0xa1, 0x45, 0xf7,
+	0xf8, 0x6c, 0xb6, 0x5b, 0x21, 0x6d, 0xb1, 0x44, 0xea, 0x56, 0xb1, 0x97, 0x5c, 0x74, 0x86, 0x7d,
+	0x36, 0xe0, 0x33, 0x20, 0xd5, 0xf7, 0xd6, 0x17, 0x3a, 0x8a, 0x7c, 0xe1, 0xc4, 0x5f, 0x7d, 0x78,
+	0xb2, 0xf2, 0xdd, 0x0f, 0x4f, 0x56, 0x7e, 0xf8, 0xe1, 0xc9, 0xca, 0xaf, 0xfd, 0xe8, 0xe4, 0xd4,
+	0x77, 0x7f, 0x74, 0x72, 0xea, 0x1f, 0x7e, 0x74, 0x72, 0xea, 0x83, 0xea, 0x68, 0x73, 0xb3, 0xc9,
+	0xcf, 0x9c, 0x9c, 0xff, 0xcf, 0x01, 0x00, 0x87, 0x17, 0x84, 0x94, 0x32, 0x64, 0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -14954,6 +14971,25 @@ func (m *EventAccountLinkChallenge) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpaceIds) > 0 {
+		for iNdEx := len(m.SpaceIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SpaceIds[iNdEx])
+			copy(dAtA[i:], m.SpaceIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.SpaceIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Scope != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Scope))
 		i--
@@ -23940,6 +23976,15 @@ func (m *EventAccountLinkChallenge) Size() (n int) {
 	if m.Scope != 0 {
 		n += 1 + sovEvents(uint64(m.Scope))
 	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if len(m.SpaceIds) > 0 {
+		for _, s := range m.SpaceIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -31384,6 +31429,58 @@ func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceIds = append(m.SpaceIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
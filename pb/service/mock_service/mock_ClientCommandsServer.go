@@ -318,6 +318,55 @@ func (_c *MockClientCommandsServer_AccountEnableLocalNetworkSync_Call) RunAndRet
 	return _c
 }
 
+// AccountLocalLinkListApps provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) AccountLocalLinkListApps(_a0 context.Context, _a1 *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccountLocalLinkListApps")
+	}
+
+	var r0 *pb.RpcAccountLocalLinkListAppsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcAccountLocalLinkListAppsResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_AccountLocalLinkListApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccountLocalLinkListApps'
+type MockClientCommandsServer_AccountLocalLinkListApps_Call struct {
+	*mock.Call
+}
+
+// AccountLocalLinkListApps is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcAccountLocalLinkListAppsRequest
+func (_e *MockClientCommandsServer_Expecter) AccountLocalLinkListApps(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_AccountLocalLinkListApps_Call {
+	return &MockClientCommandsServer_AccountLocalLinkListApps_Call{Call: _e.mock.On("AccountLocalLinkListApps", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_AccountLocalLinkListApps_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcAccountLocalLinkListAppsRequest)) *MockClientCommandsServer_AccountLocalLinkListApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcAccountLocalLinkListAppsRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_AccountLocalLinkListApps_Call) Return(_a0 *pb.RpcAccountLocalLinkListAppsResponse) *MockClientCommandsServer_AccountLocalLinkListApps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_AccountLocalLinkListApps_Call) RunAndReturn(run func(context.Context, *pb.RpcAccountLocalLinkListAppsRequest) *pb.RpcAccountLocalLinkListAppsResponse) *MockClientCommandsServer_AccountLocalLinkListApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AccountLocalLinkNewChallenge provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommandsServer) AccountLocalLinkNewChallenge(_a0 context.Context, _a1 *pb.RpcAccountLocalLinkNewChallengeRequest) *pb.RpcAccountLocalLinkNewChallengeResponse {
 	ret := _m.Called(_a0, _a1)
@@ -367,6 +416,55 @@ func (_c *MockClientCommandsServer_AccountLocalLinkNewChallenge_Call) RunAndRetu
 	return _c
 }
 
+// AccountLocalLinkRevokeApp provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) AccountLocalLinkRevokeApp(_a0 context.Context, _a1 *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccountLocalLinkRevokeApp")
+	}
+
+	var r0 *pb.RpcAccountLocalLinkRevokeAppResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcAccountLocalLinkRevokeAppResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_AccountLocalLinkRevokeApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccountLocalLinkRevokeApp'
+type MockClientCommandsServer_AccountLocalLinkRevokeApp_Call struct {
+	*mock.Call
+}
+
+// AccountLocalLinkRevokeApp is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcAccountLocalLinkRevokeAppRequest
+func (_e *MockClientCommandsServer_Expecter) AccountLocalLinkRevokeApp(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_AccountLocalLinkRevokeApp_Call {
+	return &MockClientCommandsServer_AccountLocalLinkRevokeApp_Call{Call: _e.mock.On("AccountLocalLinkRevokeApp", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_AccountLocalLinkRevokeApp_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcAccountLocalLinkRevokeAppRequest)) *MockClientCommandsServer_AccountLocalLinkRevokeApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcAccountLocalLinkRevokeAppRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_AccountLocalLinkRevokeApp_Call) Return(_a0 *pb.RpcAccountLocalLinkRevokeAppResponse) *MockClientCommandsServer_AccountLocalLinkRevokeApp_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_AccountLocalLinkRevokeApp_Call) RunAndReturn(run func(context.Context, *pb.RpcAccountLocalLinkRevokeAppRequest) *pb.RpcAccountLocalLinkRevokeAppResponse) *MockClientCommandsServer_AccountLocalLinkRevokeApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AccountLocalLinkSolveChallenge provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommandsServer) AccountLocalLinkSolveChallenge(_a0 context.Context, _a1 *pb.RpcAccountLocalLinkSolveChallengeRequest) *pb.RpcAccountLocalLinkSolveChallengeResponse {
 	ret := _m.Called(_a0, _a1)
@@ -955,6 +1053,202 @@ func (_c *MockClientCommandsServer_AppShutdown_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// BackupList provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) BackupList(_a0 context.Context, _a1 *pb.RpcBackupListRequest) *pb.RpcBackupListResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupList")
+	}
+
+	var r0 *pb.RpcBackupListResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBackupListRequest) *pb.RpcBackupListResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBackupListResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_BackupList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackupList'
+type MockClientCommandsServer_BackupList_Call struct {
+	*mock.Call
+}
+
+// BackupList is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBackupListRequest
+func (_e *MockClientCommandsServer_Expecter) BackupList(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_BackupList_Call {
+	return &MockClientCommandsServer_BackupList_Call{Call: _e.mock.On("BackupList", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_BackupList_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBackupListRequest)) *MockClientCommandsServer_BackupList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBackupListRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupList_Call) Return(_a0 *pb.RpcBackupListResponse) *MockClientCommandsServer_BackupList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupList_Call) RunAndReturn(run func(context.Context, *pb.RpcBackupListRequest) *pb.RpcBackupListResponse) *MockClientCommandsServer_BackupList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BackupRun provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) BackupRun(_a0 context.Context, _a1 *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupRun")
+	}
+
+	var r0 *pb.RpcBackupRunResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBackupRunResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_BackupRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackupRun'
+type MockClientCommandsServer_BackupRun_Call struct {
+	*mock.Call
+}
+
+// BackupRun is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBackupRunRequest
+func (_e *MockClientCommandsServer_Expecter) BackupRun(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_BackupRun_Call {
+	return &MockClientCommandsServer_BackupRun_Call{Call: _e.mock.On("BackupRun", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_BackupRun_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBackupRunRequest)) *MockClientCommandsServer_BackupRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBackupRunRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupRun_Call) Return(_a0 *pb.RpcBackupRunResponse) *MockClientCommandsServer_BackupRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupRun_Call) RunAndReturn(run func(context.Context, *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse) *MockClientCommandsServer_BackupRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BackupSettingsGet provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) BackupSettingsGet(_a0 context.Context, _a1 *pb.RpcBackupSettingsGetRequest) *pb.RpcBackupSettingsGetResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupSettingsGet")
+	}
+
+	var r0 *pb.RpcBackupSettingsGetResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBackupSettingsGetRequest) *pb.RpcBackupSettingsGetResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBackupSettingsGetResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_BackupSettingsGet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackupSettingsGet'
+type MockClientCommandsServer_BackupSettingsGet_Call struct {
+	*mock.Call
+}
+
+// BackupSettingsGet is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBackupSettingsGetRequest
+func (_e *MockClientCommandsServer_Expecter) BackupSettingsGet(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_BackupSettingsGet_Call {
+	return &MockClientCommandsServer_BackupSettingsGet_Call{Call: _e.mock.On("BackupSettingsGet", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_BackupSettingsGet_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBackupSettingsGetRequest)) *MockClientCommandsServer_BackupSettingsGet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBackupSettingsGetRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupSettingsGet_Call) Return(_a0 *pb.RpcBackupSettingsGetResponse) *MockClientCommandsServer_BackupSettingsGet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupSettingsGet_Call) RunAndReturn(run func(context.Context, *pb.RpcBackupSettingsGetRequest) *pb.RpcBackupSettingsGetResponse) *MockClientCommandsServer_BackupSettingsGet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BackupSettingsSet provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) BackupSettingsSet(_a0 context.Context, _a1 *pb.RpcBackupSettingsSetRequest) *pb.RpcBackupSettingsSetResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupSettingsSet")
+	}
+
+	var r0 *pb.RpcBackupSettingsSetResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBackupSettingsSetRequest) *pb.RpcBackupSettingsSetResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBackupSettingsSetResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_BackupSettingsSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackupSettingsSet'
+type MockClientCommandsServer_BackupSettingsSet_Call struct {
+	*mock.Call
+}
+
+// BackupSettingsSet is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBackupSettingsSetRequest
+func (_e *MockClientCommandsServer_Expecter) BackupSettingsSet(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_BackupSettingsSet_Call {
+	return &MockClientCommandsServer_BackupSettingsSet_Call{Call: _e.mock.On("BackupSettingsSet", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_BackupSettingsSet_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBackupSettingsSetRequest)) *MockClientCommandsServer_BackupSettingsSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBackupSettingsSetRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupSettingsSet_Call) Return(_a0 *pb.RpcBackupSettingsSetResponse) *MockClientCommandsServer_BackupSettingsSet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BackupSettingsSet_Call) RunAndReturn(run func(context.Context, *pb.RpcBackupSettingsSetRequest) *pb.RpcBackupSettingsSetResponse) *MockClientCommandsServer_BackupSettingsSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockBookmarkCreateAndFetch provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommandsServer) BlockBookmarkCreateAndFetch(_a0 context.Context, _a1 *pb.RpcBlockBookmarkCreateAndFetchRequest) *pb.RpcBlockBookmarkCreateAndFetchResponse {
 	ret := _m.Called(_a0, _a1)
@@ -4532,6 +4826,55 @@ func (_c *MockClientCommandsServer_BlockTableSort_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// BlockTableToCsv provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) BlockTableToCsv(_a0 context.Context, _a1 *pb.RpcBlockTableToCsvRequest) *pb.RpcBlockTableToCsvResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlockTableToCsv")
+	}
+
+	var r0 *pb.RpcBlockTableToCsvResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcBlockTableToCsvRequest) *pb.RpcBlockTableToCsvResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcBlockTableToCsvResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_BlockTableToCsv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BlockTableToCsv'
+type MockClientCommandsServer_BlockTableToCsv_Call struct {
+	*mock.Call
+}
+
+// BlockTableToCsv is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcBlockTableToCsvRequest
+func (_e *MockClientCommandsServer_Expecter) BlockTableToCsv(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_BlockTableToCsv_Call {
+	return &MockClientCommandsServer_BlockTableToCsv_Call{Call: _e.mock.On("BlockTableToCsv", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_BlockTableToCsv_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcBlockTableToCsvRequest)) *MockClientCommandsServer_BlockTableToCsv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcBlockTableToCsvRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BlockTableToCsv_Call) Return(_a0 *pb.RpcBlockTableToCsvResponse) *MockClientCommandsServer_BlockTableToCsv_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_BlockTableToCsv_Call) RunAndReturn(run func(context.Context, *pb.RpcBlockTableToCsvRequest) *pb.RpcBlockTableToCsvResponse) *MockClientCommandsServer_BlockTableToCsv_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockTextListClearContent provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommandsServer) BlockTextListClearContent(_a0 context.Context, _a1 *pb.RpcBlockTextListClearContentRequest) *pb.RpcBlockTextListClearContentResponse {
 	ret := _m.Called(_a0, _a1)
@@ -7129,6 +7472,104 @@ func (_c *MockClientCommandsServer_HistoryDiffVersions_Call) RunAndReturn(run fu
 	return _c
 }
 
+// HistoryGetBlockChanges provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) HistoryGetBlockChanges(_a0 context.Context, _a1 *pb.RpcHistoryGetBlockChangesRequest) *pb.RpcHistoryGetBlockChangesResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryGetBlockChanges")
+	}
+
+	var r0 *pb.RpcHistoryGetBlockChangesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcHistoryGetBlockChangesRequest) *pb.RpcHistoryGetBlockChangesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcHistoryGetBlockChangesResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_HistoryGetBlockChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryGetBlockChanges'
+type MockClientCommandsServer_HistoryGetBlockChanges_Call struct {
+	*mock.Call
+}
+
+// HistoryGetBlockChanges is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcHistoryGetBlockChangesRequest
+func (_e *MockClientCommandsServer_Expecter) HistoryGetBlockChanges(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_HistoryGetBlockChanges_Call {
+	return &MockClientCommandsServer_HistoryGetBlockChanges_Call{Call: _e.mock.On("HistoryGetBlockChanges", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_HistoryGetBlockChanges_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcHistoryGetBlockChangesRequest)) *MockClientCommandsServer_HistoryGetBlockChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcHistoryGetBlockChangesRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistoryGetBlockChanges_Call) Return(_a0 *pb.RpcHistoryGetBlockChangesResponse) *MockClientCommandsServer_HistoryGetBlockChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistoryGetBlockChanges_Call) RunAndReturn(run func(context.Context, *pb.RpcHistoryGetBlockChangesRequest) *pb.RpcHistoryGetBlockChangesResponse) *MockClientCommandsServer_HistoryGetBlockChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HistoryGetNamedVersions provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) HistoryGetNamedVersions(_a0 context.Context, _a1 *pb.RpcHistoryGetNamedVersionsRequest) *pb.RpcHistoryGetNamedVersionsResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryGetNamedVersions")
+	}
+
+	var r0 *pb.RpcHistoryGetNamedVersionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcHistoryGetNamedVersionsRequest) *pb.RpcHistoryGetNamedVersionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcHistoryGetNamedVersionsResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_HistoryGetNamedVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryGetNamedVersions'
+type MockClientCommandsServer_HistoryGetNamedVersions_Call struct {
+	*mock.Call
+}
+
+// HistoryGetNamedVersions is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcHistoryGetNamedVersionsRequest
+func (_e *MockClientCommandsServer_Expecter) HistoryGetNamedVersions(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_HistoryGetNamedVersions_Call {
+	return &MockClientCommandsServer_HistoryGetNamedVersions_Call{Call: _e.mock.On("HistoryGetNamedVersions", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_HistoryGetNamedVersions_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcHistoryGetNamedVersionsRequest)) *MockClientCommandsServer_HistoryGetNamedVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcHistoryGetNamedVersionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistoryGetNamedVersions_Call) Return(_a0 *pb.RpcHistoryGetNamedVersionsResponse) *MockClientCommandsServer_HistoryGetNamedVersions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistoryGetNamedVersions_Call) RunAndReturn(run func(context.Context, *pb.RpcHistoryGetNamedVersionsRequest) *pb.RpcHistoryGetNamedVersionsResponse) *MockClientCommandsServer_HistoryGetNamedVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HistoryGetVersions provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommandsServer) HistoryGetVersions(_a0 context.Context, _a1 *pb.RpcHistoryGetVersionsRequest) *pb.RpcHistoryGetVersionsResponse {
 	ret := _m.Called(_a0, _a1)
@@ -7178,6 +7619,55 @@ func (_c *MockClientCommandsServer_HistoryGetVersions_Call) RunAndReturn(run fun
 	return _c
 }
 
+// HistoryRestoreVersion provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) HistoryRestoreVersion(_a0 context.Context, _a1 *pb.RpcHistoryRestoreVersionRequest) *pb.RpcHistoryRestoreVersionResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistoryRestoreVersion")
+	}
+
+	var r0 *pb.RpcHistoryRestoreVersionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcHistoryRestoreVersionRequest) *pb.RpcHistoryRestoreVersionResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcHistoryRestoreVersionResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_HistoryRestoreVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistoryRestoreVersion'
+type MockClientCommandsServer_HistoryRestoreVersion_Call struct {
+	*mock.Call
+}
+
+// HistoryRestoreVersion is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcHistoryRestoreVersionRequest
+func (_e *MockClientCommandsServer_Expecter) HistoryRestoreVersion(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_HistoryRestoreVersion_Call {
+	return &MockClientCommandsServer_HistoryRestoreVersion_Call{Call: _e.mock.On("HistoryRestoreVersion", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_HistoryRestoreVersion_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcHistoryRestoreVersionRequest)) *MockClientCommandsServer_HistoryRestoreVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcHistoryRestoreVersionRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistoryRestoreVersion_Call) Return(_a0 *pb.RpcHistoryRestoreVersionResponse) *MockClientCommandsServer_HistoryRestoreVersion_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistoryRestoreVersion_Call) RunAndReturn(run func(context.Context, *pb.RpcHistoryRestoreVersionRequest) *pb.RpcHistoryRestoreVersionResponse) *MockClientCommandsServer_HistoryRestoreVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HistorySetVersion provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommandsServer) HistorySetVersion(_a0 context.Context, _a1 *pb.RpcHistorySetVersionRequest) *pb.RpcHistorySetVersionResponse {
 	ret := _m.Called(_a0, _a1)
@@ -7227,6 +7717,55 @@ func (_c *MockClientCommandsServer_HistorySetVersion_Call) RunAndReturn(run func
 	return _c
 }
 
+// HistorySetVersionName provides a mock function with given fields: _a0, _a1
+func (_m *MockClientCommandsServer) HistorySetVersionName(_a0 context.Context, _a1 *pb.RpcHistorySetVersionNameRequest) *pb.RpcHistorySetVersionNameResponse {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HistorySetVersionName")
+	}
+
+	var r0 *pb.RpcHistorySetVersionNameResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.RpcHistorySetVersionNameRequest) *pb.RpcHistorySetVersionNameResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.RpcHistorySetVersionNameResponse)
+		}
+	}
+
+	return r0
+}
+
+// MockClientCommandsServer_HistorySetVersionName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HistorySetVersionName'
+type MockClientCommandsServer_HistorySetVersionName_Call struct {
+	*mock.Call
+}
+
+// HistorySetVersionName is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *pb.RpcHistorySetVersionNameRequest
+func (_e *MockClientCommandsServer_Expecter) HistorySetVersionName(_a0 interface{}, _a1 interface{}) *MockClientCommandsServer_HistorySetVersionName_Call {
+	return &MockClientCommandsServer_HistorySetVersionName_Call{Call: _e.mock.On("HistorySetVersionName", _a0, _a1)}
+}
+
+func (_c *MockClientCommandsServer_HistorySetVersionName_Call) Run(run func(_a0 context.Context, _a1 *pb.RpcHistorySetVersionNameRequest)) *MockClientCommandsServer_HistorySetVersionName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*pb.RpcHistorySetVersionNameRequest))
+	})
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistorySetVersionName_Call) Return(_a0 *pb.RpcHistorySetVersionNameResponse) *MockClientCommandsServer_HistorySetVersionName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClientCommandsServer_HistorySetVersionName_Call) RunAndReturn(run func(context.Context, *pb.RpcHistorySetVersionNameRequest) *pb.RpcHistorySetVersionNameResponse) *MockClientCommandsServer_HistorySetVersionName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HistoryShowVersion provides a mock function with given fields: _a0, _a1
 func (_m *MockClientCommandsServer) HistoryShowVersion(_a0 context.Context, _a1 *pb.RpcHistoryShowVersionRequest) *pb.RpcHistoryShowVersionResponse {
 	ret := _m.Called(_a0, _a1)
@@ -187,6 +187,15 @@ func HasRelation(key domain.RelationKey) bool {
 	return exists
 }
 
+func ListRelationsKeys() []domain.RelationKey {
+	var keys []domain.RelationKey
+	for k := range relations {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
 func HasObjectTypeByKey(key domain.TypeKey) bool {
 	_, exists := types[key]
 
@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const RelationChecksum = "7669c498207b7ab4126c830c8fb451b7fbadc8e04d14621a02323768d4b0dffc"
+const RelationChecksum = "e549acbc452b40b8377b69323e7a8a5b03edf396cf2690ee7f535e85fd198c18"
 const (
 	RelationKeyTag                          domain.RelationKey = "tag"
 	RelationKeyCamera                       domain.RelationKey = "camera"
@@ -31,6 +31,7 @@ const (
 	RelationKeyTasks                        domain.RelationKey = "tasks"
 	RelationKeySnippet                      domain.RelationKey = "snippet"
 	RelationKeyRelationFormat               domain.RelationKey = "relationFormat"
+	RelationKeyRelationRemind               domain.RelationKey = "relationRemind"
 	RelationKeyRelationReadonlyValue        domain.RelationKey = "relationReadonlyValue"
 	RelationKeyIconImage                    domain.RelationKey = "iconImage"
 	RelationKeyIngredients                  domain.RelationKey = "ingredients"
@@ -56,6 +57,8 @@ const (
 	RelationKeyAuthor                       domain.RelationKey = "author"
 	RelationKeyArtist                       domain.RelationKey = "artist"
 	RelationKeyDueDate                      domain.RelationKey = "dueDate"
+	RelationKeyRecurrenceRule               domain.RelationKey = "recurrenceRule"
+	RelationKeyRecurrenceSeries             domain.RelationKey = "recurrenceSeries"
 	RelationKeyIconEmoji                    domain.RelationKey = "iconEmoji"
 	RelationKeyCoverType                    domain.RelationKey = "coverType"
 	RelationKeyCoverY                       domain.RelationKey = "coverY"
@@ -1379,6 +1382,32 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRecurrenceRule: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Rule of the recurrence in the RFC 5545 format, like FREQ=WEEKLY;BYDAY=MO. The next occurrence is created when the object is done or its due date passes",
+			Format:           model.RelationFormat_shorttext,
+			Id:               "_brrecurrenceRule",
+			Key:              "recurrenceRule",
+			MaxCount:         1,
+			Name:             "Repeat",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
+		RelationKeyRecurrenceSeries: {
+
+			DataSource:       model.Relation_details,
+			Description:      "The first object of the recurring series",
+			Format:           model.RelationFormat_object,
+			Id:               "_brrecurrenceSeries",
+			Key:              "recurrenceSeries",
+			MaxCount:         1,
+			Name:             "Recurring series",
+			ReadOnly:         true,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyRelationDefaultValue: {
 
 			DataSource:       model.Relation_details,
@@ -1474,6 +1503,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRelationRemind: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Indicates whether values of the date relation remind about the object",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brrelationRemind",
+			Key:              "relationRemind",
+			MaxCount:         1,
+			Name:             "Remind",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyReleasedYear: {
 
 			DataSource:       model.Relation_details,
@@ -6,7 +6,7 @@ package bundle
 
 import domain "github.com/anyproto/anytype-heart/core/domain"
 
-const SystemRelationsChecksum = "bd2a2181f7c85ed01a6b3f0459dbe2e5b9d83cc5ee7e71bab5c4ae2126a4c0c0"
+const SystemRelationsChecksum = "6dcda87320fbb6a103ead03e9817efdad2fc420141ff287ba38db628bac4a62f"
 
 // SystemRelations contains relations that have some special biz logic depends on them in some objects
 // in case EVERY object depend on the relation please add it to RequiredInternalRelations
@@ -27,6 +27,7 @@ var SystemRelations = append(RequiredInternalRelations, []domain.RelationKey{
 	RelationKeyRelationFormat,
 	RelationKeyRelationKey,
 	RelationKeyRelationReadonlyValue,
+	RelationKeyRelationRemind,
 	RelationKeyRelationDefaultValue,
 	RelationKeyRelationMaxCount,
 	RelationKeyRelationOptionColor,
@@ -87,4 +88,6 @@ var SystemRelations = append(RequiredInternalRelations, []domain.RelationKey{
 	RelationKeyRecommendedFeaturedRelations,
 	RelationKeyRecommendedHiddenRelations,
 	RelationKeyRecommendedFileRelations,
+	RelationKeyRecurrenceRule,
+	RelationKeyRecurrenceSeries,
 }...)
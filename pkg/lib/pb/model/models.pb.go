@@ -1986,14 +1986,18 @@ type NotificationActionType int32
 
 const (
 	Notification_CLOSE NotificationActionType = 0
+	// postpones the reminder
+	Notification_SNOOZE NotificationActionType = 1
 )
 
 var NotificationActionType_name = map[int32]string{
 	0: "CLOSE",
+	1: "SNOOZE",
 }
 
 var NotificationActionType_value = map[string]int32{
-	"CLOSE": 0,
+	"CLOSE":  0,
+	"SNOOZE": 1,
 }
 
 func (x NotificationActionType) String() string {
@@ -2041,6 +2045,12 @@ const (
 	Export_DOT        ExportFormat = 3
 	Export_SVG        ExportFormat = 4
 	Export_GRAPH_JSON ExportFormat = 5
+	Export_HTML       ExportFormat = 6
+	// rows of a set or collection view
+	Export_CSV ExportFormat = 7
+	Export_TSV ExportFormat = 8
+	// nested blocks as an outline
+	Export_OPML ExportFormat = 9
 )
 
 var ExportFormat_name = map[int32]string{
@@ -2050,6 +2060,10 @@ var ExportFormat_name = map[int32]string{
 	3: "DOT",
 	4: "SVG",
 	5: "GRAPH_JSON",
+	6: "HTML",
+	7: "CSV",
+	8: "TSV",
+	9: "OPML",
 }
 
 var ExportFormat_value = map[string]int32{
@@ -2059,6 +2073,10 @@ var ExportFormat_value = map[string]int32{
 	"DOT":        3,
 	"SVG":        4,
 	"GRAPH_JSON": 5,
+	"HTML":       6,
+	"CSV":        7,
+	"TSV":        8,
+	"OPML":       9,
 }
 
 func (x ExportFormat) String() string {
@@ -2079,16 +2097,26 @@ const (
 	Import_Html     ImportType = 4
 	Import_Txt      ImportType = 5
 	Import_Csv      ImportType = 6
+	Import_Obsidian ImportType = 7
+	Import_Enex     ImportType = 8
+	Import_Logseq   ImportType = 9
+	Import_Roam     ImportType = 10
+	Import_Opml     ImportType = 11
 )
 
 var ImportType_name = map[int32]string{
-	0: "Notion",
-	1: "Markdown",
-	2: "External",
-	3: "Pb",
-	4: "Html",
-	5: "Txt",
-	6: "Csv",
+	0:  "Notion",
+	1:  "Markdown",
+	2:  "External",
+	3:  "Pb",
+	4:  "Html",
+	5:  "Txt",
+	6:  "Csv",
+	7:  "Obsidian",
+	8:  "Enex",
+	9:  "Logseq",
+	10: "Roam",
+	11: "Opml",
 }
 
 var ImportType_value = map[string]int32{
@@ -2099,6 +2127,11 @@ var ImportType_value = map[string]int32{
 	"Html":     4,
 	"Txt":      5,
 	"Csv":      6,
+	"Obsidian": 7,
+	"Enex":     8,
+	"Logseq":   9,
+	"Roam":     10,
+	"Opml":     11,
 }
 
 func (x ImportType) String() string {
@@ -7270,6 +7303,8 @@ type Notification struct {
 	//	*NotificationPayloadOfParticipantRemove
 	//	*NotificationPayloadOfParticipantRequestDecline
 	//	*NotificationPayloadOfParticipantPermissionsChange
+	//	*NotificationPayloadOfReminder
+	//	*NotificationPayloadOfMention
 	Payload   IsNotificationPayload `protobuf_oneof:"payload"`
 	Space     string                `protobuf:"bytes,7,opt,name=space,proto3" json:"space,omitempty"`
 	AclHeadId string                `protobuf:"bytes,14,opt,name=aclHeadId,proto3" json:"aclHeadId,omitempty"`
@@ -7344,6 +7379,12 @@ type NotificationPayloadOfParticipantRequestDecline struct {
 type NotificationPayloadOfParticipantPermissionsChange struct {
 	ParticipantPermissionsChange *NotificationParticipantPermissionsChange `protobuf:"bytes,18,opt,name=participantPermissionsChange,proto3,oneof" json:"participantPermissionsChange,omitempty"`
 }
+type NotificationPayloadOfReminder struct {
+	Reminder *NotificationReminder `protobuf:"bytes,19,opt,name=reminder,proto3,oneof" json:"reminder,omitempty"`
+}
+type NotificationPayloadOfMention struct {
+	Mention *NotificationMention `protobuf:"bytes,20,opt,name=mention,proto3,oneof" json:"mention,omitempty"`
+}
 
 func (*NotificationPayloadOfImport) IsNotificationPayload()                       {}
 func (*NotificationPayloadOfExport) IsNotificationPayload()                       {}
@@ -7355,6 +7396,8 @@ func (*NotificationPayloadOfRequestToLeave) IsNotificationPayload()
 func (*NotificationPayloadOfParticipantRemove) IsNotificationPayload()            {}
 func (*NotificationPayloadOfParticipantRequestDecline) IsNotificationPayload()    {}
 func (*NotificationPayloadOfParticipantPermissionsChange) IsNotificationPayload() {}
+func (*NotificationPayloadOfReminder) IsNotificationPayload()                     {}
+func (*NotificationPayloadOfMention) IsNotificationPayload()                      {}
 
 func (m *Notification) GetPayload() IsNotificationPayload {
 	if m != nil {
@@ -7461,6 +7504,20 @@ func (m *Notification) GetParticipantPermissionsChange() *NotificationParticipan
 	return nil
 }
 
+func (m *Notification) GetReminder() *NotificationReminder {
+	if x, ok := m.GetPayload().(*NotificationPayloadOfReminder); ok {
+		return x.Reminder
+	}
+	return nil
+}
+
+func (m *Notification) GetMention() *NotificationMention {
+	if x, ok := m.GetPayload().(*NotificationPayloadOfMention); ok {
+		return x.Mention
+	}
+	return nil
+}
+
 func (m *Notification) GetSpace() string {
 	if m != nil {
 		return m.Space
@@ -7488,6 +7545,8 @@ func (*Notification) XXX_OneofWrappers() []interface{} {
 		(*NotificationPayloadOfParticipantRemove)(nil),
 		(*NotificationPayloadOfParticipantRequestDecline)(nil),
 		(*NotificationPayloadOfParticipantPermissionsChange)(nil),
+		(*NotificationPayloadOfReminder)(nil),
+		(*NotificationPayloadOfMention)(nil),
 	}
 }
 
@@ -8143,6 +8202,208 @@ func (m *NotificationParticipantPermissionsChange) GetSpaceName() string {
 	return ""
 }
 
+// value of the date relation flagged with relationRemind has come
+type NotificationReminder struct {
+	SpaceId      string `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	SpaceName    string `protobuf:"bytes,2,opt,name=spaceName,proto3" json:"spaceName,omitempty"`
+	ObjectId     string `protobuf:"bytes,3,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	ObjectName   string `protobuf:"bytes,4,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	RelationKey  string `protobuf:"bytes,5,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	RelationName string `protobuf:"bytes,6,opt,name=relationName,proto3" json:"relationName,omitempty"`
+	Date         int64  `protobuf:"varint,7,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (m *NotificationReminder) Reset()         { *m = NotificationReminder{} }
+func (m *NotificationReminder) String() string { return proto.CompactTextString(m) }
+func (*NotificationReminder) ProtoMessage()    {}
+func (*NotificationReminder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 10}
+}
+func (m *NotificationReminder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationReminder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationReminder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationReminder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationReminder.Merge(m, src)
+}
+func (m *NotificationReminder) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationReminder) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationReminder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationReminder proto.InternalMessageInfo
+
+func (m *NotificationReminder) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetSpaceName() string {
+	if m != nil {
+		return m.SpaceName
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetRelationName() string {
+	if m != nil {
+		return m.RelationName
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+// participant of the space mentioned the current user in the object or the chat message
+type NotificationMention struct {
+	SpaceId    string `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	SpaceName  string `protobuf:"bytes,2,opt,name=spaceName,proto3" json:"spaceName,omitempty"`
+	ObjectId   string `protobuf:"bytes,3,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	ObjectName string `protobuf:"bytes,4,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	BlockId    string `protobuf:"bytes,5,opt,name=blockId,proto3" json:"blockId,omitempty"`
+	MessageId  string `protobuf:"bytes,6,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	AuthorId   string `protobuf:"bytes,7,opt,name=authorId,proto3" json:"authorId,omitempty"`
+	AuthorName string `protobuf:"bytes,8,opt,name=authorName,proto3" json:"authorName,omitempty"`
+	Text       string `protobuf:"bytes,9,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (m *NotificationMention) Reset()         { *m = NotificationMention{} }
+func (m *NotificationMention) String() string { return proto.CompactTextString(m) }
+func (*NotificationMention) ProtoMessage()    {}
+func (*NotificationMention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 11}
+}
+func (m *NotificationMention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationMention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationMention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationMention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationMention.Merge(m, src)
+}
+func (m *NotificationMention) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationMention) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationMention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationMention proto.InternalMessageInfo
+
+func (m *NotificationMention) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetSpaceName() string {
+	if m != nil {
+		return m.SpaceName
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetAuthorId() string {
+	if m != nil {
+		return m.AuthorId
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetAuthorName() string {
+	if m != nil {
+		return m.AuthorName
+	}
+	return ""
+}
+
+func (m *NotificationMention) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
 type Export struct {
 }
 
@@ -9563,6 +9824,8 @@ func init() {
 	proto.RegisterType((*NotificationParticipantRemove)(nil), "anytype.model.Notification.ParticipantRemove")
 	proto.RegisterType((*NotificationParticipantRequestDecline)(nil), "anytype.model.Notification.ParticipantRequestDecline")
 	proto.RegisterType((*NotificationParticipantPermissionsChange)(nil), "anytype.model.Notification.ParticipantPermissionsChange")
+	proto.RegisterType((*NotificationReminder)(nil), "anytype.model.Notification.Reminder")
+	proto.RegisterType((*NotificationMention)(nil), "anytype.model.Notification.Mention")
 	proto.RegisterType((*Export)(nil), "anytype.model.Export")
 	proto.RegisterType((*Import)(nil), "anytype.model.Import")
 	proto.RegisterType((*Invite)(nil), "anytype.model.Invite")
@@ -9588,569 +9851,579 @@ func init() {
 }
 
 var fileDescriptor_98a910b73321e591 = []byte{
-	// 8986 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5b, 0x8c, 0x64, 0xd9,
-	0x91, 0x50, 0xe5, 0x3b, 0x33, 0xb2, 0xb2, 0xea, 0xd4, 0xe9, 0x57, 0x3a, 0xdd, 0x6e, 0xda, 0xe9,
-	0xf1, 0x4c, 0xbb, 0x3d, 0xae, 0x9e, 0xe9, 0x99, 0xf1, 0x8c, 0xc7, 0x9e, 0xb1, 0xb3, 0xb2, 0xb2,
-	0xba, 0x72, 0xba, 0xaa, 0xb2, 0x7c, 0x33, 0xbb, 0xdb, 0x33, 0xda, 0xa5, 0xb8, 0x95, 0xf7, 0x54,
-	0xe6, 0x75, 0xdd, 0xbc, 0x37, 0x7d, 0xef, 0xc9, 0xea, 0x2a, 0x0b, 0xd0, 0xf2, 0xda, 0x65, 0xff,
-	0x0c, 0x62, 0x81, 0x15, 0x42, 0x6b, 0x7f, 0x20, 0x21, 0x76, 0x25, 0x04, 0x92, 0x05, 0x0b, 0xac,
-	0x04, 0x2b, 0x21, 0x90, 0x90, 0x90, 0x81, 0x1f, 0xfe, 0x40, 0x63, 0x89, 0x1f, 0x04, 0xab, 0xe5,
-	0xcb, 0x42, 0x7c, 0xa0, 0x88, 0x73, 0xee, 0x23, 0x1f, 0x55, 0x9d, 0x3d, 0xbb, 0x8b, 0xf8, 0xaa,
-	0x7b, 0xe2, 0x46, 0xc4, 0x3d, 0x8f, 0x38, 0x71, 0x22, 0xe2, 0x44, 0x64, 0xc1, 0x2b, 0xe3, 0xd3,
-	0xc1, 0x03, 0xc7, 0x3e, 0x7e, 0x30, 0x3e, 0x7e, 0x30, 0xf2, 0x2c, 0xe1, 0x3c, 0x18, 0xfb, 0x9e,
-	0xf4, 0x02, 0xd5, 0x08, 0x36, 0xa9, 0xc5, 0x2b, 0xa6, 0x7b, 0x21, 0x2f, 0xc6, 0x62, 0x93, 0xa0,
-	0xb5, 0xdb, 0x03, 0xcf, 0x1b, 0x38, 0x42, 0xa1, 0x1e, 0x4f, 0x4e, 0x1e, 0x04, 0xd2, 0x9f, 0xf4,
-	0xa5, 0x42, 0xae, 0xff, 0x2c, 0x0b, 0x37, 0xbb, 0x23, 0xd3, 0x97, 0x5b, 0x8e, 0xd7, 0x3f, 0xed,
-	0xba, 0xe6, 0x38, 0x18, 0x7a, 0x72, 0xcb, 0x0c, 0x04, 0x7f, 0x1d, 0xf2, 0xc7, 0x08, 0x0c, 0xaa,
-	0xa9, 0xbb, 0x99, 0x7b, 0xe5, 0x87, 0xd7, 0x37, 0xa7, 0x18, 0x6f, 0x12, 0x85, 0xa1, 0x71, 0xf8,
-	0x9b, 0x50, 0xb0, 0x84, 0x34, 0x6d, 0x27, 0xa8, 0xa6, 0xef, 0xa6, 0xee, 0x95, 0x1f, 0xde, 0xda,
-	0x54, 0x1f, 0xde, 0x0c, 0x3f, 0xbc, 0xd9, 0xa5, 0x0f, 0x1b, 0x21, 0x1e, 0x7f, 0x17, 0x8a, 0x27,
-	0xb6, 0x23, 0x1e, 0x8b, 0x8b, 0xa0, 0x9a, 0xb9, 0x92, 0x66, 0x2b, 0x5d, 0x4d, 0x19, 0x11, 0x32,
-	0x6f, 0xc2, 0x9a, 0x38, 0x97, 0xbe, 0x69, 0x08, 0xc7, 0x94, 0xb6, 0xe7, 0x06, 0xd5, 0x2c, 0xf5,
-	0xf0, 0xd6, 0x4c, 0x0f, 0xc3, 0xf7, 0x44, 0x3e, 0x43, 0xc2, 0xef, 0x42, 0xd9, 0x3b, 0xfe, 0xbe,
-	0xe8, 0xcb, 0xde, 0xc5, 0x58, 0x04, 0xd5, 0xdc, 0xdd, 0xcc, 0xbd, 0x92, 0x91, 0x04, 0xf1, 0x6f,
-	0x40, 0xb9, 0xef, 0x39, 0x8e, 0xe8, 0xab, 0x6f, 0xe4, 0xaf, 0x1e, 0x56, 0x12, 0x97, 0xbf, 0x0d,
-	0x37, 0x7c, 0x31, 0xf2, 0xce, 0x84, 0xd5, 0x8c, 0xa0, 0x34, 0xce, 0x22, 0x7d, 0x66, 0xf1, 0x4b,
-	0xde, 0x80, 0x8a, 0xaf, 0xfb, 0xb7, 0x67, 0xbb, 0xa7, 0x41, 0xb5, 0x40, 0xc3, 0xfa, 0xfc, 0x25,
-	0xc3, 0x42, 0x1c, 0x63, 0x9a, 0x82, 0x33, 0xc8, 0x9c, 0x8a, 0x8b, 0x6a, 0xe9, 0x6e, 0xea, 0x5e,
-	0xc9, 0xc0, 0x47, 0xfe, 0x3e, 0x54, 0x3d, 0xdf, 0x1e, 0xd8, 0xae, 0xe9, 0x34, 0x7d, 0x61, 0x4a,
-	0x61, 0xf5, 0xec, 0x91, 0x08, 0xa4, 0x39, 0x1a, 0x57, 0xe1, 0x6e, 0xea, 0x5e, 0xc6, 0xb8, 0xf4,
-	0x3d, 0x7f, 0x4b, 0xad, 0x50, 0xdb, 0x3d, 0xf1, 0xaa, 0x65, 0x3d, 0xfc, 0xe9, 0xbe, 0xec, 0xe8,
-	0xd7, 0x46, 0x84, 0x58, 0xff, 0x45, 0x1a, 0xf2, 0x5d, 0x61, 0xfa, 0xfd, 0x61, 0xed, 0xd7, 0x52,
-	0x90, 0x37, 0x44, 0x30, 0x71, 0x24, 0xaf, 0x41, 0x51, 0xcd, 0x6d, 0xdb, 0xaa, 0xa6, 0xa8, 0x77,
-	0x51, 0xfb, 0xb3, 0xc8, 0xce, 0x26, 0x64, 0x47, 0x42, 0x9a, 0xd5, 0x0c, 0xcd, 0x50, 0x6d, 0xa6,
-	0x57, 0xea, 0xf3, 0x9b, 0xfb, 0x42, 0x9a, 0x06, 0xe1, 0xd5, 0x7e, 0x9e, 0x82, 0x2c, 0x36, 0xf9,
-	0x6d, 0x28, 0x0d, 0xed, 0xc1, 0xd0, 0xb1, 0x07, 0x43, 0xa9, 0x3b, 0x12, 0x03, 0xf8, 0x87, 0xb0,
-	0x1e, 0x35, 0x0c, 0xd3, 0x1d, 0x08, 0xec, 0xd1, 0x22, 0xe1, 0xa7, 0x97, 0xc6, 0x2c, 0x32, 0xaf,
-	0x42, 0x81, 0xf6, 0x43, 0xdb, 0x22, 0x89, 0x2e, 0x19, 0x61, 0x13, 0xc5, 0x2d, 0x5c, 0xa9, 0xc7,
-	0xe2, 0xa2, 0x9a, 0xa5, 0xb7, 0x49, 0x10, 0x6f, 0xc0, 0x7a, 0xd8, 0xdc, 0xd6, 0xb3, 0x91, 0xbb,
-	0x7a, 0x36, 0x66, 0xf1, 0xeb, 0x9f, 0xee, 0x41, 0x8e, 0xb6, 0x25, 0x5f, 0x83, 0xb4, 0x1d, 0x4e,
-	0x74, 0xda, 0xb6, 0xf8, 0x03, 0xc8, 0x9f, 0xd8, 0xc2, 0xb1, 0x5e, 0x38, 0xc3, 0x1a, 0x8d, 0xb7,
-	0x60, 0xd5, 0x17, 0x81, 0xf4, 0x6d, 0x2d, 0xfd, 0x6a, 0x83, 0x7e, 0x71, 0x91, 0x0e, 0xd8, 0x34,
-	0x12, 0x88, 0xc6, 0x14, 0x19, 0x0e, 0xbb, 0x3f, 0xb4, 0x1d, 0xcb, 0x17, 0x6e, 0xdb, 0x52, 0xfb,
-	0xb4, 0x64, 0x24, 0x41, 0xfc, 0x1e, 0xac, 0x1f, 0x9b, 0xfd, 0xd3, 0x81, 0xef, 0x4d, 0x5c, 0xdc,
-	0x10, 0x9e, 0x4f, 0xc3, 0x2e, 0x19, 0xb3, 0x60, 0xfe, 0x06, 0xe4, 0x4c, 0xc7, 0x1e, 0xb8, 0xb4,
-	0x13, 0xd7, 0xe6, 0x16, 0x5d, 0xf5, 0xa5, 0x81, 0x18, 0x86, 0x42, 0xe4, 0xbb, 0x50, 0x39, 0x13,
-	0xbe, 0xb4, 0xfb, 0xa6, 0x43, 0xf0, 0x6a, 0x81, 0x28, 0xeb, 0x0b, 0x29, 0x9f, 0x26, 0x31, 0x8d,
-	0x69, 0x42, 0xde, 0x06, 0x08, 0x50, 0x4d, 0xd2, 0x72, 0xea, 0xbd, 0xf0, 0xda, 0x42, 0x36, 0x4d,
-	0xcf, 0x95, 0xc2, 0x95, 0x9b, 0xdd, 0x08, 0x7d, 0x77, 0xc5, 0x48, 0x10, 0xf3, 0x77, 0x21, 0x2b,
-	0xc5, 0xb9, 0xac, 0xae, 0x5d, 0x31, 0xa3, 0x21, 0x93, 0x9e, 0x38, 0x97, 0xbb, 0x2b, 0x06, 0x11,
-	0x20, 0x21, 0x6e, 0xb2, 0xea, 0xfa, 0x12, 0x84, 0xb8, 0x2f, 0x91, 0x10, 0x09, 0xf8, 0x07, 0x90,
-	0x77, 0xcc, 0x0b, 0x6f, 0x22, 0xab, 0x8c, 0x48, 0xbf, 0x74, 0x25, 0xe9, 0x1e, 0xa1, 0xee, 0xae,
-	0x18, 0x9a, 0x88, 0xbf, 0x0d, 0x19, 0xcb, 0x3e, 0xab, 0x6e, 0x10, 0xed, 0xdd, 0x2b, 0x69, 0xb7,
-	0xed, 0xb3, 0xdd, 0x15, 0x03, 0xd1, 0x79, 0x13, 0x8a, 0xc7, 0x9e, 0x77, 0x3a, 0x32, 0xfd, 0xd3,
-	0x2a, 0x27, 0xd2, 0x2f, 0x5f, 0x49, 0xba, 0xa5, 0x91, 0x77, 0x57, 0x8c, 0x88, 0x10, 0x87, 0x6c,
-	0xf7, 0x3d, 0xb7, 0x7a, 0x6d, 0x89, 0x21, 0xb7, 0xfb, 0x9e, 0x8b, 0x43, 0x46, 0x02, 0x24, 0x74,
-	0x6c, 0xf7, 0xb4, 0x7a, 0x7d, 0x09, 0x42, 0xd4, 0x9c, 0x48, 0x88, 0x04, 0xd8, 0x6d, 0xcb, 0x94,
-	0xe6, 0x99, 0x2d, 0x9e, 0x57, 0x6f, 0x2c, 0xd1, 0xed, 0x6d, 0x8d, 0x8c, 0xdd, 0x0e, 0x09, 0x91,
-	0x49, 0xb8, 0x35, 0xab, 0x37, 0x97, 0x60, 0x12, 0x6a, 0x74, 0x64, 0x12, 0x12, 0xf2, 0x3f, 0x0d,
-	0x1b, 0x27, 0xc2, 0x94, 0x13, 0x5f, 0x58, 0xf1, 0x41, 0x77, 0x8b, 0xb8, 0x6d, 0x5e, 0xbd, 0xf6,
-	0xb3, 0x54, 0xbb, 0x2b, 0xc6, 0x3c, 0x2b, 0xfe, 0x3e, 0xe4, 0x1c, 0x53, 0x8a, 0xf3, 0x6a, 0x95,
-	0x78, 0xd6, 0x5f, 0x20, 0x14, 0x52, 0x9c, 0xef, 0xae, 0x18, 0x8a, 0x84, 0x7f, 0x0f, 0xd6, 0xa5,
-	0x79, 0xec, 0x88, 0xce, 0x89, 0x46, 0x08, 0xaa, 0x9f, 0x23, 0x2e, 0xaf, 0x5f, 0x2d, 0xce, 0xd3,
-	0x34, 0xbb, 0x2b, 0xc6, 0x2c, 0x1b, 0xec, 0x15, 0x81, 0xaa, 0xb5, 0x25, 0x7a, 0x45, 0xfc, 0xb0,
-	0x57, 0x44, 0xc2, 0xf7, 0xa0, 0x4c, 0x0f, 0x4d, 0xcf, 0x99, 0x8c, 0xdc, 0xea, 0xe7, 0x89, 0xc3,
-	0xbd, 0x17, 0x73, 0x50, 0xf8, 0xbb, 0x2b, 0x46, 0x92, 0x1c, 0x17, 0x91, 0x9a, 0x86, 0xf7, 0xbc,
-	0x7a, 0x7b, 0x89, 0x45, 0xec, 0x69, 0x64, 0x5c, 0xc4, 0x90, 0x10, 0xb7, 0xde, 0x73, 0xdb, 0x1a,
-	0x08, 0x59, 0xfd, 0xc2, 0x12, 0x5b, 0xef, 0x19, 0xa1, 0xe2, 0xd6, 0x53, 0x44, 0x28, 0xc6, 0xfd,
-	0xa1, 0x29, 0xab, 0x77, 0x96, 0x10, 0xe3, 0xe6, 0xd0, 0x24, 0x5d, 0x81, 0x04, 0xb5, 0x1f, 0xc2,
-	0x6a, 0x52, 0x2b, 0x73, 0x0e, 0x59, 0x5f, 0x98, 0xea, 0x44, 0x28, 0x1a, 0xf4, 0x8c, 0x30, 0x61,
-	0xd9, 0x92, 0x4e, 0x84, 0xa2, 0x41, 0xcf, 0xfc, 0x26, 0xe4, 0x95, 0x6d, 0x42, 0x0a, 0xbf, 0x68,
-	0xe8, 0x16, 0xe2, 0x5a, 0xbe, 0x39, 0xa0, 0x73, 0xab, 0x68, 0xd0, 0x33, 0xe2, 0x5a, 0xbe, 0x37,
-	0xee, 0xb8, 0xa4, 0xb0, 0x8b, 0x86, 0x6e, 0xd5, 0xfe, 0xf5, 0x07, 0x50, 0xd0, 0x9d, 0xaa, 0xfd,
-	0xdd, 0x14, 0xe4, 0x95, 0x42, 0xe1, 0xdf, 0x86, 0x5c, 0x20, 0x2f, 0x1c, 0x41, 0x7d, 0x58, 0x7b,
-	0xf8, 0x95, 0x25, 0x94, 0xd0, 0x66, 0x17, 0x09, 0x0c, 0x45, 0x57, 0x37, 0x20, 0x47, 0x6d, 0x5e,
-	0x80, 0x8c, 0xe1, 0x3d, 0x67, 0x2b, 0x1c, 0x20, 0xaf, 0x16, 0x8b, 0xa5, 0x10, 0xb8, 0x6d, 0x9f,
-	0xb1, 0x34, 0x02, 0x77, 0x85, 0x69, 0x09, 0x9f, 0x65, 0x78, 0x05, 0x4a, 0xe1, 0xb2, 0x04, 0x2c,
-	0xcb, 0x19, 0xac, 0x26, 0x16, 0x3c, 0x60, 0xb9, 0xda, 0xff, 0xca, 0x42, 0x16, 0xf7, 0x3f, 0x7f,
-	0x05, 0x2a, 0xd2, 0xf4, 0x07, 0x42, 0x19, 0xc2, 0x91, 0x91, 0x32, 0x0d, 0xe4, 0x1f, 0x84, 0x63,
-	0x48, 0xd3, 0x18, 0x5e, 0x7b, 0xa1, 0x5e, 0x99, 0x1a, 0x41, 0xe2, 0x14, 0xce, 0x2c, 0x77, 0x0a,
-	0xef, 0x40, 0x11, 0xd5, 0x59, 0xd7, 0xfe, 0xa1, 0xa0, 0xa9, 0x5f, 0x7b, 0x78, 0xff, 0xc5, 0x9f,
-	0x6c, 0x6b, 0x0a, 0x23, 0xa2, 0xe5, 0x6d, 0x28, 0xf5, 0x4d, 0xdf, 0xa2, 0xce, 0xd0, 0x6a, 0xad,
-	0x3d, 0xfc, 0xea, 0x8b, 0x19, 0x35, 0x43, 0x12, 0x23, 0xa6, 0xe6, 0x1d, 0x28, 0x5b, 0x22, 0xe8,
-	0xfb, 0xf6, 0x98, 0xd4, 0x9b, 0x3a, 0x8b, 0xbf, 0xf6, 0x62, 0x66, 0xdb, 0x31, 0x91, 0x91, 0xe4,
-	0x80, 0x16, 0x99, 0x1f, 0xe9, 0xb7, 0x02, 0x19, 0x08, 0x31, 0xa0, 0xfe, 0x2e, 0x14, 0xc3, 0xf1,
-	0xf0, 0x55, 0x28, 0xe2, 0xdf, 0x03, 0xcf, 0x15, 0x6c, 0x05, 0xd7, 0x16, 0x5b, 0xdd, 0x91, 0xe9,
-	0x38, 0x2c, 0xc5, 0xd7, 0x00, 0xb0, 0xb9, 0x2f, 0x2c, 0x7b, 0x32, 0x62, 0xe9, 0xfa, 0x37, 0x43,
-	0x69, 0x29, 0x42, 0xf6, 0xd0, 0x1c, 0x20, 0xc5, 0x2a, 0x14, 0x43, 0x75, 0xcd, 0x52, 0x48, 0xbf,
-	0x6d, 0x06, 0xc3, 0x63, 0xcf, 0xf4, 0x2d, 0x96, 0xe6, 0x65, 0x28, 0x34, 0xfc, 0xfe, 0xd0, 0x3e,
-	0x13, 0x2c, 0x53, 0x7f, 0x00, 0xe5, 0x44, 0x7f, 0x91, 0x85, 0xfe, 0x68, 0x09, 0x72, 0x0d, 0xcb,
-	0x12, 0x16, 0x4b, 0x21, 0x81, 0x1e, 0x20, 0x4b, 0xd7, 0xbf, 0x0a, 0xa5, 0x68, 0xb6, 0x10, 0x1d,
-	0x0f, 0x6e, 0xb6, 0x82, 0x4f, 0x08, 0x66, 0x29, 0x94, 0xca, 0xb6, 0xeb, 0xd8, 0xae, 0x60, 0xe9,
-	0xda, 0x9f, 0x21, 0x51, 0xe5, 0xdf, 0x9a, 0xde, 0x10, 0xaf, 0xbe, 0xe8, 0x64, 0x9d, 0xde, 0x0d,
-	0x9f, 0x4f, 0x8c, 0x6f, 0xcf, 0xa6, 0xce, 0x15, 0x21, 0xbb, 0xed, 0xc9, 0x80, 0xa5, 0x6a, 0xff,
-	0x3d, 0x0d, 0xc5, 0xf0, 0x40, 0x45, 0x9f, 0x60, 0xe2, 0x3b, 0x5a, 0xa0, 0xf1, 0x91, 0x5f, 0x87,
-	0x9c, 0xb4, 0xa5, 0x16, 0xe3, 0x92, 0xa1, 0x1a, 0x68, 0xab, 0x25, 0x57, 0x56, 0x19, 0xb0, 0xb3,
-	0x4b, 0x65, 0x8f, 0xcc, 0x81, 0xd8, 0x35, 0x83, 0xa1, 0x36, 0x61, 0x63, 0x00, 0xd2, 0x9f, 0x98,
-	0x67, 0x28, 0x73, 0xf4, 0x5e, 0x59, 0x71, 0x49, 0x10, 0x7f, 0x0b, 0xb2, 0x38, 0x40, 0x2d, 0x34,
-	0x7f, 0x6a, 0x66, 0xc0, 0x28, 0x26, 0x87, 0xbe, 0xc0, 0xe5, 0xd9, 0x44, 0x0f, 0xcc, 0x20, 0x64,
-	0xfe, 0x2a, 0xac, 0xa9, 0x4d, 0xd8, 0x09, 0xfd, 0x87, 0x02, 0x71, 0x9e, 0x81, 0xf2, 0x06, 0x4e,
-	0xa7, 0x29, 0x45, 0xb5, 0xb8, 0x84, 0x7c, 0x87, 0x93, 0xb3, 0xd9, 0x45, 0x12, 0x43, 0x51, 0xd6,
-	0xdf, 0xc1, 0x39, 0x35, 0xa5, 0xc0, 0x65, 0x6e, 0x8d, 0xc6, 0xf2, 0x42, 0x09, 0xcd, 0x8e, 0x90,
-	0xfd, 0xa1, 0xed, 0x0e, 0x58, 0x4a, 0x4d, 0x31, 0x2e, 0x22, 0xa1, 0xf8, 0xbe, 0xe7, 0xb3, 0x4c,
-	0xad, 0x06, 0x59, 0x94, 0x51, 0x54, 0x92, 0xae, 0x39, 0x12, 0x7a, 0xa6, 0xe9, 0xb9, 0x76, 0x0d,
-	0x36, 0xe6, 0xce, 0xe3, 0xda, 0xef, 0xe6, 0x95, 0x84, 0x20, 0x05, 0xd9, 0x82, 0x9a, 0x82, 0xcc,
-	0xbc, 0x97, 0xd2, 0x31, 0xc8, 0x65, 0x5a, 0xc7, 0x7c, 0x00, 0x39, 0x1c, 0x58, 0xa8, 0x62, 0x96,
-	0x20, 0xdf, 0x47, 0x74, 0x43, 0x51, 0xa1, 0x07, 0xd3, 0x1f, 0x8a, 0xfe, 0xa9, 0xb0, 0xb4, 0xae,
-	0x0f, 0x9b, 0x28, 0x34, 0xfd, 0x84, 0x79, 0xae, 0x1a, 0x24, 0x12, 0x7d, 0xcf, 0x6d, 0x8d, 0xbc,
-	0xef, 0xdb, 0xb4, 0xae, 0x28, 0x12, 0x21, 0x20, 0x7c, 0xdb, 0x46, 0x19, 0xd1, 0xcb, 0x16, 0x03,
-	0x6a, 0x2d, 0xc8, 0xd1, 0xb7, 0x71, 0x27, 0xa8, 0x3e, 0xab, 0x48, 0xc3, 0xab, 0xcb, 0xf5, 0x59,
-	0x77, 0xb9, 0xf6, 0x3b, 0x69, 0xc8, 0x62, 0x9b, 0xdf, 0x87, 0x9c, 0x8f, 0x7e, 0x18, 0x4d, 0xe7,
-	0x65, 0x3e, 0x9b, 0x42, 0xe1, 0xdf, 0xd6, 0xa2, 0x98, 0x5e, 0x42, 0x58, 0xa2, 0x2f, 0x26, 0xc5,
-	0xf2, 0x3a, 0xe4, 0xc6, 0xa6, 0x6f, 0x8e, 0xf4, 0x3e, 0x51, 0x8d, 0xfa, 0x8f, 0x53, 0x90, 0x45,
-	0x24, 0xbe, 0x01, 0x95, 0xae, 0xf4, 0xed, 0x53, 0x21, 0x87, 0xbe, 0x37, 0x19, 0x0c, 0x95, 0x24,
-	0x3d, 0x16, 0x17, 0x4a, 0xdf, 0x28, 0x85, 0x20, 0x4d, 0xc7, 0xee, 0xb3, 0x34, 0x4a, 0xd5, 0x96,
-	0xe7, 0x58, 0x2c, 0xc3, 0xd7, 0xa1, 0xfc, 0xc4, 0xb5, 0x84, 0x1f, 0xf4, 0x3d, 0x5f, 0x58, 0x2c,
-	0xab, 0x77, 0xf7, 0x29, 0xcb, 0xd1, 0x59, 0x26, 0xce, 0x25, 0xf9, 0x42, 0x2c, 0xcf, 0xaf, 0xc1,
-	0xfa, 0xd6, 0xb4, 0x83, 0xc4, 0x0a, 0xa8, 0x93, 0xf6, 0x85, 0x8b, 0x42, 0xc6, 0x8a, 0x4a, 0x88,
-	0xbd, 0xef, 0xdb, 0xac, 0x84, 0x1f, 0x53, 0xfb, 0x84, 0x41, 0xfd, 0x5f, 0xa4, 0x42, 0xcd, 0x51,
-	0x81, 0xd2, 0xa1, 0xe9, 0x9b, 0x03, 0xdf, 0x1c, 0x63, 0xff, 0xca, 0x50, 0x50, 0x07, 0xe7, 0x9b,
-	0x4a, 0xbb, 0xa9, 0xc6, 0x43, 0xa5, 0x1b, 0x55, 0xe3, 0x2d, 0x96, 0x89, 0x1b, 0x6f, 0xb3, 0x2c,
-	0x7e, 0xe3, 0xbb, 0x13, 0x4f, 0x0a, 0x96, 0x23, 0x5d, 0xe7, 0x59, 0x82, 0xe5, 0x11, 0xd8, 0x43,
-	0x8d, 0xc2, 0x0a, 0x38, 0xe6, 0x26, 0xca, 0xcf, 0xb1, 0x77, 0xce, 0x8a, 0xd8, 0x0d, 0x9c, 0x46,
-	0x61, 0xb1, 0x12, 0xbe, 0x39, 0x98, 0x8c, 0x8e, 0x05, 0x0e, 0x13, 0xf0, 0x4d, 0xcf, 0x1b, 0x0c,
-	0x1c, 0xc1, 0xca, 0x38, 0x07, 0x09, 0xe5, 0xcb, 0x56, 0x49, 0xd3, 0x9a, 0x8e, 0xe3, 0x4d, 0x24,
-	0xab, 0xd4, 0x7e, 0x91, 0x81, 0x2c, 0x7a, 0x37, 0xb8, 0x77, 0x86, 0xa8, 0x67, 0xf4, 0xde, 0xc1,
-	0xe7, 0x68, 0x07, 0xa6, 0xe3, 0x1d, 0xc8, 0xdf, 0xd7, 0x2b, 0x9d, 0x59, 0x42, 0xcb, 0x22, 0xe3,
-	0xe4, 0x22, 0x73, 0xc8, 0x8e, 0xec, 0x91, 0xd0, 0xba, 0x8e, 0x9e, 0x11, 0x16, 0xe0, 0x79, 0x9c,
-	0xa3, 0xe0, 0x09, 0x3d, 0xe3, 0xae, 0x31, 0xf1, 0x58, 0x68, 0x48, 0xda, 0x03, 0x19, 0x23, 0x6c,
-	0x2e, 0xd0, 0x5e, 0xa5, 0x85, 0xda, 0xeb, 0x83, 0x50, 0x7b, 0x15, 0x96, 0xd8, 0xf5, 0xd4, 0xcd,
-	0xa4, 0xe6, 0x8a, 0x95, 0x46, 0x71, 0x79, 0xf2, 0xc4, 0x61, 0xb2, 0xad, 0xa5, 0x36, 0x3e, 0xe8,
-	0x8a, 0x6a, 0x96, 0x59, 0x0a, 0x57, 0x93, 0xb6, 0xab, 0xd2, 0x79, 0x4f, 0x6d, 0x4b, 0x78, 0x2c,
-	0x43, 0x07, 0xe1, 0xc4, 0xb2, 0x3d, 0x96, 0x45, 0xcb, 0xeb, 0x70, 0x7b, 0x87, 0xe5, 0xea, 0xaf,
-	0x26, 0x8e, 0xa4, 0xc6, 0x44, 0x7a, 0x8a, 0x0d, 0x89, 0x6f, 0x4a, 0x49, 0xe3, 0xb1, 0xb0, 0x58,
-	0xba, 0xfe, 0xf5, 0x05, 0x6a, 0xb6, 0x02, 0xa5, 0x27, 0x63, 0xc7, 0x33, 0xad, 0x2b, 0xf4, 0xec,
-	0x2a, 0x40, 0xec, 0x55, 0xd7, 0x7e, 0x51, 0x8f, 0x8f, 0x73, 0xb4, 0x45, 0x03, 0x6f, 0xe2, 0xf7,
-	0x05, 0xa9, 0x90, 0x92, 0xa1, 0x5b, 0xfc, 0x3b, 0x90, 0xc3, 0xf7, 0x61, 0x18, 0xe7, 0xfe, 0x52,
-	0xbe, 0xdc, 0xe6, 0x53, 0x5b, 0x3c, 0x37, 0x14, 0x21, 0xbf, 0x03, 0x60, 0xf6, 0xa5, 0x7d, 0x26,
-	0x10, 0xa8, 0x37, 0x7b, 0x02, 0xc2, 0xdf, 0x49, 0x9a, 0x2f, 0x57, 0xc7, 0x21, 0x13, 0x76, 0x0d,
-	0x37, 0xa0, 0x8c, 0x5b, 0x77, 0xdc, 0xf1, 0x71, 0xb7, 0x57, 0x57, 0x89, 0xf0, 0x8d, 0xe5, 0xba,
-	0xf7, 0x28, 0x22, 0x34, 0x92, 0x4c, 0xf8, 0x13, 0x58, 0x55, 0x31, 0x35, 0xcd, 0xb4, 0x42, 0x4c,
-	0xdf, 0x5c, 0x8e, 0x69, 0x27, 0xa6, 0x34, 0xa6, 0xd8, 0xcc, 0x87, 0x25, 0x73, 0x2f, 0x1d, 0x96,
-	0x7c, 0x15, 0xd6, 0x7a, 0xd3, 0xbb, 0x40, 0x1d, 0x15, 0x33, 0x50, 0x5e, 0x87, 0x55, 0x3b, 0x88,
-	0xa3, 0xa2, 0x14, 0x23, 0x29, 0x1a, 0x53, 0xb0, 0xda, 0x7f, 0xc8, 0x43, 0x96, 0x66, 0x7e, 0x36,
-	0xc6, 0xd5, 0x9c, 0x52, 0xe9, 0x0f, 0x96, 0x5f, 0xea, 0x99, 0x1d, 0x4f, 0x1a, 0x24, 0x93, 0xd0,
-	0x20, 0xdf, 0x81, 0x5c, 0xe0, 0xf9, 0x32, 0x5c, 0xde, 0x25, 0x85, 0xa8, 0xeb, 0xf9, 0xd2, 0x50,
-	0x84, 0x7c, 0x07, 0x0a, 0x27, 0xb6, 0x23, 0x71, 0x51, 0xd4, 0xe4, 0xbd, 0xbe, 0x1c, 0x8f, 0x1d,
-	0x22, 0x32, 0x42, 0x62, 0xbe, 0x97, 0x14, 0xb6, 0x3c, 0x71, 0xda, 0x5c, 0x8e, 0xd3, 0x22, 0x19,
-	0xbc, 0x0f, 0xac, 0xef, 0x9d, 0x09, 0xdf, 0x48, 0x04, 0x26, 0xd5, 0x21, 0x3d, 0x07, 0xe7, 0x35,
-	0x28, 0x0e, 0x6d, 0x4b, 0xa0, 0x9d, 0x43, 0x3a, 0xa6, 0x68, 0x44, 0x6d, 0xfe, 0x18, 0x8a, 0xe4,
-	0x1f, 0xa0, 0x56, 0x2c, 0xbd, 0xf4, 0xe4, 0x2b, 0x57, 0x25, 0x64, 0x80, 0x1f, 0xa2, 0x8f, 0xef,
-	0xd8, 0x92, 0xe2, 0xd3, 0x45, 0x23, 0x6a, 0x63, 0x87, 0x49, 0xde, 0x93, 0x1d, 0x2e, 0xab, 0x0e,
-	0xcf, 0xc2, 0xf9, 0xdb, 0x70, 0x83, 0x60, 0x33, 0x87, 0x24, 0x6e, 0x35, 0x64, 0xba, 0xf8, 0x25,
-	0x1a, 0x2c, 0x63, 0x73, 0x20, 0xf6, 0xec, 0x91, 0x2d, 0xab, 0x95, 0xbb, 0xa9, 0x7b, 0x39, 0x23,
-	0x06, 0xf0, 0xd7, 0x61, 0xc3, 0x12, 0x27, 0xe6, 0xc4, 0x91, 0x3d, 0x31, 0x1a, 0x3b, 0xa6, 0x14,
-	0x6d, 0x8b, 0x64, 0xb4, 0x64, 0xcc, 0xbf, 0xe0, 0x6f, 0xc0, 0x35, 0x0d, 0xec, 0x44, 0xb7, 0x0a,
-	0x6d, 0x8b, 0xc2, 0x77, 0x25, 0x63, 0xd1, 0xab, 0xfa, 0xbe, 0x56, 0xc3, 0x78, 0x80, 0xa2, 0x9f,
-	0x1a, 0x2a, 0xd0, 0x40, 0xaa, 0x13, 0xf9, 0x91, 0xe9, 0x38, 0xc2, 0xbf, 0x50, 0x4e, 0xee, 0x63,
-	0xd3, 0x3d, 0x36, 0x5d, 0x96, 0xa1, 0x33, 0xd6, 0x74, 0x84, 0x6b, 0x99, 0xbe, 0x3a, 0x91, 0x1f,
-	0xd1, 0x81, 0x9e, 0xab, 0xdf, 0x83, 0x2c, 0x4d, 0x69, 0x09, 0x72, 0xca, 0x4b, 0x22, 0x8f, 0x59,
-	0x7b, 0x48, 0xa4, 0x91, 0xf7, 0x70, 0xfb, 0xb1, 0x74, 0xed, 0xef, 0xe5, 0xa1, 0x18, 0x4e, 0x5e,
-	0x78, 0x87, 0x90, 0x8a, 0xef, 0x10, 0xd0, 0x8c, 0x0b, 0x9e, 0xda, 0x81, 0x7d, 0xac, 0xcd, 0xd2,
-	0xa2, 0x11, 0x03, 0xd0, 0x12, 0x7a, 0x6e, 0x5b, 0x72, 0x48, 0x7b, 0x26, 0x67, 0xa8, 0x06, 0xbf,
-	0x07, 0xeb, 0x16, 0xce, 0x83, 0xdb, 0x77, 0x26, 0x96, 0xe8, 0xe1, 0x29, 0xaa, 0xc2, 0x04, 0xb3,
-	0x60, 0xfe, 0x31, 0x80, 0xb4, 0x47, 0x62, 0xc7, 0xf3, 0x47, 0xa6, 0xd4, 0xbe, 0xc1, 0x37, 0x5e,
-	0x4e, 0xaa, 0x37, 0x7b, 0x11, 0x03, 0x23, 0xc1, 0x0c, 0x59, 0xe3, 0xd7, 0x34, 0xeb, 0xc2, 0x67,
-	0x62, 0xbd, 0x1d, 0x31, 0x30, 0x12, 0xcc, 0x78, 0x0f, 0x0a, 0x27, 0x9e, 0x3f, 0x9a, 0x38, 0xa6,
-	0x3e, 0x73, 0xdf, 0x7f, 0x49, 0xbe, 0x3b, 0x8a, 0x9a, 0x74, 0x4f, 0xc8, 0x2a, 0x8e, 0x71, 0x97,
-	0x96, 0x8c, 0x71, 0xd7, 0x7f, 0x09, 0x20, 0xee, 0x21, 0xbf, 0x09, 0x7c, 0xdf, 0x73, 0xe5, 0xb0,
-	0x71, 0x7c, 0xec, 0x6f, 0x89, 0x13, 0xcf, 0x17, 0xdb, 0x26, 0x1e, 0xaf, 0x37, 0x60, 0x23, 0x82,
-	0x37, 0x4e, 0xa4, 0xf0, 0x11, 0x4c, 0x22, 0xd0, 0x1d, 0x7a, 0xbe, 0x54, 0x36, 0x1e, 0x3d, 0x3e,
-	0xe9, 0xb2, 0x0c, 0x1e, 0xe9, 0xed, 0x6e, 0x87, 0x65, 0xeb, 0xf7, 0x00, 0xe2, 0xa9, 0x25, 0x5f,
-	0x88, 0x9e, 0xde, 0x7c, 0xa8, 0x3d, 0x23, 0x6a, 0x3d, 0x7c, 0x9b, 0xa5, 0xea, 0x9f, 0xa6, 0xa0,
-	0x9c, 0x18, 0xd2, 0xb4, 0xcf, 0xdc, 0xf4, 0x26, 0xae, 0x54, 0x4e, 0x3a, 0x3d, 0x3e, 0x35, 0x9d,
-	0x09, 0x1e, 0xee, 0x1b, 0x50, 0xa1, 0xf6, 0xb6, 0x1d, 0x48, 0xdb, 0xed, 0x4b, 0x96, 0x89, 0x50,
-	0x94, 0x61, 0x90, 0x8d, 0x50, 0x0e, 0x3c, 0x0d, 0xca, 0x71, 0x06, 0xab, 0x87, 0xc2, 0xef, 0x8b,
-	0x10, 0x89, 0x8c, 0x61, 0x0d, 0x89, 0xd0, 0x94, 0x31, 0x6c, 0xca, 0x61, 0x77, 0x32, 0x62, 0x45,
-	0x34, 0x2a, 0xb1, 0xd1, 0x38, 0x13, 0x3e, 0xda, 0x32, 0x25, 0xfc, 0x0e, 0x02, 0x70, 0x37, 0x98,
-	0x2e, 0x83, 0x10, 0x7b, 0xdf, 0x76, 0x59, 0x39, 0x6a, 0x98, 0xe7, 0x6c, 0x15, 0xfb, 0x4f, 0xae,
-	0x03, 0xab, 0xd4, 0xfe, 0x5b, 0x06, 0xb2, 0xa8, 0xd7, 0xd1, 0xd7, 0x4d, 0x2a, 0x21, 0xb5, 0x57,
-	0x92, 0xa0, 0xcf, 0x76, 0x1a, 0x21, 0xef, 0xe4, 0x69, 0xf4, 0x1e, 0x94, 0xfb, 0x93, 0x40, 0x7a,
-	0x23, 0x3a, 0x8a, 0xf5, 0x6d, 0xd7, 0xcd, 0xb9, 0xa8, 0x11, 0x4d, 0xa7, 0x91, 0x44, 0xe5, 0xef,
-	0x40, 0xfe, 0x44, 0x49, 0xbd, 0x8a, 0x1b, 0x7d, 0xe1, 0x92, 0xd3, 0x5a, 0x4b, 0xb6, 0x46, 0xc6,
-	0x71, 0xd9, 0x73, 0x3b, 0x36, 0x09, 0xd2, 0xa7, 0x6e, 0x3e, 0x3a, 0x75, 0x7f, 0x09, 0xd6, 0x04,
-	0x4e, 0xf8, 0xa1, 0x63, 0xf6, 0xc5, 0x48, 0xb8, 0xe1, 0x36, 0x7b, 0xfb, 0x25, 0x46, 0x4c, 0x2b,
-	0x46, 0xc3, 0x9e, 0xe1, 0x85, 0x9a, 0xc7, 0xf5, 0xf0, 0xf0, 0x0f, 0x1d, 0xfb, 0xa2, 0x11, 0x03,
-	0xea, 0x5f, 0xd6, 0xfa, 0xb2, 0x00, 0x99, 0x46, 0xd0, 0xd7, 0x11, 0x10, 0x11, 0xf4, 0x95, 0x7b,
-	0xd5, 0xa4, 0xe9, 0x60, 0xe9, 0xfa, 0x9b, 0x50, 0x8a, 0xbe, 0x80, 0xc2, 0x73, 0xe0, 0xc9, 0xee,
-	0x58, 0xf4, 0xed, 0x13, 0x5b, 0x58, 0x4a, 0x3e, 0xbb, 0xd2, 0xf4, 0xa5, 0x0a, 0x22, 0xb6, 0x5c,
-	0x8b, 0xa5, 0x6b, 0xbf, 0x5d, 0x84, 0xbc, 0x3a, 0x7c, 0xf5, 0x80, 0x4b, 0xd1, 0x80, 0xbf, 0x0b,
-	0x45, 0x6f, 0x2c, 0x7c, 0x53, 0x7a, 0xbe, 0x8e, 0xdc, 0xbc, 0xf3, 0x32, 0x87, 0xf9, 0x66, 0x47,
-	0x13, 0x1b, 0x11, 0x9b, 0x59, 0x69, 0x4a, 0xcf, 0x4b, 0xd3, 0x7d, 0x60, 0xe1, 0xb9, 0x7d, 0xe8,
-	0x23, 0x9d, 0xbc, 0xd0, 0x7e, 0xf8, 0x1c, 0x9c, 0xf7, 0xa0, 0xd4, 0xf7, 0x5c, 0xcb, 0x8e, 0xa2,
-	0x38, 0x6b, 0x0f, 0xbf, 0xfe, 0x52, 0x3d, 0x6c, 0x86, 0xd4, 0x46, 0xcc, 0x88, 0xbf, 0x0e, 0xb9,
-	0x33, 0x14, 0x33, 0x92, 0xa7, 0xcb, 0x85, 0x50, 0x21, 0xf1, 0x4f, 0xa0, 0xfc, 0x83, 0x89, 0xdd,
-	0x3f, 0xed, 0x24, 0xa3, 0x84, 0xef, 0xbd, 0x54, 0x2f, 0xbe, 0x1b, 0xd3, 0x1b, 0x49, 0x66, 0x09,
-	0xd1, 0x2e, 0xfc, 0x11, 0x44, 0xbb, 0x38, 0x2f, 0xda, 0x06, 0x54, 0x5c, 0x11, 0x48, 0x61, 0xed,
-	0x68, 0x5b, 0x0d, 0x3e, 0x83, 0xad, 0x36, 0xcd, 0xa2, 0xfe, 0x25, 0x28, 0x86, 0x0b, 0xce, 0xf3,
-	0x90, 0x3e, 0x40, 0xa7, 0x28, 0x0f, 0xe9, 0x8e, 0xaf, 0xa4, 0xad, 0x81, 0xd2, 0x56, 0xff, 0x83,
-	0x14, 0x94, 0xa2, 0x49, 0x9f, 0xd6, 0x9c, 0xad, 0x1f, 0x4c, 0x4c, 0x87, 0xa5, 0xc8, 0x5d, 0xf6,
-	0xa4, 0x6a, 0x91, 0xb2, 0x7e, 0x44, 0x97, 0xf5, 0x3e, 0xcb, 0x90, 0x89, 0x20, 0x82, 0x80, 0x65,
-	0x39, 0x87, 0x35, 0x0d, 0xee, 0xf8, 0x0a, 0x35, 0x87, 0x8a, 0x0f, 0xdf, 0x86, 0x80, 0xbc, 0xb2,
-	0x28, 0x4e, 0x85, 0x52, 0x90, 0x07, 0x9e, 0xa4, 0x46, 0x11, 0x3b, 0xd5, 0x76, 0x59, 0x09, 0xbf,
-	0x79, 0xe0, 0xc9, 0x36, 0xaa, 0xc4, 0xc8, 0x3d, 0x2b, 0x87, 0x9f, 0xa7, 0x16, 0x69, 0xc4, 0x86,
-	0xe3, 0xb4, 0x5d, 0x56, 0xd1, 0x2f, 0x54, 0x6b, 0x0d, 0x39, 0xb6, 0xce, 0xcd, 0x3e, 0x92, 0xaf,
-	0xa3, 0x86, 0x45, 0x1a, 0xdd, 0x66, 0xb8, 0x25, 0x5b, 0xe7, 0x76, 0x20, 0x03, 0xb6, 0x51, 0xff,
-	0x77, 0x29, 0x28, 0x27, 0x16, 0x18, 0xdd, 0x3f, 0x42, 0xc4, 0xa3, 0x4c, 0x79, 0x83, 0x1f, 0xe3,
-	0x34, 0xfa, 0x56, 0x78, 0x4c, 0xf5, 0x3c, 0x7c, 0x4c, 0xe3, 0xf7, 0x7a, 0xde, 0xc8, 0xf3, 0x7d,
-	0xef, 0xb9, 0x32, 0x7d, 0xf6, 0xcc, 0x40, 0x3e, 0x13, 0xe2, 0x94, 0x65, 0x71, 0xa8, 0xcd, 0x89,
-	0xef, 0x0b, 0x57, 0x01, 0x72, 0xd4, 0x39, 0x71, 0xae, 0x5a, 0x79, 0x64, 0x8a, 0xc8, 0x74, 0x0e,
-	0xb2, 0x02, 0x2a, 0x02, 0x8d, 0xad, 0x20, 0x45, 0x44, 0x40, 0x74, 0xd5, 0x2c, 0xe1, 0xa1, 0xa2,
-	0x22, 0x14, 0x9d, 0x93, 0x6d, 0xf3, 0x22, 0x68, 0x0c, 0x3c, 0x06, 0xb3, 0xc0, 0x03, 0xef, 0x39,
-	0x2b, 0xd7, 0x26, 0x00, 0xb1, 0x4f, 0x86, 0xbe, 0x28, 0x0a, 0x44, 0x74, 0x87, 0xa0, 0x5b, 0xbc,
-	0x03, 0x80, 0x4f, 0x84, 0x19, 0x3a, 0xa4, 0x2f, 0x61, 0x28, 0x13, 0x9d, 0x91, 0x60, 0x51, 0xfb,
-	0x73, 0x50, 0x8a, 0x5e, 0xf0, 0x2a, 0x14, 0xc8, 0xa4, 0x8d, 0x3e, 0x1b, 0x36, 0xd1, 0x3e, 0xb3,
-	0x5d, 0x4b, 0x9c, 0x93, 0x5e, 0xc9, 0x19, 0xaa, 0x81, 0xbd, 0x1c, 0xda, 0x96, 0x25, 0xdc, 0xf0,
-	0xa6, 0x47, 0xb5, 0x16, 0xdd, 0xc7, 0x67, 0x17, 0xde, 0xc7, 0xd7, 0x7e, 0x19, 0xca, 0x09, 0xa7,
-	0xf1, 0xd2, 0x61, 0x27, 0x3a, 0x96, 0x9e, 0xee, 0xd8, 0x6d, 0x28, 0x85, 0x39, 0x20, 0x01, 0x9d,
-	0x6d, 0x25, 0x23, 0x06, 0xd4, 0xfe, 0x49, 0x1a, 0x2d, 0x59, 0x1c, 0xda, 0xac, 0xa3, 0xb7, 0x03,
-	0xf9, 0x40, 0x9a, 0x72, 0x12, 0x26, 0x33, 0x2c, 0xb9, 0x41, 0xbb, 0x44, 0xb3, 0xbb, 0x62, 0x68,
-	0x6a, 0xfe, 0x01, 0x64, 0xa4, 0x39, 0xd0, 0x81, 0xd2, 0xaf, 0x2c, 0xc7, 0xa4, 0x67, 0x0e, 0x76,
-	0x57, 0x0c, 0xa4, 0xe3, 0x7b, 0x50, 0xec, 0xeb, 0xd8, 0x96, 0x56, 0x8a, 0x4b, 0xfa, 0x62, 0x61,
-	0x44, 0x6c, 0x77, 0xc5, 0x88, 0x38, 0xf0, 0xef, 0x40, 0x16, 0xad, 0x4b, 0x9d, 0xf3, 0xb1, 0xa4,
-	0x8f, 0x89, 0xdb, 0x65, 0x77, 0xc5, 0x20, 0xca, 0xad, 0x02, 0xe4, 0x48, 0x07, 0xd7, 0xaa, 0x90,
-	0x57, 0x63, 0x9d, 0x9d, 0xb9, 0xda, 0x2d, 0xc8, 0xf4, 0xcc, 0x01, 0x5a, 0xf8, 0xb6, 0x15, 0xe8,
-	0x50, 0x09, 0x3e, 0xd6, 0x5e, 0x89, 0xe3, 0x74, 0xc9, 0x10, 0x70, 0x6a, 0x2a, 0x04, 0x5c, 0xcb,
-	0x43, 0x16, 0xbf, 0x58, 0xbb, 0x7d, 0x95, 0xb7, 0x50, 0xfb, 0x07, 0x19, 0x74, 0x2c, 0xa4, 0x38,
-	0x5f, 0x18, 0xde, 0xfe, 0x08, 0x4a, 0x63, 0xdf, 0xeb, 0x8b, 0x20, 0xf0, 0x7c, 0x6d, 0x1c, 0xbd,
-	0xfe, 0xe2, 0xab, 0xe7, 0xcd, 0xc3, 0x90, 0xc6, 0x88, 0xc9, 0xeb, 0xff, 0x32, 0x0d, 0xa5, 0xe8,
-	0x85, 0xf2, 0x67, 0xa4, 0x38, 0x57, 0xa1, 0xcc, 0x7d, 0xe1, 0x8f, 0x4c, 0xdb, 0x52, 0xda, 0xa3,
-	0x39, 0x34, 0x43, 0x23, 0xf7, 0x63, 0x6f, 0x22, 0x27, 0xc7, 0x42, 0x85, 0xb0, 0x9e, 0xda, 0x23,
-	0xe1, 0xb1, 0x2c, 0x5d, 0x1e, 0xa1, 0x60, 0xf7, 0x1d, 0x6f, 0x62, 0xb1, 0x1c, 0xb6, 0x1f, 0xd1,
-	0xf1, 0xb6, 0x6f, 0x8e, 0x03, 0xa5, 0x33, 0xf7, 0x6d, 0xdf, 0x63, 0x05, 0x24, 0xda, 0xb1, 0x07,
-	0x23, 0x93, 0x15, 0x91, 0x59, 0xef, 0xb9, 0x2d, 0x51, 0x09, 0x97, 0xd0, 0x4c, 0xed, 0x8c, 0x85,
-	0xdb, 0x95, 0xbe, 0x10, 0x72, 0xdf, 0x1c, 0xab, 0x98, 0xa6, 0x21, 0x2c, 0xcb, 0x96, 0x4a, 0x7f,
-	0xee, 0x98, 0x7d, 0x71, 0xec, 0x79, 0xa7, 0x6c, 0x15, 0x15, 0x4d, 0xdb, 0x0d, 0xa4, 0x39, 0xf0,
-	0xcd, 0x91, 0xd2, 0xa1, 0x3d, 0xe1, 0x08, 0x6a, 0xad, 0xd1, 0xb7, 0x6d, 0x39, 0x9c, 0x1c, 0x3f,
-	0x42, 0xbf, 0x6f, 0x5d, 0xdd, 0x33, 0x59, 0x62, 0x2c, 0x50, 0x87, 0xae, 0x42, 0x71, 0xcb, 0x76,
-	0xec, 0x63, 0xdb, 0xb1, 0xd9, 0x06, 0xa2, 0xb6, 0xce, 0xfb, 0xa6, 0x63, 0x5b, 0xbe, 0xf9, 0x9c,
-	0x71, 0xec, 0xdc, 0x63, 0xdf, 0x3b, 0xb5, 0xd9, 0x35, 0x44, 0x24, 0x37, 0xf0, 0xcc, 0xfe, 0x21,
-	0xbb, 0x4e, 0x77, 0x65, 0xa7, 0x42, 0xf6, 0x87, 0x27, 0xe6, 0x31, 0xbb, 0x11, 0x87, 0xf4, 0x6e,
-	0xd6, 0x36, 0x60, 0x7d, 0xe6, 0x56, 0xbe, 0x56, 0xd0, 0xde, 0x67, 0xad, 0x02, 0xe5, 0xc4, 0x75,
-	0x69, 0xed, 0x55, 0x28, 0x86, 0x97, 0xa9, 0xe8, 0xa5, 0xdb, 0x81, 0x0a, 0x03, 0x6b, 0x21, 0x89,
-	0xda, 0xb5, 0xdf, 0x4b, 0x41, 0x5e, 0xdd, 0x64, 0xf3, 0xad, 0x28, 0xf3, 0x24, 0xb5, 0xc4, 0xed,
-	0xa5, 0x22, 0xd2, 0x77, 0xbf, 0x51, 0xfa, 0xc9, 0x75, 0xc8, 0x39, 0xe4, 0x8e, 0x6b, 0xf5, 0x45,
-	0x8d, 0x84, 0xb6, 0xc9, 0x24, 0xb5, 0x4d, 0xbd, 0x11, 0xdd, 0x37, 0x87, 0xa1, 0x47, 0xb2, 0x0a,
-	0x7b, 0xbe, 0x10, 0x2a, 0xac, 0x48, 0xde, 0x74, 0x9a, 0xce, 0x0a, 0x6f, 0x34, 0x36, 0xfb, 0x92,
-	0x00, 0x74, 0x8a, 0xa2, 0x32, 0x65, 0x59, 0x94, 0xf2, 0xe6, 0xd0, 0x94, 0xf5, 0x13, 0x28, 0x1e,
-	0x7a, 0xc1, 0xec, 0x99, 0x5c, 0x80, 0x4c, 0xcf, 0x1b, 0x2b, 0x0b, 0x73, 0xcb, 0x93, 0x64, 0x61,
-	0xaa, 0x23, 0xf8, 0x44, 0x2a, 0xa1, 0x32, 0xec, 0xc1, 0x50, 0x2a, 0x4f, 0xbc, 0xed, 0xba, 0xc2,
-	0x67, 0x39, 0x5c, 0x43, 0x43, 0x8c, 0xd1, 0xaa, 0x65, 0x79, 0x5c, 0x35, 0x82, 0xef, 0xd8, 0x7e,
-	0x20, 0x59, 0xa1, 0xde, 0xc6, 0xd3, 0xd4, 0x1e, 0xd0, 0x21, 0x48, 0x0f, 0xc4, 0x6a, 0x05, 0xbb,
-	0x48, 0xcd, 0xa6, 0x70, 0x51, 0xc6, 0xc8, 0x7b, 0x52, 0xae, 0x1f, 0x7d, 0x20, 0x8d, 0x27, 0x18,
-	0xb5, 0x3f, 0x9a, 0x04, 0xd2, 0x3e, 0xb9, 0x60, 0x99, 0xfa, 0x33, 0xa8, 0x4c, 0xa5, 0x31, 0xf1,
-	0xeb, 0xc0, 0xa6, 0x00, 0xd8, 0xf5, 0x15, 0x7e, 0x0b, 0xae, 0x4d, 0x41, 0xf7, 0x6d, 0xcb, 0xa2,
-	0x58, 0xef, 0xec, 0x8b, 0x70, 0x80, 0x5b, 0x25, 0x28, 0xf4, 0xd5, 0x2a, 0xd5, 0x0f, 0xa1, 0x42,
-	0xcb, 0xb6, 0x2f, 0xa4, 0xd9, 0x71, 0x9d, 0x8b, 0x3f, 0x72, 0xae, 0x59, 0xfd, 0xab, 0xda, 0xc1,
-	0x42, 0x7d, 0x71, 0xe2, 0x7b, 0x23, 0xe2, 0x95, 0x33, 0xe8, 0x19, 0xb9, 0x4b, 0x4f, 0xaf, 0x7d,
-	0x5a, 0x7a, 0xf5, 0x7f, 0x5f, 0x82, 0x42, 0xa3, 0xdf, 0x47, 0x97, 0x70, 0xee, 0xcb, 0xef, 0x40,
-	0xbe, 0xef, 0xb9, 0x27, 0xf6, 0x40, 0xeb, 0xe3, 0x59, 0xcb, 0x50, 0xd3, 0xa1, 0xc0, 0x9d, 0xd8,
-	0x03, 0x43, 0x23, 0x23, 0x99, 0x3e, 0x4f, 0x72, 0x57, 0x92, 0x29, 0xa5, 0x1a, 0x1d, 0x1f, 0x0f,
-	0x20, 0x6b, 0xbb, 0x27, 0x9e, 0x4e, 0x0c, 0xfd, 0xfc, 0x25, 0x44, 0x94, 0x1d, 0x49, 0x88, 0xb5,
-	0xff, 0x92, 0x82, 0xbc, 0xfa, 0x34, 0x7f, 0x15, 0xd6, 0x84, 0x8b, 0x9b, 0x29, 0x54, 0xe5, 0x7a,
-	0x17, 0xcd, 0x40, 0xd1, 0x68, 0xd5, 0x10, 0x71, 0x3c, 0x19, 0xe8, 0xd8, 0x4b, 0x12, 0xc4, 0xdf,
-	0x83, 0x5b, 0xaa, 0x79, 0xe8, 0x0b, 0x5f, 0x38, 0xc2, 0x0c, 0x44, 0x73, 0x68, 0xba, 0xae, 0x70,
-	0xf4, 0xc1, 0x7e, 0xd9, 0x6b, 0x5e, 0x87, 0x55, 0xf5, 0xaa, 0x3b, 0x36, 0xfb, 0x22, 0xd0, 0xf7,
-	0x7d, 0x53, 0x30, 0xfe, 0x35, 0xc8, 0x51, 0xde, 0x6c, 0xd5, 0xba, 0x7a, 0x29, 0x15, 0x56, 0xcd,
-	0x8b, 0x4e, 0x9e, 0x06, 0x80, 0x9a, 0x26, 0x74, 0xba, 0xf4, 0xee, 0xff, 0xe2, 0x95, 0xf3, 0x4a,
-	0xfe, 0x5f, 0x82, 0x08, 0xfb, 0x67, 0x09, 0x47, 0x50, 0x82, 0x23, 0x9e, 0x8c, 0x69, 0xba, 0x59,
-	0x99, 0x82, 0xd5, 0xfe, 0x71, 0x16, 0xb2, 0x38, 0xc3, 0x88, 0x3c, 0xf4, 0x46, 0x22, 0x8a, 0x2f,
-	0x2b, 0x53, 0x63, 0x0a, 0x86, 0xa6, 0x8d, 0xa9, 0xae, 0xf8, 0x23, 0x34, 0xa5, 0x3c, 0x66, 0xc1,
-	0x88, 0x39, 0xf6, 0xbd, 0x13, 0xdb, 0x89, 0x31, 0xb5, 0x11, 0x34, 0x03, 0xe6, 0x5f, 0x87, 0x9b,
-	0x23, 0xd3, 0x3f, 0x15, 0x92, 0x76, 0xf7, 0x33, 0xcf, 0x3f, 0x0d, 0x70, 0xe6, 0xda, 0x96, 0x0e,
-	0x4c, 0x5e, 0xf2, 0x96, 0xbf, 0x0e, 0x1b, 0xcf, 0xc3, 0x66, 0xf4, 0x0d, 0x15, 0x1a, 0x9c, 0x7f,
-	0x81, 0xea, 0xd6, 0x12, 0x67, 0x36, 0xf1, 0x2d, 0xaa, 0xec, 0xd9, 0xb0, 0x8d, 0xa2, 0x64, 0xaa,
-	0x89, 0xec, 0xea, 0x2f, 0xeb, 0x1b, 0xa6, 0x69, 0x28, 0x5a, 0x5b, 0x2a, 0xab, 0x28, 0x68, 0x5b,
-	0x14, 0x59, 0x2d, 0x19, 0x31, 0x00, 0x05, 0x8d, 0x3e, 0xf9, 0x54, 0x29, 0xd5, 0x8a, 0x72, 0x41,
-	0x13, 0x20, 0xc4, 0x90, 0xa2, 0x3f, 0x0c, 0x3f, 0xa2, 0xc2, 0x9e, 0x49, 0x10, 0xbf, 0x03, 0x30,
-	0x30, 0xa5, 0x78, 0x6e, 0x5e, 0x3c, 0xf1, 0x9d, 0xaa, 0x50, 0x57, 0x25, 0x31, 0x04, 0x9d, 0x58,
-	0xc7, 0xeb, 0x9b, 0x4e, 0x57, 0x7a, 0xbe, 0x39, 0x10, 0x87, 0xa6, 0x1c, 0x56, 0x07, 0xca, 0x89,
-	0x9d, 0x85, 0xe3, 0x88, 0xa5, 0x3d, 0x12, 0x9f, 0x78, 0xae, 0xa8, 0x0e, 0xd5, 0x88, 0xc3, 0x36,
-	0xf6, 0xc4, 0x74, 0x4d, 0xe7, 0x42, 0xda, 0x7d, 0x1c, 0x8b, 0xad, 0x7a, 0x92, 0x00, 0x51, 0xd8,
-	0x40, 0x48, 0x9c, 0xc7, 0xb6, 0x55, 0xfd, 0xbe, 0x1a, 0x6b, 0x04, 0xa8, 0x7d, 0x93, 0xae, 0xa7,
-	0x86, 0xf5, 0xb7, 0xa0, 0xb2, 0x87, 0xdf, 0x6d, 0x8c, 0xed, 0x6e, 0xdf, 0x1b, 0x0b, 0x54, 0xd3,
-	0x14, 0xe8, 0xa5, 0xb0, 0x40, 0x19, 0x0a, 0x1f, 0x05, 0x9e, 0xdb, 0x38, 0x6c, 0xab, 0x83, 0x63,
-	0x67, 0xe2, 0x38, 0x2c, 0x5d, 0xef, 0x00, 0xc4, 0xf2, 0x8a, 0x87, 0x40, 0x83, 0xee, 0x82, 0xd8,
-	0x8a, 0x0a, 0x42, 0xb9, 0x96, 0xed, 0x0e, 0xb6, 0xb5, 0x88, 0xb2, 0x14, 0x02, 0x29, 0xb8, 0x20,
-	0xac, 0x08, 0x48, 0x66, 0x08, 0xb5, 0x84, 0xc5, 0x32, 0xf5, 0xff, 0x93, 0x82, 0x72, 0x22, 0xf5,
-	0xe1, 0x8f, 0x31, 0x5d, 0x03, 0x0f, 0x69, 0x3c, 0xe6, 0x71, 0x35, 0x94, 0xf8, 0x46, 0x6d, 0x5c,
-	0x2b, 0x9d, 0x99, 0x81, 0x6f, 0x55, 0x28, 0x21, 0x01, 0xf9, 0x4c, 0xa9, 0x1a, 0xf5, 0x87, 0x3a,
-	0x1e, 0x53, 0x86, 0xc2, 0x13, 0xf7, 0xd4, 0xf5, 0x9e, 0xbb, 0xea, 0xf4, 0xa5, 0xfc, 0x9b, 0xa9,
-	0x9b, 0xc4, 0x30, 0x45, 0x26, 0x53, 0xff, 0xe7, 0xd9, 0x99, 0x54, 0xb5, 0x16, 0xe4, 0x95, 0x13,
-	0x40, 0xf6, 0xe9, 0x7c, 0x6e, 0x51, 0x12, 0x59, 0xdf, 0x5a, 0x25, 0x40, 0x86, 0x26, 0x46, 0xeb,
-	0x3c, 0x4a, 0xe4, 0x4c, 0x2f, 0xbc, 0x5d, 0x9b, 0x62, 0x14, 0x6a, 0xdc, 0xa9, 0x5c, 0xe6, 0x88,
-	0x43, 0xed, 0xaf, 0xa4, 0xe0, 0xfa, 0x22, 0x94, 0x64, 0xc6, 0x77, 0x6a, 0x3a, 0xe3, 0xbb, 0x3b,
-	0x93, 0x41, 0x9d, 0xa6, 0xd1, 0x3c, 0x78, 0xc9, 0x4e, 0x4c, 0xe7, 0x53, 0xd7, 0x7f, 0x37, 0x05,
-	0x1b, 0x73, 0x63, 0x4e, 0x58, 0x27, 0x00, 0x79, 0x25, 0x59, 0x2a, 0xc1, 0x29, 0x4a, 0x39, 0x51,
-	0x57, 0x06, 0x74, 0x6e, 0x07, 0xea, 0x0e, 0x5f, 0xe7, 0x8c, 0x2b, 0xe3, 0x17, 0x57, 0x0d, 0x8f,
-	0x85, 0x81, 0x50, 0xe1, 0x55, 0x65, 0x42, 0x69, 0x48, 0x5e, 0x19, 0xa8, 0xea, 0x5e, 0x83, 0x15,
-	0x28, 0x71, 0x6a, 0x32, 0x76, 0xec, 0x3e, 0x36, 0x8b, 0xbc, 0x06, 0x37, 0x55, 0xe1, 0x80, 0x76,
-	0x06, 0x4f, 0x7a, 0x43, 0x9b, 0x36, 0x07, 0x2b, 0xe1, 0x77, 0x0e, 0x27, 0xc7, 0x8e, 0x1d, 0x0c,
-	0x19, 0xd4, 0x0d, 0xb8, 0xb6, 0x60, 0x80, 0xd4, 0xe5, 0xa7, 0xba, 0xfb, 0x6b, 0x00, 0xdb, 0x4f,
-	0xc3, 0x4e, 0xb3, 0x14, 0xe7, 0xb0, 0xb6, 0xfd, 0x34, 0xc9, 0x5d, 0x6f, 0x9e, 0xa7, 0xa8, 0x93,
-	0x02, 0x96, 0xa9, 0xff, 0x6a, 0x2a, 0xcc, 0x6c, 0xa8, 0xfd, 0x59, 0xa8, 0xa8, 0x0e, 0x1f, 0x9a,
-	0x17, 0x8e, 0x67, 0x5a, 0xbc, 0x05, 0x6b, 0x41, 0x54, 0xda, 0x92, 0x38, 0x86, 0x66, 0x8f, 0xf7,
-	0xee, 0x14, 0x92, 0x31, 0x43, 0x14, 0x3a, 0x38, 0xe9, 0xf8, 0x3a, 0x84, 0x93, 0xab, 0x66, 0xd2,
-	0x96, 0x5b, 0x25, 0xe7, 0xcb, 0xac, 0x7f, 0x0d, 0x36, 0xba, 0xb1, 0xca, 0x56, 0x96, 0x30, 0x0a,
-	0x87, 0xd2, 0xf7, 0xdb, 0xa1, 0x70, 0xe8, 0x66, 0xfd, 0x3f, 0xe5, 0x01, 0xe2, 0xab, 0x9f, 0x05,
-	0x7b, 0x7e, 0x51, 0x26, 0xc3, 0xdc, 0x45, 0x6c, 0xe6, 0xa5, 0x2f, 0x62, 0xdf, 0x8b, 0x0c, 0x72,
-	0x15, 0x16, 0x9e, 0x4d, 0xe7, 0x8e, 0xfb, 0x34, 0x6b, 0x86, 0x4f, 0x25, 0xfa, 0xe4, 0x66, 0x13,
-	0x7d, 0xee, 0xce, 0x67, 0x05, 0xce, 0x28, 0xa3, 0x38, 0xde, 0x50, 0x98, 0x8a, 0x37, 0xd4, 0xa0,
-	0xe8, 0x0b, 0xd3, 0xf2, 0x5c, 0xe7, 0x22, 0xbc, 0xef, 0x0b, 0xdb, 0xfc, 0x2d, 0xc8, 0x49, 0xaa,
-	0xce, 0x29, 0xd2, 0xde, 0x79, 0xc1, 0xc2, 0x29, 0x5c, 0xd4, 0x6c, 0x76, 0xa0, 0x53, 0xf9, 0xd4,
-	0x59, 0x58, 0x34, 0x12, 0x10, 0xbe, 0x09, 0xdc, 0x46, 0xe7, 0xcb, 0x71, 0x84, 0xb5, 0x75, 0xb1,
-	0xad, 0xae, 0xe1, 0xe8, 0xb4, 0x2e, 0x1a, 0x0b, 0xde, 0x84, 0xeb, 0xbf, 0x1a, 0xaf, 0x3f, 0x75,
-	0xf9, 0xcc, 0x0e, 0x70, 0xa4, 0x15, 0x32, 0x4a, 0xa2, 0x36, 0xda, 0x03, 0xe1, 0x86, 0x55, 0x73,
-	0x49, 0xd2, 0x1b, 0xdf, 0x65, 0x5f, 0xf2, 0xb6, 0xfe, 0xfb, 0xe9, 0xc8, 0x71, 0x29, 0x41, 0xee,
-	0xd8, 0x0c, 0xec, 0xbe, 0x3a, 0x83, 0xb4, 0xc1, 0xa1, 0xce, 0x20, 0xe9, 0x59, 0x1e, 0x4b, 0xa3,
-	0x0f, 0x12, 0x08, 0x7d, 0x59, 0x12, 0x57, 0x2c, 0xb1, 0x2c, 0x6e, 0xd4, 0x70, 0xbd, 0x55, 0x46,
-	0x0e, 0x91, 0x52, 0xe8, 0xcb, 0x8a, 0x72, 0x1d, 0xc9, 0x89, 0xa5, 0x83, 0x80, 0x15, 0x11, 0xc7,
-	0xf5, 0xa4, 0x50, 0x81, 0x3f, 0x92, 0x4e, 0x06, 0xc8, 0x26, 0x4c, 0xc1, 0x67, 0x65, 0x74, 0x0a,
-	0x42, 0xa6, 0x2a, 0x5a, 0x17, 0x90, 0xcb, 0xb4, 0x8a, 0xbb, 0x73, 0xfa, 0x05, 0xab, 0x60, 0x8f,
-	0xe2, 0x42, 0x28, 0xb6, 0x86, 0x5c, 0x4d, 0xca, 0x13, 0x59, 0xc7, 0xc7, 0x33, 0xca, 0x1e, 0x61,
-	0xf8, 0x55, 0x0b, 0xb5, 0xc7, 0x06, 0xf6, 0x2c, 0x32, 0x32, 0x18, 0x47, 0x9f, 0x67, 0x6c, 0xa2,
-	0x03, 0x62, 0x8f, 0x4d, 0x57, 0xb2, 0x6b, 0x38, 0xd4, 0xb1, 0x75, 0xc2, 0xae, 0x23, 0x49, 0x7f,
-	0x68, 0x4a, 0x76, 0x03, 0x71, 0xf0, 0x69, 0x5b, 0xf8, 0xb8, 0x9e, 0xec, 0x26, 0xe2, 0x48, 0x73,
-	0xc0, 0x6e, 0xd5, 0x7f, 0x23, 0xce, 0x36, 0x7e, 0x23, 0x72, 0x0d, 0x96, 0x11, 0x72, 0x74, 0x1e,
-	0x16, 0xed, 0xb8, 0x16, 0x6c, 0xf8, 0xe2, 0x07, 0x13, 0x7b, 0x2a, 0x07, 0x3f, 0x73, 0x75, 0x92,
-	0xc7, 0x3c, 0x45, 0xfd, 0x0c, 0x36, 0xc2, 0xc6, 0x33, 0x5b, 0x0e, 0x29, 0x4a, 0xc3, 0xdf, 0x4a,
-	0x14, 0x09, 0xa4, 0x16, 0x16, 0x57, 0x45, 0x2c, 0xe3, 0xa2, 0x80, 0x28, 0x0a, 0x9f, 0x5e, 0x22,
-	0x0a, 0x5f, 0xff, 0xdf, 0xc9, 0x6b, 0x5d, 0xe5, 0x2c, 0x59, 0x91, 0xb3, 0x34, 0x7f, 0xcd, 0x1b,
-	0x07, 0xd6, 0xd3, 0x2f, 0x13, 0x58, 0x5f, 0x94, 0x32, 0xf1, 0x3e, 0xda, 0xee, 0xb4, 0x7f, 0x9e,
-	0x2e, 0x71, 0x69, 0x30, 0x85, 0xcb, 0xb7, 0xe8, 0xd2, 0xd6, 0xec, 0xaa, 0x7c, 0x9e, 0xdc, 0xc2,
-	0x92, 0x9d, 0xe4, 0xed, 0xac, 0xc6, 0x34, 0x12, 0x54, 0x09, 0x6d, 0x93, 0x5f, 0xa4, 0x6d, 0xd0,
-	0x6f, 0xd5, 0x7a, 0x28, 0x6a, 0xab, 0x3b, 0x16, 0xf5, 0x1c, 0xb2, 0x27, 0x8b, 0xbc, 0x68, 0xcc,
-	0xc1, 0xd1, 0x24, 0x1b, 0x4d, 0x1c, 0x69, 0xeb, 0x6b, 0x04, 0xd5, 0x98, 0xad, 0x29, 0x2c, 0xcd,
-	0xd7, 0x14, 0x7e, 0x08, 0x10, 0x08, 0xdc, 0x1d, 0xdb, 0x76, 0x5f, 0xea, 0xac, 0x9f, 0x3b, 0x97,
-	0x8d, 0x4d, 0x5f, 0x7e, 0x24, 0x28, 0xb0, 0xff, 0x23, 0xf3, 0x9c, 0x2e, 0x44, 0x75, 0x7a, 0x42,
-	0xd4, 0x9e, 0xd5, 0xc1, 0x6b, 0xf3, 0x3a, 0xf8, 0x2d, 0xc8, 0x05, 0x68, 0xe8, 0x52, 0x59, 0xcc,
-	0xe5, 0xeb, 0xbb, 0x49, 0xd6, 0xb0, 0xa1, 0x70, 0x29, 0x1c, 0x88, 0x5a, 0xca, 0xf3, 0xa9, 0x20,
-	0xa6, 0x64, 0x84, 0xcd, 0x29, 0x3d, 0x78, 0x73, 0x5a, 0x0f, 0xd6, 0x2c, 0xc8, 0xeb, 0xd0, 0xfe,
-	0xac, 0x93, 0x1e, 0x06, 0x05, 0xd3, 0x89, 0xa0, 0x60, 0x94, 0x5b, 0x9a, 0x49, 0xe6, 0x96, 0xce,
-	0xd4, 0xcc, 0xe5, 0xe6, 0x6a, 0xe6, 0xea, 0x9f, 0x40, 0x4e, 0x59, 0xee, 0x10, 0x1a, 0x8d, 0xca,
-	0xe0, 0xc4, 0x41, 0xb1, 0x14, 0xbf, 0x0e, 0x2c, 0x10, 0x64, 0x91, 0x88, 0xae, 0x39, 0x12, 0xa4,
-	0x24, 0xd3, 0xbc, 0x0a, 0xd7, 0x15, 0x6e, 0x30, 0xfd, 0x86, 0xcc, 0x22, 0xc7, 0x3e, 0xf6, 0x4d,
-	0xff, 0x82, 0x65, 0xeb, 0x1f, 0xd2, 0xc5, 0x7a, 0x28, 0x50, 0xe5, 0xa8, 0x46, 0x51, 0xa9, 0x65,
-	0x4b, 0x6b, 0x1f, 0xca, 0xcb, 0xd0, 0x9e, 0x96, 0xca, 0x56, 0x23, 0x57, 0x86, 0x62, 0x31, 0xab,
-	0xc9, 0x93, 0xf8, 0x8f, 0x6d, 0xbf, 0xd5, 0xb7, 0x12, 0x76, 0xdd, 0x74, 0xfa, 0x59, 0x6a, 0xd9,
-	0xf4, 0xb3, 0xfa, 0x63, 0x58, 0x37, 0xa6, 0x75, 0x3a, 0x7f, 0x0f, 0x0a, 0xde, 0x38, 0xc9, 0xe7,
-	0x45, 0x72, 0x19, 0xa2, 0xd7, 0x7f, 0x9a, 0x82, 0xd5, 0xb6, 0x2b, 0x85, 0xef, 0x9a, 0xce, 0x8e,
-	0x63, 0x0e, 0xf8, 0xbb, 0xa1, 0x96, 0x5a, 0xec, 0xf7, 0x27, 0x71, 0xa7, 0x15, 0x96, 0xa3, 0x43,
-	0xd8, 0xfc, 0x06, 0x6c, 0x08, 0xcb, 0x96, 0x9e, 0xaf, 0xac, 0xd9, 0x30, 0x4b, 0xf0, 0x3a, 0x30,
-	0x05, 0xee, 0xd2, 0x96, 0xe8, 0xa9, 0x65, 0xae, 0xc2, 0xf5, 0x29, 0x68, 0x68, 0xaa, 0xa6, 0xf9,
-	0x6d, 0xa8, 0xc6, 0xa7, 0xd1, 0xb6, 0xe7, 0xca, 0xb6, 0x6b, 0x89, 0x73, 0x32, 0x85, 0x58, 0xa6,
-	0xfe, 0xeb, 0x85, 0xd0, 0x08, 0x7b, 0xaa, 0x73, 0x08, 0x7d, 0xcf, 0x8b, 0x0b, 0x54, 0x75, 0x2b,
-	0x51, 0x08, 0x9d, 0x5e, 0xa2, 0x10, 0xfa, 0xc3, 0xb8, 0x98, 0x55, 0x1d, 0x14, 0xaf, 0x2c, 0x3c,
-	0x7d, 0x28, 0xf5, 0x49, 0xdb, 0xe0, 0x5d, 0x91, 0xa8, 0x6c, 0x7d, 0x53, 0x3b, 0x5e, 0xd9, 0x65,
-	0x6c, 0x55, 0x95, 0x25, 0xf0, 0xce, 0x6c, 0x05, 0xc5, 0x72, 0x29, 0x88, 0x73, 0xe6, 0x24, 0xbc,
-	0xb4, 0x39, 0xf9, 0xed, 0x19, 0x1f, 0xa7, 0xb8, 0x30, 0x14, 0x76, 0x45, 0x7d, 0xe8, 0xb7, 0xa1,
-	0x30, 0xb4, 0x03, 0xe9, 0xf9, 0xaa, 0x66, 0x79, 0xbe, 0xc6, 0x2a, 0x31, 0x5b, 0xbb, 0x0a, 0x91,
-	0xf2, 0xc5, 0x42, 0x2a, 0xfe, 0x3d, 0xd8, 0xa0, 0x89, 0x3f, 0x8c, 0xad, 0x86, 0xa0, 0x5a, 0x5e,
-	0x98, 0xa7, 0x97, 0x60, 0xb5, 0x35, 0x43, 0x62, 0xcc, 0x33, 0xa9, 0x0d, 0x00, 0xe2, 0xf5, 0x99,
-	0xd3, 0x62, 0x9f, 0xa1, 0x66, 0xf9, 0x26, 0xe4, 0x83, 0xc9, 0x71, 0x7c, 0xd7, 0xa5, 0x5b, 0xb5,
-	0x73, 0xa8, 0xcd, 0x59, 0x07, 0x87, 0xc2, 0x57, 0xdd, 0xbd, 0xb2, 0x70, 0xfa, 0xc3, 0xe4, 0xc2,
-	0x2b, 0xe1, 0xbc, 0x7b, 0xc9, 0xea, 0x45, 0x9c, 0x13, 0x12, 0x50, 0x7b, 0x07, 0xca, 0x89, 0x49,
-	0x45, 0xcd, 0x3c, 0x71, 0x2d, 0x2f, 0x0c, 0xbf, 0xe2, 0xb3, 0x2a, 0x1c, 0xb3, 0xc2, 0x00, 0x2c,
-	0x3d, 0xd7, 0x0c, 0x60, 0xb3, 0x13, 0x78, 0x85, 0x1f, 0xfc, 0x0a, 0x54, 0x12, 0x26, 0x5d, 0x14,
-	0x9a, 0x9b, 0x06, 0xd6, 0xcf, 0xe0, 0xf3, 0x09, 0x76, 0x87, 0xc2, 0x1f, 0xd9, 0x01, 0x1e, 0x24,
-	0xca, 0xa5, 0xa3, 0x50, 0x86, 0x25, 0x5c, 0x69, 0xcb, 0x50, 0x83, 0x46, 0x6d, 0xfe, 0x4d, 0xc8,
-	0x8d, 0x85, 0x3f, 0x0a, 0xb4, 0x16, 0x9d, 0x95, 0xa0, 0x85, 0x6c, 0x03, 0x43, 0xd1, 0xd4, 0xff,
-	0x7e, 0x0a, 0x8a, 0xfb, 0x42, 0x9a, 0x68, 0x3b, 0xf0, 0xfd, 0x99, 0xaf, 0xcc, 0xdf, 0xcf, 0x86,
-	0xa8, 0x9b, 0xda, 0xc9, 0xdc, 0x6c, 0x6b, 0x7c, 0xdd, 0xde, 0x5d, 0x89, 0x3b, 0x56, 0xdb, 0x82,
-	0x82, 0x06, 0xd7, 0xde, 0x85, 0xf5, 0x19, 0x4c, 0x9a, 0x17, 0x65, 0xdb, 0x77, 0x2f, 0x46, 0x61,
-	0x12, 0xd1, 0xaa, 0x31, 0x0d, 0xdc, 0x2a, 0x41, 0x61, 0xac, 0x08, 0xea, 0xbf, 0x7f, 0x83, 0x52,
-	0x57, 0xec, 0x13, 0xf4, 0xbc, 0x17, 0x9d, 0xac, 0x77, 0x00, 0xe8, 0x68, 0x56, 0x09, 0x0e, 0x2a,
-	0x5c, 0x9a, 0x80, 0xf0, 0xf7, 0xa3, 0x38, 0x77, 0x76, 0xa1, 0x51, 0x95, 0x64, 0x3e, 0x1b, 0xec,
-	0xae, 0x42, 0xc1, 0x0e, 0x28, 0x5a, 0xa6, 0x93, 0x82, 0xc2, 0x26, 0xff, 0x16, 0xe4, 0xed, 0xd1,
-	0xd8, 0xf3, 0xa5, 0x0e, 0x84, 0x5f, 0xc9, 0xb5, 0x4d, 0x98, 0xbb, 0x2b, 0x86, 0xa6, 0x41, 0x6a,
-	0x71, 0x4e, 0xd4, 0xc5, 0x17, 0x53, 0xb7, 0xce, 0x43, 0x6a, 0x45, 0xc3, 0xbf, 0x0b, 0x95, 0x81,
-	0xca, 0x89, 0x54, 0x8c, 0xb5, 0x12, 0xf9, 0xca, 0x55, 0x4c, 0x1e, 0x25, 0x09, 0x76, 0x57, 0x8c,
-	0x69, 0x0e, 0xc8, 0x12, 0x0d, 0x78, 0x11, 0xc8, 0x9e, 0xf7, 0x91, 0x67, 0xbb, 0xe4, 0x94, 0xbe,
-	0x80, 0xa5, 0x91, 0x24, 0x40, 0x96, 0x53, 0x1c, 0xf8, 0xd7, 0xd1, 0xe2, 0x09, 0xa4, 0x2e, 0x1b,
-	0xbf, 0x7b, 0x15, 0xa7, 0x9e, 0x08, 0x74, 0xc1, 0x77, 0x20, 0xf9, 0x39, 0xd4, 0x12, 0x9b, 0x44,
-	0x7f, 0xa4, 0x31, 0x1e, 0xfb, 0x1e, 0x7a, 0xb6, 0x15, 0xe2, 0xf6, 0xf5, 0xab, 0xb8, 0x1d, 0x5e,
-	0x4a, 0xbd, 0xbb, 0x62, 0x5c, 0xc1, 0x9b, 0xf7, 0xd0, 0xb3, 0xd3, 0x43, 0xd8, 0x13, 0xe6, 0x59,
-	0x58, 0x74, 0x7e, 0x7f, 0xa9, 0x59, 0x20, 0x8a, 0xdd, 0x15, 0x63, 0x86, 0x07, 0xff, 0x65, 0xd8,
-	0x98, 0xfa, 0x26, 0xd5, 0x99, 0xaa, 0x92, 0xf4, 0xaf, 0x2d, 0x3d, 0x0c, 0x24, 0xda, 0x5d, 0x31,
-	0xe6, 0x39, 0xf1, 0x09, 0x7c, 0x6e, 0x7e, 0x48, 0xdb, 0xa2, 0xef, 0xd8, 0xae, 0xd0, 0xd5, 0xeb,
-	0xef, 0xbc, 0xdc, 0x6c, 0x69, 0xe2, 0xdd, 0x15, 0xe3, 0x72, 0xce, 0xfc, 0xcf, 0xc3, 0xed, 0xf1,
-	0x42, 0x15, 0xa3, 0x54, 0x97, 0x2e, 0x7e, 0x7f, 0x6f, 0xc9, 0x2f, 0xcf, 0xd1, 0xef, 0xae, 0x18,
-	0x57, 0xf2, 0x47, 0xdb, 0x99, 0x3c, 0x68, 0x9d, 0xba, 0xad, 0x1a, 0xfc, 0x36, 0x94, 0xcc, 0xbe,
-	0xb3, 0x2b, 0x4c, 0x2b, 0x8a, 0xd5, 0xc7, 0x80, 0xda, 0xff, 0x48, 0x41, 0x5e, 0xcb, 0xfb, 0xed,
-	0xe8, 0x3e, 0x3e, 0x52, 0xdd, 0x31, 0x80, 0x7f, 0x00, 0x25, 0xe1, 0xfb, 0x9e, 0xdf, 0xf4, 0xac,
-	0x30, 0x95, 0x71, 0x36, 0x16, 0xac, 0xf8, 0x6c, 0xb6, 0x42, 0x34, 0x23, 0xa6, 0xe0, 0xef, 0x03,
-	0xa8, 0x7d, 0xde, 0x8b, 0x2b, 0x70, 0x6a, 0x8b, 0xe9, 0xd5, 0xf5, 0x4f, 0x8c, 0x1d, 0x07, 0xcf,
-	0xc2, 0xbb, 0x97, 0xb0, 0x19, 0x39, 0x9c, 0xb9, 0x84, 0xc3, 0x79, 0x5b, 0xc7, 0x11, 0x0e, 0xf0,
-	0x85, 0xae, 0x43, 0x8b, 0x00, 0xb5, 0x7f, 0x95, 0x82, 0xbc, 0x52, 0x1e, 0xbc, 0x35, 0x3f, 0xa2,
-	0xd7, 0x5e, 0xac, 0x73, 0x36, 0x67, 0x47, 0xf6, 0x2d, 0x00, 0xa5, 0x83, 0x12, 0x23, 0xbb, 0x3d,
-	0xc3, 0x47, 0x93, 0x86, 0xc9, 0xc3, 0x31, 0x7e, 0xfd, 0xa1, 0xaa, 0x95, 0xa2, 0xc0, 0xed, 0x93,
-	0xbd, 0x3d, 0xb6, 0xc2, 0x37, 0xa0, 0xf2, 0xe4, 0xe0, 0xf1, 0x41, 0xe7, 0xd9, 0xc1, 0x51, 0xcb,
-	0x30, 0x3a, 0x86, 0x8a, 0xdf, 0x6e, 0x35, 0xb6, 0x8f, 0xda, 0x07, 0x87, 0x4f, 0x7a, 0x2c, 0x5d,
-	0xfb, 0xa7, 0x29, 0xa8, 0x4c, 0xe9, 0xae, 0x3f, 0xd9, 0xa5, 0x4b, 0x4c, 0x7f, 0x66, 0xf1, 0xf4,
-	0x67, 0x2f, 0x9b, 0xfe, 0xdc, 0xec, 0xf4, 0xff, 0x76, 0x0a, 0x2a, 0x53, 0x3a, 0x32, 0xc9, 0x3d,
-	0x35, 0xcd, 0x3d, 0x79, 0xd2, 0xa7, 0x67, 0x4e, 0xfa, 0x3a, 0xac, 0x86, 0xcf, 0x07, 0x71, 0xc4,
-	0x61, 0x0a, 0x96, 0xc4, 0xa1, 0x62, 0x85, 0xec, 0x34, 0x0e, 0x15, 0x2c, 0x5c, 0xdd, 0x5b, 0x2a,
-	0xce, 0x0c, 0xa8, 0x76, 0xbd, 0x76, 0xb9, 0x06, 0xbd, 0x62, 0x08, 0x8f, 0xa0, 0x3c, 0x8e, 0xb7,
-	0xe9, 0xcb, 0x99, 0x25, 0x49, 0xca, 0x17, 0xf4, 0xf3, 0x77, 0x52, 0xb0, 0x36, 0xad, 0x73, 0xff,
-	0xbf, 0x9e, 0xd6, 0x7f, 0x98, 0x82, 0x8d, 0x39, 0x4d, 0x7e, 0xa5, 0x61, 0x37, 0xdb, 0xaf, 0xf4,
-	0x12, 0xfd, 0xca, 0x2c, 0xe8, 0xd7, 0xe5, 0x9a, 0xe4, 0xea, 0x1e, 0x77, 0xe1, 0x73, 0x97, 0x9e,
-	0x09, 0x57, 0x4c, 0xf5, 0x14, 0xd3, 0xcc, 0x2c, 0xd3, 0xdf, 0x4a, 0xc1, 0xed, 0xab, 0xf4, 0xfd,
-	0xff, 0x73, 0xb9, 0x9a, 0xed, 0x61, 0xfd, 0xdd, 0xe8, 0x12, 0xbf, 0x0c, 0x05, 0xfd, 0x9b, 0x50,
-	0x3a, 0x4d, 0x7a, 0xe8, 0x3d, 0x77, 0x55, 0x24, 0xda, 0x10, 0xa6, 0xae, 0x9a, 0x37, 0xc4, 0xd8,
-	0xb1, 0xe9, 0x26, 0xf3, 0x16, 0x40, 0x83, 0xfc, 0xba, 0xb0, 0x88, 0xa5, 0xb9, 0xd7, 0xe9, 0xb6,
-	0xd8, 0x4a, 0xd2, 0x88, 0xfd, 0x24, 0x54, 0xc4, 0xf5, 0x43, 0xc8, 0xc7, 0x65, 0x05, 0xfb, 0xa6,
-	0x7f, 0x6a, 0xa9, 0xfb, 0xc2, 0x55, 0x28, 0x1e, 0x6a, 0x17, 0x4a, 0x7d, 0xea, 0xa3, 0x6e, 0xe7,
-	0x40, 0x05, 0xbd, 0xb7, 0x3b, 0x3d, 0x55, 0x9c, 0xd0, 0x7d, 0xfa, 0x48, 0x5d, 0x5c, 0x3d, 0x32,
-	0x1a, 0x87, 0xbb, 0x47, 0x84, 0x91, 0xab, 0xff, 0x66, 0x36, 0x3c, 0xd5, 0xea, 0x86, 0xbe, 0x89,
-	0x04, 0xc8, 0xa3, 0x36, 0xf7, 0x34, 0xe3, 0xe8, 0x33, 0x94, 0x50, 0xdb, 0x3a, 0x57, 0x71, 0x08,
-	0x96, 0xe6, 0x79, 0x48, 0x1f, 0x1e, 0xab, 0x2c, 0xa0, 0x5d, 0x39, 0x72, 0x54, 0x55, 0x63, 0xef,
-	0x5c, 0xb2, 0x1c, 0x3e, 0x34, 0x83, 0x33, 0x96, 0xaf, 0xff, 0xb3, 0x0c, 0x94, 0x22, 0x55, 0xf9,
-	0x32, 0xaa, 0x9b, 0x73, 0x58, 0x6b, 0x1f, 0xf4, 0x5a, 0xc6, 0x41, 0x63, 0x4f, 0xa3, 0x64, 0xf8,
-	0x35, 0x58, 0xdf, 0x69, 0xef, 0xb5, 0x8e, 0xf6, 0x3a, 0x8d, 0x6d, 0x0d, 0x2c, 0xf2, 0x9b, 0xc0,
-	0xdb, 0xfb, 0x87, 0x1d, 0xa3, 0x77, 0xd4, 0xee, 0x1e, 0x35, 0x1b, 0x07, 0xcd, 0xd6, 0x5e, 0x6b,
-	0x9b, 0xe5, 0xf9, 0x2b, 0x70, 0xf7, 0xa0, 0xd3, 0x6b, 0x77, 0x0e, 0x8e, 0x0e, 0x3a, 0x47, 0x9d,
-	0xad, 0x8f, 0x5a, 0xcd, 0x5e, 0xf7, 0xa8, 0x7d, 0x70, 0x84, 0x5c, 0x1f, 0x19, 0x0d, 0x7c, 0xc3,
-	0x72, 0xfc, 0x2e, 0xdc, 0xd6, 0x58, 0xdd, 0x96, 0xf1, 0xb4, 0x65, 0x20, 0x93, 0x27, 0x07, 0x8d,
-	0xa7, 0x8d, 0xf6, 0x5e, 0x63, 0x6b, 0xaf, 0xc5, 0x56, 0xf9, 0x1d, 0xa8, 0x69, 0x0c, 0xa3, 0xd1,
-	0x6b, 0x1d, 0xed, 0xb5, 0xf7, 0xdb, 0xbd, 0xa3, 0xd6, 0xf7, 0x9a, 0xad, 0xd6, 0x76, 0x6b, 0x9b,
-	0x55, 0xf8, 0x57, 0xe0, 0xcb, 0xd4, 0x29, 0xdd, 0x89, 0xe9, 0x8f, 0x7d, 0xd2, 0x3e, 0x3c, 0x6a,
-	0x18, 0xcd, 0xdd, 0xf6, 0xd3, 0x16, 0x5b, 0xe3, 0xaf, 0xc1, 0x97, 0x2e, 0x47, 0xdd, 0x6e, 0x1b,
-	0xad, 0x66, 0xaf, 0x63, 0x7c, 0xcc, 0x36, 0xf8, 0x17, 0xe0, 0x73, 0xbb, 0xbd, 0xfd, 0xbd, 0xa3,
-	0x67, 0x46, 0xe7, 0xe0, 0xd1, 0x11, 0x3d, 0x76, 0x7b, 0xc6, 0x93, 0x66, 0xef, 0x89, 0xd1, 0x62,
-	0xc0, 0x6b, 0x70, 0xf3, 0x70, 0xeb, 0xe8, 0xa0, 0xd3, 0x3b, 0x6a, 0x1c, 0x7c, 0xbc, 0xb5, 0xd7,
-	0x69, 0x3e, 0x3e, 0xda, 0xe9, 0x18, 0xfb, 0x8d, 0x1e, 0x2b, 0xf3, 0xaf, 0xc2, 0x6b, 0xcd, 0xee,
-	0x53, 0xdd, 0xcd, 0xce, 0xce, 0x91, 0xd1, 0x79, 0xd6, 0x3d, 0xea, 0x18, 0x47, 0x46, 0x6b, 0x8f,
-	0xc6, 0xdc, 0x8d, 0xfb, 0x5e, 0xe0, 0xb7, 0xa1, 0xda, 0x3e, 0xe8, 0x3e, 0xd9, 0xd9, 0x69, 0x37,
-	0xdb, 0xad, 0x83, 0xde, 0xd1, 0x61, 0xcb, 0xd8, 0x6f, 0x77, 0xbb, 0x88, 0xc6, 0x4a, 0xf5, 0xef,
-	0x40, 0xbe, 0xed, 0x9e, 0xd9, 0x92, 0xf6, 0x97, 0x16, 0x46, 0xed, 0x71, 0x85, 0x4d, 0xda, 0x16,
-	0xf6, 0xc0, 0xa5, 0x6a, 0x7d, 0xda, 0x5d, 0xab, 0x46, 0x0c, 0xa8, 0xff, 0xa3, 0x34, 0x54, 0x14,
-	0x8b, 0xd0, 0x83, 0xbb, 0x07, 0xeb, 0x3a, 0x14, 0xda, 0x9e, 0x56, 0x61, 0xb3, 0x60, 0xfa, 0x19,
-	0x2c, 0x05, 0x4a, 0x28, 0xb2, 0x24, 0x88, 0xae, 0xd7, 0x88, 0x39, 0x7a, 0x82, 0xea, 0x62, 0x31,
-	0x06, 0x7c, 0x56, 0x0d, 0x86, 0xda, 0x51, 0x21, 0xf6, 0x3d, 0xb7, 0x19, 0x95, 0x6d, 0x4c, 0xc1,
-	0xf8, 0x27, 0x70, 0x2b, 0x6a, 0xb7, 0xdc, 0xbe, 0x7f, 0x31, 0x8e, 0x7e, 0xad, 0xae, 0xb0, 0x30,
-	0xa4, 0xb0, 0x63, 0x3b, 0x62, 0x0a, 0xd1, 0xb8, 0x8c, 0x41, 0xfd, 0x0f, 0x52, 0x09, 0xbf, 0x57,
-	0xf9, 0xb5, 0x57, 0x6a, 0xfc, 0x45, 0x77, 0x30, 0xe8, 0x79, 0xea, 0xee, 0x6b, 0x43, 0x44, 0x37,
-	0xf9, 0x21, 0x70, 0x7b, 0xbe, 0xd3, 0xd9, 0x25, 0x3b, 0xbd, 0x80, 0x76, 0x36, 0x84, 0x9e, 0x9b,
-	0x0f, 0xa1, 0xdf, 0x01, 0x18, 0x38, 0xde, 0xb1, 0xe9, 0x24, 0x0c, 0xcd, 0x04, 0xa4, 0xee, 0x40,
-	0x31, 0xfc, 0x4d, 0x3c, 0x7e, 0x13, 0xf2, 0xf4, 0xab, 0x78, 0x51, 0x40, 0x51, 0xb5, 0xf8, 0x2e,
-	0xac, 0x89, 0xe9, 0x3e, 0xa7, 0x97, 0xec, 0xf3, 0x0c, 0x5d, 0xfd, 0x1b, 0xb0, 0x31, 0x87, 0x84,
-	0x93, 0x38, 0x36, 0x65, 0x54, 0x18, 0x8f, 0xcf, 0xf3, 0x97, 0xd8, 0xf5, 0xff, 0x98, 0x86, 0xd5,
-	0x7d, 0xd3, 0xb5, 0x4f, 0x44, 0x20, 0xc3, 0xde, 0x06, 0xfd, 0xa1, 0x18, 0x99, 0x61, 0x6f, 0x55,
-	0x4b, 0x47, 0x19, 0xd2, 0xc9, 0xf8, 0xfd, 0xdc, 0x75, 0xcf, 0x4d, 0xc8, 0x9b, 0x13, 0x39, 0x8c,
-	0x72, 0xc5, 0x75, 0x0b, 0xd7, 0xce, 0xb1, 0xfb, 0xc2, 0x0d, 0x42, 0xd9, 0x0c, 0x9b, 0x71, 0x4e,
-	0x4b, 0xfe, 0x8a, 0x9c, 0x96, 0xc2, 0xfc, 0xfc, 0xdf, 0x85, 0x72, 0xd0, 0xf7, 0x85, 0x70, 0x83,
-	0xa1, 0x27, 0xc3, 0xdf, 0x53, 0x4c, 0x82, 0x28, 0x6d, 0xcc, 0x7b, 0xee, 0xe2, 0x0e, 0xdd, 0xb3,
-	0xdd, 0x53, 0x9d, 0x0d, 0x35, 0x05, 0x43, 0x19, 0xa4, 0x18, 0x8b, 0xfd, 0x43, 0x41, 0xfe, 0x7d,
-	0xce, 0x88, 0xda, 0x14, 0x45, 0x31, 0xa5, 0x18, 0x78, 0xbe, 0x2d, 0x54, 0x28, 0xb1, 0x64, 0x24,
-	0x20, 0x48, 0xeb, 0x98, 0xee, 0x60, 0x62, 0x0e, 0x84, 0xbe, 0x14, 0x8e, 0xda, 0xf5, 0xff, 0x99,
-	0x03, 0xd8, 0x17, 0xa3, 0x63, 0xe1, 0x07, 0x43, 0x7b, 0x4c, 0x57, 0x1d, 0xb6, 0xce, 0x90, 0xad,
-	0x18, 0xf4, 0xcc, 0xdf, 0x9b, 0x4a, 0x5e, 0x9f, 0xbf, 0x9c, 0x8c, 0xc9, 0x67, 0x43, 0x30, 0x38,
-	0x39, 0xa6, 0x14, 0x3a, 0x9d, 0x88, 0xe6, 0x3f, 0x6b, 0x24, 0x41, 0x94, 0x26, 0x66, 0x4a, 0xd1,
-	0x72, 0x2d, 0x15, 0xe2, 0xc9, 0x1a, 0x51, 0x9b, 0xca, 0x5f, 0x82, 0xc6, 0x44, 0x7a, 0x86, 0x70,
-	0xc5, 0xf3, 0xa8, 0xb2, 0x2b, 0x06, 0xf1, 0x7d, 0xa8, 0x8c, 0xcd, 0x8b, 0x91, 0x70, 0xe5, 0xbe,
-	0x90, 0x43, 0xcf, 0xd2, 0xb9, 0x3f, 0xaf, 0x5d, 0xde, 0xc1, 0xc3, 0x24, 0xba, 0x31, 0x4d, 0x8d,
-	0x32, 0xe1, 0x06, 0xb4, 0x4b, 0xd4, 0x32, 0xea, 0x16, 0xdf, 0x02, 0x50, 0x4f, 0xe4, 0x39, 0x15,
-	0x17, 0x47, 0xa2, 0xcc, 0x91, 0x08, 0x84, 0x7f, 0x66, 0x2b, 0x3d, 0xa6, 0x7c, 0xc3, 0x98, 0x0a,
-	0xb5, 0xde, 0x24, 0x10, 0x7e, 0x6b, 0x64, 0xda, 0x8e, 0x5e, 0xe0, 0x18, 0xc0, 0xdf, 0x86, 0x1b,
-	0xc1, 0xe4, 0x18, 0x65, 0xe6, 0x58, 0xf4, 0xbc, 0x03, 0xf1, 0x3c, 0x70, 0x84, 0x94, 0xc2, 0xd7,
-	0xf9, 0x05, 0x8b, 0x5f, 0xd6, 0x07, 0x91, 0xd9, 0x43, 0xbf, 0xdd, 0x81, 0x4f, 0x71, 0x12, 0x53,
-	0x04, 0xd2, 0x19, 0x5e, 0x2c, 0xc5, 0x19, 0xac, 0x2a, 0x90, 0x4e, 0x00, 0x4b, 0xf3, 0x2f, 0xc3,
-	0x17, 0xa7, 0x90, 0x0c, 0x75, 0x11, 0x1c, 0xec, 0xd8, 0xae, 0xe9, 0xd8, 0x3f, 0x54, 0xd7, 0xf2,
-	0x99, 0xfa, 0x18, 0x2a, 0x53, 0x13, 0x47, 0xa5, 0x88, 0xf4, 0xa4, 0xb3, 0x60, 0x18, 0xac, 0xaa,
-	0x76, 0x57, 0xfa, 0x36, 0xdd, 0x70, 0x44, 0x90, 0x26, 0xee, 0x73, 0x8f, 0xa5, 0xf9, 0x75, 0x60,
-	0x0a, 0xd2, 0x76, 0xcd, 0xf1, 0xb8, 0x31, 0x1e, 0x3b, 0x82, 0x65, 0xa8, 0xcc, 0x33, 0x86, 0xaa,
-	0x14, 0x76, 0x96, 0xad, 0x7f, 0x0f, 0x6e, 0xd1, 0xcc, 0x3c, 0x15, 0x7e, 0xe4, 0xd8, 0xea, 0xb1,
-	0xde, 0x80, 0x0d, 0xf5, 0x74, 0xe0, 0x49, 0xf5, 0x9a, 0x8c, 0x3d, 0x0e, 0x6b, 0x0a, 0x8c, 0xb6,
-	0x4e, 0x57, 0x50, 0xf1, 0x66, 0x04, 0x8b, 0xf0, 0xd2, 0xf5, 0x9f, 0xe6, 0x81, 0xc7, 0x02, 0xd1,
-	0xb3, 0x85, 0xbf, 0x6d, 0x4a, 0x33, 0x11, 0x99, 0xac, 0x5c, 0x7a, 0xb7, 0xfe, 0xe2, 0xfc, 0xb5,
-	0x9b, 0x90, 0xb7, 0x03, 0x74, 0xc5, 0x74, 0x6a, 0xaa, 0x6e, 0xf1, 0x3d, 0x80, 0xb1, 0xf0, 0x6d,
-	0xcf, 0x22, 0x09, 0xca, 0x2d, 0xac, 0x21, 0x98, 0xef, 0xd4, 0xe6, 0x61, 0x44, 0x63, 0x24, 0xe8,
-	0xb1, 0x1f, 0xaa, 0xa5, 0x6e, 0xaa, 0xf3, 0xd4, 0xe9, 0x24, 0x88, 0xbf, 0x01, 0xd7, 0xc6, 0xbe,
-	0xdd, 0x17, 0x6a, 0x39, 0x9e, 0x04, 0x56, 0x93, 0x7e, 0xf1, 0xae, 0x40, 0x98, 0x8b, 0x5e, 0xa1,
-	0x04, 0x9a, 0x2e, 0x39, 0x28, 0x01, 0xdd, 0xcd, 0xea, 0x72, 0x67, 0x95, 0xbc, 0x59, 0x31, 0x16,
-	0xbf, 0xe4, 0xf7, 0x81, 0xe9, 0x17, 0xfb, 0xb6, 0xbb, 0x27, 0xdc, 0x81, 0x1c, 0x92, 0x70, 0x57,
-	0x8c, 0x39, 0x38, 0x69, 0x30, 0xf5, 0xbb, 0x42, 0xea, 0xde, 0xa6, 0x64, 0x44, 0x6d, 0x55, 0x42,
-	0xef, 0x78, 0x7e, 0x57, 0xfa, 0x3a, 0x0b, 0x35, 0x6a, 0xa3, 0xcd, 0x12, 0x50, 0x5f, 0x0f, 0x7d,
-	0xcf, 0x9a, 0xd0, 0xad, 0x82, 0x52, 0x62, 0xb3, 0xe0, 0x18, 0x73, 0xdf, 0x74, 0x75, 0x12, 0x61,
-	0x25, 0x89, 0x19, 0x81, 0xc9, 0x07, 0xf3, 0x82, 0x98, 0xe1, 0xba, 0xf6, 0xc1, 0x12, 0x30, 0x8d,
-	0x13, 0xb3, 0x62, 0x11, 0x4e, 0xcc, 0x87, 0xc6, 0x6f, 0xf9, 0x9e, 0x6d, 0xc5, 0xbc, 0x36, 0x54,
-	0x7e, 0xe8, 0x2c, 0x3c, 0x81, 0x1b, 0xf3, 0xe4, 0x53, 0xb8, 0x11, 0xbc, 0xfe, 0xa3, 0x14, 0x40,
-	0xbc, 0xf8, 0x28, 0xf2, 0x71, 0x2b, 0xde, 0xe2, 0xb7, 0xe0, 0x5a, 0x12, 0xec, 0xe8, 0x44, 0x50,
-	0x92, 0xfb, 0xf8, 0xc5, 0xb6, 0x79, 0x11, 0xb0, 0xb4, 0x2e, 0x38, 0xd6, 0xb0, 0x67, 0x42, 0x50,
-	0x56, 0xdd, 0x75, 0x60, 0x31, 0x90, 0xaa, 0xc8, 0x02, 0x96, 0x9d, 0x46, 0xfd, 0x58, 0x98, 0x7e,
-	0xc0, 0x72, 0xf5, 0x5d, 0xc8, 0xab, 0xcb, 0xa5, 0x05, 0xd7, 0xc2, 0x2f, 0x97, 0xe3, 0xf1, 0x57,
-	0x53, 0x00, 0xdb, 0x2a, 0x17, 0x18, 0x4f, 0xf1, 0x05, 0xb7, 0xed, 0x8b, 0x2c, 0x2a, 0xd3, 0xb2,
-	0x28, 0xa7, 0x3a, 0x13, 0xfd, 0x5a, 0x0d, 0x36, 0x51, 0x72, 0xcc, 0x30, 0x73, 0x4a, 0xed, 0xb9,
-	0xa8, 0xad, 0x0e, 0x90, 0xa6, 0xe7, 0xba, 0xa2, 0x8f, 0xc7, 0x4f, 0x74, 0x80, 0x44, 0xa0, 0xfa,
-	0xbf, 0x29, 0x40, 0xb9, 0x39, 0x34, 0xe5, 0xbe, 0x08, 0x02, 0x73, 0x20, 0xe6, 0xfa, 0x52, 0x85,
-	0x82, 0xe7, 0x5b, 0xc2, 0x8f, 0x2b, 0xc1, 0x74, 0x33, 0x99, 0x63, 0x90, 0x99, 0xce, 0x31, 0xb8,
-	0x0d, 0x25, 0x75, 0x83, 0x61, 0x35, 0x94, 0x1a, 0xc8, 0x18, 0x31, 0x00, 0xcf, 0xea, 0x91, 0x67,
-	0x91, 0x32, 0x6a, 0xa8, 0xe0, 0x7f, 0xc6, 0x48, 0x40, 0x54, 0x4a, 0xc7, 0xd8, 0xb9, 0xe8, 0x79,
-	0xba, 0x4f, 0x6d, 0x2b, 0x2e, 0x9b, 0x9d, 0x86, 0xf3, 0x26, 0x14, 0x46, 0xaa, 0xa1, 0x2f, 0x32,
-	0x66, 0x43, 0xfe, 0x89, 0xa1, 0x6d, 0xea, 0xbf, 0xba, 0x72, 0xc5, 0x08, 0x29, 0xd1, 0x45, 0x37,
-	0xa5, 0x34, 0xfb, 0xc3, 0x91, 0x56, 0x11, 0x99, 0x05, 0x77, 0x9a, 0x49, 0x46, 0x8d, 0x08, 0xdb,
-	0x48, 0x52, 0xf2, 0x2d, 0x28, 0xf9, 0xc2, 0x9c, 0xba, 0x56, 0x7d, 0xe5, 0x0a, 0x36, 0x46, 0x88,
-	0x6b, 0xc4, 0x64, 0xb5, 0x9f, 0xa4, 0x60, 0x6d, 0xba, 0xa3, 0x7f, 0x12, 0x3f, 0x38, 0xf6, 0xad,
-	0xf8, 0x07, 0xc7, 0x3e, 0xc3, 0x8f, 0x77, 0xfd, 0x56, 0x0a, 0x20, 0x9e, 0x03, 0x54, 0xf9, 0xea,
-	0x87, 0x91, 0x42, 0x23, 0x54, 0xb5, 0xf8, 0xee, 0x54, 0x35, 0xfd, 0xdb, 0x4b, 0x4d, 0x68, 0xe2,
-	0x31, 0x91, 0xa3, 0xfc, 0x00, 0xd6, 0xa6, 0xe1, 0x94, 0xdb, 0xdd, 0xde, 0x6b, 0xa9, 0x10, 0x47,
-	0x7b, 0xbf, 0xf1, 0xa8, 0xa5, 0x2b, 0x85, 0xda, 0x07, 0x8f, 0x59, 0xba, 0xf6, 0x87, 0x29, 0x28,
-	0x45, 0xd3, 0xcb, 0xbf, 0x9b, 0x5c, 0x17, 0x95, 0x27, 0xf1, 0xd6, 0x32, 0xeb, 0x12, 0x3f, 0xb5,
-	0x5c, 0xe9, 0x5f, 0x24, 0x97, 0xc9, 0x83, 0xb5, 0xe9, 0x97, 0x0b, 0x74, 0xc2, 0xa3, 0x69, 0x9d,
-	0xf0, 0xe6, 0x52, 0x9f, 0x0c, 0x3d, 0xaf, 0x3d, 0x3b, 0x90, 0x5a, 0x5d, 0xbc, 0x9f, 0x7e, 0x2f,
-	0x55, 0xbb, 0x0b, 0xab, 0xc9, 0x57, 0xf3, 0xe5, 0x80, 0xf7, 0xff, 0x30, 0x03, 0x6b, 0xd3, 0xa9,
-	0x06, 0x54, 0x7c, 0xa4, 0xd2, 0x5c, 0x3a, 0x8e, 0x95, 0x48, 0xeb, 0x66, 0x7c, 0x1d, 0xca, 0xda,
-	0xb7, 0x23, 0xc0, 0x06, 0x05, 0x51, 0xbc, 0x91, 0x60, 0x77, 0x93, 0x3f, 0xaa, 0xf8, 0x06, 0x87,
-	0xb0, 0x2c, 0x8c, 0x8d, 0x79, 0x49, 0xff, 0xbc, 0xd4, 0xaf, 0xa4, 0x79, 0x25, 0x91, 0x5c, 0xfc,
-	0x63, 0x34, 0x6c, 0xd6, 0xb7, 0x26, 0xae, 0xe5, 0x08, 0x2b, 0x82, 0xfe, 0x24, 0x09, 0x8d, 0xb2,
-	0x83, 0x7f, 0x25, 0xcb, 0xd7, 0xa0, 0xd4, 0x9d, 0x1c, 0xeb, 0xcc, 0xe0, 0xbf, 0x90, 0xe5, 0x37,
-	0x61, 0x43, 0x63, 0xc5, 0x29, 0x7e, 0xec, 0x2f, 0xa2, 0x0a, 0x5e, 0x6b, 0xa8, 0xf9, 0xd2, 0x1d,
-	0x65, 0x7f, 0x29, 0x8b, 0x5d, 0xa0, 0x6a, 0xe3, 0xbf, 0x4c, 0x7c, 0xa2, 0xda, 0x0c, 0xf6, 0xab,
-	0x59, 0xbe, 0x0e, 0xd0, 0xed, 0x45, 0x1f, 0xfa, 0xf5, 0x2c, 0x2f, 0x43, 0xbe, 0xdb, 0x23, 0x6e,
-	0x3f, 0xca, 0xf2, 0x1b, 0xc0, 0xe2, 0xb7, 0x3a, 0xf1, 0xf1, 0xaf, 0xa9, 0xce, 0x44, 0x99, 0x8c,
-	0x7f, 0x3d, 0x8b, 0xe3, 0x0a, 0x67, 0x99, 0xfd, 0x8d, 0x2c, 0x67, 0x50, 0x4e, 0x84, 0xe6, 0xd8,
-	0xdf, 0xcc, 0x72, 0x0e, 0x95, 0x7d, 0x3b, 0x08, 0x6c, 0x77, 0xa0, 0x47, 0xf0, 0x6b, 0xf4, 0xe5,
-	0x9d, 0xa8, 0xbc, 0x84, 0xfd, 0x46, 0x96, 0xdf, 0x02, 0x9e, 0xbc, 0x8e, 0xd0, 0x2f, 0xfe, 0x16,
-	0x51, 0x2b, 0xb5, 0x1f, 0x68, 0xd8, 0xdf, 0x26, 0x6a, 0x94, 0x04, 0x0d, 0xf8, 0x4d, 0x9a, 0x90,
-	0x66, 0x9c, 0x2a, 0xa9, 0xe1, 0x3f, 0x26, 0xe2, 0x70, 0x31, 0x15, 0xec, 0x27, 0xd9, 0xfb, 0x3f,
-	0xa5, 0x70, 0x72, 0x32, 0xe3, 0x88, 0xaf, 0x42, 0xd1, 0xf1, 0xdc, 0x81, 0x54, 0x3f, 0x66, 0x59,
-	0x81, 0x52, 0x30, 0xf4, 0x7c, 0x49, 0x4d, 0xaa, 0x7f, 0x73, 0xa9, 0x12, 0x5a, 0xe5, 0x96, 0x2b,
-	0x27, 0x45, 0x85, 0xe7, 0xa4, 0x39, 0x60, 0xe5, 0x28, 0xc9, 0x33, 0x1b, 0x25, 0xa2, 0x52, 0x45,
-	0x76, 0x58, 0xf1, 0xca, 0xf2, 0x88, 0x3a, 0xf1, 0x1d, 0x95, 0x90, 0x2a, 0xd0, 0x40, 0x55, 0xbf,
-	0x5a, 0x37, 0x1e, 0xa2, 0x1d, 0x5c, 0x52, 0x50, 0xef, 0xfb, 0xb6, 0xaa, 0xa5, 0xd4, 0xf9, 0x5d,
-	0x16, 0xf6, 0x23, 0x4a, 0x61, 0x60, 0xe2, 0xfe, 0xdf, 0x49, 0xc1, 0x6a, 0x58, 0x87, 0x6c, 0x0f,
-	0x6c, 0x57, 0xa5, 0xb4, 0x86, 0x3f, 0x11, 0xda, 0x77, 0xec, 0x71, 0xf8, 0x93, 0x7b, 0xeb, 0x50,
-	0xb6, 0x7c, 0x73, 0xd0, 0x70, 0xad, 0x6d, 0xdf, 0x1b, 0xab, 0x6e, 0xab, 0x0b, 0x27, 0x95, 0x4a,
-	0xfb, 0x5c, 0x1c, 0x23, 0xfa, 0x58, 0xf8, 0x2c, 0x4b, 0xb9, 0x63, 0x43, 0xd3, 0xb7, 0xdd, 0x41,
-	0xeb, 0x5c, 0x0a, 0x37, 0x50, 0x29, 0xb5, 0x65, 0x28, 0x4c, 0x02, 0xd1, 0x37, 0x03, 0xc1, 0xf2,
-	0xd8, 0x38, 0x9e, 0xd8, 0x8e, 0xb4, 0x5d, 0xf5, 0x4b, 0x77, 0x51, 0xce, 0x6c, 0x11, 0x47, 0x66,
-	0x8e, 0x6d, 0x56, 0xba, 0xff, 0x7b, 0x29, 0x28, 0x93, 0x58, 0xc4, 0x21, 0xd5, 0xd8, 0xe4, 0x28,
-	0x43, 0x61, 0x2f, 0xfa, 0xc9, 0xb3, 0x3c, 0xa4, 0x3b, 0xa7, 0x2a, 0xa4, 0xaa, 0xc5, 0x42, 0x95,
-	0x13, 0xaa, 0x5f, 0x3f, 0xcb, 0xf2, 0xcf, 0xc1, 0x0d, 0x43, 0x8c, 0x3c, 0x29, 0x9e, 0x99, 0xb6,
-	0x4c, 0x16, 0x99, 0xe4, 0xd0, 0x3b, 0x51, 0xaf, 0xc2, 0xaa, 0x92, 0x3c, 0x79, 0x27, 0xf8, 0xd9,
-	0x10, 0x52, 0xc0, 0xd1, 0x13, 0x44, 0xbb, 0x2b, 0xc5, 0x08, 0xe5, 0x23, 0xcf, 0x76, 0xf1, 0x6b,
-	0x54, 0xc4, 0x4a, 0x10, 0x8a, 0xcd, 0x23, 0x08, 0xee, 0x1f, 0xc0, 0xcd, 0xc5, 0x11, 0x65, 0x55,
-	0xde, 0x4a, 0xbf, 0xb3, 0x4b, 0x65, 0x07, 0xcf, 0x7c, 0x5b, 0x55, 0x29, 0x96, 0x20, 0xd7, 0x79,
-	0xee, 0x92, 0x58, 0x6c, 0x40, 0xe5, 0xc0, 0x4b, 0xd0, 0xb0, 0xcc, 0xfd, 0xfe, 0xd4, 0x25, 0x40,
-	0x3c, 0x29, 0x61, 0x27, 0x56, 0x12, 0x25, 0x35, 0x29, 0x15, 0x5e, 0xa6, 0x7f, 0x95, 0xa0, 0x4a,
-	0xff, 0x75, 0xf0, 0xdd, 0x52, 0xa5, 0xff, 0x51, 0x37, 0xb3, 0xea, 0x37, 0x90, 0xdc, 0xbe, 0x70,
-	0x84, 0xc5, 0x72, 0xf7, 0xdf, 0x83, 0x75, 0x3d, 0xd4, 0xbe, 0x08, 0x82, 0xb0, 0x24, 0xe5, 0xd0,
-	0xb7, 0xcf, 0xd4, 0xcf, 0x0b, 0xac, 0x42, 0xf1, 0x50, 0xf8, 0x81, 0xe7, 0xd2, 0x4f, 0x2b, 0x00,
-	0xe4, 0xbb, 0x43, 0xd3, 0xc7, 0x6f, 0xdc, 0x6f, 0x42, 0x89, 0x4a, 0x54, 0x1e, 0xdb, 0xae, 0x85,
-	0x23, 0xd9, 0xd2, 0x89, 0xd8, 0xf4, 0x1b, 0x36, 0x67, 0x34, 0xbe, 0xa2, 0xfa, 0xb5, 0x4f, 0x96,
-	0xe6, 0x37, 0x81, 0xa3, 0xf7, 0x3c, 0x32, 0xa9, 0x60, 0xd2, 0xb9, 0x50, 0xbf, 0x0c, 0x9b, 0xb9,
-	0xff, 0x6d, 0xe0, 0x2a, 0x06, 0x64, 0x89, 0x73, 0xdb, 0x1d, 0x44, 0xb5, 0xd8, 0x40, 0x3f, 0xac,
-	0x60, 0x89, 0xf3, 0xb0, 0xbe, 0x28, 0x6c, 0x84, 0x3f, 0xef, 0xb0, 0xe3, 0x4d, 0x5c, 0xec, 0xc5,
-	0x53, 0xb8, 0xae, 0x64, 0x06, 0xbb, 0x45, 0xd5, 0x78, 0x97, 0x3a, 0xa6, 0xaa, 0xbe, 0x48, 0x4e,
-	0x82, 0x08, 0x97, 0xa5, 0xb0, 0x63, 0x91, 0x53, 0x17, 0xc3, 0xd3, 0xf7, 0xeb, 0x70, 0x6d, 0x81,
-	0x67, 0x4d, 0x5a, 0x5a, 0xf9, 0x17, 0x6c, 0xe5, 0xfe, 0x87, 0xb0, 0xa1, 0xf4, 0xca, 0x81, 0xaa,
-	0x97, 0x0a, 0x8f, 0xc8, 0x67, 0xed, 0x9d, 0xb6, 0x9a, 0xba, 0x66, 0x6b, 0x6f, 0xef, 0xc9, 0x5e,
-	0xc3, 0x60, 0x29, 0x5a, 0xe0, 0x4e, 0xef, 0xa8, 0xd9, 0x39, 0x38, 0x68, 0x35, 0x7b, 0xad, 0x6d,
-	0x96, 0xde, 0xba, 0xff, 0x6f, 0x3f, 0xbd, 0x93, 0xfa, 0xd9, 0xa7, 0x77, 0x52, 0xff, 0xf5, 0xd3,
-	0x3b, 0xa9, 0x1f, 0xfd, 0xfc, 0xce, 0xca, 0xcf, 0x7e, 0x7e, 0x67, 0xe5, 0x3f, 0xff, 0xfc, 0xce,
-	0xca, 0x27, 0x6c, 0xf6, 0xdf, 0x97, 0x1c, 0xe7, 0xc9, 0xa4, 0x7d, 0xeb, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xb5, 0x87, 0x64, 0x2b, 0xd9, 0x64, 0x00, 0x00,
+	// 9139 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0xbd, 0x5d, 0x8c, 0x23, 0x59,
+	0x96, 0x10, 0x9c, 0xfe, 0xb7, 0x8f, 0xd3, 0x59, 0x37, 0x6f, 0x55, 0x57, 0xb9, 0xdd, 0x35, 0xf5,
+	0xd5, 0xc4, 0xf4, 0x74, 0xd7, 0xd4, 0xf4, 0x64, 0x75, 0x57, 0xff, 0x4e, 0xcf, 0x74, 0xf7, 0x38,
+	0x9d, 0xce, 0x4a, 0x77, 0x65, 0xa6, 0xb3, 0xc3, 0xae, 0xac, 0xe9, 0xd6, 0xee, 0x97, 0x44, 0x3a,
+	0x6e, 0xda, 0x31, 0x15, 0x8e, 0xf0, 0x44, 0x84, 0xb3, 0x32, 0x47, 0x80, 0x86, 0x9f, 0xdd, 0x65,
+	0x79, 0x1a, 0x10, 0xcb, 0x8f, 0x10, 0xda, 0x99, 0x07, 0x24, 0xc4, 0xae, 0x84, 0x40, 0x1a, 0x60,
+	0x81, 0x95, 0x00, 0x09, 0x81, 0x84, 0x84, 0x86, 0xe5, 0x65, 0xdf, 0x40, 0x3d, 0x12, 0x2f, 0x08,
+	0xd0, 0xf2, 0x34, 0x42, 0x3c, 0xa0, 0x73, 0xee, 0x8d, 0x3f, 0xdb, 0x99, 0xe5, 0xea, 0xdd, 0x05,
+	0x9e, 0xd2, 0xf7, 0xc4, 0x39, 0x27, 0xee, 0xef, 0xb9, 0xe7, 0x37, 0x12, 0x5e, 0x9e, 0x3c, 0x19,
+	0xde, 0xb3, 0xad, 0xe3, 0x7b, 0x93, 0xe3, 0x7b, 0x63, 0xd7, 0x14, 0xf6, 0xbd, 0x89, 0xe7, 0x06,
+	0xae, 0x2f, 0x1b, 0xfe, 0x06, 0xb5, 0x78, 0xcd, 0x70, 0xce, 0x83, 0xf3, 0x89, 0xd8, 0x20, 0x68,
+	0xe3, 0xe6, 0xd0, 0x75, 0x87, 0xb6, 0x90, 0xa8, 0xc7, 0xd3, 0x93, 0x7b, 0x7e, 0xe0, 0x4d, 0x07,
+	0x81, 0x44, 0xd6, 0x7e, 0x96, 0x87, 0xeb, 0xbd, 0xb1, 0xe1, 0x05, 0x9b, 0xb6, 0x3b, 0x78, 0xd2,
+	0x73, 0x8c, 0x89, 0x3f, 0x72, 0x83, 0x4d, 0xc3, 0x17, 0xfc, 0x35, 0x28, 0x1e, 0x23, 0xd0, 0xaf,
+	0x67, 0x6e, 0xe7, 0xee, 0x54, 0xef, 0x5f, 0xdb, 0x48, 0x31, 0xde, 0x20, 0x0a, 0x5d, 0xe1, 0xf0,
+	0x37, 0xa0, 0x64, 0x8a, 0xc0, 0xb0, 0x6c, 0xbf, 0x9e, 0xbd, 0x9d, 0xb9, 0x53, 0xbd, 0x7f, 0x63,
+	0x43, 0xbe, 0x78, 0x23, 0x7c, 0xf1, 0x46, 0x8f, 0x5e, 0xac, 0x87, 0x78, 0xfc, 0x5d, 0x28, 0x9f,
+	0x58, 0xb6, 0x78, 0x28, 0xce, 0xfd, 0x7a, 0xee, 0x52, 0x9a, 0xcd, 0x6c, 0x3d, 0xa3, 0x47, 0xc8,
+	0xbc, 0x05, 0x6b, 0xe2, 0x2c, 0xf0, 0x0c, 0x5d, 0xd8, 0x46, 0x60, 0xb9, 0x8e, 0x5f, 0xcf, 0x53,
+	0x0f, 0x6f, 0xcc, 0xf4, 0x30, 0x7c, 0x4e, 0xe4, 0x33, 0x24, 0xfc, 0x36, 0x54, 0xdd, 0xe3, 0xef,
+	0x89, 0x41, 0xd0, 0x3f, 0x9f, 0x08, 0xbf, 0x5e, 0xb8, 0x9d, 0xbb, 0x53, 0xd1, 0x93, 0x20, 0xfe,
+	0x4d, 0xa8, 0x0e, 0x5c, 0xdb, 0x16, 0x03, 0xf9, 0x8e, 0xe2, 0xe5, 0xc3, 0x4a, 0xe2, 0xf2, 0xb7,
+	0xe0, 0x05, 0x4f, 0x8c, 0xdd, 0x53, 0x61, 0xb6, 0x22, 0x28, 0x8d, 0xb3, 0x4c, 0xaf, 0x59, 0xfc,
+	0x90, 0x37, 0xa1, 0xe6, 0xa9, 0xfe, 0xed, 0x5a, 0xce, 0x13, 0xbf, 0x5e, 0xa2, 0x61, 0xbd, 0x74,
+	0xc1, 0xb0, 0x10, 0x47, 0x4f, 0x53, 0x70, 0x06, 0xb9, 0x27, 0xe2, 0xbc, 0x5e, 0xb9, 0x9d, 0xb9,
+	0x53, 0xd1, 0xf1, 0x27, 0x7f, 0x1f, 0xea, 0xae, 0x67, 0x0d, 0x2d, 0xc7, 0xb0, 0x5b, 0x9e, 0x30,
+	0x02, 0x61, 0xf6, 0xad, 0xb1, 0xf0, 0x03, 0x63, 0x3c, 0xa9, 0xc3, 0xed, 0xcc, 0x9d, 0x9c, 0x7e,
+	0xe1, 0x73, 0xfe, 0xa6, 0x5c, 0xa1, 0x8e, 0x73, 0xe2, 0xd6, 0xab, 0x6a, 0xf8, 0xe9, 0xbe, 0x6c,
+	0xab, 0xc7, 0x7a, 0x84, 0xa8, 0xfd, 0x22, 0x0b, 0xc5, 0x9e, 0x30, 0xbc, 0xc1, 0xa8, 0xf1, 0x6b,
+	0x19, 0x28, 0xea, 0xc2, 0x9f, 0xda, 0x01, 0x6f, 0x40, 0x59, 0xce, 0x6d, 0xc7, 0xac, 0x67, 0xa8,
+	0x77, 0x51, 0xfb, 0x8b, 0xec, 0x9d, 0x0d, 0xc8, 0x8f, 0x45, 0x60, 0xd4, 0x73, 0x34, 0x43, 0x8d,
+	0x99, 0x5e, 0xc9, 0xd7, 0x6f, 0xec, 0x89, 0xc0, 0xd0, 0x09, 0xaf, 0xf1, 0xf3, 0x0c, 0xe4, 0xb1,
+	0xc9, 0x6f, 0x42, 0x65, 0x64, 0x0d, 0x47, 0xb6, 0x35, 0x1c, 0x05, 0xaa, 0x23, 0x31, 0x80, 0x7f,
+	0x08, 0x57, 0xa2, 0x86, 0x6e, 0x38, 0x43, 0x81, 0x3d, 0x5a, 0xb4, 0xf9, 0xe9, 0xa1, 0x3e, 0x8b,
+	0xcc, 0xeb, 0x50, 0xa2, 0xf3, 0xd0, 0x31, 0x69, 0x47, 0x57, 0xf4, 0xb0, 0x89, 0xdb, 0x2d, 0x5c,
+	0xa9, 0x87, 0xe2, 0xbc, 0x9e, 0xa7, 0xa7, 0x49, 0x10, 0x6f, 0xc2, 0x95, 0xb0, 0xb9, 0xa5, 0x66,
+	0xa3, 0x70, 0xf9, 0x6c, 0xcc, 0xe2, 0x6b, 0x9f, 0xef, 0x42, 0x81, 0x8e, 0x25, 0x5f, 0x83, 0xac,
+	0x15, 0x4e, 0x74, 0xd6, 0x32, 0xf9, 0x3d, 0x28, 0x9e, 0x58, 0xc2, 0x36, 0x9f, 0x39, 0xc3, 0x0a,
+	0x8d, 0xb7, 0x61, 0xd5, 0x13, 0x7e, 0xe0, 0x59, 0x6a, 0xf7, 0xcb, 0x03, 0xfa, 0xe5, 0x45, 0x32,
+	0x60, 0x43, 0x4f, 0x20, 0xea, 0x29, 0x32, 0x1c, 0xf6, 0x60, 0x64, 0xd9, 0xa6, 0x27, 0x9c, 0x8e,
+	0x29, 0xcf, 0x69, 0x45, 0x4f, 0x82, 0xf8, 0x1d, 0xb8, 0x72, 0x6c, 0x0c, 0x9e, 0x0c, 0x3d, 0x77,
+	0xea, 0xe0, 0x81, 0x70, 0x3d, 0x1a, 0x76, 0x45, 0x9f, 0x05, 0xf3, 0xd7, 0xa1, 0x60, 0xd8, 0xd6,
+	0xd0, 0xa1, 0x93, 0xb8, 0x76, 0xbf, 0xb1, 0xb0, 0x2f, 0x4d, 0xc4, 0xd0, 0x25, 0x22, 0xdf, 0x81,
+	0xda, 0xa9, 0xf0, 0x02, 0x6b, 0x60, 0xd8, 0x04, 0xaf, 0x97, 0x88, 0x52, 0x5b, 0x48, 0x79, 0x98,
+	0xc4, 0xd4, 0xd3, 0x84, 0xbc, 0x03, 0xe0, 0xa3, 0x98, 0xa4, 0xe5, 0x54, 0x67, 0xe1, 0xd5, 0x85,
+	0x6c, 0x5a, 0xae, 0x13, 0x08, 0x27, 0xd8, 0xe8, 0x45, 0xe8, 0x3b, 0x2b, 0x7a, 0x82, 0x98, 0xbf,
+	0x0b, 0xf9, 0x40, 0x9c, 0x05, 0xf5, 0xb5, 0x4b, 0x66, 0x34, 0x64, 0xd2, 0x17, 0x67, 0xc1, 0xce,
+	0x8a, 0x4e, 0x04, 0x48, 0x88, 0x87, 0xac, 0x7e, 0x65, 0x09, 0x42, 0x3c, 0x97, 0x48, 0x88, 0x04,
+	0xfc, 0x03, 0x28, 0xda, 0xc6, 0xb9, 0x3b, 0x0d, 0xea, 0x8c, 0x48, 0xbf, 0x72, 0x29, 0xe9, 0x2e,
+	0xa1, 0xee, 0xac, 0xe8, 0x8a, 0x88, 0xbf, 0x05, 0x39, 0xd3, 0x3a, 0xad, 0xaf, 0x13, 0xed, 0xed,
+	0x4b, 0x69, 0xb7, 0xac, 0xd3, 0x9d, 0x15, 0x1d, 0xd1, 0x79, 0x0b, 0xca, 0xc7, 0xae, 0xfb, 0x64,
+	0x6c, 0x78, 0x4f, 0xea, 0x9c, 0x48, 0xbf, 0x7a, 0x29, 0xe9, 0xa6, 0x42, 0xde, 0x59, 0xd1, 0x23,
+	0x42, 0x1c, 0xb2, 0x35, 0x70, 0x9d, 0xfa, 0xd5, 0x25, 0x86, 0xdc, 0x19, 0xb8, 0x0e, 0x0e, 0x19,
+	0x09, 0x90, 0xd0, 0xb6, 0x9c, 0x27, 0xf5, 0x6b, 0x4b, 0x10, 0xa2, 0xe4, 0x44, 0x42, 0x24, 0xc0,
+	0x6e, 0x9b, 0x46, 0x60, 0x9c, 0x5a, 0xe2, 0x69, 0xfd, 0x85, 0x25, 0xba, 0xbd, 0xa5, 0x90, 0xb1,
+	0xdb, 0x21, 0x21, 0x32, 0x09, 0x8f, 0x66, 0xfd, 0xfa, 0x12, 0x4c, 0x42, 0x89, 0x8e, 0x4c, 0x42,
+	0x42, 0xfe, 0xff, 0xc3, 0xfa, 0x89, 0x30, 0x82, 0xa9, 0x27, 0xcc, 0xf8, 0xa2, 0xbb, 0x41, 0xdc,
+	0x36, 0x2e, 0x5f, 0xfb, 0x59, 0xaa, 0x9d, 0x15, 0x7d, 0x9e, 0x15, 0x7f, 0x1f, 0x0a, 0xb6, 0x11,
+	0x88, 0xb3, 0x7a, 0x9d, 0x78, 0x6a, 0xcf, 0xd8, 0x14, 0x81, 0x38, 0xdb, 0x59, 0xd1, 0x25, 0x09,
+	0xff, 0x2e, 0x5c, 0x09, 0x8c, 0x63, 0x5b, 0x74, 0x4f, 0x14, 0x82, 0x5f, 0x7f, 0x91, 0xb8, 0xbc,
+	0x76, 0xf9, 0x76, 0x4e, 0xd3, 0xec, 0xac, 0xe8, 0xb3, 0x6c, 0xb0, 0x57, 0x04, 0xaa, 0x37, 0x96,
+	0xe8, 0x15, 0xf1, 0xc3, 0x5e, 0x11, 0x09, 0xdf, 0x85, 0x2a, 0xfd, 0x68, 0xb9, 0xf6, 0x74, 0xec,
+	0xd4, 0x5f, 0x22, 0x0e, 0x77, 0x9e, 0xcd, 0x41, 0xe2, 0xef, 0xac, 0xe8, 0x49, 0x72, 0x5c, 0x44,
+	0x6a, 0xea, 0xee, 0xd3, 0xfa, 0xcd, 0x25, 0x16, 0xb1, 0xaf, 0x90, 0x71, 0x11, 0x43, 0x42, 0x3c,
+	0x7a, 0x4f, 0x2d, 0x73, 0x28, 0x82, 0xfa, 0x97, 0x96, 0x38, 0x7a, 0x8f, 0x09, 0x15, 0x8f, 0x9e,
+	0x24, 0xc2, 0x6d, 0x3c, 0x18, 0x19, 0x41, 0xfd, 0xd6, 0x12, 0xdb, 0xb8, 0x35, 0x32, 0x48, 0x56,
+	0x20, 0x41, 0xe3, 0x07, 0xb0, 0x9a, 0x94, 0xca, 0x9c, 0x43, 0xde, 0x13, 0x86, 0xbc, 0x11, 0xca,
+	0x3a, 0xfd, 0x46, 0x98, 0x30, 0xad, 0x80, 0x6e, 0x84, 0xb2, 0x4e, 0xbf, 0xf9, 0x75, 0x28, 0x4a,
+	0xdd, 0x84, 0x04, 0x7e, 0x59, 0x57, 0x2d, 0xc4, 0x35, 0x3d, 0x63, 0x48, 0xf7, 0x56, 0x59, 0xa7,
+	0xdf, 0x88, 0x6b, 0x7a, 0xee, 0xa4, 0xeb, 0x90, 0xc0, 0x2e, 0xeb, 0xaa, 0xd5, 0xf8, 0x57, 0x1f,
+	0x40, 0x49, 0x75, 0xaa, 0xf1, 0xb7, 0x32, 0x50, 0x94, 0x02, 0x85, 0x7f, 0x04, 0x05, 0x3f, 0x38,
+	0xb7, 0x05, 0xf5, 0x61, 0xed, 0xfe, 0xd7, 0x96, 0x10, 0x42, 0x1b, 0x3d, 0x24, 0xd0, 0x25, 0x9d,
+	0xa6, 0x43, 0x81, 0xda, 0xbc, 0x04, 0x39, 0xdd, 0x7d, 0xca, 0x56, 0x38, 0x40, 0x51, 0x2e, 0x16,
+	0xcb, 0x20, 0x70, 0xcb, 0x3a, 0x65, 0x59, 0x04, 0xee, 0x08, 0xc3, 0x14, 0x1e, 0xcb, 0xf1, 0x1a,
+	0x54, 0xc2, 0x65, 0xf1, 0x59, 0x9e, 0x33, 0x58, 0x4d, 0x2c, 0xb8, 0xcf, 0x0a, 0x8d, 0xff, 0x91,
+	0x87, 0x3c, 0x9e, 0x7f, 0xfe, 0x32, 0xd4, 0x02, 0xc3, 0x1b, 0x0a, 0xa9, 0x08, 0x47, 0x4a, 0x4a,
+	0x1a, 0xc8, 0x3f, 0x08, 0xc7, 0x90, 0xa5, 0x31, 0xbc, 0xfa, 0x4c, 0xb9, 0x92, 0x1a, 0x41, 0xe2,
+	0x16, 0xce, 0x2d, 0x77, 0x0b, 0x6f, 0x43, 0x19, 0xc5, 0x59, 0xcf, 0xfa, 0x81, 0xa0, 0xa9, 0x5f,
+	0xbb, 0x7f, 0xf7, 0xd9, 0xaf, 0xec, 0x28, 0x0a, 0x3d, 0xa2, 0xe5, 0x1d, 0xa8, 0x0c, 0x0c, 0xcf,
+	0xa4, 0xce, 0xd0, 0x6a, 0xad, 0xdd, 0xff, 0xfa, 0xb3, 0x19, 0xb5, 0x42, 0x12, 0x3d, 0xa6, 0xe6,
+	0x5d, 0xa8, 0x9a, 0xc2, 0x1f, 0x78, 0xd6, 0x84, 0xc4, 0x9b, 0xbc, 0x8b, 0xbf, 0xf1, 0x6c, 0x66,
+	0x5b, 0x31, 0x91, 0x9e, 0xe4, 0x80, 0x1a, 0x99, 0x17, 0xc9, 0xb7, 0x12, 0x29, 0x08, 0x31, 0x40,
+	0x7b, 0x17, 0xca, 0xe1, 0x78, 0xf8, 0x2a, 0x94, 0xf1, 0xef, 0xbe, 0xeb, 0x08, 0xb6, 0x82, 0x6b,
+	0x8b, 0xad, 0xde, 0xd8, 0xb0, 0x6d, 0x96, 0xe1, 0x6b, 0x00, 0xd8, 0xdc, 0x13, 0xa6, 0x35, 0x1d,
+	0xb3, 0xac, 0xf6, 0xad, 0x70, 0xb7, 0x94, 0x21, 0x7f, 0x60, 0x0c, 0x91, 0x62, 0x15, 0xca, 0xa1,
+	0xb8, 0x66, 0x19, 0xa4, 0xdf, 0x32, 0xfc, 0xd1, 0xb1, 0x6b, 0x78, 0x26, 0xcb, 0xf2, 0x2a, 0x94,
+	0x9a, 0xde, 0x60, 0x64, 0x9d, 0x0a, 0x96, 0xd3, 0xee, 0x41, 0x35, 0xd1, 0x5f, 0x64, 0xa1, 0x5e,
+	0x5a, 0x81, 0x42, 0xd3, 0x34, 0x85, 0xc9, 0x32, 0x48, 0xa0, 0x06, 0xc8, 0xb2, 0xda, 0xd7, 0xa1,
+	0x12, 0xcd, 0x16, 0xa2, 0xe3, 0xc5, 0xcd, 0x56, 0xf0, 0x17, 0x82, 0x59, 0x06, 0x77, 0x65, 0xc7,
+	0xb1, 0x2d, 0x47, 0xb0, 0x6c, 0xe3, 0x4f, 0xd0, 0x56, 0xe5, 0xdf, 0x4e, 0x1f, 0x88, 0x57, 0x9e,
+	0x75, 0xb3, 0xa6, 0x4f, 0xc3, 0x4b, 0x89, 0xf1, 0xed, 0x5a, 0xd4, 0xb9, 0x32, 0xe4, 0xb7, 0xdc,
+	0xc0, 0x67, 0x99, 0xc6, 0x7f, 0xc9, 0x42, 0x39, 0xbc, 0x50, 0xd1, 0x26, 0x98, 0x7a, 0xb6, 0xda,
+	0xd0, 0xf8, 0x93, 0x5f, 0x83, 0x42, 0x60, 0x05, 0x6a, 0x1b, 0x57, 0x74, 0xd9, 0x40, 0x5d, 0x2d,
+	0xb9, 0xb2, 0x52, 0x81, 0x9d, 0x5d, 0x2a, 0x6b, 0x6c, 0x0c, 0xc5, 0x8e, 0xe1, 0x8f, 0x94, 0x0a,
+	0x1b, 0x03, 0x90, 0xfe, 0xc4, 0x38, 0xc5, 0x3d, 0x47, 0xcf, 0xa5, 0x16, 0x97, 0x04, 0xf1, 0x37,
+	0x21, 0x8f, 0x03, 0x54, 0x9b, 0xe6, 0xff, 0x9b, 0x19, 0x30, 0x6e, 0x93, 0x03, 0x4f, 0xe0, 0xf2,
+	0x6c, 0xa0, 0x05, 0xa6, 0x13, 0x32, 0x7f, 0x05, 0xd6, 0xe4, 0x21, 0xec, 0x86, 0xf6, 0x43, 0x89,
+	0x38, 0xcf, 0x40, 0x79, 0x13, 0xa7, 0xd3, 0x08, 0x44, 0xbd, 0xbc, 0xc4, 0xfe, 0x0e, 0x27, 0x67,
+	0xa3, 0x87, 0x24, 0xba, 0xa4, 0xd4, 0xde, 0xc6, 0x39, 0x35, 0x02, 0x81, 0xcb, 0xdc, 0x1e, 0x4f,
+	0x82, 0x73, 0xb9, 0x69, 0xb6, 0x45, 0x30, 0x18, 0x59, 0xce, 0x90, 0x65, 0xe4, 0x14, 0xe3, 0x22,
+	0x12, 0x8a, 0xe7, 0xb9, 0x1e, 0xcb, 0x35, 0x1a, 0x90, 0xc7, 0x3d, 0x8a, 0x42, 0xd2, 0x31, 0xc6,
+	0x42, 0xcd, 0x34, 0xfd, 0x6e, 0x5c, 0x85, 0xf5, 0xb9, 0xfb, 0xb8, 0xf1, 0x3b, 0x45, 0xb9, 0x43,
+	0x90, 0x82, 0x74, 0x41, 0x45, 0x81, 0xbf, 0x9f, 0x4f, 0xc6, 0x20, 0x97, 0xb4, 0x8c, 0xf9, 0x00,
+	0x0a, 0x38, 0xb0, 0x50, 0xc4, 0x2c, 0x41, 0xbe, 0x87, 0xe8, 0xba, 0xa4, 0x42, 0x0b, 0x66, 0x30,
+	0x12, 0x83, 0x27, 0xc2, 0x54, 0xb2, 0x3e, 0x6c, 0xe2, 0xa6, 0x19, 0x24, 0xd4, 0x73, 0xd9, 0xa0,
+	0x2d, 0x31, 0x70, 0x9d, 0xf6, 0xd8, 0xfd, 0x9e, 0x55, 0x2f, 0xaa, 0x2d, 0x11, 0x02, 0xc2, 0xa7,
+	0x1d, 0xdc, 0x23, 0x6a, 0xd9, 0x62, 0x40, 0xa3, 0x0d, 0x05, 0x7a, 0x37, 0x9e, 0x04, 0xd9, 0x67,
+	0xe9, 0x69, 0x78, 0x65, 0xb9, 0x3e, 0xab, 0x2e, 0x37, 0x7e, 0x3b, 0x0b, 0x79, 0x6c, 0xf3, 0xbb,
+	0x50, 0xf0, 0xd0, 0x0e, 0xa3, 0xe9, 0xbc, 0xc8, 0x66, 0x93, 0x28, 0xfc, 0x23, 0xb5, 0x15, 0xb3,
+	0x4b, 0x6c, 0x96, 0xe8, 0x8d, 0xc9, 0x6d, 0x79, 0x0d, 0x0a, 0x13, 0xc3, 0x33, 0xc6, 0xea, 0x9c,
+	0xc8, 0x86, 0xf6, 0xe3, 0x0c, 0xe4, 0x11, 0x89, 0xaf, 0x43, 0xad, 0x17, 0x78, 0xd6, 0x13, 0x11,
+	0x8c, 0x3c, 0x77, 0x3a, 0x1c, 0xc9, 0x9d, 0xf4, 0x50, 0x9c, 0x1f, 0xbb, 0xb1, 0x40, 0x08, 0x0c,
+	0xdb, 0x1a, 0xb0, 0x2c, 0xee, 0xaa, 0x4d, 0xd7, 0x36, 0x59, 0x8e, 0x5f, 0x81, 0xea, 0x23, 0xc7,
+	0x14, 0x9e, 0x3f, 0x70, 0x3d, 0x61, 0xb2, 0xbc, 0x3a, 0xdd, 0x4f, 0x58, 0x81, 0xee, 0x32, 0x71,
+	0x16, 0x90, 0x2d, 0xc4, 0x8a, 0xfc, 0x2a, 0x5c, 0xd9, 0x4c, 0x1b, 0x48, 0xac, 0x84, 0x32, 0x69,
+	0x4f, 0x38, 0xb8, 0xc9, 0x58, 0x59, 0x6e, 0x62, 0xf7, 0x7b, 0x16, 0xab, 0xe0, 0xcb, 0xe4, 0x39,
+	0x61, 0xa0, 0xfd, 0xb3, 0x4c, 0x28, 0x39, 0x6a, 0x50, 0x39, 0x30, 0x3c, 0x63, 0xe8, 0x19, 0x13,
+	0xec, 0x5f, 0x15, 0x4a, 0xf2, 0xe2, 0x7c, 0x83, 0x65, 0xe2, 0xc6, 0x7d, 0x96, 0x8d, 0x1b, 0x6f,
+	0xb2, 0x5c, 0xdc, 0x78, 0x8b, 0xe5, 0xf1, 0x1d, 0x9f, 0x4c, 0xdd, 0x40, 0xb0, 0x02, 0xc9, 0x3a,
+	0xd7, 0x14, 0xac, 0x88, 0xc0, 0x3e, 0x4a, 0x14, 0x56, 0xc2, 0x31, 0xb7, 0x70, 0xff, 0x1c, 0xbb,
+	0x67, 0xac, 0x8c, 0xdd, 0xc0, 0x69, 0x14, 0x26, 0xab, 0xe0, 0x93, 0xfd, 0xe9, 0xf8, 0x58, 0xe0,
+	0x30, 0x01, 0x9f, 0xf4, 0xdd, 0xe1, 0xd0, 0x16, 0xac, 0xca, 0xaf, 0xa4, 0x84, 0x2f, 0x5b, 0x25,
+	0x49, 0x6b, 0xd8, 0xb6, 0x3b, 0x0d, 0x58, 0xad, 0xf1, 0x8b, 0x1c, 0xe4, 0xd1, 0xba, 0xc1, 0xb3,
+	0x33, 0x42, 0x39, 0xa3, 0xce, 0x0e, 0xfe, 0x8e, 0x4e, 0x60, 0x36, 0x3e, 0x81, 0xfc, 0x7d, 0xb5,
+	0xd2, 0xb9, 0x25, 0xa4, 0x2c, 0x32, 0x4e, 0x2e, 0x32, 0x87, 0xfc, 0xd8, 0x1a, 0x0b, 0x25, 0xeb,
+	0xe8, 0x37, 0xc2, 0x7c, 0xbc, 0x8f, 0x0b, 0xe4, 0x3c, 0xa1, 0xdf, 0x78, 0x6a, 0x0c, 0xbc, 0x16,
+	0x9a, 0x01, 0x9d, 0x81, 0x9c, 0x1e, 0x36, 0x17, 0x48, 0xaf, 0xca, 0x42, 0xe9, 0xf5, 0x41, 0x28,
+	0xbd, 0x4a, 0x4b, 0x9c, 0x7a, 0xea, 0x66, 0x52, 0x72, 0xc5, 0x42, 0xa3, 0xbc, 0x3c, 0x79, 0xe2,
+	0x32, 0xd9, 0x52, 0xbb, 0x36, 0xbe, 0xe8, 0xca, 0x72, 0x96, 0x59, 0x06, 0x57, 0x93, 0x8e, 0xab,
+	0x94, 0x79, 0x87, 0x96, 0x29, 0x5c, 0x96, 0xa3, 0x8b, 0x70, 0x6a, 0x5a, 0x2e, 0xcb, 0xa3, 0xe6,
+	0x75, 0xb0, 0xb5, 0xcd, 0x0a, 0xda, 0x2b, 0x89, 0x2b, 0xa9, 0x39, 0x0d, 0x5c, 0xb6, 0x12, 0x6d,
+	0xdf, 0x8c, 0xdc, 0x8d, 0xc7, 0xc2, 0x64, 0x59, 0xed, 0x9d, 0x05, 0x62, 0xb6, 0x06, 0x95, 0x47,
+	0x13, 0xdb, 0x35, 0xcc, 0x4b, 0xe4, 0xec, 0x2a, 0x40, 0x6c, 0x55, 0x37, 0x7e, 0xa1, 0xc5, 0xd7,
+	0x39, 0xea, 0xa2, 0xbe, 0x3b, 0xf5, 0x06, 0x82, 0x44, 0x48, 0x45, 0x57, 0x2d, 0xfe, 0x1d, 0x28,
+	0xe0, 0xf3, 0xd0, 0x8d, 0x73, 0x77, 0x29, 0x5b, 0x6e, 0xe3, 0xd0, 0x12, 0x4f, 0x75, 0x49, 0xc8,
+	0x6f, 0x01, 0x18, 0x83, 0xc0, 0x3a, 0x15, 0x08, 0x54, 0x87, 0x3d, 0x01, 0xe1, 0x6f, 0x27, 0xd5,
+	0x97, 0xcb, 0xfd, 0x90, 0x09, 0xbd, 0x86, 0xeb, 0x50, 0xc5, 0xa3, 0x3b, 0xe9, 0x7a, 0x78, 0xda,
+	0xeb, 0xab, 0x44, 0xf8, 0xfa, 0x72, 0xdd, 0x7b, 0x10, 0x11, 0xea, 0x49, 0x26, 0xfc, 0x11, 0xac,
+	0x4a, 0x9f, 0x9a, 0x62, 0x5a, 0x23, 0xa6, 0x6f, 0x2c, 0xc7, 0xb4, 0x1b, 0x53, 0xea, 0x29, 0x36,
+	0xf3, 0x6e, 0xc9, 0xc2, 0x73, 0xbb, 0x25, 0x5f, 0x81, 0xb5, 0x7e, 0xfa, 0x14, 0xc8, 0xab, 0x62,
+	0x06, 0xca, 0x35, 0x58, 0xb5, 0xfc, 0xd8, 0x2b, 0x4a, 0x3e, 0x92, 0xb2, 0x9e, 0x82, 0x35, 0xfe,
+	0x7d, 0x11, 0xf2, 0x34, 0xf3, 0xb3, 0x3e, 0xae, 0x56, 0x4a, 0xa4, 0xdf, 0x5b, 0x7e, 0xa9, 0x67,
+	0x4e, 0x3c, 0x49, 0x90, 0x5c, 0x42, 0x82, 0x7c, 0x07, 0x0a, 0xbe, 0xeb, 0x05, 0xe1, 0xf2, 0x2e,
+	0xb9, 0x89, 0x7a, 0xae, 0x17, 0xe8, 0x92, 0x90, 0x6f, 0x43, 0xe9, 0xc4, 0xb2, 0x03, 0xe1, 0x85,
+	0x93, 0xf7, 0xda, 0x72, 0x3c, 0xb6, 0x89, 0x48, 0x0f, 0x89, 0xf9, 0x6e, 0x72, 0xb3, 0x15, 0x6f,
+	0xe7, 0x9e, 0xe9, 0x0b, 0x88, 0x38, 0x2d, 0xda, 0x83, 0x77, 0x81, 0x0d, 0xdc, 0x53, 0xe1, 0xe9,
+	0x09, 0xc7, 0xa4, 0xbc, 0xa4, 0xe7, 0xe0, 0xe8, 0xbf, 0x1d, 0x59, 0xa6, 0x40, 0x3d, 0x87, 0x64,
+	0x4c, 0x59, 0x8f, 0xda, 0xfc, 0x21, 0x94, 0xc9, 0x3e, 0x40, 0xa9, 0x58, 0x79, 0xee, 0xc9, 0x97,
+	0xa6, 0x4a, 0xc8, 0x00, 0x5f, 0x44, 0x2f, 0xdf, 0xb6, 0x02, 0xf2, 0x4f, 0x97, 0xf5, 0xa8, 0x8d,
+	0x1d, 0xa6, 0xfd, 0x9e, 0xec, 0x70, 0x55, 0x76, 0x78, 0x16, 0x8e, 0x2e, 0x78, 0x82, 0xcd, 0x5c,
+	0x92, 0x78, 0xd4, 0x90, 0xe9, 0xe2, 0x87, 0xa8, 0xb0, 0x4c, 0x8c, 0xa1, 0xd8, 0xb5, 0xc6, 0x56,
+	0x50, 0xaf, 0xdd, 0xce, 0xdc, 0x29, 0xe8, 0x31, 0x80, 0xbf, 0x06, 0xeb, 0xa6, 0x38, 0x31, 0xa6,
+	0x76, 0xd0, 0x17, 0xe3, 0x89, 0x6d, 0x04, 0xa2, 0x63, 0xd2, 0x1e, 0xad, 0xe8, 0xf3, 0x0f, 0xf8,
+	0xeb, 0x70, 0x55, 0x01, 0xbb, 0x51, 0x54, 0xa1, 0x63, 0x92, 0xfb, 0xae, 0xa2, 0x2f, 0x7a, 0xa4,
+	0xed, 0x29, 0x31, 0x8c, 0x17, 0x28, 0xda, 0xa9, 0xa1, 0x00, 0xf5, 0x03, 0x79, 0x23, 0x3f, 0x30,
+	0x6c, 0x5b, 0x78, 0xe7, 0xd2, 0xc8, 0x7d, 0x68, 0x38, 0xc7, 0x86, 0xc3, 0x72, 0x74, 0xc7, 0x1a,
+	0xb6, 0x70, 0x4c, 0xc3, 0x93, 0x37, 0xf2, 0x03, 0xba, 0xd0, 0x0b, 0xda, 0x1d, 0xc8, 0xd3, 0x94,
+	0x56, 0xa0, 0x20, 0xad, 0x24, 0xb2, 0x98, 0x95, 0x85, 0x44, 0x12, 0x79, 0x17, 0x8f, 0x1f, 0xcb,
+	0x36, 0xfe, 0x76, 0x11, 0xca, 0xe1, 0xe4, 0x85, 0x31, 0x84, 0x4c, 0x1c, 0x43, 0x40, 0x35, 0xce,
+	0x3f, 0xb4, 0x7c, 0xeb, 0x58, 0xa9, 0xa5, 0x65, 0x3d, 0x06, 0xa0, 0x26, 0xf4, 0xd4, 0x32, 0x83,
+	0x11, 0x9d, 0x99, 0x82, 0x2e, 0x1b, 0xe8, 0xd7, 0x35, 0x71, 0x1e, 0x9c, 0x81, 0x3d, 0x35, 0x05,
+	0xc6, 0x14, 0x94, 0x9b, 0x60, 0x16, 0xcc, 0x3f, 0x05, 0x08, 0xac, 0xb1, 0xd8, 0x76, 0xbd, 0xb1,
+	0x11, 0x28, 0xdb, 0xe0, 0x9b, 0xcf, 0xb7, 0xab, 0x37, 0xfa, 0x11, 0x03, 0x3d, 0xc1, 0x0c, 0x59,
+	0xe3, 0xdb, 0x14, 0xeb, 0xd2, 0x17, 0x62, 0xbd, 0x15, 0x31, 0xd0, 0x13, 0xcc, 0x78, 0x1f, 0x4a,
+	0x27, 0xae, 0x37, 0x9e, 0xda, 0x86, 0xba, 0x73, 0xdf, 0x7f, 0x4e, 0xbe, 0xdb, 0x92, 0x9a, 0x64,
+	0x4f, 0xc8, 0x2a, 0xf6, 0x71, 0x57, 0x96, 0xf4, 0x71, 0x6b, 0xbf, 0x04, 0x10, 0xf7, 0x90, 0x5f,
+	0x07, 0xbe, 0xe7, 0x3a, 0xc1, 0xa8, 0x79, 0x7c, 0xec, 0x6d, 0x8a, 0x13, 0xd7, 0x13, 0x5b, 0x06,
+	0x5e, 0xaf, 0x2f, 0xc0, 0x7a, 0x04, 0x6f, 0x9e, 0x04, 0xc2, 0x43, 0x30, 0x6d, 0x81, 0xde, 0xc8,
+	0xf5, 0x02, 0xa9, 0xe3, 0xd1, 0xcf, 0x47, 0x3d, 0x96, 0xc3, 0x2b, 0xbd, 0xd3, 0xeb, 0xb2, 0xbc,
+	0x76, 0x07, 0x20, 0x9e, 0x5a, 0xb2, 0x85, 0xe8, 0xd7, 0x1b, 0xf7, 0xd9, 0x4a, 0xdc, 0xba, 0xff,
+	0x16, 0xcb, 0x68, 0x9f, 0x67, 0xa0, 0x9a, 0x18, 0x52, 0xda, 0x66, 0x6e, 0xb9, 0x53, 0x27, 0x90,
+	0x46, 0x3a, 0xfd, 0x3c, 0x34, 0xec, 0x29, 0x5e, 0xee, 0xeb, 0x50, 0xa3, 0xf6, 0x96, 0xe5, 0x07,
+	0x96, 0x33, 0x08, 0x58, 0x2e, 0x42, 0x91, 0x8a, 0x41, 0x3e, 0x42, 0xd9, 0x77, 0x15, 0xa8, 0x80,
+	0x6e, 0x9c, 0x03, 0xe1, 0x0d, 0x44, 0x88, 0x44, 0xca, 0xb0, 0x82, 0x44, 0x68, 0x52, 0x19, 0x36,
+	0x82, 0x51, 0x6f, 0x3a, 0x66, 0x65, 0x54, 0x2a, 0xb1, 0xd1, 0x3c, 0x15, 0x1e, 0xea, 0x32, 0x15,
+	0x7c, 0x0f, 0x02, 0xf0, 0x34, 0x18, 0x0e, 0x83, 0x10, 0x7b, 0xcf, 0x72, 0x58, 0x35, 0x6a, 0x18,
+	0x67, 0x6c, 0x15, 0xfb, 0x4f, 0xa6, 0x03, 0xab, 0x35, 0xfe, 0x73, 0x0e, 0xf2, 0x28, 0xd7, 0xd1,
+	0xd6, 0x4d, 0x0a, 0x21, 0x79, 0x56, 0x92, 0xa0, 0x2f, 0x76, 0x1b, 0x21, 0xef, 0xe4, 0x6d, 0xf4,
+	0x1e, 0x54, 0x07, 0x53, 0x3f, 0x70, 0xc7, 0x74, 0x15, 0xab, 0x68, 0xd7, 0xf5, 0x39, 0xaf, 0x11,
+	0x4d, 0xa7, 0x9e, 0x44, 0xe5, 0x6f, 0x43, 0xf1, 0x44, 0xee, 0x7a, 0xe9, 0x37, 0xfa, 0xd2, 0x05,
+	0xb7, 0xb5, 0xda, 0xd9, 0x0a, 0x19, 0xc7, 0x65, 0xcd, 0x9d, 0xd8, 0x24, 0x48, 0xdd, 0xba, 0xc5,
+	0xe8, 0xd6, 0xfd, 0x25, 0x58, 0x13, 0x38, 0xe1, 0x07, 0xb6, 0x31, 0x10, 0x63, 0xe1, 0x84, 0xc7,
+	0xec, 0xad, 0xe7, 0x18, 0x31, 0xad, 0x18, 0x0d, 0x7b, 0x86, 0x17, 0x4a, 0x1e, 0xc7, 0xc5, 0xcb,
+	0x3f, 0x34, 0xec, 0xcb, 0x7a, 0x0c, 0xd0, 0xbe, 0xaa, 0xe4, 0x65, 0x09, 0x72, 0x4d, 0x7f, 0xa0,
+	0x3c, 0x20, 0xc2, 0x1f, 0x48, 0xf3, 0xaa, 0x45, 0xd3, 0xc1, 0xb2, 0xda, 0x1b, 0x50, 0x89, 0xde,
+	0x80, 0x9b, 0x67, 0xdf, 0x0d, 0x7a, 0x13, 0x31, 0xb0, 0x4e, 0x2c, 0x61, 0xca, 0xfd, 0xd9, 0x0b,
+	0x0c, 0x2f, 0x90, 0x4e, 0xc4, 0xb6, 0x63, 0xb2, 0x6c, 0xe3, 0xb7, 0xca, 0x50, 0x94, 0x97, 0xaf,
+	0x1a, 0x70, 0x25, 0x1a, 0xf0, 0x27, 0x50, 0x76, 0x27, 0xc2, 0x33, 0x02, 0xd7, 0x53, 0x9e, 0x9b,
+	0xb7, 0x9f, 0xe7, 0x32, 0xdf, 0xe8, 0x2a, 0x62, 0x3d, 0x62, 0x33, 0xbb, 0x9b, 0xb2, 0xf3, 0xbb,
+	0xe9, 0x2e, 0xb0, 0xf0, 0xde, 0x3e, 0xf0, 0x90, 0x2e, 0x38, 0x57, 0x76, 0xf8, 0x1c, 0x9c, 0xf7,
+	0xa1, 0x32, 0x70, 0x1d, 0xd3, 0x8a, 0xbc, 0x38, 0x6b, 0xf7, 0xdf, 0x79, 0xae, 0x1e, 0xb6, 0x42,
+	0x6a, 0x3d, 0x66, 0xc4, 0x5f, 0x83, 0xc2, 0x29, 0x6e, 0x33, 0xda, 0x4f, 0x17, 0x6f, 0x42, 0x89,
+	0xc4, 0x3f, 0x83, 0xea, 0xf7, 0xa7, 0xd6, 0xe0, 0x49, 0x37, 0xe9, 0x25, 0x7c, 0xef, 0xb9, 0x7a,
+	0xf1, 0x49, 0x4c, 0xaf, 0x27, 0x99, 0x25, 0xb6, 0x76, 0xe9, 0x0f, 0xb1, 0xb5, 0xcb, 0xf3, 0x5b,
+	0x5b, 0x87, 0x9a, 0x23, 0xfc, 0x40, 0x98, 0xdb, 0x4a, 0x57, 0x83, 0x2f, 0xa0, 0xab, 0xa5, 0x59,
+	0x68, 0x5f, 0x81, 0x72, 0xb8, 0xe0, 0xbc, 0x08, 0xd9, 0x7d, 0x34, 0x8a, 0x8a, 0x90, 0xed, 0x7a,
+	0x72, 0xb7, 0x35, 0x71, 0xb7, 0x69, 0xff, 0x3d, 0x03, 0x95, 0x68, 0xd2, 0xd3, 0x92, 0xb3, 0xfd,
+	0xfd, 0xa9, 0x81, 0xee, 0x4d, 0x34, 0x97, 0xdd, 0x40, 0xb6, 0x48, 0x58, 0x3f, 0xa0, 0x60, 0x3d,
+	0x3a, 0xb9, 0x51, 0x45, 0x10, 0x3e, 0xfa, 0xb7, 0x39, 0xac, 0x29, 0x70, 0xd7, 0x93, 0xa8, 0x05,
+	0x14, 0x7c, 0xf8, 0x34, 0x04, 0x14, 0x09, 0xdd, 0x7a, 0x22, 0xa4, 0x80, 0xdc, 0x77, 0x03, 0x6a,
+	0x94, 0xb1, 0x53, 0x1d, 0x87, 0x55, 0xf0, 0x9d, 0xfb, 0x6e, 0xd0, 0x41, 0x91, 0x18, 0x99, 0x67,
+	0xd5, 0xf0, 0xf5, 0xd4, 0x22, 0x89, 0xd8, 0xb4, 0xed, 0x8e, 0xc3, 0x6a, 0xea, 0x81, 0x6c, 0xad,
+	0x21, 0xc7, 0xf6, 0x99, 0x31, 0x40, 0xf2, 0x2b, 0x28, 0x61, 0x91, 0x46, 0xb5, 0x19, 0x1e, 0xc9,
+	0xf6, 0x99, 0xe5, 0x07, 0x3e, 0x5b, 0xd7, 0xfe, 0x6d, 0x06, 0xaa, 0x89, 0x05, 0x46, 0xf3, 0x8f,
+	0x10, 0xf1, 0x2a, 0x93, 0xd6, 0xe0, 0xa7, 0x38, 0x8d, 0x9e, 0x19, 0x5e, 0x53, 0x7d, 0x17, 0x7f,
+	0x66, 0xf1, 0x7d, 0x7d, 0x77, 0xec, 0x7a, 0x9e, 0xfb, 0x54, 0xaa, 0x3e, 0xbb, 0x86, 0x1f, 0x3c,
+	0x16, 0xe2, 0x09, 0xcb, 0xe3, 0x50, 0x5b, 0x53, 0xcf, 0x13, 0x8e, 0x04, 0x14, 0xa8, 0x73, 0xe2,
+	0x4c, 0xb6, 0x8a, 0xc8, 0x14, 0x91, 0xe9, 0x1e, 0x64, 0x25, 0x14, 0x04, 0x0a, 0x5b, 0x42, 0xca,
+	0x88, 0x80, 0xe8, 0xb2, 0x59, 0xc1, 0x4b, 0x45, 0x7a, 0x28, 0xba, 0x27, 0x5b, 0xc6, 0xb9, 0xdf,
+	0x1c, 0xba, 0x0c, 0x66, 0x81, 0xfb, 0xee, 0x53, 0x56, 0x6d, 0x4c, 0x01, 0x62, 0x9b, 0x0c, 0x6d,
+	0x51, 0xdc, 0x10, 0x51, 0x0c, 0x41, 0xb5, 0x78, 0x17, 0x00, 0x7f, 0x11, 0x66, 0x68, 0x90, 0x3e,
+	0x87, 0xa2, 0x4c, 0x74, 0x7a, 0x82, 0x45, 0xe3, 0x4f, 0x41, 0x25, 0x7a, 0x80, 0x2e, 0x08, 0x52,
+	0x69, 0xa3, 0xd7, 0x86, 0x4d, 0xd4, 0xcf, 0x2c, 0xc7, 0x14, 0x67, 0x24, 0x57, 0x0a, 0xba, 0x6c,
+	0x60, 0x2f, 0x47, 0x96, 0x69, 0x0a, 0x27, 0x8c, 0xf4, 0xc8, 0xd6, 0xa2, 0x78, 0x7c, 0x7e, 0x61,
+	0x3c, 0xbe, 0xf1, 0xcb, 0x50, 0x4d, 0x18, 0x8d, 0x17, 0x0e, 0x3b, 0xd1, 0xb1, 0x6c, 0xba, 0x63,
+	0x37, 0xa1, 0x12, 0xe6, 0x80, 0xf8, 0x74, 0xb7, 0x55, 0xf4, 0x18, 0xd0, 0xf8, 0x47, 0x59, 0x28,
+	0xc8, 0xa1, 0xcd, 0x1a, 0x7a, 0xdb, 0x50, 0xf4, 0x03, 0x23, 0x98, 0x86, 0xc9, 0x0c, 0x4b, 0x1e,
+	0xd0, 0x1e, 0xd1, 0x60, 0x74, 0x4d, 0x52, 0xf3, 0x0f, 0x20, 0x17, 0x18, 0x43, 0xe5, 0x28, 0xfd,
+	0xda, 0x72, 0x4c, 0xfa, 0xc6, 0x10, 0x23, 0xdc, 0x81, 0x31, 0xe4, 0xbb, 0x50, 0x1e, 0x28, 0xdf,
+	0x96, 0x12, 0x8a, 0x4b, 0xda, 0x62, 0xa1, 0x47, 0x0c, 0x23, 0x85, 0x21, 0x07, 0xfe, 0x1d, 0xc8,
+	0x9b, 0x78, 0xc9, 0xc9, 0x9c, 0x8f, 0x25, 0x6d, 0x4c, 0x3c, 0x2e, 0x18, 0xf3, 0x43, 0xca, 0xcd,
+	0x12, 0x14, 0x48, 0x06, 0x37, 0xea, 0x50, 0x94, 0x63, 0x9d, 0x9d, 0xb9, 0xc6, 0x0d, 0xc8, 0xf5,
+	0x8d, 0x21, 0x6a, 0xf8, 0x96, 0xe9, 0x2b, 0x57, 0x09, 0xfe, 0x6c, 0xbc, 0x1c, 0xfb, 0xe9, 0x92,
+	0x2e, 0xe0, 0x4c, 0xca, 0x05, 0xdc, 0x28, 0x42, 0x1e, 0xdf, 0xd8, 0xb8, 0x79, 0x99, 0xb5, 0xd0,
+	0xf8, 0xbb, 0x39, 0x34, 0x2c, 0x30, 0x4c, 0xbc, 0xc8, 0xbd, 0xfd, 0x31, 0x54, 0x26, 0x9e, 0x3b,
+	0x10, 0xbe, 0xef, 0x7a, 0x4a, 0x39, 0x7a, 0xed, 0xd9, 0xa1, 0xe7, 0x8d, 0x83, 0x90, 0x46, 0x8f,
+	0xc9, 0xb5, 0x7f, 0x9e, 0x85, 0x4a, 0xf4, 0x40, 0xda, 0x33, 0x81, 0x38, 0x93, 0xae, 0xcc, 0x3d,
+	0xe1, 0x8d, 0x0d, 0xcb, 0x94, 0xd2, 0xa3, 0x35, 0x32, 0x42, 0x25, 0xf7, 0x53, 0x77, 0x1a, 0x4c,
+	0x8f, 0x85, 0x74, 0x61, 0x1d, 0x5a, 0x63, 0x81, 0x2e, 0x2c, 0x0c, 0x1e, 0xe1, 0xc6, 0x1e, 0xd8,
+	0xee, 0xd4, 0x64, 0x05, 0x6c, 0x3f, 0xa0, 0xeb, 0x6d, 0xcf, 0x98, 0xf8, 0x52, 0x66, 0xee, 0x59,
+	0x9e, 0xcb, 0x4a, 0x48, 0xb4, 0x6d, 0x0d, 0xc7, 0x06, 0x2b, 0x23, 0xb3, 0xfe, 0x53, 0x2b, 0x40,
+	0x21, 0x5c, 0x41, 0x35, 0xb5, 0x3b, 0x11, 0x4e, 0x2f, 0xf0, 0x84, 0x08, 0xf6, 0x8c, 0x89, 0xf4,
+	0x69, 0xea, 0xc2, 0x34, 0xad, 0x40, 0xca, 0xcf, 0x6d, 0x63, 0x20, 0x30, 0xb1, 0x81, 0xad, 0xa2,
+	0xa0, 0xe9, 0x38, 0x7e, 0x80, 0x9e, 0xd7, 0xb1, 0x94, 0xa1, 0x7d, 0x61, 0x0b, 0x6a, 0xad, 0xd1,
+	0xbb, 0xad, 0x60, 0x34, 0x3d, 0x7e, 0x80, 0x76, 0xdf, 0x15, 0x19, 0x67, 0x32, 0xc5, 0x44, 0xa0,
+	0x0c, 0x5d, 0x85, 0xf2, 0xa6, 0x65, 0x5b, 0xc7, 0x96, 0x6d, 0xb1, 0x75, 0x44, 0x6d, 0x9f, 0x0d,
+	0x0c, 0xdb, 0x32, 0x3d, 0xe3, 0x29, 0xe3, 0xd8, 0xb9, 0x87, 0x9e, 0xfb, 0xc4, 0x62, 0x57, 0x11,
+	0x91, 0xcc, 0xc0, 0x53, 0xeb, 0x07, 0xec, 0x1a, 0xc5, 0xca, 0x9e, 0x60, 0x14, 0xe3, 0xc4, 0x38,
+	0x66, 0x2f, 0xc4, 0x2e, 0xbd, 0xeb, 0x8d, 0x75, 0xb8, 0x32, 0x13, 0x95, 0x6f, 0x94, 0x94, 0xf5,
+	0xd9, 0xa8, 0x41, 0x35, 0x11, 0x2e, 0x6d, 0xbc, 0x02, 0xe5, 0x30, 0x98, 0x8a, 0x56, 0xba, 0xe5,
+	0x4b, 0x37, 0xb0, 0xda, 0x24, 0x51, 0xbb, 0xf1, 0xbb, 0x19, 0x28, 0xca, 0x48, 0x36, 0xdf, 0x8c,
+	0x32, 0x4f, 0x32, 0x4b, 0x44, 0x2f, 0x25, 0x91, 0x8a, 0xfd, 0x46, 0xe9, 0x27, 0xd7, 0xa0, 0x60,
+	0x93, 0x39, 0xae, 0xc4, 0x17, 0x35, 0x12, 0xd2, 0x26, 0x97, 0x94, 0x36, 0x5a, 0x33, 0x8a, 0x37,
+	0x87, 0xae, 0x47, 0xd2, 0x0a, 0xfb, 0x9e, 0x10, 0x2c, 0x13, 0x59, 0xd3, 0x59, 0xba, 0x2b, 0xdc,
+	0xf1, 0xc4, 0x18, 0x04, 0x04, 0xa0, 0x5b, 0x14, 0x85, 0x29, 0xcb, 0xe3, 0x2e, 0xc7, 0x58, 0xba,
+	0x76, 0x02, 0xe5, 0x03, 0xd7, 0x9f, 0xbd, 0x93, 0x4b, 0x90, 0xeb, 0xbb, 0x13, 0xa9, 0x61, 0x6e,
+	0xba, 0x01, 0x69, 0x98, 0xc4, 0x57, 0x9c, 0x04, 0x72, 0x53, 0xe9, 0x98, 0x10, 0x26, 0x2d, 0xf1,
+	0x8e, 0xe3, 0x08, 0x8f, 0x15, 0x70, 0x0d, 0x75, 0x31, 0x41, 0xad, 0x96, 0x15, 0x71, 0xd5, 0x08,
+	0xbe, 0x6d, 0x79, 0x7e, 0xc0, 0x4a, 0x5a, 0x07, 0x0a, 0x32, 0xc9, 0xa8, 0x06, 0x15, 0xfa, 0x41,
+	0xac, 0x56, 0xb0, 0x8b, 0xd4, 0x6c, 0x09, 0x07, 0xf7, 0x18, 0x59, 0x4f, 0x04, 0x90, 0x2f, 0xc8,
+	0xe2, 0x0d, 0x46, 0xed, 0x8f, 0xa7, 0x7e, 0x60, 0x9d, 0x9c, 0xb3, 0x9c, 0xf6, 0x18, 0x6a, 0xa9,
+	0x34, 0x26, 0x7e, 0x0d, 0x58, 0x0a, 0x80, 0x5d, 0x5f, 0xe1, 0x37, 0xe0, 0x6a, 0x0a, 0xba, 0x67,
+	0x99, 0x26, 0xf9, 0x7a, 0x67, 0x1f, 0x84, 0x03, 0xdc, 0xac, 0x40, 0x69, 0x20, 0x57, 0x49, 0x3b,
+	0x80, 0x1a, 0x2d, 0x1b, 0xa6, 0xd3, 0x75, 0x1d, 0xfb, 0xfc, 0x0f, 0x9d, 0x6b, 0xa6, 0x7d, 0x5d,
+	0x19, 0x58, 0x28, 0x2f, 0x4e, 0x3c, 0x77, 0x4c, 0xbc, 0x0a, 0x3a, 0xfd, 0x46, 0xee, 0x81, 0xab,
+	0xd6, 0x3e, 0x1b, 0xb8, 0xda, 0xbf, 0xab, 0x40, 0xa9, 0x39, 0x18, 0xa0, 0x49, 0x38, 0xf7, 0xe6,
+	0xb7, 0xa1, 0x38, 0x70, 0x9d, 0x13, 0x6b, 0xa8, 0xe4, 0xf1, 0xac, 0x66, 0xa8, 0xe8, 0x70, 0xc3,
+	0x9d, 0x58, 0x43, 0x5d, 0x21, 0x23, 0x99, 0xba, 0x4f, 0x0a, 0x97, 0x92, 0x49, 0xa1, 0x1a, 0x5d,
+	0x1f, 0xf7, 0x20, 0x6f, 0x61, 0x66, 0xa4, 0x4c, 0x0c, 0x7d, 0xe9, 0x02, 0x22, 0xca, 0x8e, 0x24,
+	0xc4, 0xc6, 0x7f, 0xcc, 0x60, 0xbe, 0x02, 0xbd, 0xf2, 0x15, 0x58, 0x13, 0x0e, 0x1e, 0xa6, 0x50,
+	0x94, 0xab, 0x53, 0x34, 0x03, 0x45, 0xa5, 0x55, 0x41, 0xc4, 0xf1, 0x74, 0xa8, 0x7c, 0x2f, 0x49,
+	0x10, 0x7f, 0x0f, 0x6e, 0xc8, 0xe6, 0x81, 0x27, 0x3c, 0x61, 0x0b, 0xc3, 0x17, 0xad, 0x91, 0xe1,
+	0x38, 0xc2, 0x56, 0x17, 0xfb, 0x45, 0x8f, 0xd1, 0xd9, 0x2a, 0x1f, 0xf5, 0x26, 0xc6, 0x40, 0xf8,
+	0x2a, 0xde, 0x97, 0x82, 0xf1, 0x6f, 0x40, 0x81, 0xf2, 0x66, 0xeb, 0xe6, 0xe5, 0x4b, 0x29, 0xb1,
+	0x1a, 0x6e, 0x74, 0xf3, 0x34, 0x01, 0xe4, 0x34, 0xa1, 0xd1, 0xa5, 0x4e, 0xff, 0x97, 0x2f, 0x9d,
+	0x57, 0x44, 0xd4, 0x13, 0x44, 0xd8, 0x3f, 0x53, 0xd8, 0x82, 0x12, 0x1c, 0xf1, 0x66, 0xcc, 0x52,
+	0x64, 0x25, 0x05, 0x6b, 0xfc, 0x83, 0x3c, 0xe4, 0x71, 0x86, 0x11, 0x79, 0xe4, 0x8e, 0x45, 0xe4,
+	0x5f, 0x96, 0xaa, 0x46, 0x0a, 0x86, 0xaa, 0x8d, 0x21, 0x43, 0xfc, 0x11, 0x9a, 0x14, 0x1e, 0xb3,
+	0x60, 0xc4, 0x9c, 0x78, 0x2e, 0x26, 0xcf, 0x45, 0x98, 0x4a, 0x09, 0x9a, 0x01, 0xf3, 0x77, 0xe0,
+	0x3a, 0x46, 0x21, 0x45, 0x40, 0xa7, 0xfb, 0xb1, 0xeb, 0x3d, 0xf1, 0x71, 0xe6, 0x3a, 0xa6, 0x72,
+	0x4c, 0x5e, 0xf0, 0x14, 0x5d, 0x89, 0x4f, 0xc3, 0x66, 0xf4, 0x0e, 0xe9, 0x1a, 0x9c, 0x7f, 0x80,
+	0xe2, 0xd6, 0x14, 0xa7, 0x16, 0xf1, 0x2d, 0x13, 0x52, 0xd4, 0xc6, 0xad, 0x64, 0xc8, 0x89, 0xec,
+	0xa9, 0x37, 0xab, 0x08, 0x53, 0x1a, 0x8a, 0xda, 0x96, 0xcc, 0x2a, 0xf2, 0x3b, 0x26, 0x79, 0x56,
+	0x2b, 0x7a, 0x0c, 0xc0, 0x8d, 0x46, 0xaf, 0x3c, 0x94, 0x42, 0xb5, 0x26, 0x4d, 0xd0, 0x04, 0x08,
+	0x31, 0x02, 0x31, 0x18, 0x85, 0x2f, 0x91, 0x6e, 0xcf, 0x24, 0x08, 0x43, 0x25, 0x43, 0x23, 0x10,
+	0x4f, 0x8d, 0xf3, 0x47, 0x9e, 0x5d, 0x17, 0x84, 0x90, 0x80, 0xa0, 0x11, 0x6b, 0xbb, 0x03, 0xc3,
+	0xee, 0x05, 0x2e, 0x3a, 0x61, 0x0e, 0x8c, 0x60, 0x54, 0x1f, 0x12, 0xd6, 0x1c, 0x1c, 0x47, 0x8c,
+	0x7e, 0xbc, 0xcf, 0x5c, 0x47, 0xd4, 0x47, 0x72, 0xc4, 0x61, 0x1b, 0x7b, 0x62, 0x38, 0x86, 0x7d,
+	0x1e, 0x58, 0x03, 0x1c, 0x8b, 0x25, 0x7b, 0x92, 0x00, 0xe1, 0x58, 0x1d, 0x11, 0xe0, 0x3c, 0x76,
+	0xcc, 0xfa, 0xf7, 0xe4, 0x58, 0x23, 0x40, 0xe3, 0x5b, 0x14, 0x9e, 0x1a, 0x69, 0x6f, 0x42, 0x6d,
+	0x17, 0xdf, 0xdb, 0x9c, 0x58, 0xbd, 0x81, 0x3b, 0x11, 0x28, 0xa6, 0xc9, 0xd1, 0x4b, 0x6e, 0x81,
+	0x2a, 0x94, 0x3e, 0xf6, 0x5d, 0xa7, 0x79, 0xd0, 0x91, 0x17, 0xc7, 0xf6, 0xd4, 0xb6, 0x59, 0x56,
+	0xeb, 0x02, 0xc4, 0xfb, 0x15, 0x2f, 0x81, 0x26, 0xc5, 0x82, 0xd8, 0x8a, 0x74, 0x42, 0x39, 0x18,
+	0xc0, 0xda, 0x52, 0x5b, 0x94, 0x65, 0x10, 0x48, 0xce, 0x05, 0x61, 0x46, 0x40, 0x52, 0x43, 0xa8,
+	0x25, 0x4c, 0x96, 0xd3, 0xfe, 0x57, 0x06, 0xaa, 0x89, 0xd4, 0x87, 0x3f, 0xc2, 0x74, 0x0d, 0xbc,
+	0xa4, 0xf1, 0x9a, 0xc7, 0xd5, 0x90, 0xdb, 0x37, 0x6a, 0xe3, 0x5a, 0xa9, 0xcc, 0x0c, 0x7c, 0x2a,
+	0x5d, 0x09, 0x09, 0xc8, 0x17, 0x4a, 0xd5, 0xd0, 0xee, 0x2b, 0x7f, 0x4c, 0x15, 0x4a, 0x8f, 0x9c,
+	0x27, 0x8e, 0xfb, 0xd4, 0x61, 0x2b, 0x51, 0xfe, 0x4d, 0x2a, 0x92, 0x18, 0xa6, 0xc8, 0xe4, 0xb4,
+	0x7f, 0x9a, 0x9f, 0x49, 0x55, 0x6b, 0x43, 0x51, 0x1a, 0x01, 0xa4, 0x9f, 0xce, 0xe7, 0x16, 0x25,
+	0x91, 0x55, 0xd4, 0x2a, 0x01, 0xd2, 0x15, 0x31, 0x6a, 0xe7, 0x51, 0x22, 0x67, 0x76, 0x61, 0x74,
+	0x2d, 0xc5, 0x28, 0x94, 0xb8, 0x49, 0x60, 0x9c, 0xd1, 0xd9, 0xf8, 0x95, 0x0c, 0x5c, 0x5b, 0x84,
+	0x92, 0xcc, 0xf8, 0xce, 0xa4, 0x33, 0xbe, 0x7b, 0x33, 0x19, 0xd4, 0x59, 0x1a, 0xcd, 0xbd, 0xe7,
+	0xec, 0x44, 0x3a, 0x9f, 0x5a, 0xfb, 0x9d, 0x0c, 0xac, 0xcf, 0x8d, 0x39, 0xa1, 0x9d, 0x00, 0x14,
+	0xe5, 0xce, 0x92, 0x09, 0x4e, 0x51, 0xca, 0x89, 0x0c, 0x19, 0xd0, 0xbd, 0xed, 0xcb, 0x18, 0xbe,
+	0xca, 0x19, 0x97, 0xca, 0x2f, 0xae, 0x1a, 0x5e, 0x0b, 0x43, 0x21, 0xdd, 0xab, 0x52, 0x85, 0x52,
+	0x90, 0xa2, 0x54, 0x50, 0x65, 0x5c, 0x83, 0x95, 0x28, 0x71, 0x6a, 0x3a, 0xb1, 0xad, 0x01, 0x36,
+	0xcb, 0xbc, 0x01, 0xd7, 0x65, 0xe1, 0x80, 0x32, 0x06, 0x4f, 0xfa, 0x23, 0x8b, 0x0e, 0x07, 0xab,
+	0xe0, 0x7b, 0x0e, 0xa6, 0xc7, 0xb6, 0xe5, 0x8f, 0x18, 0x68, 0x3a, 0x5c, 0x5d, 0x30, 0x40, 0xea,
+	0xf2, 0xa1, 0xea, 0xfe, 0x1a, 0xc0, 0xd6, 0x61, 0xd8, 0x69, 0x96, 0x41, 0x87, 0xc6, 0xd6, 0x61,
+	0x92, 0xbb, 0x3a, 0x3c, 0x87, 0x28, 0x93, 0x7c, 0x96, 0xd3, 0x7e, 0x35, 0x13, 0x66, 0x36, 0x34,
+	0xfe, 0x24, 0xd4, 0x64, 0x87, 0x0f, 0x8c, 0x73, 0xdb, 0x35, 0x4c, 0xde, 0x86, 0x35, 0x3f, 0x2a,
+	0x6d, 0x49, 0x5c, 0x43, 0xb3, 0xd7, 0x7b, 0x2f, 0x85, 0xa4, 0xcf, 0x10, 0x85, 0x06, 0x4e, 0x36,
+	0x0e, 0x87, 0x70, 0x32, 0xd5, 0x0c, 0x3a, 0x72, 0xab, 0x64, 0x7c, 0x19, 0xda, 0x37, 0x60, 0xbd,
+	0x17, 0x8b, 0x6c, 0xa9, 0x09, 0xe3, 0xe6, 0x90, 0xf2, 0x7e, 0x2b, 0xdc, 0x1c, 0xaa, 0xa9, 0xfd,
+	0x87, 0x22, 0x40, 0x1c, 0xfa, 0x59, 0x70, 0xe6, 0x17, 0x65, 0x32, 0xcc, 0x05, 0x62, 0x73, 0xcf,
+	0x1d, 0x88, 0x7d, 0x2f, 0x52, 0xc8, 0xa5, 0x5b, 0x78, 0x36, 0x9d, 0x3b, 0xee, 0xd3, 0xac, 0x1a,
+	0x9e, 0x4a, 0xf4, 0x29, 0xcc, 0x26, 0xfa, 0xdc, 0x9e, 0xcf, 0x0a, 0x9c, 0x11, 0x46, 0xb1, 0xbf,
+	0xa1, 0x94, 0xf2, 0x37, 0x34, 0x30, 0x57, 0xda, 0x30, 0x5d, 0xc7, 0x3e, 0x0f, 0xe3, 0x7d, 0x61,
+	0x9b, 0xbf, 0x09, 0x85, 0x80, 0xaa, 0x73, 0xca, 0xb7, 0x73, 0xcf, 0x5e, 0x38, 0x89, 0x8b, 0x92,
+	0xcd, 0xf2, 0x55, 0x2a, 0x9f, 0xbc, 0x0b, 0xcb, 0x7a, 0x02, 0xc2, 0x37, 0x80, 0x5b, 0x68, 0x7c,
+	0xd9, 0xb6, 0x30, 0x37, 0xcf, 0xb7, 0x64, 0x18, 0x8e, 0x6e, 0xeb, 0xb2, 0xbe, 0xe0, 0x49, 0xb8,
+	0xfe, 0xab, 0xf1, 0xfa, 0x53, 0x97, 0x4f, 0x2d, 0x1f, 0x47, 0x5a, 0x23, 0xa5, 0x24, 0x6a, 0xa3,
+	0x3e, 0x10, 0x1e, 0x58, 0x39, 0x97, 0xb4, 0x7b, 0xe3, 0x58, 0xf6, 0x05, 0x4f, 0xb5, 0x7f, 0x99,
+	0x8d, 0x0c, 0x97, 0x0a, 0x14, 0x8e, 0x0d, 0xdf, 0x1a, 0xc8, 0x3b, 0x48, 0x29, 0x1c, 0xf2, 0x0e,
+	0x0a, 0x5c, 0xd3, 0x65, 0x59, 0xb4, 0x41, 0x7c, 0xa1, 0x82, 0x25, 0x71, 0xc5, 0x12, 0xcb, 0xe3,
+	0x41, 0x0d, 0xd7, 0x5b, 0x66, 0xe4, 0x10, 0x29, 0xb9, 0xbe, 0xcc, 0x28, 0xd7, 0x91, 0x8c, 0x58,
+	0xba, 0x08, 0x58, 0x19, 0x71, 0x1c, 0x37, 0x10, 0xd2, 0xf1, 0x47, 0xbb, 0x93, 0x01, 0xb2, 0x09,
+	0x53, 0xf0, 0x59, 0x15, 0x8d, 0x82, 0x90, 0xa9, 0xf4, 0xd6, 0xf9, 0x64, 0x32, 0xad, 0xe2, 0xe9,
+	0x4c, 0x3f, 0x60, 0x35, 0xec, 0x51, 0x5c, 0x08, 0xc5, 0xd6, 0x90, 0xab, 0x41, 0x79, 0x22, 0x57,
+	0xf0, 0xe7, 0x29, 0x65, 0x8f, 0x30, 0x7c, 0xab, 0x89, 0xd2, 0x63, 0x1d, 0x7b, 0x16, 0x29, 0x19,
+	0x8c, 0xa3, 0xcd, 0x33, 0x31, 0xd0, 0x00, 0xb1, 0x26, 0x86, 0x13, 0xb0, 0xab, 0x38, 0xd4, 0x89,
+	0x79, 0xc2, 0xae, 0x21, 0x09, 0x66, 0x36, 0xb3, 0x17, 0x10, 0x07, 0x7f, 0x6d, 0x09, 0x0f, 0xd7,
+	0x93, 0x5d, 0x47, 0x9c, 0xc0, 0x18, 0xb2, 0x1b, 0xda, 0x6f, 0xc4, 0xd9, 0xc6, 0xaf, 0x47, 0xa6,
+	0xc1, 0x32, 0x9b, 0x1c, 0x8d, 0x87, 0x45, 0x27, 0xae, 0x0d, 0xeb, 0x9e, 0xf8, 0xfe, 0xd4, 0x4a,
+	0xe5, 0xe0, 0xe7, 0x2e, 0x4f, 0xf2, 0x98, 0xa7, 0xd0, 0x4e, 0x61, 0x3d, 0x6c, 0x3c, 0xb6, 0x82,
+	0x11, 0x79, 0x69, 0xb0, 0xb8, 0x2a, 0x2a, 0x12, 0xc8, 0x2c, 0x2c, 0xae, 0x8a, 0x58, 0x46, 0x88,
+	0xb1, 0x17, 0x3e, 0xbb, 0x84, 0x17, 0x5e, 0xfb, 0x9f, 0xc9, 0xb0, 0xae, 0x34, 0x96, 0xcc, 0xc8,
+	0x58, 0x9a, 0x0f, 0xf3, 0xc6, 0x8e, 0xf5, 0xec, 0xf3, 0x38, 0xd6, 0x17, 0xa5, 0x4c, 0xbc, 0x8f,
+	0xba, 0x3b, 0x9d, 0x9f, 0xc3, 0x25, 0x82, 0x06, 0x29, 0x5c, 0xbe, 0x49, 0x41, 0x5b, 0xa3, 0x27,
+	0xf3, 0x79, 0x0a, 0x0b, 0x4b, 0x76, 0x92, 0xd1, 0x59, 0x85, 0xa9, 0x27, 0xa8, 0x12, 0xd2, 0xa6,
+	0xb8, 0x48, 0xda, 0xa0, 0xdd, 0xaa, 0xe4, 0x50, 0xd4, 0x96, 0x31, 0x16, 0xf9, 0x3b, 0x64, 0x4f,
+	0x1a, 0x79, 0x59, 0x9f, 0x83, 0xa3, 0x4a, 0x36, 0x9e, 0xda, 0x81, 0xa5, 0xc2, 0x08, 0xb2, 0x31,
+	0x5b, 0x53, 0x58, 0x99, 0xaf, 0x29, 0xfc, 0x10, 0xc0, 0x17, 0x78, 0x3a, 0xb6, 0xac, 0x41, 0xa0,
+	0xb2, 0x7e, 0x6e, 0x5d, 0x34, 0x36, 0x15, 0xfc, 0x48, 0x50, 0x60, 0xff, 0xc7, 0xc6, 0x19, 0x05,
+	0x44, 0x55, 0x7a, 0x42, 0xd4, 0x9e, 0x95, 0xc1, 0x6b, 0xf3, 0x32, 0xf8, 0x4d, 0x28, 0xf8, 0xa8,
+	0xe8, 0xd6, 0xaf, 0x5d, 0xba, 0xbe, 0x1b, 0xa4, 0x0d, 0xeb, 0x12, 0x97, 0xdc, 0x81, 0x28, 0xa5,
+	0x5c, 0x8f, 0x0a, 0x62, 0x2a, 0x7a, 0xd8, 0x4c, 0xc9, 0xc1, 0xeb, 0x69, 0x39, 0xd8, 0x30, 0xa1,
+	0xd8, 0x9d, 0x24, 0xf6, 0x5d, 0x6c, 0xa4, 0x87, 0x4e, 0xc1, 0x6c, 0xc2, 0x29, 0x18, 0xe5, 0x96,
+	0xe6, 0x92, 0xb9, 0xa5, 0x33, 0x35, 0x73, 0x85, 0xb9, 0x9a, 0x39, 0xed, 0x33, 0x28, 0x48, 0xcd,
+	0x1d, 0x42, 0xa5, 0x51, 0x2a, 0x9c, 0x38, 0x28, 0x96, 0x41, 0xef, 0x87, 0x2f, 0x48, 0x23, 0x11,
+	0x3d, 0x63, 0x2c, 0x48, 0x48, 0x66, 0x79, 0x1d, 0xae, 0x49, 0x5c, 0x3f, 0xfd, 0x84, 0xd4, 0x22,
+	0xdb, 0x3a, 0xf6, 0x0c, 0xef, 0x9c, 0xe5, 0xb5, 0x0f, 0x29, 0xb0, 0x1e, 0x6e, 0xa8, 0x6a, 0x54,
+	0xa3, 0x28, 0xc5, 0xb2, 0xa9, 0xa4, 0x0f, 0xe5, 0x65, 0x28, 0x4b, 0x4b, 0x66, 0xab, 0x91, 0x29,
+	0x43, 0xbe, 0x98, 0xd5, 0xe4, 0x4d, 0xfc, 0x47, 0x76, 0xde, 0xb4, 0xcd, 0x84, 0x5e, 0x97, 0x4e,
+	0x3f, 0xcb, 0x2c, 0x9b, 0x7e, 0xa6, 0x3d, 0x84, 0x2b, 0x7a, 0x5a, 0xa6, 0xf3, 0xf7, 0xa0, 0xe4,
+	0x4e, 0x92, 0x7c, 0x9e, 0xb5, 0x2f, 0x43, 0x74, 0xed, 0xa7, 0x19, 0x58, 0xed, 0x38, 0x81, 0xf0,
+	0x1c, 0xc3, 0xde, 0xb6, 0x8d, 0x21, 0x7f, 0x37, 0x94, 0x52, 0x8b, 0xed, 0xfe, 0x24, 0x6e, 0x5a,
+	0x60, 0xd9, 0xca, 0x85, 0x8d, 0xf9, 0x0a, 0xc2, 0xb4, 0x02, 0xd7, 0x93, 0xda, 0x6c, 0x98, 0x25,
+	0x78, 0x0d, 0x98, 0x04, 0xf7, 0xe8, 0x48, 0xf4, 0xe5, 0x32, 0xd7, 0xe1, 0x5a, 0x0a, 0x1a, 0xaa,
+	0xaa, 0x59, 0x7e, 0x13, 0xea, 0xf1, 0x6d, 0xb4, 0xe5, 0x3a, 0x41, 0x07, 0x63, 0x1f, 0xa4, 0x0a,
+	0xb1, 0x9c, 0xf6, 0xeb, 0xa5, 0x50, 0x09, 0x3b, 0x54, 0x39, 0x84, 0x9e, 0xeb, 0xc6, 0x05, 0xaa,
+	0xaa, 0x95, 0x28, 0x84, 0xce, 0x2e, 0x51, 0x08, 0xfd, 0x61, 0x5c, 0xcc, 0x2a, 0x2f, 0x8a, 0x97,
+	0x17, 0xde, 0x3e, 0x87, 0xe4, 0xbe, 0x97, 0x88, 0x3d, 0x91, 0xa8, 0x6c, 0x7d, 0x43, 0x19, 0x5e,
+	0xf9, 0x65, 0x74, 0x55, 0x42, 0xe5, 0x6f, 0xcf, 0x56, 0x50, 0x2c, 0x97, 0x82, 0x38, 0xa7, 0x4e,
+	0xc2, 0x73, 0xab, 0x93, 0x1f, 0xcd, 0xd8, 0x38, 0xe5, 0x85, 0xae, 0xb0, 0x4b, 0xea, 0x43, 0x3f,
+	0x82, 0xd2, 0xc8, 0xf2, 0x03, 0xd7, 0x93, 0x35, 0xcb, 0xf3, 0x35, 0x56, 0x89, 0xd9, 0xda, 0x91,
+	0x88, 0x94, 0x2f, 0x16, 0x52, 0xf1, 0xef, 0xc2, 0x3a, 0x4d, 0xfc, 0x41, 0xac, 0x35, 0xf8, 0xf5,
+	0xea, 0xc2, 0x3c, 0xbd, 0x04, 0xab, 0xcd, 0x19, 0x12, 0x7d, 0x9e, 0x49, 0x63, 0x08, 0x10, 0xaf,
+	0xcf, 0x9c, 0x14, 0xfb, 0x02, 0x35, 0xcb, 0x98, 0xa3, 0x3a, 0x3d, 0x8e, 0x63, 0x5d, 0xaa, 0xd5,
+	0x38, 0x83, 0xc6, 0x9c, 0x76, 0x70, 0x20, 0x3c, 0xd9, 0xdd, 0x4b, 0x0b, 0xa7, 0x3f, 0x4c, 0x2e,
+	0xbc, 0xdc, 0x9c, 0xb7, 0x2f, 0x58, 0xbd, 0x88, 0x73, 0x62, 0x07, 0x34, 0xde, 0x86, 0x6a, 0x62,
+	0x52, 0x51, 0x32, 0x4f, 0x1d, 0xd3, 0x0d, 0xdd, 0xaf, 0xf8, 0x9b, 0x53, 0xe1, 0x98, 0x19, 0x3a,
+	0x60, 0xe9, 0x77, 0x43, 0x07, 0x36, 0x3b, 0x81, 0x97, 0xd8, 0xc1, 0x2f, 0x43, 0x2d, 0xa1, 0xd2,
+	0x45, 0xae, 0xb9, 0x34, 0x50, 0x3b, 0x85, 0x97, 0x12, 0xec, 0x0e, 0x84, 0x37, 0xb6, 0x7c, 0xbc,
+	0x48, 0xa4, 0x49, 0x47, 0xae, 0x0c, 0x53, 0x38, 0x81, 0x15, 0x84, 0x12, 0x34, 0x6a, 0xf3, 0x6f,
+	0x41, 0x61, 0x22, 0xbc, 0xb1, 0xaf, 0xa4, 0xe8, 0xec, 0x0e, 0x5a, 0xc8, 0xd6, 0xd7, 0x25, 0x8d,
+	0xf6, 0x77, 0x32, 0x50, 0x46, 0x4f, 0xb6, 0x69, 0x04, 0x06, 0xdf, 0x9b, 0x79, 0xcb, 0x7c, 0x7c,
+	0x36, 0x44, 0xdd, 0x50, 0x46, 0xe6, 0x46, 0x47, 0xe1, 0xab, 0x36, 0x86, 0xf4, 0x42, 0x16, 0x8d,
+	0x4d, 0x28, 0x29, 0x70, 0xe3, 0x5d, 0xb8, 0x32, 0x83, 0x49, 0xf3, 0x22, 0x75, 0xfb, 0xde, 0xf9,
+	0x38, 0x4c, 0x22, 0x5a, 0xd5, 0xd3, 0x40, 0x74, 0xbc, 0x4f, 0x24, 0x81, 0xf6, 0x0f, 0x1b, 0x94,
+	0xba, 0x62, 0x9d, 0xa0, 0xe5, 0xbd, 0xe8, 0x66, 0xbd, 0x05, 0x40, 0x57, 0xb3, 0x4c, 0x70, 0x90,
+	0xee, 0xd2, 0x04, 0x84, 0xbf, 0x1f, 0xf9, 0xb9, 0xf3, 0x0b, 0x95, 0xaa, 0x24, 0xf3, 0x59, 0x67,
+	0x77, 0x1d, 0x4a, 0x96, 0x4f, 0xde, 0x32, 0x95, 0x14, 0x14, 0x36, 0xf9, 0xb7, 0xa1, 0x68, 0x8d,
+	0x27, 0xae, 0x17, 0x28, 0x47, 0xf8, 0xa5, 0x5c, 0x3b, 0x84, 0x89, 0x31, 0x58, 0x49, 0x83, 0xd4,
+	0xe2, 0x8c, 0xa8, 0xcb, 0xcf, 0xa6, 0x6e, 0x9f, 0x85, 0xd4, 0x92, 0x86, 0x7f, 0x02, 0xb5, 0xa1,
+	0xcc, 0x89, 0x94, 0x8c, 0xeb, 0x95, 0x85, 0xb1, 0xdc, 0x14, 0x93, 0x07, 0x49, 0x82, 0x9d, 0x15,
+	0x3d, 0xcd, 0x01, 0x59, 0xa2, 0x02, 0x2f, 0xfc, 0xa0, 0xef, 0x7e, 0xec, 0x5a, 0x4e, 0x1d, 0x9e,
+	0xcd, 0x52, 0x4f, 0x12, 0x20, 0xcb, 0x14, 0x07, 0xfe, 0x0e, 0x6a, 0x3c, 0x7e, 0xa0, 0xca, 0xc6,
+	0x6f, 0x5f, 0xc6, 0xa9, 0x2f, 0x7c, 0x55, 0xf0, 0xed, 0x07, 0xfc, 0x0c, 0x1a, 0x89, 0x43, 0xa2,
+	0x5e, 0xd2, 0x9c, 0x4c, 0x3c, 0xfc, 0x76, 0x04, 0xa9, 0x7f, 0xd5, 0xfb, 0xef, 0x5c, 0xc6, 0xed,
+	0xe0, 0x42, 0xea, 0x9d, 0x15, 0xfd, 0x12, 0xde, 0xbc, 0x8f, 0x96, 0x9d, 0x1a, 0xc2, 0xae, 0x30,
+	0x4e, 0xc3, 0xa2, 0xf3, 0xbb, 0x4b, 0xcd, 0x02, 0x51, 0xec, 0xac, 0xe8, 0x33, 0x3c, 0xf8, 0x2f,
+	0xc3, 0x7a, 0xea, 0x9d, 0x54, 0x67, 0x2a, 0x4b, 0xd2, 0xbf, 0xb1, 0xf4, 0x30, 0x90, 0x08, 0x0b,
+	0x9a, 0xe7, 0x38, 0xf1, 0x29, 0xbc, 0x38, 0x3f, 0xa4, 0x2d, 0x31, 0xb0, 0x2d, 0x47, 0xa8, 0xea,
+	0xf5, 0xb7, 0x9f, 0x6f, 0xb6, 0x14, 0xf1, 0xce, 0x8a, 0x7e, 0x31, 0x67, 0xfe, 0xa7, 0xe1, 0xe6,
+	0x64, 0xa1, 0x88, 0x91, 0xa2, 0x4b, 0x15, 0xbf, 0xbf, 0xb7, 0xe4, 0x9b, 0xe7, 0xe8, 0x77, 0x56,
+	0xf4, 0x4b, 0xf9, 0xf3, 0x4d, 0xd4, 0xc2, 0xc7, 0x96, 0x83, 0xa1, 0x58, 0x59, 0x27, 0xff, 0xf2,
+	0xe5, 0xab, 0x24, 0x71, 0x65, 0xad, 0xb9, 0xfc, 0x8d, 0xd7, 0xf0, 0x58, 0xd6, 0x0d, 0xd5, 0xaf,
+	0x2d, 0xac, 0x53, 0x4e, 0xb1, 0x50, 0x25, 0x46, 0x3b, 0x2b, 0x7a, 0x48, 0x85, 0x0a, 0x3c, 0x99,
+	0xf1, 0x2a, 0x7f, 0x5c, 0x36, 0xd0, 0x67, 0x64, 0x0c, 0x6c, 0x74, 0x86, 0x45, 0x01, 0x83, 0x18,
+	0xd0, 0xf8, 0xaf, 0x19, 0x28, 0xaa, 0x43, 0x77, 0x33, 0x4a, 0x0a, 0x88, 0xee, 0x8f, 0x18, 0xc0,
+	0x3f, 0x80, 0x8a, 0xf0, 0x3c, 0xd7, 0xc3, 0x30, 0x78, 0x3d, 0xbb, 0xd0, 0x21, 0x2d, 0xf9, 0x6c,
+	0xb4, 0x43, 0x34, 0x3d, 0xa6, 0xe0, 0xef, 0x03, 0x48, 0x61, 0xd3, 0x8f, 0xcb, 0x80, 0x1a, 0x8b,
+	0xe9, 0x65, 0x0c, 0x2a, 0xc6, 0x8e, 0x3d, 0x78, 0x61, 0x00, 0x28, 0x6c, 0x46, 0x56, 0x6f, 0x21,
+	0x61, 0xf5, 0xde, 0x54, 0xce, 0x8c, 0x7d, 0x7c, 0xa0, 0x8a, 0xe1, 0x22, 0x40, 0xe3, 0x5f, 0x64,
+	0x30, 0x01, 0x8a, 0xc6, 0xdb, 0x9e, 0x1f, 0xd1, 0xab, 0xcf, 0x16, 0x7c, 0x1b, 0xb3, 0x23, 0xfb,
+	0x36, 0x80, 0x38, 0x0b, 0xfb, 0xaa, 0x46, 0x76, 0x73, 0x86, 0x8f, 0x22, 0x0d, 0x33, 0x98, 0x63,
+	0x7c, 0xf4, 0xd6, 0x13, 0x17, 0xf4, 0x1e, 0x3f, 0xda, 0xdd, 0x65, 0x2b, 0x98, 0xc4, 0xf0, 0x68,
+	0xff, 0xe1, 0x7e, 0xf7, 0xf1, 0xfe, 0x51, 0x5b, 0xd7, 0xbb, 0xba, 0x74, 0x22, 0x6f, 0x36, 0xb7,
+	0x8e, 0x3a, 0xfb, 0x07, 0x8f, 0xfa, 0x2c, 0xdb, 0xf8, 0xc7, 0x19, 0xa8, 0xa5, 0x04, 0xe8, 0x1f,
+	0xef, 0xd2, 0x25, 0xa6, 0x3f, 0xb7, 0x78, 0xfa, 0xf3, 0x17, 0x4d, 0x7f, 0x61, 0x76, 0xfa, 0x7f,
+	0x2b, 0x03, 0xb5, 0x94, 0xa0, 0x4e, 0x72, 0xcf, 0xa4, 0xb9, 0x27, 0xd5, 0x8d, 0xec, 0x8c, 0xba,
+	0x81, 0x35, 0x2a, 0xea, 0xf7, 0x7e, 0xec, 0xf6, 0x48, 0xc1, 0x92, 0x38, 0x54, 0x31, 0x91, 0x4f,
+	0xe3, 0x20, 0xec, 0x19, 0xbd, 0xa5, 0x0a, 0x51, 0x9f, 0x0a, 0xe8, 0x1b, 0x17, 0x8b, 0xf1, 0x4b,
+	0x86, 0xf0, 0x00, 0xaa, 0x93, 0x58, 0x56, 0x3c, 0x9f, 0x6e, 0x94, 0xa4, 0x7c, 0x46, 0x3f, 0x7f,
+	0x3b, 0x03, 0x6b, 0x69, 0xc1, 0xff, 0xff, 0xf4, 0xb4, 0xfe, 0xbd, 0x0c, 0xac, 0xcf, 0x5d, 0x27,
+	0x97, 0x6a, 0x97, 0xb3, 0xfd, 0xca, 0x2e, 0xd1, 0xaf, 0xdc, 0x82, 0x7e, 0x5d, 0x2c, 0x49, 0x2e,
+	0xef, 0x71, 0x0f, 0x5e, 0xbc, 0xf0, 0x62, 0xba, 0x64, 0xaa, 0x53, 0x4c, 0x73, 0xb3, 0x4c, 0x7f,
+	0x33, 0x03, 0x37, 0x2f, 0xbb, 0x74, 0xfe, 0x8f, 0xef, 0xab, 0xb9, 0x1e, 0xfe, 0x7e, 0x06, 0x5d,
+	0x97, 0xea, 0x7a, 0x5a, 0x72, 0x98, 0xd9, 0x19, 0x26, 0x29, 0xdb, 0x29, 0x37, 0x63, 0x3b, 0xdd,
+	0x0a, 0x5d, 0xe7, 0xfb, 0xb1, 0x18, 0x49, 0x40, 0x9e, 0xed, 0x7c, 0xc2, 0x55, 0x0f, 0x9b, 0x09,
+	0x81, 0x9f, 0x82, 0xa9, 0x50, 0x91, 0xbc, 0x16, 0x73, 0x32, 0x4f, 0xaf, 0xf1, 0x2b, 0xd9, 0xa8,
+	0x4a, 0xf7, 0xff, 0xca, 0xc8, 0x12, 0xa6, 0x5a, 0x21, 0x6d, 0xaa, 0xdd, 0x84, 0xca, 0x58, 0xf8,
+	0xbe, 0x31, 0x14, 0x51, 0x85, 0x5e, 0x0c, 0xc0, 0x77, 0x1a, 0xd3, 0x60, 0xe4, 0x7a, 0x51, 0x09,
+	0x7e, 0xd4, 0xc6, 0x77, 0xca, 0xdf, 0xf4, 0x4e, 0x99, 0xa2, 0x90, 0x80, 0x44, 0x4e, 0xbf, 0x4a,
+	0xec, 0xf4, 0xd3, 0xde, 0x8d, 0x92, 0x45, 0x30, 0xc5, 0x4d, 0x7e, 0x7b, 0x4c, 0xa5, 0xe3, 0x8f,
+	0x30, 0x74, 0x4c, 0x11, 0x0f, 0x5d, 0x18, 0xea, 0xeb, 0x0c, 0x98, 0x40, 0x65, 0x51, 0xc4, 0xfc,
+	0x2b, 0x00, 0x4d, 0xf2, 0x1f, 0x84, 0xc5, 0x52, 0xad, 0xdd, 0x6e, 0xaf, 0x2d, 0xa3, 0x9f, 0xbd,
+	0xfd, 0x6e, 0xf7, 0xb3, 0x36, 0xcb, 0x24, 0x0d, 0xa7, 0x1f, 0x46, 0x17, 0xaf, 0x76, 0x0a, 0xc5,
+	0xb8, 0x96, 0x05, 0x6b, 0x91, 0x4d, 0x19, 0xa4, 0x5e, 0x85, 0xf2, 0x81, 0xb2, 0xdb, 0xe5, 0x7b,
+	0x3f, 0xee, 0x75, 0xf7, 0x65, 0xa4, 0x65, 0xab, 0xdb, 0x97, 0x15, 0x31, 0xbd, 0xc3, 0x07, 0x32,
+	0x5a, 0xfa, 0x40, 0x6f, 0x1e, 0xec, 0x1c, 0x11, 0x06, 0x05, 0x59, 0x76, 0xfa, 0x7b, 0xbb, 0xac,
+	0x88, 0x28, 0xad, 0xde, 0x21, 0x2b, 0xe1, 0x8f, 0x7e, 0xef, 0x50, 0x06, 0x57, 0xba, 0x07, 0x7b,
+	0xbb, 0xac, 0xa2, 0xfd, 0x5e, 0x3e, 0xd4, 0x75, 0xb4, 0xbf, 0x18, 0x96, 0x88, 0x03, 0x14, 0xf1,
+	0x92, 0x77, 0xd5, 0xfb, 0xa3, 0xde, 0x50, 0xb2, 0x77, 0xfb, 0x4c, 0xfa, 0xc8, 0x58, 0x16, 0x33,
+	0xb3, 0x0f, 0x8e, 0x65, 0x86, 0xda, 0x4e, 0x30, 0xb6, 0x65, 0xc5, 0x6d, 0xff, 0x2c, 0x60, 0x05,
+	0x7a, 0xa5, 0x7f, 0x2a, 0x23, 0xb4, 0xdd, 0x63, 0xdf, 0xa2, 0x5a, 0x96, 0x12, 0x62, 0xb6, 0x1d,
+	0xa1, 0x2a, 0xae, 0x77, 0xdd, 0xa1, 0x2f, 0xbe, 0xcf, 0x2a, 0x34, 0x9b, 0xae, 0x31, 0x66, 0x40,
+	0xfd, 0x9a, 0x8c, 0x6d, 0x56, 0xd5, 0xfe, 0x49, 0x0e, 0x2a, 0xd1, 0xcd, 0xfb, 0x3c, 0x9a, 0x00,
+	0x06, 0x7f, 0x3a, 0xfb, 0xfd, 0xb6, 0xbe, 0xdf, 0xdc, 0x55, 0x28, 0x39, 0x4c, 0x76, 0xd8, 0xee,
+	0xec, 0xb6, 0x8f, 0x76, 0xbb, 0xcd, 0x2d, 0x05, 0x2c, 0x63, 0x49, 0x52, 0x67, 0xef, 0xa0, 0xab,
+	0xf7, 0x8f, 0x3a, 0xbd, 0xa3, 0x56, 0x73, 0xbf, 0xd5, 0xde, 0x6d, 0x6f, 0xb1, 0x22, 0x7f, 0x19,
+	0x6e, 0xef, 0x77, 0xfb, 0x9d, 0xee, 0xfe, 0xd1, 0x7e, 0xf7, 0xa8, 0xbb, 0xf9, 0x71, 0xbb, 0xd5,
+	0xef, 0x1d, 0x75, 0xf6, 0x8f, 0x90, 0xeb, 0x03, 0xbd, 0x89, 0x4f, 0x58, 0x81, 0xdf, 0x86, 0x9b,
+	0x0a, 0xab, 0xd7, 0xd6, 0x0f, 0xdb, 0x3a, 0x32, 0x79, 0xb4, 0xdf, 0x3c, 0x6c, 0x76, 0x76, 0x9b,
+	0x9b, 0xbb, 0x6d, 0xb6, 0xca, 0x6f, 0x41, 0x43, 0x61, 0xe8, 0xcd, 0x7e, 0xfb, 0x68, 0xb7, 0xb3,
+	0xd7, 0xe9, 0x1f, 0xb5, 0xbf, 0xdb, 0x6a, 0xb7, 0xb7, 0xda, 0x5b, 0xac, 0xc6, 0xbf, 0x06, 0x5f,
+	0xa5, 0x4e, 0xa9, 0x4e, 0xa4, 0x5f, 0xf6, 0x59, 0xe7, 0xe0, 0xa8, 0xa9, 0xb7, 0x76, 0x3a, 0x87,
+	0x6d, 0xb6, 0xc6, 0x5f, 0x85, 0xaf, 0x5c, 0x8c, 0xba, 0xd5, 0xd1, 0xdb, 0xad, 0x7e, 0x57, 0xff,
+	0x94, 0xad, 0xf3, 0x2f, 0xc1, 0x8b, 0xb8, 0xe8, 0x47, 0x8f, 0xf5, 0xee, 0xfe, 0x83, 0x23, 0xfa,
+	0xd9, 0xeb, 0xeb, 0x8f, 0x5a, 0xfd, 0x47, 0x7a, 0x9b, 0x01, 0x86, 0xc4, 0x0f, 0x36, 0x8f, 0xf6,
+	0xbb, 0xfd, 0xa3, 0xe6, 0xfe, 0xa7, 0x9b, 0xbb, 0xdd, 0xd6, 0xc3, 0xa3, 0xed, 0xae, 0xbe, 0xd7,
+	0xec, 0xb3, 0x2a, 0xff, 0x3a, 0xbc, 0xda, 0xea, 0x1d, 0xaa, 0x6e, 0x76, 0xb7, 0x8f, 0xf4, 0xee,
+	0xe3, 0xde, 0x51, 0x57, 0x3f, 0xd2, 0xdb, 0xbb, 0x34, 0xe6, 0x5e, 0xdc, 0xf7, 0x12, 0xfa, 0x2f,
+	0x3b, 0xfb, 0xbd, 0x47, 0xdb, 0xdb, 0x9d, 0x56, 0xa7, 0xbd, 0xdf, 0x3f, 0x3a, 0x68, 0xeb, 0x7b,
+	0x9d, 0x5e, 0x0f, 0xd1, 0x58, 0x45, 0xfb, 0x0e, 0x7e, 0x53, 0xe4, 0xd4, 0x0a, 0xe8, 0x48, 0xab,
+	0xcd, 0xae, 0xbc, 0x08, 0x61, 0x93, 0xc4, 0x88, 0x35, 0x74, 0xe8, 0x0b, 0x14, 0x24, 0x46, 0x56,
+	0xf5, 0x18, 0xa0, 0xfd, 0xfd, 0x2c, 0xd4, 0x24, 0x8b, 0xd0, 0x2b, 0x71, 0x07, 0xae, 0x28, 0xf7,
+	0x7e, 0x27, 0x7d, 0x23, 0xce, 0x82, 0xe9, 0xd3, 0x6e, 0x12, 0x94, 0x10, 0x51, 0x49, 0x10, 0xbe,
+	0xdb, 0x22, 0xe6, 0x28, 0x40, 0x65, 0xb0, 0x3c, 0x06, 0x7c, 0xd1, 0x0b, 0x11, 0xc5, 0xae, 0x44,
+	0x1c, 0xb8, 0x4e, 0x2b, 0x2a, 0x45, 0x4a, 0xc1, 0xf8, 0x67, 0x70, 0x23, 0x6a, 0xb7, 0x9d, 0x81,
+	0x77, 0x3e, 0x89, 0xbe, 0xc0, 0x58, 0x5a, 0xe8, 0x26, 0xc3, 0x5a, 0xf7, 0x14, 0xa2, 0x7e, 0x11,
+	0x03, 0x2c, 0xd6, 0x88, 0x7d, 0x39, 0xd2, 0x57, 0x73, 0xa9, 0x02, 0xb1, 0x28, 0xae, 0x88, 0xde,
+	0x14, 0xd5, 0x7d, 0xa5, 0xd7, 0xaa, 0x26, 0x3f, 0x00, 0x6e, 0xcd, 0x77, 0x3a, 0xbf, 0x64, 0xa7,
+	0x17, 0xd0, 0xce, 0x86, 0x85, 0x0a, 0xf3, 0x61, 0x21, 0xcc, 0xdb, 0xb2, 0xdd, 0x63, 0xc3, 0x4e,
+	0x5c, 0x63, 0x09, 0x88, 0x66, 0x43, 0x39, 0xfc, 0xce, 0x23, 0x3a, 0x31, 0x71, 0xc4, 0xb1, 0x93,
+	0x5c, 0xb6, 0xf8, 0x0e, 0x26, 0x34, 0xa6, 0xfa, 0x9c, 0x5d, 0xb2, 0xcf, 0x33, 0x74, 0xda, 0x37,
+	0x61, 0x7d, 0x0e, 0x09, 0x27, 0x71, 0x82, 0xe9, 0x62, 0xf2, 0xa5, 0xf4, 0x7b, 0x3e, 0x31, 0x43,
+	0xfb, 0xbd, 0x2c, 0xac, 0xee, 0x19, 0x8e, 0x75, 0x22, 0xfc, 0x20, 0xec, 0xad, 0x3f, 0x18, 0x89,
+	0xb1, 0x11, 0xf6, 0x56, 0xb6, 0x94, 0xe7, 0x2c, 0x9b, 0x8c, 0x49, 0xcd, 0x85, 0x30, 0xaf, 0x43,
+	0x51, 0x5e, 0x60, 0x6a, 0x7b, 0xaa, 0x16, 0xae, 0x9d, 0x6d, 0x0d, 0x84, 0xe3, 0x87, 0x7b, 0x33,
+	0x6c, 0xc6, 0x79, 0x5a, 0xc5, 0x4b, 0xf2, 0xb4, 0x4a, 0xf3, 0xf3, 0x8f, 0xb9, 0x77, 0x03, 0x4f,
+	0x08, 0xc7, 0x1f, 0xb9, 0x41, 0xf8, 0x8d, 0xd0, 0x24, 0x88, 0x52, 0x21, 0xdd, 0xa7, 0x0e, 0x9e,
+	0x50, 0x74, 0xbc, 0xab, 0x6b, 0x34, 0x05, 0xc3, 0x3d, 0x48, 0x7e, 0x43, 0xac, 0xc2, 0x06, 0x19,
+	0x1a, 0x0c, 0xdb, 0xe4, 0x19, 0x34, 0x02, 0x31, 0x74, 0x3d, 0x4b, 0x48, 0xf7, 0x78, 0x45, 0x4f,
+	0x40, 0x90, 0xd6, 0x36, 0x9c, 0xe1, 0x14, 0x3f, 0xd3, 0x22, 0x13, 0x1d, 0xa2, 0xb6, 0xf6, 0xdf,
+	0x0a, 0x00, 0x7b, 0x02, 0x4b, 0x5e, 0xfc, 0x91, 0x35, 0xa1, 0x9b, 0xdc, 0x52, 0x59, 0xdf, 0x35,
+	0x9d, 0x7e, 0x63, 0x56, 0x49, 0xa2, 0x20, 0x63, 0x3e, 0xe0, 0x1e, 0x93, 0xcf, 0xba, 0x15, 0x71,
+	0x72, 0x8c, 0x40, 0xa8, 0x14, 0x39, 0x9a, 0xff, 0xbc, 0x9e, 0x04, 0x61, 0xd7, 0xb0, 0xd9, 0x76,
+	0x4c, 0xe9, 0xb6, 0xcc, 0xeb, 0x51, 0x1b, 0xa9, 0x2d, 0x1f, 0xbf, 0x34, 0xa1, 0x0b, 0x47, 0x3c,
+	0x8d, 0xaa, 0x15, 0x63, 0x10, 0xdf, 0x43, 0xe7, 0xf3, 0x39, 0x7a, 0x29, 0xf6, 0x44, 0x30, 0x72,
+	0xcd, 0x7a, 0x71, 0xa1, 0xb1, 0x9d, 0xe8, 0xe0, 0x41, 0x12, 0x5d, 0x4f, 0x53, 0xe3, 0x9e, 0x70,
+	0x7c, 0x3a, 0x25, 0x72, 0x19, 0x55, 0x0b, 0x43, 0xd6, 0xf2, 0x17, 0x19, 0xe2, 0xe5, 0xc5, 0xde,
+	0x55, 0x63, 0x2c, 0x7c, 0xe1, 0x61, 0xd6, 0x66, 0x88, 0xa9, 0x27, 0xa8, 0x50, 0xea, 0x4d, 0x7d,
+	0xe1, 0xb5, 0xc7, 0x86, 0x65, 0xab, 0x05, 0x8e, 0x01, 0x58, 0xce, 0xee, 0x4f, 0x8f, 0x71, 0xcf,
+	0x1c, 0x8b, 0xbe, 0xbb, 0x2f, 0x9e, 0xfa, 0xb6, 0x08, 0x02, 0xe1, 0xa9, 0x9c, 0x99, 0xc5, 0x0f,
+	0xb5, 0x61, 0xa4, 0x62, 0xd1, 0xf7, 0x68, 0xf0, 0x57, 0x9c, 0x98, 0x17, 0x81, 0x54, 0xd6, 0x22,
+	0xcb, 0x60, 0xea, 0x97, 0x04, 0xa9, 0xa4, 0xc6, 0x2c, 0xff, 0x2a, 0x7c, 0x39, 0x85, 0xa4, 0xcb,
+	0xe4, 0x06, 0x7f, 0xdb, 0x72, 0x0c, 0xdb, 0xfa, 0x81, 0x4c, 0x35, 0xc9, 0x69, 0x13, 0xa8, 0xa5,
+	0x26, 0x8e, 0xca, 0x6b, 0xe9, 0x97, 0xca, 0xec, 0x62, 0xb0, 0x2a, 0xdb, 0xf8, 0x55, 0x1c, 0x8a,
+	0xda, 0x45, 0x90, 0x16, 0x9e, 0x73, 0x4c, 0x6b, 0xb9, 0x06, 0x4c, 0x42, 0x3a, 0x8e, 0x31, 0x99,
+	0x34, 0x27, 0x13, 0x1b, 0x83, 0xb2, 0x58, 0xba, 0x1c, 0x43, 0x65, 0x59, 0x06, 0xcb, 0x6b, 0xdf,
+	0x85, 0x1b, 0x34, 0x33, 0x87, 0xc2, 0x8b, 0xfc, 0x24, 0x6a, 0xac, 0x2f, 0xc0, 0xba, 0xfc, 0xb5,
+	0xef, 0x06, 0xf2, 0x31, 0x29, 0x96, 0x1c, 0xd6, 0x24, 0x18, 0x75, 0x9d, 0x9e, 0xa0, 0x82, 0xe4,
+	0x08, 0x16, 0xe1, 0x65, 0xb5, 0x9f, 0x16, 0x81, 0xc7, 0x1b, 0xa2, 0x6f, 0x61, 0xb1, 0x74, 0x60,
+	0x24, 0xbc, 0xed, 0xb5, 0x0b, 0xf3, 0x45, 0x9e, 0x9d, 0x93, 0x79, 0x1d, 0x8a, 0x96, 0x8f, 0x96,
+	0xbd, 0x4a, 0xb7, 0x56, 0x2d, 0xbe, 0x0b, 0x30, 0x11, 0x9e, 0xe5, 0x9a, 0xb4, 0x83, 0x0a, 0x0b,
+	0xeb, 0x62, 0xe6, 0x3b, 0xb5, 0x71, 0x10, 0xd1, 0xe8, 0x09, 0x7a, 0xec, 0x87, 0x6c, 0xc9, 0xec,
+	0x8b, 0x22, 0x75, 0x3a, 0x09, 0xc2, 0x8f, 0x13, 0x4c, 0x3c, 0x6b, 0x20, 0xe4, 0x72, 0x3c, 0xf2,
+	0xcd, 0x16, 0x7d, 0xc5, 0xb1, 0x44, 0x98, 0x8b, 0x1e, 0xe1, 0x0e, 0x34, 0x1c, 0xb2, 0x77, 0x7d,
+	0xca, 0x37, 0x50, 0x25, 0xfc, 0x32, 0x21, 0xb9, 0xa6, 0x2f, 0x7e, 0x88, 0x49, 0x15, 0xea, 0xc1,
+	0x9e, 0xe5, 0xec, 0x0a, 0x67, 0x18, 0x8c, 0x68, 0x73, 0xd7, 0xf4, 0x39, 0x38, 0x49, 0x30, 0xf9,
+	0xad, 0x2c, 0x19, 0x8b, 0xac, 0xe8, 0x51, 0x9b, 0xd3, 0x67, 0x21, 0x6c, 0xd7, 0xeb, 0x05, 0x9e,
+	0xca, 0xac, 0x8e, 0xda, 0xa8, 0xb3, 0xf8, 0xd4, 0xd7, 0x03, 0xcf, 0x35, 0xa7, 0x64, 0x13, 0x49,
+	0x21, 0x36, 0x0b, 0x8e, 0x31, 0xf7, 0x0c, 0x47, 0x25, 0xc6, 0xd6, 0x92, 0x98, 0x11, 0x98, 0x4c,
+	0x7a, 0xd7, 0x8f, 0x19, 0x5e, 0x51, 0x26, 0x7d, 0x02, 0xa6, 0x70, 0x62, 0x56, 0x2c, 0xc2, 0x89,
+	0xf9, 0xd0, 0xf8, 0x4d, 0xcf, 0xb5, 0xcc, 0x98, 0xd7, 0x3a, 0xe1, 0xcd, 0xc1, 0x13, 0xb8, 0x31,
+	0x4f, 0x9e, 0xc2, 0x8d, 0xe0, 0xda, 0x8f, 0x32, 0x00, 0xf1, 0xe2, 0xe3, 0x96, 0x8f, 0x5b, 0xf1,
+	0x11, 0xbf, 0x01, 0x57, 0x93, 0x60, 0x5b, 0x25, 0x37, 0xd3, 0xbe, 0x8f, 0x1f, 0x60, 0x21, 0x23,
+	0xcb, 0xaa, 0x22, 0x7a, 0x05, 0xc3, 0x9a, 0x49, 0xcc, 0x14, 0xbd, 0x06, 0x2c, 0x06, 0x52, 0x65,
+	0x24, 0xa6, 0x8c, 0xa6, 0x50, 0x3f, 0x15, 0x86, 0xe7, 0xb3, 0x82, 0xb6, 0x83, 0xb9, 0xa7, 0x01,
+	0x0a, 0xab, 0xf9, 0x54, 0x87, 0xe7, 0xcb, 0x5b, 0xfa, 0x0b, 0x19, 0x8c, 0xbd, 0x52, 0x7e, 0x3b,
+	0xde, 0xe2, 0x0b, 0x32, 0x48, 0x16, 0x69, 0x54, 0x86, 0x69, 0x52, 0x9d, 0x40, 0x2e, 0xfa, 0x02,
+	0x13, 0x36, 0xc9, 0x6c, 0x0d, 0xb3, 0x01, 0xe5, 0x99, 0x8b, 0xda, 0xf2, 0x02, 0x69, 0xb9, 0x8e,
+	0x23, 0x06, 0x78, 0xfd, 0x44, 0x17, 0x48, 0x04, 0xd2, 0xfe, 0x75, 0x09, 0xaa, 0x58, 0x0d, 0xb4,
+	0x27, 0xcd, 0xe0, 0xb9, 0xbe, 0xd4, 0xa1, 0xe4, 0x7a, 0xa6, 0xf0, 0xe2, 0xea, 0x46, 0xd5, 0x4c,
+	0xe6, 0xcd, 0xe4, 0xd2, 0x79, 0x33, 0x37, 0xa1, 0x32, 0x90, 0xe6, 0x6e, 0x53, 0x8a, 0x81, 0x9c,
+	0x1e, 0x03, 0xf0, 0xae, 0x1e, 0xbb, 0x26, 0x09, 0xa3, 0xa6, 0x34, 0x98, 0x73, 0x7a, 0x02, 0x22,
+	0xd3, 0x94, 0x26, 0xf6, 0x79, 0xdf, 0xdd, 0x8b, 0x6c, 0xf5, 0xa8, 0x14, 0x3c, 0x0d, 0xe7, 0x2d,
+	0xf4, 0xeb, 0x53, 0xa3, 0x5e, 0x5c, 0x18, 0xc6, 0x4a, 0x0c, 0x6d, 0x43, 0xfd, 0x55, 0xd5, 0x58,
+	0x7a, 0x48, 0x89, 0x1e, 0x1f, 0x23, 0x08, 0x8c, 0xc1, 0x68, 0xac, 0x44, 0x44, 0x6e, 0x41, 0x9c,
+	0x3e, 0xc9, 0xa8, 0x19, 0x61, 0xeb, 0x49, 0x4a, 0xbe, 0x89, 0xe1, 0x6a, 0x23, 0x95, 0x2a, 0xf0,
+	0xf2, 0x25, 0x6c, 0xf4, 0x10, 0x57, 0x8f, 0xc9, 0x1a, 0x3f, 0xc9, 0xc0, 0x5a, 0xba, 0xa3, 0x7f,
+	0x1c, 0x1f, 0xd1, 0xfb, 0x76, 0xfc, 0x11, 0xbd, 0x2f, 0xf0, 0x41, 0xba, 0xdf, 0xcc, 0x00, 0xc4,
+	0x73, 0x80, 0x22, 0x5f, 0x7e, 0xec, 0x2b, 0x54, 0x42, 0x65, 0x8b, 0xef, 0xa4, 0xbe, 0x10, 0xf1,
+	0xd6, 0x52, 0x13, 0x9a, 0xf8, 0x99, 0xc8, 0xbb, 0xbf, 0x07, 0x6b, 0x69, 0x38, 0xd5, 0x2b, 0x74,
+	0x76, 0xdb, 0xd2, 0x9d, 0xd2, 0xd9, 0x6b, 0x3e, 0x68, 0xab, 0xea, 0xb7, 0xce, 0xfe, 0x43, 0x96,
+	0x6d, 0xfc, 0x41, 0x06, 0x73, 0x88, 0xd4, 0x9c, 0xf2, 0x4f, 0x92, 0xeb, 0x22, 0x73, 0x7f, 0xde,
+	0x5c, 0x66, 0x5d, 0xe2, 0x5f, 0x6d, 0x27, 0xf0, 0xce, 0x93, 0xcb, 0xe4, 0xa2, 0x57, 0x38, 0xf9,
+	0x70, 0x81, 0x4c, 0x78, 0x90, 0x96, 0x09, 0x6f, 0x2c, 0xf5, 0xca, 0xd0, 0xf2, 0xc2, 0x14, 0x54,
+	0x25, 0x2e, 0xde, 0xcf, 0xbe, 0x97, 0x69, 0xdc, 0x86, 0xd5, 0xe4, 0xa3, 0xf9, 0x12, 0xd7, 0xbb,
+	0x7f, 0x90, 0x83, 0xb5, 0x74, 0xfa, 0x0c, 0x15, 0xd4, 0xc9, 0xd4, 0xad, 0xae, 0x6d, 0x26, 0x4a,
+	0x15, 0x18, 0xe6, 0x98, 0x2a, 0xdb, 0x8e, 0x00, 0xeb, 0xe4, 0x7c, 0x71, 0xc7, 0x82, 0xdd, 0x4e,
+	0x7e, 0x28, 0xf4, 0x75, 0xf4, 0xaf, 0xc8, 0xaa, 0x45, 0x36, 0xe1, 0x15, 0xf5, 0xc9, 0xb4, 0x1f,
+	0x66, 0x79, 0x2d, 0x91, 0x30, 0xff, 0x63, 0x54, 0x6c, 0xae, 0x6c, 0x4e, 0x1d, 0xd3, 0x16, 0x66,
+	0x04, 0xfd, 0x49, 0x12, 0x1a, 0x65, 0xbc, 0xff, 0x10, 0xfd, 0x4b, 0x95, 0xde, 0xf4, 0x58, 0x65,
+	0xbb, 0xff, 0x99, 0x3c, 0xbf, 0x0e, 0xeb, 0x0a, 0x2b, 0x4e, 0x5b, 0x65, 0x7f, 0x16, 0x45, 0xf0,
+	0x5a, 0x53, 0xce, 0x97, 0xea, 0x28, 0xfb, 0x73, 0x58, 0x72, 0x48, 0x05, 0xba, 0xec, 0xcf, 0x13,
+	0x9f, 0xa8, 0xde, 0x88, 0xfd, 0x2a, 0x16, 0xc7, 0x43, 0xaf, 0x1f, 0xbd, 0xe8, 0xd7, 0xf3, 0xbc,
+	0x0a, 0xc5, 0x5e, 0x9f, 0xb8, 0xfd, 0x28, 0xcf, 0x5f, 0x00, 0x16, 0x3f, 0x55, 0xc9, 0xbc, 0x7f,
+	0x49, 0x76, 0x26, 0xca, 0xce, 0xfd, 0xcb, 0x79, 0x1c, 0x57, 0x38, 0xcb, 0xec, 0xaf, 0xe0, 0xf7,
+	0x74, 0xab, 0x09, 0x4f, 0x2f, 0xfb, 0xab, 0xf8, 0x05, 0x82, 0xda, 0x1e, 0x3a, 0x78, 0x9d, 0xa1,
+	0x1a, 0xc1, 0xaf, 0xd1, 0x9b, 0xb7, 0xa3, 0x92, 0x29, 0xf6, 0x1b, 0x79, 0x7e, 0x03, 0x78, 0x32,
+	0xba, 0xa5, 0x1e, 0xfc, 0x35, 0xa2, 0x96, 0x62, 0xdf, 0x57, 0xb0, 0xbf, 0x4e, 0xd4, 0xb8, 0x13,
+	0x14, 0xe0, 0x6f, 0xd0, 0x84, 0xb4, 0xe2, 0xf4, 0x5f, 0x05, 0xff, 0x31, 0x11, 0x87, 0x8b, 0x29,
+	0x61, 0x3f, 0xc9, 0xdf, 0xfd, 0x29, 0x45, 0x27, 0x92, 0x59, 0x74, 0xe8, 0x29, 0xb3, 0x5d, 0x67,
+	0x18, 0xc8, 0x0f, 0xb4, 0x62, 0xfa, 0xf1, 0xc8, 0xf5, 0x02, 0x6a, 0x52, 0x4d, 0xa7, 0x43, 0xd5,
+	0xfd, 0xb2, 0x5e, 0x42, 0x1a, 0x29, 0x2c, 0x17, 0x66, 0x18, 0x57, 0xa3, 0xc4, 0xe5, 0x7c, 0x94,
+	0x5c, 0x4d, 0x5f, 0x19, 0x08, 0xab, 0xb8, 0xa5, 0x17, 0x70, 0xea, 0xd9, 0x32, 0xc9, 0x5a, 0xa0,
+	0x82, 0x2a, 0xbf, 0xc4, 0x38, 0x19, 0xb9, 0x8e, 0xca, 0xb2, 0x16, 0xf4, 0x51, 0x46, 0x48, 0xe4,
+	0x2c, 0x9a, 0xd8, 0x8f, 0x28, 0x2d, 0x87, 0x89, 0xbb, 0x7f, 0x33, 0x03, 0xab, 0x61, 0x6d, 0x3d,
+	0xfe, 0x6f, 0x06, 0x99, 0xa6, 0x1d, 0x7e, 0xf6, 0x76, 0x60, 0x5b, 0x93, 0xf0, 0x33, 0x92, 0x57,
+	0xa0, 0x8a, 0x1f, 0x63, 0x6e, 0x3a, 0xe6, 0x96, 0xe7, 0x4e, 0x64, 0xb7, 0x65, 0xfc, 0x52, 0xa6,
+	0x87, 0x3f, 0x15, 0xc7, 0x88, 0x3e, 0x11, 0xf8, 0x6d, 0x28, 0xcc, 0x87, 0x1c, 0x19, 0x9e, 0xe5,
+	0x0c, 0xd1, 0xbd, 0xe8, 0xf8, 0x32, 0x4d, 0xbc, 0x0a, 0xa5, 0xa9, 0x2f, 0x06, 0x86, 0x8f, 0x99,
+	0xe2, 0x55, 0x28, 0x1d, 0x4f, 0x2d, 0x3b, 0xb0, 0x1c, 0x56, 0x4a, 0xe5, 0x81, 0x97, 0x71, 0x64,
+	0xc6, 0xc4, 0x62, 0x95, 0xbb, 0xbf, 0x9b, 0x81, 0x2a, 0x6d, 0x8b, 0xd8, 0x7d, 0x1b, 0xab, 0x1c,
+	0x58, 0x43, 0x15, 0x7d, 0xc6, 0x0f, 0xbf, 0x74, 0xf1, 0x44, 0xba, 0x6f, 0xd5, 0xb6, 0x90, 0x25,
+	0xb2, 0xf2, 0x8b, 0x7e, 0x79, 0xfe, 0x22, 0xbc, 0x80, 0x21, 0x98, 0x40, 0x3c, 0x36, 0xac, 0x20,
+	0x59, 0x38, 0x55, 0x40, 0xeb, 0x44, 0x3e, 0x0a, 0x2b, 0xa5, 0x8a, 0x64, 0x9d, 0xe0, 0x6b, 0x43,
+	0x48, 0x09, 0x47, 0x4f, 0x10, 0x65, 0xae, 0x94, 0x23, 0x14, 0x8c, 0xef, 0xe1, 0xdb, 0xa8, 0x30,
+	0x9b, 0x20, 0x14, 0xea, 0x41, 0x10, 0xdc, 0xdd, 0x87, 0xeb, 0x8b, 0x03, 0x14, 0xb2, 0x64, 0x9b,
+	0xbe, 0x1d, 0x4d, 0xce, 0xe4, 0xc7, 0x9e, 0x25, 0x2b, 0x6f, 0x2b, 0x50, 0xe8, 0x3e, 0x75, 0x68,
+	0x5b, 0xac, 0x43, 0x6d, 0xdf, 0x4d, 0xd0, 0xb0, 0xdc, 0xdd, 0x41, 0x2a, 0xa6, 0x14, 0x4f, 0x4a,
+	0xd8, 0x89, 0x95, 0x44, 0x99, 0x58, 0x46, 0xba, 0xb2, 0xe9, 0xdf, 0x7f, 0xc8, 0xcf, 0x59, 0xa8,
+	0x58, 0x8e, 0x29, 0x3f, 0x67, 0x11, 0x75, 0x93, 0x92, 0xf9, 0x5b, 0x86, 0x33, 0x10, 0xb6, 0x30,
+	0x59, 0xe1, 0xee, 0x7b, 0x70, 0x45, 0x0d, 0x15, 0x43, 0xab, 0x61, 0x99, 0xd5, 0x81, 0x67, 0x9d,
+	0xca, 0x4f, 0x66, 0xa0, 0x07, 0x5b, 0x78, 0xbe, 0xeb, 0xd0, 0xe7, 0x42, 0xd0, 0x13, 0x3e, 0x32,
+	0x3c, 0x7c, 0xc7, 0xdd, 0x16, 0x54, 0xa8, 0xec, 0xea, 0xa1, 0xe5, 0x98, 0x38, 0x92, 0x4d, 0x55,
+	0x5c, 0x40, 0xdf, 0x65, 0x3a, 0xa5, 0xf1, 0x95, 0xe5, 0x17, 0x6c, 0x59, 0x16, 0xbd, 0xb5, 0x68,
+	0x3d, 0x8f, 0x0d, 0x2a, 0x02, 0xb6, 0xcf, 0xe5, 0xd7, 0x8e, 0x73, 0x77, 0x3f, 0x02, 0x2e, 0x7d,
+	0x40, 0xa6, 0x38, 0xb3, 0x9c, 0x61, 0xf4, 0x7d, 0x01, 0xa0, 0x8f, 0x85, 0x98, 0xe2, 0x2c, 0xac,
+	0x99, 0x0b, 0x1b, 0xe1, 0x27, 0x4b, 0xb6, 0xb1, 0xae, 0x9e, 0x65, 0xef, 0x1e, 0xc2, 0x35, 0xb9,
+	0x67, 0xb0, 0x5b, 0x54, 0x61, 0x7a, 0xa1, 0x61, 0x2a, 0x6b, 0xe6, 0x82, 0xa9, 0x1f, 0xe1, 0xb2,
+	0x0c, 0x76, 0x2c, 0x32, 0xea, 0x62, 0x78, 0xf6, 0xae, 0x06, 0x57, 0x17, 0x58, 0xd6, 0x24, 0xa5,
+	0xa5, 0x7d, 0xc1, 0x56, 0xee, 0x7e, 0x08, 0xeb, 0x52, 0xae, 0xec, 0xcb, 0x1a, 0xc0, 0xf0, 0x8a,
+	0x7c, 0xdc, 0xd9, 0xee, 0xc8, 0xa9, 0x6b, 0xb5, 0x77, 0x77, 0x1f, 0xed, 0x36, 0xd1, 0xcf, 0x8d,
+	0x0b, 0xdc, 0xed, 0x1f, 0xb5, 0xba, 0xfb, 0xfb, 0xed, 0x56, 0xbf, 0xbd, 0xc5, 0xb2, 0x9b, 0x77,
+	0xff, 0xcd, 0xe7, 0xb7, 0x32, 0x3f, 0xfb, 0xfc, 0x56, 0xe6, 0x3f, 0x7d, 0x7e, 0x2b, 0xf3, 0xa3,
+	0x9f, 0xdf, 0x5a, 0xf9, 0xd9, 0xcf, 0x6f, 0xad, 0xfc, 0xfe, 0xcf, 0x6f, 0xad, 0x7c, 0xc6, 0x66,
+	0xff, 0x25, 0xcf, 0x71, 0x91, 0x54, 0xda, 0x37, 0xff, 0xf7, 0x00, 0x4a, 0x18, 0xda, 0x86, 0xad,
+	0x67, 0x00, 0x00,
 }
 
 func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
@@ -14703,6 +14976,52 @@ func (m *NotificationPayloadOfParticipantPermissionsChange) MarshalToSizedBuffer
 	}
 	return len(dAtA) - i, nil
 }
+func (m *NotificationPayloadOfReminder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPayloadOfReminder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reminder != nil {
+		{
+			size, err := m.Reminder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NotificationPayloadOfMention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPayloadOfMention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Mention != nil {
+		{
+			size, err := m.Mention.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *NotificationImport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15171,7 +15490,7 @@ func (m *NotificationParticipantPermissionsChange) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *Export) Marshal() (dAtA []byte, err error) {
+func (m *NotificationReminder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15181,43 +15500,67 @@ func (m *Export) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Export) MarshalTo(dAtA []byte) (int, error) {
+func (m *NotificationReminder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Export) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NotificationReminder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *Import) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Date != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RelationName) > 0 {
+		i -= len(m.RelationName)
+		copy(dAtA[i:], m.RelationName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Import) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Import) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *Invite) Marshal() (dAtA []byte, err error) {
+func (m *NotificationMention) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -15227,25 +15570,157 @@ func (m *Invite) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Invite) MarshalTo(dAtA []byte) (int, error) {
+func (m *NotificationMention) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Invite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NotificationMention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Signature)))
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
+	if len(m.AuthorName) > 0 {
+		i -= len(m.AuthorName)
+		copy(dAtA[i:], m.AuthorName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.AuthorName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.AuthorId) > 0 {
+		i -= len(m.AuthorId)
+		copy(dAtA[i:], m.AuthorId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.AuthorId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockId) > 0 {
+		i -= len(m.BlockId)
+		copy(dAtA[i:], m.BlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BlockId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Export) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Export) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Export) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Import) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Import) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Import) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Invite) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Invite) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Invite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
 		copy(dAtA[i:], m.Payload)
 		i = encodeVarintModels(dAtA, i, uint64(len(m.Payload)))
 		i--
@@ -18263,6 +18738,30 @@ func (m *NotificationPayloadOfParticipantPermissionsChange) Size() (n int) {
 	}
 	return n
 }
+func (m *NotificationPayloadOfReminder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reminder != nil {
+		l = m.Reminder.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *NotificationPayloadOfMention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mention != nil {
+		l = m.Mention.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
 func (m *NotificationImport) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18490,6 +18989,87 @@ func (m *NotificationParticipantPermissionsChange) Size() (n int) {
 	return n
 }
 
+func (m *NotificationReminder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Date != 0 {
+		n += 1 + sovModels(uint64(m.Date))
+	}
+	return n
+}
+
+func (m *NotificationMention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.AuthorId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.AuthorName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
 func (m *Export) Size() (n int) {
 	if m == nil {
 		return 0
@@ -30935,36 +31515,106 @@ func (m *Notification) Unmarshal(dAtA []byte) error {
 			}
 			m.Payload = &NotificationPayloadOfParticipantPermissionsChange{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reminder", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationImport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NotificationReminder{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &NotificationPayloadOfReminder{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NotificationMention{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &NotificationPayloadOfMention{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationImport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -32517,6 +33167,605 @@ func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *NotificationReminder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reminder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reminder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			m.Date = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Date |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationMention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Export) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
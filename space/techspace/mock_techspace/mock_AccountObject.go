@@ -1862,6 +1862,52 @@ func (_c *MockAccountObject_ResetToVersion_Call) RunAndReturn(run func(*state.St
 	return _c
 }
 
+// RestoreVersion provides a mock function with given fields: s
+func (_m *MockAccountObject) RestoreVersion(s *state.State) error {
+	ret := _m.Called(s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreVersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*state.State) error); ok {
+		r0 = rf(s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAccountObject_RestoreVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreVersion'
+type MockAccountObject_RestoreVersion_Call struct {
+	*mock.Call
+}
+
+// RestoreVersion is a helper method to define mock.On call
+//   - s *state.State
+func (_e *MockAccountObject_Expecter) RestoreVersion(s interface{}) *MockAccountObject_RestoreVersion_Call {
+	return &MockAccountObject_RestoreVersion_Call{Call: _e.mock.On("RestoreVersion", s)}
+}
+
+func (_c *MockAccountObject_RestoreVersion_Call) Run(run func(s *state.State)) *MockAccountObject_RestoreVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*state.State))
+	})
+	return _c
+}
+
+func (_c *MockAccountObject_RestoreVersion_Call) Return(err error) *MockAccountObject_RestoreVersion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAccountObject_RestoreVersion_Call) RunAndReturn(run func(*state.State) error) *MockAccountObject_RestoreVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Restrictions provides a mock function with given fields:
 func (_m *MockAccountObject) Restrictions() restriction.Restrictions {
 	ret := _m.Called()
@@ -46,6 +46,22 @@ func StructEqualIgnore(det1 *types.Struct, det2 *types.Struct, excludeKeys []str
 	return true
 }
 
+// StructDiffKeys returns sorted keys of top-level fields that are set in only one of structs or have different values
+func StructDiffKeys(st1, st2 *types.Struct) (keys []string) {
+	for key, v1 := range st1.GetFields() {
+		if v2, ok := st2.GetFields()[key]; !ok || !v1.Equal(v2) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range st2.GetFields() {
+		if _, ok := st1.GetFields()[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // StructFilterKeys returns provided keys reusing underlying pb values pointers
 func StructFilterKeys(st *types.Struct, filteredKeys []string) *types.Struct {
 	return StructFilterKeysFunc(st, func(key string, _ *types.Value) bool {
@@ -76,3 +76,23 @@ func TestStructMerge(t *testing.T) {
 		require.Len(t, gotWithCopy.Fields, 1)
 	})
 }
+
+func TestStructDiffKeys(t *testing.T) {
+	t.Run("nil structs", func(t *testing.T) {
+		require.Empty(t, StructDiffKeys(nil, nil))
+	})
+
+	t.Run("changed, added and removed keys", func(t *testing.T) {
+		st1 := &types.Struct{Fields: map[string]*types.Value{
+			"same":    String("a"),
+			"changed": StringList([]string{"1", "2"}),
+			"removed": Bool(true),
+		}}
+		st2 := &types.Struct{Fields: map[string]*types.Value{
+			"same":    String("a"),
+			"changed": StringList([]string{"1"}),
+			"added":   Int64(1),
+		}}
+		require.Equal(t, []string{"added", "changed", "removed"}, StructDiffKeys(st1, st2))
+	})
+}
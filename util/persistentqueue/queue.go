@@ -270,11 +270,12 @@ func (q *Queue[T]) Add(item T) error {
 	q.set[item.Key()] = struct{}{}
 	q.lock.Unlock()
 
-	err = q.batcher.Add(q.ctx, item)
+	// the item is stored before it's queued, so the handler doesn't change it while it's being stored
+	err = q.storage.Put(item)
 	if err != nil {
 		return err
 	}
-	return q.storage.Put(item)
+	return q.batcher.Add(q.ctx, item)
 }
 
 // Has returns true if item with specific key is in queue